@@ -24,10 +24,19 @@ import (
 	"github.com/odpf/siren/pkg/secret"
 	"github.com/odpf/siren/pkg/telemetry"
 	"github.com/odpf/siren/plugins/providers/cortex"
+	"github.com/odpf/siren/plugins/providers/loki"
+	"github.com/odpf/siren/plugins/providers/mimir"
+	"github.com/odpf/siren/plugins/providers/prometheus"
+	"github.com/odpf/siren/plugins/providers/webhook"
+	"github.com/odpf/siren/plugins/receivers/discord"
+	"github.com/odpf/siren/plugins/receivers/email"
 	"github.com/odpf/siren/plugins/receivers/file"
 	"github.com/odpf/siren/plugins/receivers/httpreceiver"
+	"github.com/odpf/siren/plugins/receivers/msteams"
+	"github.com/odpf/siren/plugins/receivers/opsgenie"
 	"github.com/odpf/siren/plugins/receivers/pagerduty"
 	"github.com/odpf/siren/plugins/receivers/slack"
+	"github.com/odpf/siren/plugins/receivers/telegram"
 )
 
 func InitDeps(
@@ -66,24 +75,38 @@ func InitDeps(
 	templateService := template.NewService(templateRepository)
 
 	providerRepository := postgres.NewProviderRepository(pgClient)
-	providerService := provider.NewService(providerRepository)
+	providerService := provider.NewService(providerRepository, map[string]provider.ConfigValidator{
+		provider.TypeWebhook: webhook.ValidateConfig,
+	})
 
 	logRepository := postgres.NewLogRepository(pgClient)
 	logService := log.NewService(logRepository)
 
 	cortexPluginService := cortex.NewPluginService(logger, cfg.Providers.Cortex)
+	prometheusPluginService := prometheus.NewPluginService(logger, cfg.Providers.Prometheus)
+	mimirPluginService := mimir.NewPluginService(logger, cfg.Providers.Mimir)
+	lokiPluginService := loki.NewPluginService(logger, cfg.Providers.Loki)
+	webhookPluginService := webhook.NewPluginService(logger, providerService)
 	alertRepository := postgres.NewAlertRepository(pgClient)
 	alertService := alert.NewService(
 		alertRepository,
 		logService,
 		map[string]alert.AlertTransformer{
-			provider.TypeCortex: cortexPluginService,
+			provider.TypeCortex:     cortexPluginService,
+			provider.TypePrometheus: prometheusPluginService,
+			provider.TypeMimir:      mimirPluginService,
+			provider.TypeLoki:       lokiPluginService,
+			provider.TypeWebhook:    webhookPluginService,
 		},
 	)
 
 	namespaceRepository := postgres.NewNamespaceRepository(pgClient)
 	namespaceService := namespace.NewService(encryptor, namespaceRepository, providerService, map[string]namespace.ConfigSyncer{
-		provider.TypeCortex: cortexPluginService,
+		provider.TypeCortex:     cortexPluginService,
+		provider.TypePrometheus: prometheusPluginService,
+		provider.TypeMimir:      mimirPluginService,
+		provider.TypeLoki:       lokiPluginService,
+		provider.TypeWebhook:    webhookPluginService,
 	})
 
 	ruleRepository := postgres.NewRuleRepository(pgClient)
@@ -92,7 +115,10 @@ func InitDeps(
 		templateService,
 		namespaceService,
 		map[string]rule.RuleUploader{
-			provider.TypeCortex: cortexPluginService,
+			provider.TypeCortex:     cortexPluginService,
+			provider.TypePrometheus: prometheusPluginService,
+			provider.TypeMimir:      mimirPluginService,
+			provider.TypeLoki:       lokiPluginService,
 		},
 	)
 
@@ -100,10 +126,17 @@ func InitDeps(
 	silenceService := silence.NewService(silenceRepository)
 
 	// plugin receiver services
-	slackPluginService := slack.NewPluginService(cfg.Receivers.Slack, encryptor)
-	pagerDutyPluginService := pagerduty.NewPluginService(cfg.Receivers.Pagerduty)
-	httpreceiverPluginService := httpreceiver.NewPluginService(logger, cfg.Receivers.HTTPReceiver)
+	slackPluginService := slack.NewPluginService(cfg.Receivers.Slack, encryptor,
+		slack.WithThreadRepository(postgres.NewThreadRepository(pgClient)))
+	pagerDutyPluginService := pagerduty.NewPluginService(logger, cfg.Receivers.Pagerduty,
+		pagerduty.WithCorrelationRepository(postgres.NewCorrelationRepository(pgClient)))
+	httpreceiverPluginService := httpreceiver.NewPluginService(logger, cfg.Receivers.HTTPReceiver, encryptor)
 	filePluginService := file.NewPluginService()
+	msteamsPluginService := msteams.NewPluginService(cfg.Receivers.MSTeams, encryptor)
+	emailPluginService := email.NewPluginService(cfg.Receivers.Email, encryptor)
+	opsgeniePluginService := opsgenie.NewPluginService(cfg.Receivers.Opsgenie)
+	telegramPluginService := telegram.NewPluginService(cfg.Receivers.Telegram, encryptor)
+	discordPluginService := discord.NewPluginService(cfg.Receivers.Discord, encryptor)
 
 	receiverRepository := postgres.NewReceiverRepository(pgClient)
 	receiverService := receiver.NewService(
@@ -113,6 +146,11 @@ func InitDeps(
 			receiver.TypeHTTP:      httpreceiverPluginService,
 			receiver.TypePagerDuty: pagerDutyPluginService,
 			receiver.TypeFile:      filePluginService,
+			receiver.TypeMSTeams:   msteamsPluginService,
+			receiver.TypeEmail:     emailPluginService,
+			receiver.TypeOpsgenie:  opsgeniePluginService,
+			receiver.TypeTelegram:  telegramPluginService,
+			receiver.TypeDiscord:   discordPluginService,
 		},
 	)
 
@@ -130,10 +168,21 @@ func InitDeps(
 		receiver.TypePagerDuty: pagerDutyPluginService,
 		receiver.TypeHTTP:      httpreceiverPluginService,
 		receiver.TypeFile:      filePluginService,
+		receiver.TypeMSTeams:   msteamsPluginService,
+		receiver.TypeEmail:     emailPluginService,
+		receiver.TypeOpsgenie:  opsgeniePluginService,
+		receiver.TypeTelegram:  telegramPluginService,
+		receiver.TypeDiscord:   discordPluginService,
 	}
 
 	idempotencyRepository := postgres.NewIdempotencyRepository(pgClient)
 	notificationRepository := postgres.NewNotificationRepository(pgClient)
+
+	var grouper notification.MessageGrouper
+	if cfg.Notification.Group.Enabled && queue != nil {
+		grouper = notification.NewGrouper(cfg.Notification.Group, logger, postgres.NewGroupRepository(pgClient), queue)
+	}
+
 	notificationService := notification.NewService(
 		logger,
 		notificationRepository,
@@ -146,6 +195,7 @@ func InitDeps(
 			SubscriptionService:   subscriptionService,
 			SilenceService:        silenceService,
 			AlertService:          alertService,
+			Grouper:               grouper,
 		},
 	)
 
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
@@ -31,6 +32,8 @@ func jobCmd(cmdxConfig *cmdx.Config) *cobra.Command {
 		Example: heredoc.Doc(`
 			$ siren job run cleanup_idempotency
 			$ siren job run cleanup_queue
+			$ siren job run expire_silences
+			$ siren job run reconcile_rules
 		`),
 	}
 
@@ -52,16 +55,22 @@ func jobRunCommand(cmdxConfig *cmdx.Config) *cobra.Command {
 		ValidArgs: []string{
 			"cleanup_queue",
 			"cleanup_idempotency",
+			"expire_silences",
+			"reconcile_rules",
 		},
 		Example: heredoc.Doc(`
 			$ siren job run cleanup_idempotency
 			$ siren job run cleanup_queue
+			$ siren job run expire_silences
+			$ siren job run reconcile_rules
 		`),
 	}
 
 	cmd.AddCommand(
 		jobRunCleanupQueueCommand(),
 		jobRunCleanupIdempotencyCommand(),
+		jobRunExpireSilencesCommand(),
+		jobRunReconcileRulesCommand(),
 	)
 
 	return cmd
@@ -169,7 +178,7 @@ func jobRunCleanupIdempotencyCommand() *cobra.Command {
 				return err
 			}
 
-			jobHandler := jobs.NewHandler(logger, apiDeps.NotificationService)
+			jobHandler := jobs.NewHandler(logger, apiDeps.NotificationService, apiDeps.SilenceService, apiDeps.NamespaceService, apiDeps.RuleService)
 			if err := jobHandler.CleanupIdempotencies(cmd.Context(), ttlInTimeDuration); err != nil {
 				spinner.Stop()
 				if errors.Is(err, errors.ErrNotFound) {
@@ -197,3 +206,147 @@ func jobRunCleanupIdempotencyCommand() *cobra.Command {
 
 	return cmd
 }
+
+func jobRunExpireSilencesCommand() *cobra.Command {
+	var configFile string
+
+	cmd := &cobra.Command{
+		Use:   "expire_silences",
+		Short: "Expire silences that passed their end time",
+		Long: heredoc.Doc(`
+			Marking all silences with ends_at earlier than now() as expired.
+		`),
+		Example: heredoc.Doc(`
+			$ siren job run expire_silences
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := config.Load(configFile)
+			if err != nil {
+				return err
+			}
+
+			logger := initLogger(cfg.Log)
+
+			apiDeps, _, pgClient, _, err := InitDeps(cmd.Context(), logger, cfg, nil)
+			if err != nil {
+				return err
+			}
+
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+			printer.Infof("Running job expire_silences\n")
+
+			jobHandler := jobs.NewHandler(logger, apiDeps.NotificationService, apiDeps.SilenceService, apiDeps.NamespaceService, apiDeps.RuleService)
+			if err := jobHandler.ExpireSilences(cmd.Context()); err != nil {
+				spinner.Stop()
+				if errors.Is(err, errors.ErrNotFound) {
+					printer.Success("No lapsed silences found")
+				} else {
+					logger.Error(err.Error())
+				}
+			} else {
+				spinner.Stop()
+				printer.Success("Job expire_silences finished")
+				printer.Space()
+				printer.SuccessIcon()
+			}
+
+			if err := pgClient.Close(); err != nil {
+				logger.Error(err.Error())
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&configFile, "config", "c", "config.yaml", "Config file path")
+
+	return cmd
+}
+
+func jobRunReconcileRulesCommand() *cobra.Command {
+	var (
+		configFile string
+		repush     bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "reconcile_rules",
+		Short: "Detect drift of rules between siren and providers",
+		Long: heredoc.Doc(`
+			Comparing rules of every namespace stored in siren with rules in the provider
+			and reporting missing, extra and modified rules.
+
+			(Optional) re-uploading siren version of the drifted rules.
+			Rules in the provider that are not managed by siren are only reported.
+		`),
+		Example: heredoc.Doc(`
+			$ siren job run reconcile_rules
+			$ siren job run reconcile_rules --repush
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := config.Load(configFile)
+			if err != nil {
+				return err
+			}
+
+			logger := initLogger(cfg.Log)
+
+			apiDeps, _, pgClient, _, err := InitDeps(cmd.Context(), logger, cfg, nil)
+			if err != nil {
+				return err
+			}
+
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+			printer.Infof("Running job reconcile_rules\n")
+
+			jobHandler := jobs.NewHandler(logger, apiDeps.NotificationService, apiDeps.SilenceService, apiDeps.NamespaceService, apiDeps.RuleService)
+			reports, jobErr := jobHandler.ReconcileRules(cmd.Context(), repush)
+			spinner.Stop()
+
+			report := [][]string{}
+			report = append(report, []string{"NAMESPACE", "RULE ID", "RULE NAMESPACE", "GROUP", "NAME", "STATUS", "RECONCILED", "ERROR"})
+			driftCount := 0
+			for _, r := range reports {
+				for _, d := range r.Drifts {
+					report = append(report, []string{
+						r.NamespaceURN,
+						fmt.Sprintf("%v", d.RuleID),
+						d.Namespace,
+						d.GroupName,
+						d.Name,
+						d.Status,
+						fmt.Sprintf("%v", d.Reconciled),
+						d.Error,
+					})
+					driftCount++
+				}
+			}
+
+			if driftCount == 0 {
+				printer.Success(fmt.Sprintf("No drift found in %d namespace(s)", len(reports)))
+			} else {
+				fmt.Printf(" \nFound %d drifted rule(s) in %d namespace(s)\n \n", driftCount, len(reports))
+				printer.Table(os.Stdout, report)
+			}
+
+			if jobErr == nil {
+				printer.Success("Job reconcile_rules finished")
+				printer.Space()
+				printer.SuccessIcon()
+			}
+
+			if err := pgClient.Close(); err != nil {
+				logger.Error(err.Error())
+			}
+
+			return jobErr
+		},
+	}
+
+	cmd.Flags().StringVarP(&configFile, "config", "c", "config.yaml", "Config file path")
+	cmd.Flags().BoolVar(&repush, "repush", false, "Re-upload siren version of drifted rules to the provider")
+
+	return cmd
+}
@@ -0,0 +1,481 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/odpf/salt/cmdx"
+	"github.com/odpf/salt/printer"
+	"github.com/odpf/siren/pkg/errors"
+	sirenv1beta1 "github.com/odpf/siren/proto/odpf/siren/v1beta1"
+	"github.com/spf13/cobra"
+)
+
+const maxLastErrorLength = 60
+
+func messagesCmd(cmdxConfig *cmdx.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "message",
+		Aliases: []string{"messages"},
+		Short:   "Manage notification messages",
+		Long: heredoc.Doc(`
+			Work with notification messages in the queue.
+
+			Inspect messages with their last error and try history,
+			requeue or replay failed messages and discard messages.
+			Only queues supporting inspection (postgres) could be managed.
+		`),
+		Example: heredoc.Doc(`
+			$ siren message list --status failed --receiver-type slack
+			$ siren message view 7ee0a8c8-5f86-4a5c-a5a5-1b1b4e1bdc1f
+			$ siren message requeue --receiver-type slack --error "rate limited" --since 1h
+		`),
+		Annotations: map[string]string{
+			"group":  "core",
+			"client": "true",
+		},
+	}
+
+	cmd.AddCommand(
+		listMessagesCmd(cmdxConfig),
+		viewMessageCmd(cmdxConfig),
+		requeueMessagesCmd(cmdxConfig),
+		replayMessagesCmd(cmdxConfig),
+		discardMessagesCmd(cmdxConfig),
+	)
+
+	return cmd
+}
+
+// messageSelector selects messages by filters for bulk operations
+type messageSelector struct {
+	status       string
+	receiverType string
+	errorSubstr  string
+	since        time.Duration
+	limit        uint64
+}
+
+func (ms *messageSelector) addFlags(cmd *cobra.Command, defaultStatus string) {
+	cmd.Flags().StringVar(&ms.status, "status", defaultStatus, "message status: enqueued, pending, failed or published")
+	cmd.Flags().StringVar(&ms.receiverType, "receiver-type", "", "receiver type of the messages")
+	cmd.Flags().StringVar(&ms.errorSubstr, "error", "", "only messages which last error contains the value, case insensitive")
+	cmd.Flags().DurationVar(&ms.since, "since", 0, "only messages created within the duration, e.g. 30m or 24h")
+	cmd.Flags().Uint64Var(&ms.limit, "limit", 100, "maximum number of messages")
+}
+
+func (ms *messageSelector) request() *sirenv1beta1.ListMessagesRequest {
+	req := &sirenv1beta1.ListMessagesRequest{
+		Status:       ms.status,
+		ReceiverType: ms.receiverType,
+		Error:        ms.errorSubstr,
+		Limit:        ms.limit,
+	}
+	if ms.since > 0 {
+		req.StartTime = uint64(time.Now().Add(-ms.since).Unix())
+	}
+	return req
+}
+
+// selectMessageIDs returns ids passed as args or ids of messages matching the filter flags
+func (ms *messageSelector) selectMessageIDs(ctx context.Context, cmd *cobra.Command, client sirenv1beta1.SirenServiceClient, args []string) ([]string, error) {
+	if len(args) > 0 {
+		return args, nil
+	}
+
+	var filtered bool
+	for _, name := range []string{"status", "receiver-type", "error", "since"} {
+		if cmd.Flags().Changed(name) {
+			filtered = true
+		}
+	}
+	if !filtered {
+		return nil, errors.New("provide message ids or at least one of --status, --receiver-type, --error or --since flags")
+	}
+
+	res, err := client.ListMessages(ctx, ms.request())
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, msg := range res.GetMessages() {
+		ids = append(ids, msg.GetId())
+	}
+	if len(ids) == 0 {
+		return nil, errors.New("no messages match the filters")
+	}
+	return ids, nil
+}
+
+func listMessagesCmd(cmdxConfig *cmdx.Config) *cobra.Command {
+	var selector messageSelector
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List notification messages",
+		Long: heredoc.Doc(`
+			List notification messages in the queue, latest created first.
+		`),
+		Example: heredoc.Doc(`
+			$ siren message list --status failed
+			$ siren message list --receiver-type slack --error timeout --since 24h
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			ctx := cmd.Context()
+
+			c, err := loadClientConfig(cmd, cmdxConfig)
+			if err != nil {
+				return err
+			}
+
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.ListMessages(ctx, selector.request())
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+			messages := res.GetMessages()
+			report := [][]string{}
+
+			fmt.Printf(" \nShowing %d messages\n \n", len(messages))
+			report = append(report, []string{"ID", "RECEIVER_TYPE", "STATUS", "TRY_COUNT", "LAST_ERROR", "CREATED_AT"})
+
+			for _, msg := range messages {
+				lastError := msg.GetLastError()
+				if len(lastError) > maxLastErrorLength {
+					lastError = lastError[:maxLastErrorLength] + "..."
+				}
+				report = append(report, []string{
+					msg.GetId(),
+					msg.GetReceiverType(),
+					msg.GetStatus(),
+					strconv.FormatUint(msg.GetTryCount(), 10),
+					lastError,
+					msg.GetCreatedAt().AsTime().Format(time.RFC3339),
+				})
+			}
+			printer.Table(os.Stdout, report)
+
+			fmt.Println("\nFor details on a message, try: siren message view <id>")
+			return nil
+		},
+	}
+
+	selector.addFlags(cmd, "")
+
+	return cmd
+}
+
+type messageAttemptView struct {
+	TryCount uint64    `json:"try_count" yaml:"try_count"`
+	Status   string    `json:"status" yaml:"status"`
+	Error    string    `json:"error,omitempty" yaml:"error,omitempty"`
+	At       time.Time `json:"at" yaml:"at"`
+}
+
+type messageView struct {
+	ID            string                 `json:"id" yaml:"id"`
+	Status        string                 `json:"status" yaml:"status"`
+	ReceiverType  string                 `json:"receiver_type" yaml:"receiver_type"`
+	Details       map[string]interface{} `json:"details" yaml:"details"`
+	LastError     string                 `json:"last_error,omitempty" yaml:"last_error,omitempty"`
+	MaxTries      uint64                 `json:"max_tries" yaml:"max_tries"`
+	TryCount      uint64                 `json:"try_count" yaml:"try_count"`
+	Retryable     bool                   `json:"retryable" yaml:"retryable"`
+	ExternalID    string                 `json:"external_id,omitempty" yaml:"external_id,omitempty"`
+	Attempts      []messageAttemptView   `json:"attempts" yaml:"attempts"`
+	ExpiredAt     *time.Time             `json:"expired_at,omitempty" yaml:"expired_at,omitempty"`
+	NextAttemptAt *time.Time             `json:"next_attempt_at,omitempty" yaml:"next_attempt_at,omitempty"`
+	CreatedAt     time.Time              `json:"created_at" yaml:"created_at"`
+	UpdatedAt     time.Time              `json:"updated_at" yaml:"updated_at"`
+}
+
+func viewMessageCmd(cmdxConfig *cmdx.Config) *cobra.Command {
+	var format string
+	cmd := &cobra.Command{
+		Use:   "view",
+		Short: "View a notification message details",
+		Long: heredoc.Doc(`
+			View a notification message.
+
+			Display the status, details, last error and try history of a message.
+		`),
+		Example: heredoc.Doc(`
+			$ siren message view 7ee0a8c8-5f86-4a5c-a5a5-1b1b4e1bdc1f
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			ctx := cmd.Context()
+
+			c, err := loadClientConfig(cmd, cmdxConfig)
+			if err != nil {
+				return err
+			}
+
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.GetMessage(ctx, &sirenv1beta1.GetMessageRequest{
+				Id: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			if res.GetMessage() == nil {
+				return errors.New("no response from server")
+			}
+
+			msg := res.GetMessage()
+			view := &messageView{
+				ID:           msg.GetId(),
+				Status:       msg.GetStatus(),
+				ReceiverType: msg.GetReceiverType(),
+				Details:      msg.GetDetails().AsMap(),
+				LastError:    msg.GetLastError(),
+				MaxTries:     msg.GetMaxTries(),
+				TryCount:     msg.GetTryCount(),
+				Retryable:    msg.GetRetryable(),
+				ExternalID:   msg.GetExternalId(),
+				CreatedAt:    msg.GetCreatedAt().AsTime(),
+				UpdatedAt:    msg.GetUpdatedAt().AsTime(),
+			}
+			if msg.GetExpiredAt() != nil {
+				expiredAt := msg.GetExpiredAt().AsTime()
+				view.ExpiredAt = &expiredAt
+			}
+			if msg.GetNextAttemptAt() != nil {
+				nextAttemptAt := msg.GetNextAttemptAt().AsTime()
+				view.NextAttemptAt = &nextAttemptAt
+			}
+			for _, at := range msg.GetAttempts() {
+				view.Attempts = append(view.Attempts, messageAttemptView{
+					TryCount: at.GetTryCount(),
+					Status:   at.GetStatus(),
+					Error:    at.GetError(),
+					At:       at.GetAt().AsTime(),
+				})
+			}
+
+			spinner.Stop()
+			if err := printer.File(view, format); err != nil {
+				return fmt.Errorf("failed to format message: %v", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", "yaml", "Print output with the selected format")
+
+	return cmd
+}
+
+func requeueMessagesCmd(cmdxConfig *cmdx.Config) *cobra.Command {
+	var selector messageSelector
+	cmd := &cobra.Command{
+		Use:   "requeue [id...]",
+		Short: "Requeue failed notification messages",
+		Long: heredoc.Doc(`
+			Put failed notification messages back to the queue to be sent again.
+
+			The try count and last error of requeued messages are reset, their try history is kept.
+			Messages are selected by ids or, when no id is passed, by the filter flags.
+			Messages which are not failed are left untouched.
+		`),
+		Example: heredoc.Doc(`
+			$ siren message requeue 7ee0a8c8-5f86-4a5c-a5a5-1b1b4e1bdc1f
+			$ siren message requeue --receiver-type slack --error "rate limited" --since 1h
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			ctx := cmd.Context()
+
+			c, err := loadClientConfig(cmd, cmdxConfig)
+			if err != nil {
+				return err
+			}
+
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			ids, err := selector.selectMessageIDs(ctx, cmd, client, args)
+			if err != nil {
+				return err
+			}
+
+			res, err := client.RequeueMessages(ctx, &sirenv1beta1.RequeueMessagesRequest{
+				Ids: ids,
+			})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+			printer.Success(fmt.Sprintf("Requeued %d of %d messages", res.GetCount(), len(ids)))
+			printer.Space()
+			printer.SuccessIcon()
+
+			return nil
+		},
+	}
+
+	selector.addFlags(cmd, "failed")
+
+	return cmd
+}
+
+func replayMessagesCmd(cmdxConfig *cmdx.Config) *cobra.Command {
+	var selector messageSelector
+	cmd := &cobra.Command{
+		Use:   "replay [id...]",
+		Short: "Replay notification messages",
+		Long: heredoc.Doc(`
+			Enqueue a new copy of notification messages to be sent again.
+
+			Replayed messages start fresh with new ids, the original messages are left untouched.
+			Messages are selected by ids or, when no id is passed, by the filter flags.
+		`),
+		Example: heredoc.Doc(`
+			$ siren message replay 7ee0a8c8-5f86-4a5c-a5a5-1b1b4e1bdc1f
+			$ siren message replay --receiver-type pagerduty --since 30m
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			ctx := cmd.Context()
+
+			c, err := loadClientConfig(cmd, cmdxConfig)
+			if err != nil {
+				return err
+			}
+
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			ids, err := selector.selectMessageIDs(ctx, cmd, client, args)
+			if err != nil {
+				return err
+			}
+
+			res, err := client.ReplayMessages(ctx, &sirenv1beta1.ReplayMessagesRequest{
+				Ids: ids,
+			})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+			printer.Success(fmt.Sprintf("Replayed %d messages with new ids: %s", len(res.GetIds()), strings.Join(res.GetIds(), ", ")))
+			printer.Space()
+			printer.SuccessIcon()
+
+			return nil
+		},
+	}
+
+	selector.addFlags(cmd, "failed")
+
+	return cmd
+}
+
+func discardMessagesCmd(cmdxConfig *cmdx.Config) *cobra.Command {
+	var selector messageSelector
+	cmd := &cobra.Command{
+		Use:   "discard [id...]",
+		Short: "Discard notification messages",
+		Long: heredoc.Doc(`
+			Remove notification messages from the queue.
+
+			Messages are selected by ids or, when no id is passed, by the filter flags.
+			Pending messages, which are being sent, are left untouched.
+		`),
+		Example: heredoc.Doc(`
+			$ siren message discard 7ee0a8c8-5f86-4a5c-a5a5-1b1b4e1bdc1f
+			$ siren message discard --status failed --receiver-type email
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			ctx := cmd.Context()
+
+			c, err := loadClientConfig(cmd, cmdxConfig)
+			if err != nil {
+				return err
+			}
+
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			ids, err := selector.selectMessageIDs(ctx, cmd, client, args)
+			if err != nil {
+				return err
+			}
+
+			res, err := client.DiscardMessages(ctx, &sirenv1beta1.DiscardMessagesRequest{
+				Ids: ids,
+			})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+			printer.Success(fmt.Sprintf("Discarded %d of %d messages", res.GetCount(), len(ids)))
+			printer.Space()
+			printer.SuccessIcon()
+
+			return nil
+		},
+	}
+
+	selector.addFlags(cmd, "")
+
+	return cmd
+}
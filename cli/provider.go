@@ -136,6 +136,11 @@ func createProviderCmd(cmdxConfig *cmdx.Config) *cobra.Command {
 				return err
 			}
 
+			grpcConfig, err := structpb.NewStruct(providerConfig.Config)
+			if err != nil {
+				return err
+			}
+
 			client, cancel, err := createClient(ctx, c.Host)
 			if err != nil {
 				return err
@@ -149,6 +154,7 @@ func createProviderCmd(cmdxConfig *cmdx.Config) *cobra.Command {
 				Type:        providerConfig.Type,
 				Credentials: grpcCredentials,
 				Labels:      providerConfig.Labels,
+				Config:      grpcConfig,
 			})
 
 			if err != nil {
@@ -228,6 +234,7 @@ func getProviderCmd(cmdxConfig *cmdx.Config) *cobra.Command {
 				Type:        res.GetProvider().GetType(),
 				Credentials: res.GetProvider().GetCredentials().AsMap(),
 				Labels:      res.GetProvider().GetLabels(),
+				Config:      res.GetProvider().GetConfig().AsMap(),
 				CreatedAt:   res.GetProvider().GetCreatedAt().AsTime(),
 				UpdatedAt:   res.GetProvider().GetUpdatedAt().AsTime(),
 			}
@@ -278,6 +285,11 @@ func updateProviderCmd(cmdxConfig *cmdx.Config) *cobra.Command {
 				return err
 			}
 
+			grpcConfig, err := structpb.NewStruct(providerConfig.Config)
+			if err != nil {
+				return err
+			}
+
 			client, cancel, err := createClient(ctx, c.Host)
 			if err != nil {
 				return err
@@ -291,6 +303,7 @@ func updateProviderCmd(cmdxConfig *cmdx.Config) *cobra.Command {
 				Type:        providerConfig.Type,
 				Credentials: grpcCredentials,
 				Labels:      providerConfig.Labels,
+				Config:      grpcConfig,
 			})
 			if err != nil {
 				return err
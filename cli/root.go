@@ -42,7 +42,9 @@ func New() *cobra.Command {
 	rootCmd.AddCommand(templatesCmd(cmdxConfig))
 	rootCmd.AddCommand(rulesCmd(cmdxConfig))
 	rootCmd.AddCommand(subscriptionsCmd(cmdxConfig))
+	rootCmd.AddCommand(silencesCmd(cmdxConfig))
 	rootCmd.AddCommand(alertsCmd(cmdxConfig))
+	rootCmd.AddCommand(messagesCmd(cmdxConfig))
 	rootCmd.AddCommand(jobCmd(cmdxConfig))
 	rootCmd.AddCommand(workerCmd())
 
@@ -6,6 +6,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/odpf/siren/pkg/errors"
 	"gopkg.in/yaml.v3"
@@ -37,7 +38,10 @@ func rulesCmd(cmdxConfig *cmdx.Config) *cobra.Command {
 	cmd.AddCommand(
 		listRulesCmd(cmdxConfig),
 		updateRuleCmd(cmdxConfig),
+		deleteRuleCmd(cmdxConfig),
 		uploadRuleCmd(cmdxConfig),
+		listRuleRevisionsCmd(cmdxConfig),
+		rollbackRuleCmd(cmdxConfig),
 	)
 
 	return cmd
@@ -194,8 +198,64 @@ func updateRuleCmd(cmdxConfig *cmdx.Config) *cobra.Command {
 	return cmd
 }
 
+func deleteRuleCmd(cmdxConfig *cmdx.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a rule",
+		Long: heredoc.Doc(`
+			Delete a rule from siren and remove it from the provider.
+		`),
+		Example: heredoc.Doc(`
+			$ siren rule delete 1
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			ctx := cmd.Context()
+
+			c, err := loadClientConfig(cmd, cmdxConfig)
+			if err != nil {
+				return err
+			}
+
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid rule id: %v", err)
+			}
+
+			_, err = client.DeleteRule(ctx, &sirenv1beta1.DeleteRuleRequest{
+				Id: id,
+			})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+			printer.Success("Successfully deleted rule")
+			printer.Space()
+			printer.SuccessIcon()
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
 func uploadRuleCmd(cmdxConfig *cmdx.Config) *cobra.Command {
 	var fileReader = os.ReadFile
+	var dryRun bool
 	cmd := &cobra.Command{
 		Use:   "upload",
 		Short: "Upload Rules YAML file",
@@ -235,6 +295,11 @@ func uploadRuleCmd(cmdxConfig *cmdx.Config) *cobra.Command {
 			}
 
 			if strings.ToLower(yamlObject.Type) == "rule" {
+				if dryRun {
+					spinner.Stop()
+					return PreviewRules(client, yamlFile)
+				}
+
 				rulesID, err := UploadRules(client, yamlFile)
 				if err != nil {
 					return err
@@ -249,16 +314,74 @@ func uploadRuleCmd(cmdxConfig *cmdx.Config) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "validate rules and print the diff of rule groups without uploading")
+
 	return cmd
 }
 
 func UploadRules(client sirenv1beta1.SirenServiceClient, yamlFile []byte) ([]uint64, error) {
+	payloads, err := parseRuleFile(client, yamlFile)
+	if err != nil {
+		return nil, err
+	}
+	var successfullyUpsertedRulesID []uint64
+
+	for _, payload := range payloads {
+		result, err := client.UpdateRule(context.Background(), payload)
+		if err != nil {
+			fmt.Println(fmt.Sprintf("rule %s/%s/%s upload error",
+				payload.Namespace, payload.GroupName, payload.Template), err)
+			return successfullyUpsertedRulesID, err
+		}
+		successfullyUpsertedRulesID = append(successfullyUpsertedRulesID, result.GetRule().GetId())
+		fmt.Printf("successfully uploaded %s/%s/%s",
+			payload.Namespace, payload.GroupName, payload.Template)
+
+	}
+	return successfullyUpsertedRulesID, nil
+}
+
+// PreviewRules validates rules in the yaml file and prints the diff of each rule group
+// in the provider without applying the rules
+func PreviewRules(client sirenv1beta1.SirenServiceClient, yamlFile []byte) error {
+	payloads, err := parseRuleFile(client, yamlFile)
+	if err != nil {
+		return err
+	}
+
+	for _, payload := range payloads {
+		result, err := client.PreviewRule(context.Background(), &sirenv1beta1.PreviewRuleRequest{
+			GroupName:         payload.GroupName,
+			Namespace:         payload.Namespace,
+			Template:          payload.Template,
+			Variables:         payload.Variables,
+			ProviderNamespace: payload.ProviderNamespace,
+			Enabled:           payload.Enabled,
+		})
+		if err != nil {
+			fmt.Println(fmt.Sprintf("rule %s/%s/%s preview error",
+				payload.Namespace, payload.GroupName, payload.Template), err)
+			return err
+		}
+
+		if result.GetDiff() == "" {
+			fmt.Printf("no changes in %s/%s/%s\n",
+				payload.Namespace, payload.GroupName, payload.Template)
+			continue
+		}
+		fmt.Printf("changes in %s/%s/%s\n%s\n",
+			payload.Namespace, payload.GroupName, payload.Template, result.GetDiff())
+	}
+	return nil
+}
+
+func parseRuleFile(client sirenv1beta1.SirenServiceClient, yamlFile []byte) ([]*sirenv1beta1.UpdateRuleRequest, error) {
 	var yamlBody rule.RuleFile
 	err := yaml.Unmarshal(yamlFile, &yamlBody)
 	if err != nil {
 		return nil, err
 	}
-	var successfullyUpsertedRulesID []uint64
+	var payloads []*sirenv1beta1.UpdateRuleRequest
 
 	for groupName, v := range yamlBody.Rules {
 		var ruleVariables []*sirenv1beta1.Variables
@@ -327,18 +450,9 @@ func UploadRules(client sirenv1beta1.SirenServiceClient, yamlFile []byte) ([]uin
 			Enabled:           v.Enabled,
 		}
 
-		result, err := client.UpdateRule(context.Background(), payload)
-		if err != nil {
-			fmt.Println(fmt.Sprintf("rule %s/%s/%s upload error",
-				payload.Namespace, payload.GroupName, payload.Template), err)
-			return successfullyUpsertedRulesID, err
-		}
-		successfullyUpsertedRulesID = append(successfullyUpsertedRulesID, result.GetRule().GetId())
-		fmt.Printf("successfully uploaded %s/%s/%s",
-			payload.Namespace, payload.GroupName, payload.Template)
-
+		payloads = append(payloads, payload)
 	}
-	return successfullyUpsertedRulesID, nil
+	return payloads, nil
 }
 
 func printRulesID(rulesID []uint64) {
@@ -348,3 +462,133 @@ func printRulesID(rulesID []uint64) {
 		fmt.Println()
 	}
 }
+
+func listRuleRevisionsCmd(cmdxConfig *cmdx.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revisions",
+		Short: "List revisions of a rule",
+		Long: heredoc.Doc(`
+			List revisions of a rule, the latest revision comes first.
+		`),
+		Example: heredoc.Doc(`
+			$ siren rule revisions <rule_id>
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			ctx := cmd.Context()
+
+			c, err := loadClientConfig(cmd, cmdxConfig)
+			if err != nil {
+				return err
+			}
+
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			ruleID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid rule id: %v", err)
+			}
+
+			res, err := client.ListRuleRevisions(ctx, &sirenv1beta1.ListRuleRevisionsRequest{
+				RuleId: ruleID,
+			})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+			revisions := res.GetRevisions()
+			report := [][]string{}
+
+			fmt.Printf(" \nShowing %d revisions of rule %d\n \n", len(revisions), ruleID)
+			report = append(report, []string{"ID", "TEMPLATE", "ENABLED", "DELETED", "ACTOR", "CREATED_AT"})
+
+			for _, rev := range revisions {
+				report = append(report, []string{
+					fmt.Sprintf("%v", rev.GetId()),
+					rev.GetTemplate(),
+					strconv.FormatBool(rev.GetEnabled()),
+					strconv.FormatBool(rev.GetDeleted()),
+					rev.GetActor(),
+					rev.GetCreatedAt().AsTime().Format(time.RFC3339),
+				})
+			}
+			printer.Table(os.Stdout, report)
+
+			fmt.Println("\nTo restore a revision, try: siren rule rollback <revision_id>")
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func rollbackRuleCmd(cmdxConfig *cmdx.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Rollback a rule to a revision",
+		Long: heredoc.Doc(`
+			Rollback a rule to a revision and re-upload it to the provider.
+
+			The rule is rendered with the current version of its template, the rollback
+			is rejected if the template has changed since the revision. Rollback the
+			template first to restore the exact rendered rule. A revision recording
+			the deletion of a rule cannot be rolled back to.
+		`),
+		Example: heredoc.Doc(`
+			$ siren rule rollback <revision_id>
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			ctx := cmd.Context()
+
+			c, err := loadClientConfig(cmd, cmdxConfig)
+			if err != nil {
+				return err
+			}
+
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			revisionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid revision id: %v", err)
+			}
+
+			res, err := client.RollbackRule(ctx, &sirenv1beta1.RollbackRuleRequest{
+				RevisionId: revisionID,
+			})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+			printer.Success(fmt.Sprintf("Successfully rolled back rule %d to revision %d", res.GetRule().GetId(), revisionID))
+			printer.Space()
+			printer.SuccessIcon()
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -129,11 +129,18 @@ func StartServer(ctx context.Context, cfg config.Config) error {
 	var err error
 	switch cfg.Notification.Queue.Kind {
 	case queues.KindPostgres:
-		queue, err = postgresq.New(logger, cfg.DB)
+		queue, err = postgresq.New(logger, cfg.DB,
+			postgresq.WithBackoff(cfg.Notification.Queue.Backoff),
+			postgresq.WithVisibilityTimeout(cfg.Notification.Queue.VisibilityTimeout),
+		)
 		if err != nil {
 			return err
 		}
-		dlq, err = postgresq.New(logger, cfg.DB, postgresq.WithStrategy(postgresq.StrategyDLQ))
+		dlq, err = postgresq.New(logger, cfg.DB,
+			postgresq.WithStrategy(postgresq.StrategyDLQ),
+			postgresq.WithBackoff(cfg.Notification.Queue.Backoff),
+			postgresq.WithVisibilityTimeout(cfg.Notification.Queue.VisibilityTimeout),
+		)
 		if err != nil {
 			return err
 		}
@@ -155,7 +162,8 @@ func StartServer(ctx context.Context, cfg config.Config) error {
 	if cfg.Notification.MessageHandler.Enabled {
 		workerTicker := worker.NewTicker(logger, worker.WithTickerDuration(cfg.Notification.MessageHandler.PollDuration), worker.WithID("message-handler"))
 		notificationHandler := notification.NewHandler(cfg.Notification.MessageHandler, logger, queue, notifierRegistry,
-			notification.HandlerWithIdentifier(workerTicker.GetID()))
+			notification.HandlerWithIdentifier(workerTicker.GetID()),
+			notification.HandlerWithRateLimiter(postgres.NewRateLimitRepository(pgClient)))
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
@@ -167,7 +175,8 @@ func StartServer(ctx context.Context, cfg config.Config) error {
 	if cfg.Notification.DLQHandler.Enabled {
 		workerDLQTicker := worker.NewTicker(logger, worker.WithTickerDuration(cfg.Notification.DLQHandler.PollDuration), worker.WithID("dlq-handler"))
 		notificationDLQHandler := notification.NewHandler(cfg.Notification.DLQHandler, logger, dlq, notifierRegistry,
-			notification.HandlerWithIdentifier(workerDLQTicker.GetID()))
+			notification.HandlerWithIdentifier(workerDLQTicker.GetID()),
+			notification.HandlerWithRateLimiter(postgres.NewRateLimitRepository(pgClient)))
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
@@ -177,6 +186,18 @@ func StartServer(ctx context.Context, cfg config.Config) error {
 		}()
 	}
 
+	if cfg.Notification.Group.Enabled {
+		workerGroupTicker := worker.NewTicker(logger, worker.WithTickerDuration(cfg.Notification.Group.PollDuration), worker.WithID("group-flusher"))
+		grouper := notification.NewGrouper(cfg.Notification.Group, logger, postgres.NewGroupRepository(pgClient), queue)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			workerGroupTicker.Run(ctx, cancelWorkerChan, func(ctx context.Context, runningAt time.Time) error {
+				return grouper.Flush(ctx, runningAt)
+			})
+		}()
+	}
+
 	err = server.RunServer(
 		ctx,
 		cfg.Service,
@@ -0,0 +1,383 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/odpf/salt/cmdx"
+	"github.com/odpf/salt/printer"
+	"github.com/odpf/siren/core/silence"
+	"github.com/odpf/siren/pkg/errors"
+	sirenv1beta1 "github.com/odpf/siren/proto/odpf/siren/v1beta1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func silencesCmd(cmdxConfig *cmdx.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "silence",
+		Aliases: []string{"silences"},
+		Short:   "Manage silences",
+		Long: heredoc.Doc(`
+			Work with silences.
+
+			Silence notifications of a subscription or matching labels,
+			optionally only within a time window or a recurring schedule.
+		`),
+		Annotations: map[string]string{
+			"group":  "core",
+			"client": "true",
+		},
+	}
+
+	cmd.AddCommand(
+		listSilencesCmd(cmdxConfig),
+		viewSilenceCmd(cmdxConfig),
+		createSilenceCmd(cmdxConfig),
+		expireSilenceCmd(cmdxConfig),
+	)
+
+	return cmd
+}
+
+func listSilencesCmd(cmdxConfig *cmdx.Config) *cobra.Command {
+	var (
+		namespaceID    uint64
+		subscriptionID uint64
+	)
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List silences",
+		Long: heredoc.Doc(`
+			List all active silences.
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			ctx := cmd.Context()
+
+			c, err := loadClientConfig(cmd, cmdxConfig)
+			if err != nil {
+				return err
+			}
+
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.ListSilences(ctx, &sirenv1beta1.ListSilencesRequest{
+				NamespaceId:    namespaceID,
+				SubscriptionId: subscriptionID,
+			})
+			if err != nil {
+				return err
+			}
+
+			if res.GetSilences() == nil {
+				return errors.New("no response from server")
+			}
+
+			spinner.Stop()
+			silences := res.GetSilences()
+			report := [][]string{}
+
+			fmt.Printf(" \nShowing %d of %d silences\n \n", len(silences), len(silences))
+			report = append(report, []string{"ID", "NAMESPACE", "TYPE", "TARGET ID", "TARGET EXPRESSION", "STARTS AT", "ENDS AT", "SCHEDULE"})
+
+			for _, s := range silences {
+				targetExpressionStr, err := json.Marshal(s.GetTargetExpression().AsMap())
+				if err != nil {
+					return errors.New("cannot marshal target expression")
+				}
+
+				report = append(report, []string{
+					s.GetId(),
+					fmt.Sprintf("%v", s.GetNamespaceId()),
+					s.GetType(),
+					fmt.Sprintf("%v", s.GetTargetId()),
+					string(targetExpressionStr),
+					formatSilenceTime(s.GetStartsAt()),
+					formatSilenceTime(s.GetEndsAt()),
+					formatSilenceSchedule(s.GetSchedule()),
+				})
+			}
+			printer.Table(os.Stdout, report)
+
+			fmt.Println("\nFor details on a silence, try: siren silence view <id>")
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64Var(&namespaceID, "namespace-id", 0, "namespace id")
+	cmd.Flags().Uint64Var(&subscriptionID, "subscription-id", 0, "subscription id")
+
+	return cmd
+}
+
+func viewSilenceCmd(cmdxConfig *cmdx.Config) *cobra.Command {
+	var format string
+	cmd := &cobra.Command{
+		Use:   "view",
+		Short: "View a silence details",
+		Long: heredoc.Doc(`
+			View a silence.
+
+			Display the id, namespace, type, target, and window of a silence.
+		`),
+		Example: heredoc.Doc(`
+			$ siren silence view 7ee0a8c8-5f86-4a5c-a5a5-1b1b4e1bdc1f
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			ctx := cmd.Context()
+
+			c, err := loadClientConfig(cmd, cmdxConfig)
+			if err != nil {
+				return err
+			}
+
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.GetSilence(ctx, &sirenv1beta1.GetSilenceRequest{
+				Id: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			if res.GetSilence() == nil {
+				return errors.New("no response from server")
+			}
+
+			sil := &silence.Silence{
+				ID:               res.GetSilence().GetId(),
+				NamespaceID:      res.GetSilence().GetNamespaceId(),
+				Type:             res.GetSilence().GetType(),
+				TargetID:         res.GetSilence().GetTargetId(),
+				TargetExpression: res.GetSilence().GetTargetExpression().AsMap(),
+				StartsAt:         res.GetSilence().GetStartsAt().AsTime(),
+				EndsAt:           res.GetSilence().GetEndsAt().AsTime(),
+				Schedule: silence.Schedule{
+					Cron:     res.GetSilence().GetSchedule().GetCron(),
+					Duration: res.GetSilence().GetSchedule().GetDuration().AsDuration(),
+					TimeZone: res.GetSilence().GetSchedule().GetTimeZone(),
+				},
+				CreatedAt: res.GetSilence().GetCreatedAt().AsTime(),
+				DeletedAt: res.GetSilence().GetDeletedAt().AsTime(),
+			}
+
+			spinner.Stop()
+			if err := printer.File(sil, format); err != nil {
+				return fmt.Errorf("failed to format silence: %v", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", "yaml", "Print output with the selected format")
+
+	return cmd
+}
+
+func createSilenceCmd(cmdxConfig *cmdx.Config) *cobra.Command {
+	var filePath string
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a new silence",
+		Long: heredoc.Doc(`
+			Create a new silence.
+
+			The silence is only applied between starts_at and ends_at if set.
+			A silence with type recurring is only applied within the windows
+			of its schedule, every cron occurrence for the duration.
+		`),
+		Example: heredoc.Doc(`
+			$ siren silence create --file silence.json
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			ctx := cmd.Context()
+
+			c, err := loadClientConfig(cmd, cmdxConfig)
+			if err != nil {
+				return err
+			}
+
+			var silenceDetail silenceFile
+			if err := parseFile(filePath, &silenceDetail); err != nil {
+				return err
+			}
+
+			req, err := silenceDetail.toCreateRequest()
+			if err != nil {
+				return err
+			}
+
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.CreateSilence(ctx, req)
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+			printer.Successf("Silence created with id: %v", res.GetId())
+			printer.Space()
+			printer.SuccessIcon()
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&filePath, "file", "f", "", "path to the silence config")
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func expireSilenceCmd(cmdxConfig *cmdx.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "expire",
+		Short: "Expire a silence",
+		Example: heredoc.Doc(`
+			$ siren silence expire 7ee0a8c8-5f86-4a5c-a5a5-1b1b4e1bdc1f
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			ctx := cmd.Context()
+
+			c, err := loadClientConfig(cmd, cmdxConfig)
+			if err != nil {
+				return err
+			}
+
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			_, err = client.ExpireSilence(ctx, &sirenv1beta1.ExpireSilenceRequest{
+				Id: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+			printer.Success("Successfully expired silence")
+			printer.Space()
+			printer.SuccessIcon()
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// silenceFile is the silence file format with a human readable schedule duration (e.g. 2h, 30m)
+type silenceFile struct {
+	NamespaceID      uint64                 `json:"namespace_id" yaml:"namespace_id"`
+	Type             string                 `json:"type" yaml:"type"`
+	TargetID         uint64                 `json:"target_id" yaml:"target_id"`
+	TargetExpression map[string]interface{} `json:"target_expression" yaml:"target_expression"`
+	StartsAt         time.Time              `json:"starts_at" yaml:"starts_at"`
+	EndsAt           time.Time              `json:"ends_at" yaml:"ends_at"`
+	Schedule         silenceScheduleFile    `json:"schedule" yaml:"schedule"`
+}
+
+type silenceScheduleFile struct {
+	Cron     string `json:"cron" yaml:"cron"`
+	Duration string `json:"duration" yaml:"duration"`
+	TimeZone string `json:"time_zone" yaml:"time_zone"`
+}
+
+func (sf silenceFile) toCreateRequest() (*sirenv1beta1.CreateSilenceRequest, error) {
+	targetExpression, err := structpb.NewStruct(sf.TargetExpression)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &sirenv1beta1.CreateSilenceRequest{
+		NamespaceId:      sf.NamespaceID,
+		Type:             sf.Type,
+		TargetId:         sf.TargetID,
+		TargetExpression: targetExpression,
+	}
+
+	if !sf.StartsAt.IsZero() {
+		req.StartsAt = timestamppb.New(sf.StartsAt)
+	}
+
+	if !sf.EndsAt.IsZero() {
+		req.EndsAt = timestamppb.New(sf.EndsAt)
+	}
+
+	if sf.Schedule.Cron != "" {
+		scheduleDuration, err := time.ParseDuration(sf.Schedule.Duration)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule duration: %w", err)
+		}
+		req.Schedule = &sirenv1beta1.SilenceSchedule{
+			Cron:     sf.Schedule.Cron,
+			Duration: durationpb.New(scheduleDuration),
+			TimeZone: sf.Schedule.TimeZone,
+		}
+	}
+
+	return req, nil
+}
+
+func formatSilenceSchedule(sc *sirenv1beta1.SilenceSchedule) string {
+	if sc == nil || sc.GetCron() == "" {
+		return "-"
+	}
+	if sc.GetTimeZone() == "" {
+		return fmt.Sprintf("%s for %s", sc.GetCron(), sc.GetDuration().AsDuration())
+	}
+	return fmt.Sprintf("%s for %s (%s)", sc.GetCron(), sc.GetDuration().AsDuration(), sc.GetTimeZone())
+}
+
+func formatSilenceTime(ts *timestamppb.Timestamp) string {
+	if ts == nil || ts.AsTime().IsZero() {
+		return "-"
+	}
+	return ts.AsTime().String()
+}
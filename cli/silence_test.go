@@ -0,0 +1,150 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	sirenv1beta1 "github.com/odpf/siren/proto/odpf/siren/v1beta1"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gopkg.in/yaml.v3"
+)
+
+func TestSilenceFile_ToCreateRequest(t *testing.T) {
+	testCases := []struct {
+		name     string
+		fileName string
+		content  string
+		want     *sirenv1beta1.CreateSilenceRequest
+		wantErr  bool
+	}{
+		{
+			name:     "should parse recurring silence yaml file with schedule",
+			fileName: "silence.yaml",
+			content: `
+namespace_id: 1
+type: recurring
+target_expression:
+  team: odpf
+schedule:
+  cron: "0 22 * * 5"
+  duration: 2h
+  time_zone: Asia/Jakarta
+`,
+			want: &sirenv1beta1.CreateSilenceRequest{
+				NamespaceId: 1,
+				Type:        "recurring",
+				TargetExpression: &structpb.Struct{
+					Fields: map[string]*structpb.Value{
+						"team": structpb.NewStringValue("odpf"),
+					},
+				},
+				Schedule: &sirenv1beta1.SilenceSchedule{
+					Cron:     "0 22 * * 5",
+					Duration: durationpb.New(2 * time.Hour),
+					TimeZone: "Asia/Jakarta",
+				},
+			},
+		},
+		{
+			name:     "should parse recurring silence json file with schedule",
+			fileName: "silence.json",
+			content:  `{"namespace_id": 1, "type": "recurring", "target_expression": {"team": "odpf"}, "schedule": {"cron": "0 22 * * 5", "duration": "30m"}}`,
+			want: &sirenv1beta1.CreateSilenceRequest{
+				NamespaceId: 1,
+				Type:        "recurring",
+				TargetExpression: &structpb.Struct{
+					Fields: map[string]*structpb.Value{
+						"team": structpb.NewStringValue("odpf"),
+					},
+				},
+				Schedule: &sirenv1beta1.SilenceSchedule{
+					Cron:     "0 22 * * 5",
+					Duration: durationpb.New(30 * time.Minute),
+				},
+			},
+		},
+		{
+			name:     "should return error if schedule duration is invalid",
+			fileName: "silence.yaml",
+			content: `
+type: recurring
+schedule:
+  cron: "0 22 * * 5"
+  duration: two hours
+`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filePath := filepath.Join(t.TempDir(), tc.fileName)
+			if err := os.WriteFile(filePath, []byte(tc.content), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			var sf silenceFile
+			if err := parseFile(filePath, &sf); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := sf.toCreateRequest()
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("toCreateRequest() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("toCreateRequest() diff = %v", diff)
+			}
+		})
+	}
+}
+
+func TestSilenceFile_YAMLRoundTrip(t *testing.T) {
+	want := silenceFile{
+		NamespaceID:      1,
+		Type:             "subscription",
+		TargetID:         2,
+		TargetExpression: map[string]interface{}{"rule": "cpu_high"},
+		StartsAt:         time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC),
+		EndsAt:           time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	b, err := yaml.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []string{"namespace_id:", "target_id:", "starts_at:", "ends_at:"} {
+		if !strings.Contains(string(b), key) {
+			t.Errorf("marshalled silence file should have %q key, got\n%s", key, b)
+		}
+	}
+
+	filePath := filepath.Join(t.TempDir(), "silence.yaml")
+	if err := os.WriteFile(filePath, b, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var got silenceFile
+	if err := parseFile(filePath, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("silence file yaml round trip diff = %v", diff)
+	}
+
+	req, err := got.toCreateRequest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.GetNamespaceId() != 1 || req.GetTargetId() != 2 || !req.GetStartsAt().AsTime().Equal(want.StartsAt) || !req.GetEndsAt().AsTime().Equal(want.EndsAt) {
+		t.Errorf("unexpected create silence request %v", req)
+	}
+}
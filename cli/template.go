@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/odpf/siren/pkg/errors"
 	"gopkg.in/yaml.v3"
@@ -40,6 +42,9 @@ func templatesCmd(cmdxConfig *cmdx.Config) *cobra.Command {
 		deleteTemplateCmd(cmdxConfig),
 		renderTemplateCmd(cmdxConfig),
 		uploadTemplateCmd(cmdxConfig),
+		listTemplateRevisionsCmd(cmdxConfig),
+		rollbackTemplateCmd(cmdxConfig),
+		syncTemplateRulesCmd(cmdxConfig),
 	)
 
 	return cmd
@@ -111,7 +116,10 @@ func listTemplatesCmd(cmdxConfig *cmdx.Config) *cobra.Command {
 }
 
 func upsertTemplateCmd(cmdxConfig *cmdx.Config) *cobra.Command {
-	var filePath string
+	var (
+		filePath  string
+		syncRules bool
+	)
 	cmd := &cobra.Command{
 		Use:   "upsert",
 		Short: "Create or edit a new template",
@@ -158,6 +166,7 @@ func upsertTemplateCmd(cmdxConfig *cmdx.Config) *cobra.Command {
 				Body:      templateConfig.Body,
 				Tags:      templateConfig.Tags,
 				Variables: variables,
+				SyncRules: syncRules,
 			})
 
 			if err != nil {
@@ -165,8 +174,14 @@ func upsertTemplateCmd(cmdxConfig *cmdx.Config) *cobra.Command {
 			}
 
 			spinner.Stop()
-			printer.Successf("Template created with id: %v", res.GetId())
+			printer.Success(fmt.Sprintf("Template created with id: %v", res.GetId()))
 			printer.Space()
+
+			if syncRules {
+				if err := printRuleSyncResults(templateConfig.Name, res.GetRuleSyncResults()); err != nil {
+					return err
+				}
+			}
 			printer.SuccessIcon()
 
 			return nil
@@ -175,6 +190,7 @@ func upsertTemplateCmd(cmdxConfig *cmdx.Config) *cobra.Command {
 
 	cmd.Flags().StringVarP(&filePath, "file", "f", "", "path to the template config")
 	cmd.MarkFlagRequired("file")
+	cmd.Flags().BoolVar(&syncRules, "sync-rules", false, "re-render rules of the template and upload them to providers")
 
 	return cmd
 }
@@ -452,57 +468,242 @@ func UploadTemplate(client sirenv1beta1.SirenServiceClient, yamlFile []byte) (ui
 		Body:      string(body),
 		Variables: variables,
 		Tags:      t.Tags,
+		SyncRules: true,
 	})
 	if err != nil {
 		return 0, err
 	}
 
-	//update associated rules for this template
-	data, err := client.ListRules(context.Background(), &sirenv1beta1.ListRulesRequest{
-		Template: t.Name,
-	})
-	if err != nil {
+	if err := printRuleSyncResults(t.Name, template.GetRuleSyncResults()); err != nil {
 		return 0, err
 	}
 
-	if data.GetRules() == nil {
-		return template.GetId(), nil
+	return template.GetId(), nil
+}
+
+// printRuleSyncResults prints outcome of each rule synced and returns error with
+// the command to retry if any of the rules failed to be synced
+func printRuleSyncResults(templateName string, results []*sirenv1beta1.RuleSyncResult) error {
+	if len(results) == 0 {
+		return nil
 	}
 
-	associatedRules := data.GetRules()
-	for i := 0; i < len(associatedRules); i++ {
-		associatedRule := associatedRules[i]
+	report := [][]string{}
+	report = append(report, []string{"RULE_ID", "NAME", "STATUS", "ERROR"})
 
-		var updatedVariables []*sirenv1beta1.Variables
-		for j := 0; j < len(associatedRules[i].Variables); j++ {
-			ruleVar := &sirenv1beta1.Variables{
-				Name:        associatedRules[i].Variables[j].Name,
-				Value:       associatedRules[i].Variables[j].Value,
-				Type:        associatedRules[i].Variables[j].Type,
-				Description: associatedRules[i].Variables[j].Description,
-			}
-			updatedVariables = append(updatedVariables, ruleVar)
+	failedIDs := []string{}
+	for _, r := range results {
+		status := "synced"
+		if !r.GetSuccess() {
+			status = "failed"
+			failedIDs = append(failedIDs, fmt.Sprintf("%d", r.GetRuleId()))
 		}
-
-		_, err := client.UpdateRule(context.Background(), &sirenv1beta1.UpdateRuleRequest{
-			GroupName:         associatedRule.GroupName,
-			Namespace:         associatedRule.Namespace,
-			Template:          associatedRule.Template,
-			Variables:         updatedVariables,
-			ProviderNamespace: associatedRule.ProviderNamespace,
-			Enabled:           associatedRule.Enabled,
+		report = append(report, []string{
+			fmt.Sprintf("%v", r.GetRuleId()),
+			r.GetName(),
+			status,
+			r.GetError(),
 		})
+	}
+	fmt.Printf(" \nSynced rules of template %s\n \n", templateName)
+	printer.Table(os.Stdout, report)
 
-		if err != nil {
-			return 0, fmt.Errorf("failed to update rule of ID: %d\tname: %s", associatedRule.Id, associatedRule.Name)
-		}
-		fmt.Println("successfully updated rule of ID: ", associatedRule.Id, "\tname: ", associatedRule.Name)
+	if len(failedIDs) > 0 {
+		return fmt.Errorf("failed to sync %d of %d rules, to retry try: siren template sync %s --rule-ids %s",
+			len(failedIDs), len(results), templateName, strings.Join(failedIDs, ","))
 	}
 
-	return template.GetId(), nil
+	return nil
 }
 
 func printTemplateID(templateID uint64) {
 	fmt.Println("Upserted Template")
 	fmt.Println("ID:", templateID)
 }
+
+func listTemplateRevisionsCmd(cmdxConfig *cmdx.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revisions",
+		Short: "List revisions of a template",
+		Long: heredoc.Doc(`
+			List revisions of a template, the latest revision comes first.
+		`),
+		Example: heredoc.Doc(`
+			$ siren template revisions <template_name>
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			ctx := cmd.Context()
+
+			c, err := loadClientConfig(cmd, cmdxConfig)
+			if err != nil {
+				return err
+			}
+
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.ListTemplateRevisions(ctx, &sirenv1beta1.ListTemplateRevisionsRequest{
+				Name: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+			revisions := res.GetRevisions()
+			report := [][]string{}
+
+			fmt.Printf(" \nShowing %d revisions of template %s\n \n", len(revisions), args[0])
+			report = append(report, []string{"ID", "ACTOR", "CREATED_AT"})
+
+			for _, rev := range revisions {
+				report = append(report, []string{
+					fmt.Sprintf("%v", rev.GetId()),
+					rev.GetActor(),
+					rev.GetCreatedAt().AsTime().Format(time.RFC3339),
+				})
+			}
+			printer.Table(os.Stdout, report)
+
+			fmt.Println("\nTo restore a revision, try: siren template rollback <revision_id>")
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func rollbackTemplateCmd(cmdxConfig *cmdx.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Rollback a template to a revision",
+		Long: heredoc.Doc(`
+			Rollback a template to a revision.
+
+			All rules of the template are re-uploaded to their providers with the restored template.
+		`),
+		Example: heredoc.Doc(`
+			$ siren template rollback <revision_id>
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			ctx := cmd.Context()
+
+			c, err := loadClientConfig(cmd, cmdxConfig)
+			if err != nil {
+				return err
+			}
+
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			revisionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid revision id: %v", err)
+			}
+
+			res, err := client.RollbackTemplate(ctx, &sirenv1beta1.RollbackTemplateRequest{
+				RevisionId: revisionID,
+			})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+			printer.Success(fmt.Sprintf("Successfully rolled back template %s to revision %d", res.GetTemplate().GetName(), revisionID))
+			printer.Space()
+
+			if err := printRuleSyncResults(res.GetTemplate().GetName(), res.GetRuleSyncResults()); err != nil {
+				return err
+			}
+			printer.SuccessIcon()
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func syncTemplateRulesCmd(cmdxConfig *cmdx.Config) *cobra.Command {
+	var ruleIDs []uint
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Sync rules of a template",
+		Long: heredoc.Doc(`
+			Re-render rules of a template and upload them to their providers.
+
+			Rules failed to be synced are reported and could be retried with --rule-ids.
+		`),
+		Example: heredoc.Doc(`
+			$ siren template sync <template_name>
+			$ siren template sync <template_name> --rule-ids 2,5
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			ctx := cmd.Context()
+
+			c, err := loadClientConfig(cmd, cmdxConfig)
+			if err != nil {
+				return err
+			}
+
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			ids := make([]uint64, 0, len(ruleIDs))
+			for _, id := range ruleIDs {
+				ids = append(ids, uint64(id))
+			}
+
+			res, err := client.SyncTemplateRules(ctx, &sirenv1beta1.SyncTemplateRulesRequest{
+				Name:    args[0],
+				RuleIds: ids,
+			})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+			if err := printRuleSyncResults(args[0], res.GetRuleSyncResults()); err != nil {
+				return err
+			}
+			printer.Space()
+			printer.SuccessIcon()
+
+			return nil
+		},
+	}
+
+	cmd.Flags().UintSliceVar(&ruleIDs, "rule-ids", nil, "ids of the rules to sync, all rules of the template are synced if empty")
+
+	return cmd
+}
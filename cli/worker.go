@@ -8,6 +8,7 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/odpf/siren/config"
 	"github.com/odpf/siren/core/notification"
+	"github.com/odpf/siren/internal/store/postgres"
 	"github.com/odpf/siren/pkg/worker"
 	"github.com/odpf/siren/plugins/queues"
 	"github.com/odpf/siren/plugins/queues/postgresq"
@@ -138,7 +139,10 @@ func StartNotificationHandlerWorker(ctx context.Context, cfg config.Config, canc
 	var queue notification.Queuer
 	switch cfg.Notification.Queue.Kind {
 	case queues.KindPostgres:
-		queue, err = postgresq.New(logger, cfg.DB)
+		queue, err = postgresq.New(logger, cfg.DB,
+			postgresq.WithBackoff(cfg.Notification.Queue.Backoff),
+			postgresq.WithVisibilityTimeout(cfg.Notification.Queue.VisibilityTimeout),
+		)
 		if err != nil {
 			return err
 		}
@@ -151,7 +155,8 @@ func StartNotificationHandlerWorker(ctx context.Context, cfg config.Config, canc
 	}
 	workerTicker := worker.NewTicker(logger, worker.WithTickerDuration(cfg.Notification.MessageHandler.PollDuration), worker.WithID("message-worker"))
 	notificationHandler := notification.NewHandler(cfg.Notification.MessageHandler, logger, queue, notifierRegistry,
-		notification.HandlerWithIdentifier(workerTicker.GetID()))
+		notification.HandlerWithIdentifier(workerTicker.GetID()),
+		notification.HandlerWithRateLimiter(postgres.NewRateLimitRepository(pgClient)))
 
 	go func() {
 		workerTicker.Run(ctx, cancelWorkerChan, func(ctx context.Context, runningAt time.Time) error {
@@ -178,7 +183,11 @@ func StartNotificationDLQHandlerWorker(ctx context.Context, cfg config.Config, c
 	var queue notification.Queuer
 	switch cfg.Notification.Queue.Kind {
 	case queues.KindPostgres:
-		queue, err = postgresq.New(logger, cfg.DB, postgresq.WithStrategy(postgresq.StrategyDLQ))
+		queue, err = postgresq.New(logger, cfg.DB,
+			postgresq.WithStrategy(postgresq.StrategyDLQ),
+			postgresq.WithBackoff(cfg.Notification.Queue.Backoff),
+			postgresq.WithVisibilityTimeout(cfg.Notification.Queue.VisibilityTimeout),
+		)
 		if err != nil {
 			return err
 		}
@@ -192,7 +201,8 @@ func StartNotificationDLQHandlerWorker(ctx context.Context, cfg config.Config, c
 
 	workerTicker := worker.NewTicker(logger, worker.WithTickerDuration(cfg.Notification.DLQHandler.PollDuration), worker.WithID("dlq-worker"))
 	notificationHandler := notification.NewHandler(cfg.Notification.DLQHandler, logger, queue, notifierRegistry,
-		notification.HandlerWithIdentifier("dlq-"+workerTicker.GetID()))
+		notification.HandlerWithIdentifier("dlq-"+workerTicker.GetID()),
+		notification.HandlerWithRateLimiter(postgres.NewRateLimitRepository(pgClient)))
 	go func() {
 		workerTicker.Run(ctx, cancelWorkerChan, func(ctx context.Context, runningAt time.Time) error {
 			return notificationHandler.Process(ctx, runningAt)
@@ -20,5 +20,13 @@ type Notification struct {
 	ReceiverID     uint64
 	AlertIDs       []int64
 	SilenceIDs     []string
+	SilenceWindows []SilenceWindow
 	CreatedAt      time.Time
 }
+
+// SilenceWindow is the recurring window of a silence that suppressed a notification
+type SilenceWindow struct {
+	SilenceID string    `json:"silence_id"`
+	StartsAt  time.Time `json:"starts_at"`
+	EndsAt    time.Time `json:"ends_at"`
+}
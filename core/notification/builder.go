@@ -14,6 +14,7 @@ import (
 // .Data
 // - id
 // - status "FIRING"/"RESOLVED"
+// - fingerprint
 // - resource
 // - template
 // - metric_value
@@ -70,6 +71,7 @@ func BuildFromAlerts(
 		}
 
 		data["status"] = sampleAlert.Status
+		data["fingerprint"] = sampleAlert.Fingerprint
 		data["generator_url"] = sampleAlert.GeneratorURL
 		data["num_alerts_firing"] = firingLen
 
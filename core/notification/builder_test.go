@@ -103,6 +103,7 @@ func TestBuildFromAlerts(t *testing.T) {
 			alerts: []alert.Alert{
 				{
 					ID:           14,
+					Fingerprint:  "fp-1",
 					ProviderID:   1,
 					NamespaceID:  1,
 					ResourceName: "test-alert-host-1",
@@ -116,6 +117,7 @@ func TestBuildFromAlerts(t *testing.T) {
 				},
 				{
 					ID:           15,
+					Fingerprint:  "fp-2",
 					ProviderID:   1,
 					NamespaceID:  1,
 					ResourceName: "test-alert-host-2",
@@ -129,6 +131,7 @@ func TestBuildFromAlerts(t *testing.T) {
 				},
 				{
 					ID:           16,
+					Fingerprint:  "fp-2",
 					ProviderID:   1,
 					NamespaceID:  1,
 					ResourceName: "test-alert-host-2",
@@ -150,6 +153,7 @@ func TestBuildFromAlerts(t *testing.T) {
 						"generator_url":     "",
 						"num_alerts_firing": 2,
 						"status":            "FIRING",
+						"fingerprint":       "fp-1",
 						"ak1":               "akv1",
 					},
 					Labels: map[string]string{
@@ -167,6 +171,7 @@ func TestBuildFromAlerts(t *testing.T) {
 						"generator_url":     "",
 						"num_alerts_firing": 2,
 						"status":            "FIRING",
+						"fingerprint":       "fp-2",
 						"ak1":               "akv1\nakv11",
 						"ak2":               "akv2",
 					},
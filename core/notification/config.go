@@ -10,6 +10,7 @@ type Config struct {
 	Queue          queues.Config `mapstructure:"queue" yaml:"queue"`
 	MessageHandler HandlerConfig `mapstructure:"message_handler" yaml:"message_handler"`
 	DLQHandler     HandlerConfig `mapstructure:"dlq_handler" yaml:"dlq_handler"`
+	Group          GroupConfig   `mapstructure:"group" yaml:"group"`
 }
 
 type HandlerConfig struct {
@@ -17,4 +18,19 @@ type HandlerConfig struct {
 	PollDuration  time.Duration `mapstructure:"poll_duration" yaml:"poll_duration" default:"5s"`
 	ReceiverTypes []string      `mapstructure:"receiver_types" yaml:"receiver_types"`
 	BatchSize     int           `mapstructure:"batch_size" yaml:"batch_size" default:"1"`
+	// Concurrency is the number of messages of a dequeued batch sent at the same time
+	Concurrency int `mapstructure:"concurrency" yaml:"concurrency" default:"1"`
+	// RateLimits are token buckets keyed by receiver type, each receiver has its own bucket
+	RateLimits map[string]RateLimitConfig `mapstructure:"rate_limits" yaml:"rate_limits"`
+}
+
+// GroupConfig configures the grouping of subscriber messages before enqueued
+// messages with the same unique key, subscription, and receiver are buffered for group wait
+// and a merged message with the same content is not sent again within repeat interval
+type GroupConfig struct {
+	Enabled        bool          `mapstructure:"enabled" yaml:"enabled" default:"false"`
+	GroupWait      time.Duration `mapstructure:"group_wait" yaml:"group_wait" default:"30s"`
+	RepeatInterval time.Duration `mapstructure:"repeat_interval" yaml:"repeat_interval" default:"4h"`
+	PollDuration   time.Duration `mapstructure:"poll_duration" yaml:"poll_duration" default:"5s"`
+	BatchSize      int           `mapstructure:"batch_size" yaml:"batch_size" default:"10"`
 }
@@ -0,0 +1,39 @@
+package notification
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+const alertStatusResolved = "resolved"
+
+//go:generate mockery --name=CorrelationRepository -r --case underscore --with-expecter --structname CorrelationRepository --filename correlation_repository.go --output=./mocks
+type CorrelationRepository interface {
+	Upsert(ctx context.Context, c Correlation) error
+	Get(ctx context.Context, fingerprint, receiverType, destination string) (Correlation, error)
+}
+
+// Correlation is the identifiers returned by a receiver when a notification of an alert is sent.
+// Identifiers are kept per alert fingerprint so the resolved notification
+// could close or update the original incident or message.
+type Correlation struct {
+	Fingerprint  string
+	ReceiverType string
+	Destination  string
+	Identifiers  map[string]string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// AlertFingerprint returns the fingerprint of the alert the message is built from
+func (m Message) AlertFingerprint() string {
+	fingerprint, _ := m.Details[DetailsKeyAlertFingerprint].(string)
+	return fingerprint
+}
+
+// IsAlertResolved returns true if the message is built from a resolved alert
+func (m Message) IsAlertResolved() bool {
+	status, _ := m.Details[DetailsKeyAlertStatus].(string)
+	return strings.EqualFold(status, alertStatusResolved)
+}
@@ -0,0 +1,51 @@
+package notification_test
+
+import (
+	"testing"
+
+	"github.com/odpf/siren/core/notification"
+)
+
+func TestMessage_AlertCorrelation(t *testing.T) {
+	testCases := []struct {
+		name            string
+		details         map[string]interface{}
+		wantFingerprint string
+		wantResolved    bool
+	}{
+		{
+			name: "should return empty fingerprint if message is not built from alerts",
+			details: map[string]interface{}{
+				"status": "resolved",
+			},
+		},
+		{
+			name: "should return fingerprint and not resolved if alert is firing",
+			details: map[string]interface{}{
+				notification.DetailsKeyAlertFingerprint: "some-fingerprint",
+				notification.DetailsKeyAlertStatus:      "firing",
+			},
+			wantFingerprint: "some-fingerprint",
+		},
+		{
+			name: "should return fingerprint and resolved regardless status case",
+			details: map[string]interface{}{
+				notification.DetailsKeyAlertFingerprint: "some-fingerprint",
+				notification.DetailsKeyAlertStatus:      "RESOLVED",
+			},
+			wantFingerprint: "some-fingerprint",
+			wantResolved:    true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := notification.Message{Details: tc.details}
+			if got := m.AlertFingerprint(); got != tc.wantFingerprint {
+				t.Errorf("AlertFingerprint() = %v, want %v", got, tc.wantFingerprint)
+			}
+			if got := m.IsAlertResolved(); got != tc.wantResolved {
+				t.Errorf("IsAlertResolved() = %v, want %v", got, tc.wantResolved)
+			}
+		})
+	}
+}
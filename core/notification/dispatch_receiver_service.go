@@ -56,6 +56,7 @@ func (s *DispatchReceiverService) PrepareMessage(ctx context.Context, n Notifica
 		rcv.Type,
 		rcv.Configurations,
 		InitWithExpiryDuration(n.ValidDuration),
+		InitWithReceiverID(rcv.ID),
 	)
 	if err != nil {
 		return nil, nil, false, err
@@ -3,6 +3,7 @@ package notification
 import (
 	"context"
 	"fmt"
+	"time"
 
 	saltlog "github.com/odpf/salt/log"
 	"github.com/odpf/siren/core/log"
@@ -57,6 +58,8 @@ func (s *DispatchSubscriberService) PrepareMessage(ctx context.Context, n Notifi
 		return nil, nil, false, errors.ErrInvalid.WithMsgf("not matching any subscription")
 	}
 
+	now := time.Now()
+
 	for _, sub := range subscriptions {
 
 		if len(sub.Receivers) == 0 {
@@ -72,6 +75,7 @@ func (s *DispatchSubscriberService) PrepareMessage(ctx context.Context, n Notifi
 		if err != nil {
 			return nil, nil, false, err
 		}
+		silences = activeSilences(silences, now)
 
 		if len(silences) != 0 {
 			hasSilenced = true
@@ -87,6 +91,7 @@ func (s *DispatchSubscriberService) PrepareMessage(ctx context.Context, n Notifi
 				SubscriptionID: sub.ID,
 				AlertIDs:       n.AlertIDs,
 				SilenceIDs:     silenceIDs,
+				SilenceWindows: recurringWindows(silences, now),
 			})
 
 			s.logger.Info(fmt.Sprintf("notification '%s' of alert ids '%v' is being silenced by labels '%v'", n.ID, n.AlertIDs, silences))
@@ -101,6 +106,7 @@ func (s *DispatchSubscriberService) PrepareMessage(ctx context.Context, n Notifi
 		if err != nil {
 			return nil, nil, false, err
 		}
+		silences = activeSilences(silences, now)
 
 		silencedReceiversMap, validReceivers, err := sub.SilenceReceivers(silences)
 		if err != nil {
@@ -123,6 +129,7 @@ func (s *DispatchSubscriberService) PrepareMessage(ctx context.Context, n Notifi
 					ReceiverID:     rcvID,
 					AlertIDs:       n.AlertIDs,
 					SilenceIDs:     silenceIDs,
+					SilenceWindows: recurringWindows(sils, now),
 				})
 			}
 		}
@@ -140,11 +147,25 @@ func (s *DispatchSubscriberService) PrepareMessage(ctx context.Context, n Notifi
 				rcv.Type,
 				rcv.Configuration,
 				InitWithExpiryDuration(n.ValidDuration),
+				InitWithReceiverID(rcv.ID),
 			)
 			if err != nil {
 				return nil, nil, false, err
 			}
 
+			if n.UniqueKey != "" {
+				contentHash, err := hashContent(n)
+				if err != nil {
+					return nil, nil, false, err
+				}
+				message.GroupKey = GroupKey{
+					UniqueKey:      n.UniqueKey,
+					SubscriptionID: sub.ID,
+					ReceiverID:     rcv.ID,
+				}
+				message.ContentHash = contentHash
+			}
+
 			messages = append(messages, message)
 			notificationLogs = append(notificationLogs, log.Notification{
 				NamespaceID:    n.NamespaceID,
@@ -158,3 +179,29 @@ func (s *DispatchSubscriberService) PrepareMessage(ctx context.Context, n Notifi
 
 	return messages, notificationLogs, hasSilenced, nil
 }
+
+// activeSilences filters out silences that are outside their window at the time
+func activeSilences(silences []silence.Silence, t time.Time) []silence.Silence {
+	var active []silence.Silence
+	for _, sil := range silences {
+		if sil.IsActiveAt(t) {
+			active = append(active, sil)
+		}
+	}
+	return active
+}
+
+// recurringWindows returns the windows of recurring silences that cover the time
+func recurringWindows(silences []silence.Silence, t time.Time) []log.SilenceWindow {
+	var windows []log.SilenceWindow
+	for _, sil := range silences {
+		if w, ok := sil.RecurringWindowAt(t); ok {
+			windows = append(windows, log.SilenceWindow{
+				SilenceID: sil.ID,
+				StartsAt:  w.StartsAt,
+				EndsAt:    w.EndsAt,
+			})
+		}
+	}
+	return windows
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -295,13 +296,227 @@ func TestDispatchSubscriberService_PrepareMessage(t *testing.T) {
 					Status:       notification.MessageStatusEnqueued,
 					ReceiverType: testPluginType,
 					Configs:      map[string]interface{}{},
-					Details:      map[string]interface{}{"notification_type": string("")},
+					Details:      map[string]interface{}{"notification_type": string(""), "receiver_id": "1"},
 					MaxTries:     3,
 				},
 			},
 			want1: []log.Notification{{NamespaceID: 1, SubscriptionID: 123, ReceiverID: 1}},
 			want2: false,
 		},
+		{
+			name: "should not silence if silences are outside their window",
+			n: notification.Notification{
+				NamespaceID: 1,
+			},
+			setup: func(ss1 *mocks.SubscriptionService, ss2 *mocks.SilenceService, n *mocks.Notifier) {
+				ss1.EXPECT().MatchByLabels(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("uint64"), mock.AnythingOfType("map[string]string")).Return([]subscription.Subscription{
+					{
+						ID:        123,
+						Namespace: 1,
+						Match: map[string]string{
+							"k1": "v1",
+						},
+						Receivers: []subscription.Receiver{
+							{
+								ID:   1,
+								Type: testPluginType,
+							},
+						},
+					},
+				}, nil)
+				ss2.EXPECT().List(mock.AnythingOfType("*context.emptyCtx"), silence.Filter{
+					NamespaceID: 1,
+					SubscriptionMatch: map[string]string{
+						"k1": "v1",
+					},
+				}).Return([]silence.Silence{
+					{
+						ID:          "silence-id",
+						NamespaceID: 1,
+						EndsAt:      time.Now().Add(-time.Hour),
+					},
+				}, nil)
+				ss2.EXPECT().List(mock.AnythingOfType("*context.emptyCtx"), silence.Filter{
+					NamespaceID:    1,
+					SubscriptionID: 123,
+				}).Return([]silence.Silence{
+					{
+						ID:          "silence-id-2",
+						NamespaceID: 1,
+						Type:        silence.TypeSubscription,
+						StartsAt:    time.Now().Add(time.Hour),
+					},
+				}, nil)
+				n.EXPECT().PreHookQueueTransformConfigs(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("map[string]interface {}")).Return(map[string]interface{}{}, nil)
+			},
+			want: []notification.Message{
+				{
+					Status:       notification.MessageStatusEnqueued,
+					ReceiverType: testPluginType,
+					Configs:      map[string]interface{}{},
+					Details:      map[string]interface{}{"notification_type": string(""), "receiver_id": "1"},
+					MaxTries:     3,
+				},
+			},
+			want1: []log.Notification{{NamespaceID: 1, SubscriptionID: 123, ReceiverID: 1}},
+			want2: false,
+		},
+		{
+			name: "should silence by labels and record the window if recurring silence is within its schedule",
+			n: notification.Notification{
+				NamespaceID: 1,
+			},
+			setup: func(ss1 *mocks.SubscriptionService, ss2 *mocks.SilenceService, n *mocks.Notifier) {
+				ss1.EXPECT().MatchByLabels(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("uint64"), mock.AnythingOfType("map[string]string")).Return([]subscription.Subscription{
+					{
+						ID:        123,
+						Namespace: 1,
+						Match: map[string]string{
+							"k1": "v1",
+						},
+						Receivers: []subscription.Receiver{
+							{
+								ID:   1,
+								Type: testPluginType,
+							},
+						},
+					},
+				}, nil)
+				ss2.EXPECT().List(mock.AnythingOfType("*context.emptyCtx"), silence.Filter{
+					NamespaceID: 1,
+					SubscriptionMatch: map[string]string{
+						"k1": "v1",
+					},
+				}).Return([]silence.Silence{
+					{
+						ID:          "silence-id",
+						NamespaceID: 1,
+						Type:        silence.TypeRecurring,
+						Schedule: silence.Schedule{
+							Cron:     "* * * * *",
+							Duration: time.Hour,
+						},
+					},
+				}, nil)
+			},
+			want: []notification.Message{},
+			want1: []log.Notification{
+				{
+					NamespaceID:    1,
+					SubscriptionID: 123,
+					SilenceIDs:     []string{"silence-id"},
+					SilenceWindows: []log.SilenceWindow{{SilenceID: "silence-id"}},
+				},
+			},
+			want2: true,
+		},
+		{
+			name: "should not silence if recurring silence is outside its schedule",
+			n: notification.Notification{
+				NamespaceID: 1,
+			},
+			setup: func(ss1 *mocks.SubscriptionService, ss2 *mocks.SilenceService, n *mocks.Notifier) {
+				ss1.EXPECT().MatchByLabels(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("uint64"), mock.AnythingOfType("map[string]string")).Return([]subscription.Subscription{
+					{
+						ID:        123,
+						Namespace: 1,
+						Match: map[string]string{
+							"k1": "v1",
+						},
+						Receivers: []subscription.Receiver{
+							{
+								ID:   1,
+								Type: testPluginType,
+							},
+						},
+					},
+				}, nil)
+				ss2.EXPECT().List(mock.AnythingOfType("*context.emptyCtx"), silence.Filter{
+					NamespaceID: 1,
+					SubscriptionMatch: map[string]string{
+						"k1": "v1",
+					},
+				}).Return([]silence.Silence{
+					{
+						ID:          "silence-id",
+						NamespaceID: 1,
+						Type:        silence.TypeRecurring,
+						Schedule: silence.Schedule{
+							// february 30th never happens
+							Cron:     "0 0 30 2 *",
+							Duration: time.Hour,
+						},
+					},
+				}, nil)
+				ss2.EXPECT().List(mock.AnythingOfType("*context.emptyCtx"), silence.Filter{
+					NamespaceID:    1,
+					SubscriptionID: 123,
+				}).Return(nil, nil)
+				n.EXPECT().PreHookQueueTransformConfigs(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("map[string]interface {}")).Return(map[string]interface{}{}, nil)
+			},
+			want: []notification.Message{
+				{
+					Status:       notification.MessageStatusEnqueued,
+					ReceiverType: testPluginType,
+					Configs:      map[string]interface{}{},
+					Details:      map[string]interface{}{"notification_type": string(""), "receiver_id": "1"},
+					MaxTries:     3,
+				},
+			},
+			want1: []log.Notification{{NamespaceID: 1, SubscriptionID: 123, ReceiverID: 1}},
+			want2: false,
+		},
+		{
+			name: "should set group key to messages if notification has unique key",
+			n: notification.Notification{
+				NamespaceID: 1,
+				UniqueKey:   "unique-key",
+			},
+			setup: func(ss1 *mocks.SubscriptionService, ss2 *mocks.SilenceService, n *mocks.Notifier) {
+				ss1.EXPECT().MatchByLabels(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("uint64"), mock.AnythingOfType("map[string]string")).Return([]subscription.Subscription{
+					{
+						ID:        123,
+						Namespace: 1,
+						Match: map[string]string{
+							"k1": "v1",
+						},
+						Receivers: []subscription.Receiver{
+							{
+								ID:   1,
+								Type: testPluginType,
+							},
+						},
+					},
+				}, nil)
+				ss2.EXPECT().List(mock.AnythingOfType("*context.emptyCtx"), silence.Filter{
+					NamespaceID: 1,
+					SubscriptionMatch: map[string]string{
+						"k1": "v1",
+					},
+				}).Return(nil, nil)
+				ss2.EXPECT().List(mock.AnythingOfType("*context.emptyCtx"), silence.Filter{
+					NamespaceID:    1,
+					SubscriptionID: 123,
+				}).Return(nil, nil)
+				n.EXPECT().PreHookQueueTransformConfigs(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("map[string]interface {}")).Return(map[string]interface{}{}, nil)
+			},
+			want: []notification.Message{
+				{
+					Status:       notification.MessageStatusEnqueued,
+					ReceiverType: testPluginType,
+					Configs:      map[string]interface{}{},
+					Details:      map[string]interface{}{"notification_type": string(""), "unique_key": "unique-key", "receiver_id": "1"},
+					MaxTries:     3,
+					GroupKey: notification.GroupKey{
+						UniqueKey:      "unique-key",
+						SubscriptionID: 123,
+						ReceiverID:     1,
+					},
+				},
+			},
+			want1: []log.Notification{{NamespaceID: 1, SubscriptionID: 123, ReceiverID: 1}},
+			want2: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -327,11 +542,12 @@ func TestDispatchSubscriberService_PrepareMessage(t *testing.T) {
 				return
 			}
 			if diff := cmp.Diff(got, tt.want,
-				cmpopts.IgnoreFields(notification.Message{}, "ID", "CreatedAt", "UpdatedAt"),
+				cmpopts.IgnoreFields(notification.Message{}, "ID", "CreatedAt", "UpdatedAt", "ContentHash"),
 				cmpopts.IgnoreUnexported(notification.Message{})); diff != "" {
 				t.Errorf("DispatchSubscriberService.PrepareMessage() diff = %v", diff)
 			}
-			if diff := cmp.Diff(got1, tt.want1); diff != "" {
+			if diff := cmp.Diff(got1, tt.want1,
+				cmpopts.IgnoreFields(log.SilenceWindow{}, "StartsAt", "EndsAt")); diff != "" {
 				t.Errorf("DispatchSubscriberService.PrepareMessage() diff = %v", diff)
 			}
 			if got2 != tt.want2 {
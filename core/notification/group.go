@@ -0,0 +1,203 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/hashstructure/v2"
+	saltlog "github.com/odpf/salt/log"
+	"go.opencensus.io/tag"
+
+	"github.com/odpf/siren/pkg/telemetry"
+)
+
+const defaultGroupBatchSize = 10
+
+//go:generate mockery --name=GroupRepository -r --case underscore --with-expecter --structname GroupRepository --filename group_repository.go --output=./mocks
+type GroupRepository interface {
+	Transactor
+	Buffer(ctx context.Context, m Message, groupWait time.Duration) error
+	ListDue(ctx context.Context, batchSize int) ([]Group, error)
+	MarkFlushed(ctx context.Context, g Group) error
+}
+
+//go:generate mockery --name=Transactor -r --case underscore --with-expecter --structname Transactor --filename transactor.go --output=./mocks
+type Transactor interface {
+	WithTransaction(ctx context.Context) context.Context
+	Rollback(ctx context.Context, err error) error
+	Commit(ctx context.Context) error
+}
+
+//go:generate mockery --name=MessageGrouper -r --case underscore --with-expecter --structname MessageGrouper --filename message_grouper.go --output=./mocks
+type MessageGrouper interface {
+	Buffer(ctx context.Context, ms ...Message) error
+}
+
+// GroupKey identifies messages of the same alert group sent to the same subscription receiver
+type GroupKey struct {
+	UniqueKey      string `json:"unique_key"`
+	SubscriptionID uint64 `json:"subscription_id"`
+	ReceiverID     uint64 `json:"receiver_id"`
+}
+
+// IsEmpty returns true if the message could not be grouped
+func (gk GroupKey) IsEmpty() bool {
+	return gk.UniqueKey == ""
+}
+
+// Group is the buffered messages of a group key waiting to be flushed
+type Group struct {
+	GroupKey
+	Messages     []Message
+	FlushAt      time.Time
+	LastSentAt   time.Time
+	LastSentHash string
+}
+
+// Merge merges all buffered messages into one message
+// the latest message represents the latest state of the alert group
+// details are merged from the oldest to the latest message
+func (g Group) Merge() (Message, bool) {
+	if len(g.Messages) == 0 {
+		return Message{}, false
+	}
+
+	merged := g.Messages[len(g.Messages)-1]
+	details := map[string]interface{}{}
+	for _, m := range g.Messages {
+		for k, v := range m.Details {
+			details[k] = v
+		}
+	}
+	merged.Details = details
+
+	return merged, true
+}
+
+// FlushID returns the id of the merged message of the group window
+// it is the same every time the window is flushed until the flush is committed
+// so a message enqueued by a flush that failed to commit is not enqueued twice
+func (g Group) FlushID() string {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(fmt.Sprintf("%s/%d/%d/%s",
+		g.UniqueKey, g.SubscriptionID, g.ReceiverID, g.FlushAt.UTC().Format(time.RFC3339Nano),
+	))).String()
+}
+
+// IsRepeatAt returns true if the content was already sent within the repeat interval
+func (g Group) IsRepeatAt(contentHash string, repeatInterval time.Duration, t time.Time) bool {
+	if g.LastSentAt.IsZero() || g.LastSentHash != contentHash {
+		return false
+	}
+	return t.Before(g.LastSentAt.Add(repeatInterval))
+}
+
+// Grouper buffers messages of the same group key for a group wait
+// and enqueues one merged message per group once the wait is over
+type Grouper struct {
+	logger     saltlog.Logger
+	cfg        GroupConfig
+	repository GroupRepository
+	q          Queuer
+}
+
+// NewGrouper creates a new grouper
+func NewGrouper(cfg GroupConfig, logger saltlog.Logger, repository GroupRepository, q Queuer) *Grouper {
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = defaultGroupBatchSize
+	}
+	return &Grouper{
+		logger:     logger,
+		cfg:        cfg,
+		repository: repository,
+		q:          q,
+	}
+}
+
+// Buffer stores messages to their group until the group is flushed
+func (g *Grouper) Buffer(ctx context.Context, ms ...Message) error {
+	for _, m := range ms {
+		if m.GroupKey.IsEmpty() {
+			return fmt.Errorf("message %s has no group key", m.ID)
+		}
+		if err := g.repository.Buffer(ctx, m, g.cfg.GroupWait); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush enqueues a merged message of every group that passed its group wait
+// groups are locked while flushing so only one replica flushes the same group
+// the queue is not part of the group transaction, messages are enqueued before the groups are committed
+// with the flush id of the window, so a window flushed again after a failed commit is not sent twice
+func (g *Grouper) Flush(ctx context.Context, runAt time.Time) error {
+	ctx = g.repository.WithTransaction(ctx)
+
+	groups, err := g.repository.ListDue(ctx, g.cfg.BatchSize)
+	if err != nil {
+		if err := g.repository.Rollback(ctx, err); err != nil {
+			return err
+		}
+		return err
+	}
+
+	var messages []Message
+	for _, grp := range groups {
+		merged, ok := grp.Merge()
+		if ok {
+			merged.ID = grp.FlushID()
+			if grp.IsRepeatAt(merged.ContentHash, g.cfg.RepeatInterval, runAt) {
+				telemetry.IncrementInt64Counter(ctx, telemetry.MetricNotificationGroupSuppressed,
+					tag.Upsert(telemetry.TagReceiverType, merged.ReceiverType))
+
+				g.logger.Debug("suppressing repeated message of group", "unique_key", grp.UniqueKey, "subscription_id", grp.SubscriptionID, "receiver_id", grp.ReceiverID)
+			} else {
+				messages = append(messages, merged)
+				grp.LastSentAt = runAt
+				grp.LastSentHash = merged.ContentHash
+			}
+		}
+
+		if err := g.repository.MarkFlushed(ctx, grp); err != nil {
+			if err := g.repository.Rollback(ctx, err); err != nil {
+				return err
+			}
+			return err
+		}
+	}
+
+	if len(messages) != 0 {
+		if err := g.q.Enqueue(ctx, messages...); err != nil {
+			if err := g.repository.Rollback(ctx, err); err != nil {
+				return err
+			}
+			return fmt.Errorf("failed enqueuing grouped messages: %w", err)
+		}
+	}
+
+	return g.repository.Commit(ctx)
+}
+
+// hashContent hashes the notification content that determines whether a message is a repeat
+func hashContent(n Notification) (string, error) {
+	data := map[string]interface{}{}
+	for k, v := range n.Data {
+		// notification id is different on every dispatch
+		if k == "id" {
+			continue
+		}
+		data[k] = v
+	}
+
+	hash, err := hashstructure.Hash(struct {
+		Data   map[string]interface{}
+		Labels map[string]string
+	}{data, n.Labels}, hashstructure.FormatV2, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d", hash), nil
+}
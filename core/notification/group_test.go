@@ -0,0 +1,310 @@
+package notification_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	saltlog "github.com/odpf/salt/log"
+	"github.com/odpf/siren/core/notification"
+	"github.com/odpf/siren/core/notification/mocks"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGroup_Merge(t *testing.T) {
+	tests := []struct {
+		name   string
+		g      notification.Group
+		want   notification.Message
+		wantOK bool
+	}{
+		{
+			name: "should return false if group has no messages",
+			g:    notification.Group{},
+		},
+		{
+			name: "should merge details into the latest message",
+			g: notification.Group{
+				Messages: []notification.Message{
+					{
+						ID:      "1",
+						Details: map[string]interface{}{"status": "firing", "summary": "cpu high"},
+					},
+					{
+						ID:      "2",
+						Details: map[string]interface{}{"status": "resolved"},
+					},
+				},
+			},
+			want: notification.Message{
+				ID:      "2",
+				Details: map[string]interface{}{"status": "resolved", "summary": "cpu high"},
+			},
+			wantOK: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotOK := tt.g.Merge()
+			if gotOK != tt.wantOK {
+				t.Errorf("Group.Merge() ok = %v, want %v", gotOK, tt.wantOK)
+			}
+			if diff := cmp.Diff(got, tt.want, cmp.AllowUnexported(notification.Message{})); diff != "" {
+				t.Errorf("Group.Merge() diff = %v", diff)
+			}
+		})
+	}
+}
+
+func TestGroup_IsRepeatAt(t *testing.T) {
+	lastSentAt := time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		g           notification.Group
+		contentHash string
+		at          time.Time
+		want        bool
+	}{
+		{
+			name:        "should return false if nothing has been sent",
+			g:           notification.Group{},
+			contentHash: "hash",
+			at:          lastSentAt,
+		},
+		{
+			name:        "should return false if content is different",
+			g:           notification.Group{LastSentAt: lastSentAt, LastSentHash: "old-hash"},
+			contentHash: "hash",
+			at:          lastSentAt.Add(time.Minute),
+		},
+		{
+			name:        "should return true if same content within repeat interval",
+			g:           notification.Group{LastSentAt: lastSentAt, LastSentHash: "hash"},
+			contentHash: "hash",
+			at:          lastSentAt.Add(time.Minute),
+			want:        true,
+		},
+		{
+			name:        "should return false if same content after repeat interval",
+			g:           notification.Group{LastSentAt: lastSentAt, LastSentHash: "hash"},
+			contentHash: "hash",
+			at:          lastSentAt.Add(time.Hour),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.IsRepeatAt(tt.contentHash, time.Hour, tt.at); got != tt.want {
+				t.Errorf("Group.IsRepeatAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGrouper_Buffer(t *testing.T) {
+	groupKey := notification.GroupKey{
+		UniqueKey:      "unique-key",
+		SubscriptionID: 1,
+		ReceiverID:     2,
+	}
+
+	tests := []struct {
+		name     string
+		messages []notification.Message
+		setup    func(*mocks.GroupRepository)
+		wantErr  bool
+	}{
+		{
+			name:     "should return error if message has no group key",
+			messages: []notification.Message{{ID: "1"}},
+			wantErr:  true,
+		},
+		{
+			name:     "should return error if repository buffer return error",
+			messages: []notification.Message{{ID: "1", GroupKey: groupKey}},
+			setup: func(gr *mocks.GroupRepository) {
+				gr.EXPECT().Buffer(mock.AnythingOfType("*context.emptyCtx"), notification.Message{ID: "1", GroupKey: groupKey}, 30*time.Second).Return(errors.New("some error"))
+			},
+			wantErr: true,
+		},
+		{
+			name:     "should return nil if all messages are buffered",
+			messages: []notification.Message{{ID: "1", GroupKey: groupKey}, {ID: "2", GroupKey: groupKey}},
+			setup: func(gr *mocks.GroupRepository) {
+				gr.EXPECT().Buffer(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("notification.Message"), 30*time.Second).Return(nil).Times(2)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGroupRepository := new(mocks.GroupRepository)
+
+			if tt.setup != nil {
+				tt.setup(mockGroupRepository)
+			}
+
+			g := notification.NewGrouper(notification.GroupConfig{GroupWait: 30 * time.Second}, saltlog.NewNoop(), mockGroupRepository, nil)
+			if err := g.Buffer(context.TODO(), tt.messages...); (err != nil) != tt.wantErr {
+				t.Errorf("Grouper.Buffer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			mockGroupRepository.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGroup_FlushID(t *testing.T) {
+	var (
+		flushAt = time.Date(2022, 12, 1, 1, 0, 0, 0, time.UTC)
+		g       = notification.Group{
+			GroupKey: notification.GroupKey{UniqueKey: "unique-key", SubscriptionID: 1, ReceiverID: 2},
+			FlushAt:  flushAt,
+		}
+	)
+
+	if g.FlushID() != g.FlushID() {
+		t.Errorf("Group.FlushID() should be the same for the same window")
+	}
+
+	sameWindow := g
+	sameWindow.FlushAt = flushAt.In(time.FixedZone("WIB", 7*60*60))
+	sameWindow.LastSentAt = flushAt
+	if g.FlushID() != sameWindow.FlushID() {
+		t.Errorf("Group.FlushID() should not depend on time zone or last sent time")
+	}
+
+	nextWindow := g
+	nextWindow.FlushAt = flushAt.Add(time.Minute)
+	if g.FlushID() == nextWindow.FlushID() {
+		t.Errorf("Group.FlushID() should be different for the next window")
+	}
+
+	otherReceiver := g
+	otherReceiver.ReceiverID = 3
+	if g.FlushID() == otherReceiver.FlushID() {
+		t.Errorf("Group.FlushID() should be different for another receiver")
+	}
+}
+
+func TestGrouper_Flush(t *testing.T) {
+	var (
+		runAt    = time.Date(2022, 12, 1, 1, 0, 0, 0, time.UTC)
+		groupKey = notification.GroupKey{
+			UniqueKey:      "unique-key",
+			SubscriptionID: 1,
+			ReceiverID:     2,
+		}
+		message = notification.Message{
+			ID:          "1",
+			Details:     map[string]interface{}{"status": "firing"},
+			GroupKey:    groupKey,
+			ContentHash: "hash",
+		}
+		flushedMessage = func() notification.Message {
+			m := message
+			m.ID = notification.Group{GroupKey: groupKey}.FlushID()
+			return m
+		}()
+	)
+
+	tests := []struct {
+		name    string
+		setup   func(*mocks.GroupRepository, *mocks.Queuer)
+		wantErr bool
+	}{
+		{
+			name: "should return error if list due groups return error",
+			setup: func(gr *mocks.GroupRepository, q *mocks.Queuer) {
+				gr.EXPECT().WithTransaction(mock.AnythingOfType("*context.emptyCtx")).Return(context.TODO())
+				gr.EXPECT().ListDue(mock.AnythingOfType("*context.emptyCtx"), 10).Return(nil, errors.New("some error"))
+				gr.EXPECT().Rollback(mock.AnythingOfType("*context.emptyCtx"), mock.Anything).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return error if mark flushed return error",
+			setup: func(gr *mocks.GroupRepository, q *mocks.Queuer) {
+				gr.EXPECT().WithTransaction(mock.AnythingOfType("*context.emptyCtx")).Return(context.TODO())
+				gr.EXPECT().ListDue(mock.AnythingOfType("*context.emptyCtx"), 10).Return([]notification.Group{
+					{GroupKey: groupKey, Messages: []notification.Message{message}},
+				}, nil)
+				gr.EXPECT().MarkFlushed(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("notification.Group")).Return(errors.New("some error"))
+				gr.EXPECT().Rollback(mock.AnythingOfType("*context.emptyCtx"), mock.Anything).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return error if enqueue return error",
+			setup: func(gr *mocks.GroupRepository, q *mocks.Queuer) {
+				gr.EXPECT().WithTransaction(mock.AnythingOfType("*context.emptyCtx")).Return(context.TODO())
+				gr.EXPECT().ListDue(mock.AnythingOfType("*context.emptyCtx"), 10).Return([]notification.Group{
+					{GroupKey: groupKey, Messages: []notification.Message{message}},
+				}, nil)
+				gr.EXPECT().MarkFlushed(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("notification.Group")).Return(nil)
+				q.EXPECT().Enqueue(mock.AnythingOfType("*context.emptyCtx"), flushedMessage).Return(errors.New("some error"))
+				gr.EXPECT().Rollback(mock.AnythingOfType("*context.emptyCtx"), mock.Anything).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "should enqueue merged message and mark group as sent",
+			setup: func(gr *mocks.GroupRepository, q *mocks.Queuer) {
+				gr.EXPECT().WithTransaction(mock.AnythingOfType("*context.emptyCtx")).Return(context.TODO())
+				gr.EXPECT().ListDue(mock.AnythingOfType("*context.emptyCtx"), 10).Return([]notification.Group{
+					{GroupKey: groupKey, Messages: []notification.Message{message}},
+				}, nil)
+				gr.EXPECT().MarkFlushed(mock.AnythingOfType("*context.emptyCtx"), notification.Group{
+					GroupKey:     groupKey,
+					Messages:     []notification.Message{message},
+					LastSentAt:   runAt,
+					LastSentHash: "hash",
+				}).Return(nil)
+				q.EXPECT().Enqueue(mock.AnythingOfType("*context.emptyCtx"), flushedMessage).Return(nil)
+				gr.EXPECT().Commit(mock.AnythingOfType("*context.emptyCtx")).Return(nil)
+			},
+		},
+		{
+			name: "should suppress merged message if it is a repeat within repeat interval",
+			setup: func(gr *mocks.GroupRepository, q *mocks.Queuer) {
+				gr.EXPECT().WithTransaction(mock.AnythingOfType("*context.emptyCtx")).Return(context.TODO())
+				gr.EXPECT().ListDue(mock.AnythingOfType("*context.emptyCtx"), 10).Return([]notification.Group{
+					{
+						GroupKey:     groupKey,
+						Messages:     []notification.Message{message},
+						LastSentAt:   runAt.Add(-time.Minute),
+						LastSentHash: "hash",
+					},
+				}, nil)
+				gr.EXPECT().MarkFlushed(mock.AnythingOfType("*context.emptyCtx"), notification.Group{
+					GroupKey:     groupKey,
+					Messages:     []notification.Message{message},
+					LastSentAt:   runAt.Add(-time.Minute),
+					LastSentHash: "hash",
+				}).Return(nil)
+				gr.EXPECT().Commit(mock.AnythingOfType("*context.emptyCtx")).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mockGroupRepository = new(mocks.GroupRepository)
+				mockQueuer          = new(mocks.Queuer)
+			)
+
+			if tt.setup != nil {
+				tt.setup(mockGroupRepository, mockQueuer)
+			}
+
+			g := notification.NewGrouper(notification.GroupConfig{RepeatInterval: time.Hour}, saltlog.NewNoop(), mockGroupRepository, mockQueuer)
+			if err := g.Flush(context.TODO(), runAt); (err != nil) != tt.wantErr {
+				t.Errorf("Grouper.Flush() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			mockGroupRepository.AssertExpectations(t)
+			mockQueuer.AssertExpectations(t)
+		})
+	}
+}
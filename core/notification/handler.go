@@ -3,6 +3,7 @@ package notification
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/odpf/salt/log"
@@ -14,7 +15,8 @@ import (
 )
 
 const (
-	defaultBatchSize = 1
+	defaultBatchSize   = 1
+	defaultConcurrency = 1
 )
 
 // Handler is a process to handle message publishing
@@ -25,23 +27,31 @@ type Handler struct {
 	notifierRegistry       map[string]Notifier
 	supportedReceiverTypes []string
 	messagingTracer        *telemetry.MessagingTracer
+	rateLimiter            RateLimiter
+	rateLimits             map[string]RateLimitConfig
 
-	batchSize int
+	batchSize   int
+	concurrency int
 }
 
 // NewHandler creates a new handler with some supported type of Notifiers
 func NewHandler(cfg HandlerConfig, logger log.Logger, q Queuer, registry map[string]Notifier, opts ...HandlerOption) *Handler {
 	h := &Handler{
-		batchSize: defaultBatchSize,
+		batchSize:   defaultBatchSize,
+		concurrency: defaultConcurrency,
 
 		logger:           logger,
 		notifierRegistry: registry,
 		q:                q,
+		rateLimits:       cfg.RateLimits,
 	}
 
 	if cfg.BatchSize != 0 {
 		h.batchSize = cfg.BatchSize
 	}
+	if cfg.Concurrency > 0 {
+		h.concurrency = cfg.Concurrency
+	}
 	registeredReceivers := make([]string, 0, len(h.notifierRegistry))
 	for k := range h.notifierRegistry {
 		registeredReceivers = append(registeredReceivers, k)
@@ -104,66 +114,152 @@ func (h *Handler) Process(ctx context.Context, runAt time.Time) error {
 }
 
 // MessageHandler is a function to handler dequeued message
+// messages of the batch are handled concurrently by the handler workers, each message is
+// called back independently so a failed message does not leave the rest of the batch pending
 func (h *Handler) MessageHandler(ctx context.Context, messages []Message) error {
+	var (
+		wg          sync.WaitGroup
+		mu          sync.Mutex
+		failedCount int
+		firstErr    error
+		messageCh   = make(chan Message)
+	)
+
+	workers := h.concurrency
+	if workers > len(messages) {
+		workers = len(messages)
+	}
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for message := range messageCh {
+				if err := h.handleMessage(ctx, message); err != nil {
+					mu.Lock()
+					failedCount++
+					if firstErr == nil {
+						firstErr = err
+					}
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
 	for _, message := range messages {
+		messageCh <- message
+	}
+	close(messageCh)
+	wg.Wait()
 
-		telemetry.GaugeMillisecond(ctx, telemetry.MetricNotificationMessageQueueTime, time.Since(message.UpdatedAt).Milliseconds(),
-			tag.Upsert(telemetry.TagReceiverType, message.ReceiverType))
+	if failedCount > 0 {
+		return fmt.Errorf("failed to handle %d of %d messages: %w", failedCount, len(messages), firstErr)
+	}
 
-		notifier, err := h.getNotifierPlugin(message.ReceiverType)
+	return nil
+}
+
+// handleMessage sends a message to its receiver and calls back the queue with the outcome,
+// the outcome is traced as a child of the batch_dequeue span
+func (h *Handler) handleMessage(ctx context.Context, message Message) (err error) {
+	ctx, span := h.messagingTracer.StartSpan(ctx, "process",
+		trace.StringAttribute("messaging.message_id", message.ID),
+		trace.StringAttribute("messaging.receiver_type", message.ReceiverType),
+	)
+	defer func() {
+		span.AddAttributes(trace.StringAttribute("messaging.message_status", message.Status.String()))
 		if err != nil {
-			return err
+			span.SetStatus(trace.Status{
+				Code:    trace.StatusCodeUnknown,
+				Message: err.Error(),
+			})
 		}
+		span.End()
+	}()
 
-		message.MarkPending(time.Now())
+	telemetry.GaugeMillisecond(ctx, telemetry.MetricNotificationMessageQueueTime, time.Since(message.UpdatedAt).Milliseconds(),
+		tag.Upsert(telemetry.TagReceiverType, message.ReceiverType))
 
-		telemetry.IncrementInt64Counter(ctx, telemetry.MetricNotificationMessageCounter,
-			tag.Upsert(telemetry.TagMessageStatus, message.Status.String()),
+	notifier, err := h.getNotifierPlugin(message.ReceiverType)
+	if err != nil {
+		message.MarkFailed(time.Now(), false, err)
+		if cerr := h.q.ErrorCallback(ctx, message); cerr != nil {
+			return cerr
+		}
+		return err
+	}
+
+	if wait := h.throttle(ctx, message); wait > 0 {
+		message.MarkThrottled(time.Now(), time.Now().Add(wait))
+
+		telemetry.IncrementInt64Counter(ctx, telemetry.MetricNotificationMessageThrottled,
 			tag.Upsert(telemetry.TagReceiverType, message.ReceiverType))
 
-		newConfig, err := notifier.PostHookQueueTransformConfigs(ctx, message.Configs)
-		if err != nil {
-			message.MarkFailed(time.Now(), false, err)
+		return h.q.DelayCallback(ctx, message)
+	}
 
-			telemetry.IncrementInt64Counter(ctx, telemetry.MetricReceiverHookFailed,
-				tag.Upsert(telemetry.TagReceiverType, message.ReceiverType),
-				tag.Upsert(telemetry.TagHookCondition, telemetry.HookConditionPostHookQueue),
-			)
+	message.MarkPending(time.Now())
 
-			telemetry.IncrementInt64Counter(ctx, telemetry.MetricNotificationMessageCounter,
-				tag.Upsert(telemetry.TagMessageStatus, message.Status.String()),
-				tag.Upsert(telemetry.TagReceiverType, message.ReceiverType))
+	telemetry.IncrementInt64Counter(ctx, telemetry.MetricNotificationMessageCounter,
+		tag.Upsert(telemetry.TagMessageStatus, message.Status.String()),
+		tag.Upsert(telemetry.TagReceiverType, message.ReceiverType))
 
-			if cerr := h.q.ErrorCallback(ctx, message); cerr != nil {
-				return cerr
-			}
-			return err
-		}
-		message.Configs = newConfig
+	newConfig, err := notifier.PostHookQueueTransformConfigs(ctx, message.Configs)
+	if err != nil {
+		message.MarkFailed(time.Now(), false, err)
 
-		if retryable, err := notifier.Send(ctx, message); err != nil {
-			message.MarkFailed(time.Now(), retryable, err)
+		telemetry.IncrementInt64Counter(ctx, telemetry.MetricReceiverHookFailed,
+			tag.Upsert(telemetry.TagReceiverType, message.ReceiverType),
+			tag.Upsert(telemetry.TagHookCondition, telemetry.HookConditionPostHookQueue),
+		)
 
-			telemetry.IncrementInt64Counter(ctx, telemetry.MetricNotificationMessageCounter,
-				tag.Upsert(telemetry.TagMessageStatus, message.Status.String()),
-				tag.Upsert(telemetry.TagReceiverType, message.ReceiverType))
+		telemetry.IncrementInt64Counter(ctx, telemetry.MetricNotificationMessageCounter,
+			tag.Upsert(telemetry.TagMessageStatus, message.Status.String()),
+			tag.Upsert(telemetry.TagReceiverType, message.ReceiverType))
 
-			if cerr := h.q.ErrorCallback(ctx, message); cerr != nil {
-				return cerr
-			}
-			return err
+		if cerr := h.q.ErrorCallback(ctx, message); cerr != nil {
+			return cerr
 		}
+		return err
+	}
+	message.Configs = newConfig
 
-		message.MarkPublished(time.Now())
+	externalID, retryable, err := notifier.Send(ctx, message)
+	if err != nil {
+		message.MarkFailed(time.Now(), retryable, err)
 
 		telemetry.IncrementInt64Counter(ctx, telemetry.MetricNotificationMessageCounter,
 			tag.Upsert(telemetry.TagMessageStatus, message.Status.String()),
 			tag.Upsert(telemetry.TagReceiverType, message.ReceiverType))
 
-		if err := h.q.SuccessCallback(ctx, message); err != nil {
-			return err
+		if cerr := h.q.ErrorCallback(ctx, message); cerr != nil {
+			return cerr
 		}
+		return err
 	}
 
-	return nil
+	message.MarkPublished(time.Now(), externalID)
+
+	telemetry.IncrementInt64Counter(ctx, telemetry.MetricNotificationMessageCounter,
+		tag.Upsert(telemetry.TagMessageStatus, message.Status.String()),
+		tag.Upsert(telemetry.TagReceiverType, message.ReceiverType))
+
+	return h.q.SuccessCallback(ctx, message)
+}
+
+// throttle returns how long the message should wait if the receiver of the message has no token left,
+// the message is sent without limit if the rate limiter is not available
+func (h *Handler) throttle(ctx context.Context, message Message) time.Duration {
+	limit, ok := h.rateLimits[message.ReceiverType]
+	if !ok || !limit.IsEnabled() || h.rateLimiter == nil {
+		return 0
+	}
+
+	wait, err := h.rateLimiter.Take(ctx, rateLimitKey(message), limit)
+	if err != nil {
+		h.logger.Warn("failed to take rate limit token, sending message without limit", "id", message.ID, "receiver_type", message.ReceiverType, "error", err)
+		return 0
+	}
+	return wait
 }
@@ -10,9 +10,23 @@ func HandlerWithBatchSize(bs int) HandlerOption {
 	}
 }
 
+// HandlerWithConcurrency sets created handler with the number of messages sent at the same time
+func HandlerWithConcurrency(c int) HandlerOption {
+	return func(w *Handler) {
+		w.concurrency = c
+	}
+}
+
 // HandlerWithIdentifier sets created handler with the specified batch size
 func HandlerWithIdentifier(identifier string) HandlerOption {
 	return func(w *Handler) {
 		w.identifier = identifier
 	}
 }
+
+// HandlerWithRateLimiter sets created handler with the rate limiter shared by handlers
+func HandlerWithRateLimiter(rl RateLimiter) HandlerOption {
+	return func(w *Handler) {
+		w.rateLimiter = rl
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/odpf/salt/log"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/odpf/siren/core/notification"
@@ -30,6 +32,9 @@ func TestHandler_MessageHandler(t *testing.T) {
 			},
 			setup: func(q *mocks.Queuer, _ *mocks.Notifier) {
 				q.EXPECT().Type().Return("postgresql")
+				q.EXPECT().ErrorCallback(mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(m notification.Message) bool {
+					return m.Status == notification.MessageStatusFailed && !m.Retryable
+				})).Return(nil)
 			},
 			wantErr: true,
 		},
@@ -42,8 +47,8 @@ func TestHandler_MessageHandler(t *testing.T) {
 			},
 			setup: func(q *mocks.Queuer, n *mocks.Notifier) {
 				q.EXPECT().Type().Return("postgresql")
-				n.EXPECT().PostHookQueueTransformConfigs(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("map[string]interface {}")).Return(nil, errors.New("some error"))
-				q.EXPECT().ErrorCallback(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("notification.Message")).Return(nil)
+				n.EXPECT().PostHookQueueTransformConfigs(mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("map[string]interface {}")).Return(nil, errors.New("some error"))
+				q.EXPECT().ErrorCallback(mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("notification.Message")).Return(nil)
 			},
 			wantErr: true,
 		},
@@ -56,8 +61,8 @@ func TestHandler_MessageHandler(t *testing.T) {
 			},
 			setup: func(q *mocks.Queuer, n *mocks.Notifier) {
 				q.EXPECT().Type().Return("postgresql")
-				n.EXPECT().PostHookQueueTransformConfigs(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("map[string]interface {}")).Return(nil, errors.New("some error"))
-				q.EXPECT().ErrorCallback(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("notification.Message")).Return(errors.New("some error"))
+				n.EXPECT().PostHookQueueTransformConfigs(mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("map[string]interface {}")).Return(nil, errors.New("some error"))
+				q.EXPECT().ErrorCallback(mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("notification.Message")).Return(errors.New("some error"))
 			},
 			wantErr: true,
 		},
@@ -70,9 +75,9 @@ func TestHandler_MessageHandler(t *testing.T) {
 			},
 			setup: func(q *mocks.Queuer, n *mocks.Notifier) {
 				q.EXPECT().Type().Return("postgresql")
-				n.EXPECT().PostHookQueueTransformConfigs(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("map[string]interface {}")).Return(map[string]interface{}{}, nil)
-				n.EXPECT().Send(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("notification.Message")).Return(false, errors.New("some error"))
-				q.EXPECT().ErrorCallback(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("notification.Message")).Return(errors.New("some error"))
+				n.EXPECT().PostHookQueueTransformConfigs(mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("map[string]interface {}")).Return(map[string]interface{}{}, nil)
+				n.EXPECT().Send(mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("notification.Message")).Return("", false, errors.New("some error"))
+				q.EXPECT().ErrorCallback(mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("notification.Message")).Return(errors.New("some error"))
 			},
 			wantErr: true,
 		},
@@ -85,9 +90,9 @@ func TestHandler_MessageHandler(t *testing.T) {
 			},
 			setup: func(q *mocks.Queuer, n *mocks.Notifier) {
 				q.EXPECT().Type().Return("postgresql")
-				n.EXPECT().PostHookQueueTransformConfigs(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("map[string]interface {}")).Return(map[string]interface{}{}, nil)
-				n.EXPECT().Send(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("notification.Message")).Return(false, errors.New("some error"))
-				q.EXPECT().ErrorCallback(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("notification.Message")).Return(nil)
+				n.EXPECT().PostHookQueueTransformConfigs(mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("map[string]interface {}")).Return(map[string]interface{}{}, nil)
+				n.EXPECT().Send(mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("notification.Message")).Return("", false, errors.New("some error"))
+				q.EXPECT().ErrorCallback(mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("notification.Message")).Return(nil)
 			},
 			wantErr: true,
 		},
@@ -100,9 +105,9 @@ func TestHandler_MessageHandler(t *testing.T) {
 			},
 			setup: func(q *mocks.Queuer, n *mocks.Notifier) {
 				q.EXPECT().Type().Return("postgresql")
-				n.EXPECT().PostHookQueueTransformConfigs(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("map[string]interface {}")).Return(map[string]interface{}{}, nil)
-				n.EXPECT().Send(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("notification.Message")).Return(false, nil)
-				q.EXPECT().SuccessCallback(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("notification.Message")).Return(errors.New("some error"))
+				n.EXPECT().PostHookQueueTransformConfigs(mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("map[string]interface {}")).Return(map[string]interface{}{}, nil)
+				n.EXPECT().Send(mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("notification.Message")).Return("", false, nil)
+				q.EXPECT().SuccessCallback(mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("notification.Message")).Return(errors.New("some error"))
 			},
 			wantErr: true,
 		},
@@ -115,9 +120,9 @@ func TestHandler_MessageHandler(t *testing.T) {
 			},
 			setup: func(q *mocks.Queuer, n *mocks.Notifier) {
 				q.EXPECT().Type().Return("postgresql")
-				n.EXPECT().PostHookQueueTransformConfigs(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("map[string]interface {}")).Return(map[string]interface{}{}, nil)
-				n.EXPECT().Send(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("notification.Message")).Return(false, nil)
-				q.EXPECT().SuccessCallback(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("notification.Message")).Return(nil)
+				n.EXPECT().PostHookQueueTransformConfigs(mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("map[string]interface {}")).Return(map[string]interface{}{}, nil)
+				n.EXPECT().Send(mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("notification.Message")).Return("", false, nil)
+				q.EXPECT().SuccessCallback(mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("notification.Message")).Return(nil)
 			},
 			wantErr: false,
 		},
@@ -145,3 +150,125 @@ func TestHandler_MessageHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_MessageHandlerConcurrently(t *testing.T) {
+	messages := []notification.Message{
+		{ID: "1", ReceiverType: testReceiverType},
+		{ID: "2", ReceiverType: testReceiverType},
+		{ID: "3", ReceiverType: testReceiverType},
+		{ID: "4", ReceiverType: testReceiverType},
+	}
+
+	t.Run("should call back every message of the batch even if some of them are failed", func(t *testing.T) {
+		var (
+			mockQueue    = new(mocks.Queuer)
+			mockNotifier = new(mocks.Notifier)
+		)
+
+		mockQueue.EXPECT().Type().Return("postgresql")
+		mockNotifier.EXPECT().PostHookQueueTransformConfigs(mock.AnythingOfType("*context.valueCtx"), mock.Anything).Return(map[string]interface{}{}, nil)
+		mockNotifier.EXPECT().Send(mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(m notification.Message) bool {
+			return m.ID == "2"
+		})).Return("", true, errors.New("some error")).Once()
+		mockNotifier.EXPECT().Send(mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(m notification.Message) bool {
+			return m.ID != "2"
+		})).Return("", false, nil).Times(3)
+		mockQueue.EXPECT().ErrorCallback(mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(m notification.Message) bool {
+			return m.ID == "2" && m.Retryable
+		})).Return(nil).Once()
+		mockQueue.EXPECT().SuccessCallback(mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("notification.Message")).Return(nil).Times(3)
+
+		h := notification.NewHandler(notification.HandlerConfig{Concurrency: 2}, log.NewNoop(), mockQueue, map[string]notification.Notifier{
+			testReceiverType: mockNotifier,
+		})
+		err := h.MessageHandler(context.TODO(), messages)
+
+		assert.EqualError(t, err, "failed to handle 1 of 4 messages: some error")
+		mockQueue.AssertExpectations(t)
+		mockNotifier.AssertExpectations(t)
+	})
+}
+
+func TestHandler_MessageHandlerWithRateLimit(t *testing.T) {
+	var (
+		rateLimits = map[string]notification.RateLimitConfig{
+			testReceiverType: {Rate: 1, Burst: 1},
+		}
+		messages = []notification.Message{
+			{
+				ReceiverType: testReceiverType,
+				Details: map[string]interface{}{
+					notification.DetailsKeyReceiverID: "11",
+				},
+			},
+		}
+	)
+
+	testCases := []struct {
+		name    string
+		setup   func(*mocks.Queuer, *mocks.Notifier, *mocks.RateLimiter)
+		wantErr bool
+	}{
+		{
+			name: "should put the message back to the queue with delay if receiver is throttled",
+			setup: func(q *mocks.Queuer, n *mocks.Notifier, rl *mocks.RateLimiter) {
+				q.EXPECT().Type().Return("postgresql")
+				rl.EXPECT().Take(mock.AnythingOfType("*context.valueCtx"), "test:11", rateLimits[testReceiverType]).Return(2*time.Second, nil)
+				q.EXPECT().DelayCallback(mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(m notification.Message) bool {
+					return m.Status == notification.MessageStatusEnqueued && m.TryCount == 0 && time.Until(m.NextAttemptAt) > time.Second
+				})).Return(nil)
+			},
+		},
+		{
+			name: "should return error if delay callback return error",
+			setup: func(q *mocks.Queuer, n *mocks.Notifier, rl *mocks.RateLimiter) {
+				q.EXPECT().Type().Return("postgresql")
+				rl.EXPECT().Take(mock.AnythingOfType("*context.valueCtx"), "test:11", rateLimits[testReceiverType]).Return(2*time.Second, nil)
+				q.EXPECT().DelayCallback(mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("notification.Message")).Return(errors.New("some error"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "should send the message if a token is taken",
+			setup: func(q *mocks.Queuer, n *mocks.Notifier, rl *mocks.RateLimiter) {
+				q.EXPECT().Type().Return("postgresql")
+				rl.EXPECT().Take(mock.AnythingOfType("*context.valueCtx"), "test:11", rateLimits[testReceiverType]).Return(0, nil)
+				n.EXPECT().PostHookQueueTransformConfigs(mock.AnythingOfType("*context.valueCtx"), mock.Anything).Return(map[string]interface{}{}, nil)
+				n.EXPECT().Send(mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("notification.Message")).Return("", false, nil)
+				q.EXPECT().SuccessCallback(mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("notification.Message")).Return(nil)
+			},
+		},
+		{
+			name: "should send the message if rate limiter return error",
+			setup: func(q *mocks.Queuer, n *mocks.Notifier, rl *mocks.RateLimiter) {
+				q.EXPECT().Type().Return("postgresql")
+				rl.EXPECT().Take(mock.AnythingOfType("*context.valueCtx"), "test:11", rateLimits[testReceiverType]).Return(0, errors.New("db error"))
+				n.EXPECT().PostHookQueueTransformConfigs(mock.AnythingOfType("*context.valueCtx"), mock.Anything).Return(map[string]interface{}{}, nil)
+				n.EXPECT().Send(mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("notification.Message")).Return("", false, nil)
+				q.EXPECT().SuccessCallback(mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("notification.Message")).Return(nil)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				mockQueue       = new(mocks.Queuer)
+				mockNotifier    = new(mocks.Notifier)
+				mockRateLimiter = new(mocks.RateLimiter)
+			)
+
+			tc.setup(mockQueue, mockNotifier, mockRateLimiter)
+
+			h := notification.NewHandler(notification.HandlerConfig{RateLimits: rateLimits}, log.NewNoop(), mockQueue, map[string]notification.Notifier{
+				testReceiverType: mockNotifier,
+			}, notification.HandlerWithRateLimiter(mockRateLimiter))
+			if err := h.MessageHandler(context.TODO(), messages); (err != nil) != tc.wantErr {
+				t.Errorf("Handler.messageHandler() error = %v, wantErr %v", err, tc.wantErr)
+			}
+
+			mockQueue.AssertExpectations(t)
+			mockNotifier.AssertExpectations(t)
+			mockRateLimiter.AssertExpectations(t)
+		})
+	}
+}
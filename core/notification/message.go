@@ -2,6 +2,7 @@ package notification
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -20,6 +21,10 @@ const (
 
 	// additional details
 	DetailsKeyNotificationType = "notification_type"
+	DetailsKeyAlertFingerprint = "alert_fingerprint"
+	DetailsKeyAlertStatus      = "alert_status"
+	DetailsKeyUniqueKey        = "unique_key"
+	DetailsKeyReceiverID       = "receiver_id"
 
 	MessageStatusEnqueued  MessageStatus = "enqueued"
 	MessageStatusFailed    MessageStatus = "failed"
@@ -31,6 +36,14 @@ func (ms MessageStatus) String() string {
 	return string(ms)
 }
 
+// MessageAttempt is the outcome of a try to send a message
+type MessageAttempt struct {
+	TryCount int           `json:"try_count"`
+	Status   MessageStatus `json:"status"`
+	Error    string        `json:"error,omitempty"`
+	At       time.Time     `json:"at"`
+}
+
 // MessageOption provides ability to configure the message initialization
 type MessageOption func(*Message)
 
@@ -56,6 +69,13 @@ func InitWithExpiryDuration(dur time.Duration) MessageOption {
 	}
 }
 
+// InitWithReceiverID initializes the message with the id of the receiver it is sent to
+func InitWithReceiverID(id uint64) MessageOption {
+	return func(m *Message) {
+		m.receiverID = id
+	}
+}
+
 // InitWithMaxTries initializes the message with custom max tries
 func InitWithMaxTries(mt int) MessageOption {
 	return func(m *Message) {
@@ -79,7 +99,20 @@ type Message struct {
 	TryCount  int
 	Retryable bool
 
+	// the earliest time a retryable failed message is tried again
+	NextAttemptAt time.Time
+	// the outcome of every try of the message, only kept by queues supporting inspection
+	Attempts []MessageAttempt
+
+	// the id of the message in the receiver once it is published
+	ExternalID string
+
+	// only set for subscriber messages to be grouped before enqueued
+	GroupKey    GroupKey
+	ContentHash string
+
 	expiryDuration time.Duration
+	receiverID     uint64
 }
 
 // Initialize initializes the message with some default value
@@ -144,7 +177,7 @@ func InitMessage(
 		var templateBody string
 
 		if template.IsReservedName(n.Template) {
-			templateBody = notifierPlugin.GetSystemDefaultTemplate()
+			templateBody = notifierPlugin.GetSystemDefaultTemplate(newConfigs)
 		}
 
 		if templateBody != "" {
@@ -163,6 +196,22 @@ func InitMessage(
 
 	m.Details[DetailsKeyNotificationType] = n.Type
 
+	// keep the notification identity so receivers could thread messages of the same unique key
+	if n.UniqueKey != "" {
+		m.Details[DetailsKeyUniqueKey] = n.UniqueKey
+	}
+
+	// keep the receiver identity so messages could be rate limited per receiver
+	if m.receiverID != 0 {
+		m.Details[DetailsKeyReceiverID] = fmt.Sprintf("%d", m.receiverID)
+	}
+
+	// keep the alert identity so receivers could correlate resolved alerts with the sent ones
+	if fingerprint, ok := n.Data["fingerprint"]; ok {
+		m.Details[DetailsKeyAlertFingerprint] = fingerprint
+		m.Details[DetailsKeyAlertStatus] = n.Data["status"]
+	}
+
 	telemetry.IncrementInt64Counter(ctx, telemetry.MetricNotificationMessageCounter,
 		tag.Upsert(telemetry.TagNotificationType, TypeSubscriber),
 		tag.Upsert(telemetry.TagMessageStatus, m.Status.String()),
@@ -186,8 +235,34 @@ func (m *Message) MarkPending(updatedAt time.Time) {
 	m.UpdatedAt = updatedAt
 }
 
+// MarkThrottled puts the message back to the state before it was dequeued
+// to be tried again at nextAttemptAt without counting it as a try
+func (m *Message) MarkThrottled(updatedAt time.Time, nextAttemptAt time.Time) {
+	m.Status = MessageStatusEnqueued
+	if m.LastError != "" {
+		m.Status = MessageStatusFailed
+	}
+	m.NextAttemptAt = nextAttemptAt
+	m.UpdatedAt = updatedAt
+}
+
+// Replay returns a copy of the message to be sent again as a new message
+func (m Message) Replay(id string, createdAt time.Time) Message {
+	return Message{
+		ID:           id,
+		Status:       MessageStatusEnqueued,
+		ReceiverType: m.ReceiverType,
+		Configs:      m.Configs,
+		Details:      m.Details,
+		MaxTries:     m.MaxTries,
+		CreatedAt:    createdAt,
+		UpdatedAt:    createdAt,
+	}
+}
+
 // MarkPublished update message to the published state
-func (m *Message) MarkPublished(updatedAt time.Time) {
+func (m *Message) MarkPublished(updatedAt time.Time, externalID string) {
+	m.ExternalID = externalID
 	m.TryCount = m.TryCount + 1
 	m.Status = MessageStatusPublished
 	m.UpdatedAt = updatedAt
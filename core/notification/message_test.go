@@ -58,6 +58,54 @@ func TestMessage_InitMessage(t *testing.T) {
 				ExpiredAt: testTimeNow.Add(testExpiryDuration),
 			},
 		},
+		{
+			name: "alert fingerprint and status should be added to message detail if notification is built from alerts",
+			setup: func(n *mocks.Notifier) {
+				n.EXPECT().PreHookQueueTransformConfigs(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("map[string]interface {}")).Return(nil, nil)
+			},
+			n: notification.Notification{
+				Type: notification.TypeSubscriber,
+				Data: map[string]interface{}{
+					"fingerprint": "some-fingerprint",
+					"status":      "resolved",
+				},
+			},
+			want: notification.Message{
+				ID:     testID,
+				Status: notification.MessageStatusEnqueued,
+				Details: map[string]interface{}{
+					"fingerprint":                           "some-fingerprint",
+					"status":                                "resolved",
+					notification.DetailsKeyNotificationType: notification.TypeSubscriber,
+					notification.DetailsKeyAlertFingerprint: "some-fingerprint",
+					notification.DetailsKeyAlertStatus:      "resolved",
+				},
+				CreatedAt: testTimeNow,
+				UpdatedAt: testTimeNow,
+				ExpiredAt: testTimeNow.Add(testExpiryDuration),
+			},
+		},
+		{
+			name: "unique key should be added to message detail if notification has unique key",
+			setup: func(n *mocks.Notifier) {
+				n.EXPECT().PreHookQueueTransformConfigs(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("map[string]interface {}")).Return(nil, nil)
+			},
+			n: notification.Notification{
+				Type:      notification.TypeSubscriber,
+				UniqueKey: "some-unique-key",
+			},
+			want: notification.Message{
+				ID:     testID,
+				Status: notification.MessageStatusEnqueued,
+				Details: map[string]interface{}{
+					notification.DetailsKeyNotificationType: notification.TypeSubscriber,
+					notification.DetailsKeyUniqueKey:        "some-unique-key",
+				},
+				CreatedAt: testTimeNow,
+				UpdatedAt: testTimeNow,
+				ExpiredAt: testTimeNow.Add(testExpiryDuration),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -157,8 +205,9 @@ func TestMessage_Mark(t *testing.T) {
 		expectedMessage.TryCount = m.TryCount + 1
 		expectedMessage.Status = notification.MessageStatusPublished
 		expectedMessage.UpdatedAt = testTimeNow
+		expectedMessage.ExternalID = "external-id"
 
-		m.MarkPublished(testTimeNow)
+		m.MarkPublished(testTimeNow, "external-id")
 
 		if diff := cmp.Diff(m, expectedMessage,
 			cmpopts.IgnoreUnexported(notification.Message{}),
@@ -0,0 +1,123 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	notification "github.com/odpf/siren/core/notification"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CorrelationRepository is an autogenerated mock type for the CorrelationRepository type
+type CorrelationRepository struct {
+	mock.Mock
+}
+
+type CorrelationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CorrelationRepository) EXPECT() *CorrelationRepository_Expecter {
+	return &CorrelationRepository_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function with given fields: ctx, fingerprint, receiverType, destination
+func (_m *CorrelationRepository) Get(ctx context.Context, fingerprint string, receiverType string, destination string) (notification.Correlation, error) {
+	ret := _m.Called(ctx, fingerprint, receiverType, destination)
+
+	var r0 notification.Correlation
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) notification.Correlation); ok {
+		r0 = rf(ctx, fingerprint, receiverType, destination)
+	} else {
+		r0 = ret.Get(0).(notification.Correlation)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, fingerprint, receiverType, destination)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CorrelationRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type CorrelationRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fingerprint string
+//   - receiverType string
+//   - destination string
+func (_e *CorrelationRepository_Expecter) Get(ctx interface{}, fingerprint interface{}, receiverType interface{}, destination interface{}) *CorrelationRepository_Get_Call {
+	return &CorrelationRepository_Get_Call{Call: _e.mock.On("Get", ctx, fingerprint, receiverType, destination)}
+}
+
+func (_c *CorrelationRepository_Get_Call) Run(run func(ctx context.Context, fingerprint string, receiverType string, destination string)) *CorrelationRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *CorrelationRepository_Get_Call) Return(_a0 notification.Correlation, _a1 error) *CorrelationRepository_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// Upsert provides a mock function with given fields: ctx, c
+func (_m *CorrelationRepository) Upsert(ctx context.Context, c notification.Correlation) error {
+	ret := _m.Called(ctx, c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, notification.Correlation) error); ok {
+		r0 = rf(ctx, c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CorrelationRepository_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type CorrelationRepository_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - c notification.Correlation
+func (_e *CorrelationRepository_Expecter) Upsert(ctx interface{}, c interface{}) *CorrelationRepository_Upsert_Call {
+	return &CorrelationRepository_Upsert_Call{Call: _e.mock.On("Upsert", ctx, c)}
+}
+
+func (_c *CorrelationRepository_Upsert_Call) Run(run func(ctx context.Context, c notification.Correlation)) *CorrelationRepository_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(notification.Correlation))
+	})
+	return _c
+}
+
+func (_c *CorrelationRepository_Upsert_Call) Return(_a0 error) *CorrelationRepository_Upsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+type mockConstructorTestingTNewCorrelationRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewCorrelationRepository creates a new instance of CorrelationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewCorrelationRepository(t mockConstructorTestingTNewCorrelationRepository) *CorrelationRepository {
+	mock := &CorrelationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,277 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	time "time"
+
+	notification "github.com/odpf/siren/core/notification"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// GroupRepository is an autogenerated mock type for the GroupRepository type
+type GroupRepository struct {
+	mock.Mock
+}
+
+type GroupRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *GroupRepository) EXPECT() *GroupRepository_Expecter {
+	return &GroupRepository_Expecter{mock: &_m.Mock}
+}
+
+// Buffer provides a mock function with given fields: ctx, m, groupWait
+func (_m *GroupRepository) Buffer(ctx context.Context, m notification.Message, groupWait time.Duration) error {
+	ret := _m.Called(ctx, m, groupWait)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, notification.Message, time.Duration) error); ok {
+		r0 = rf(ctx, m, groupWait)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GroupRepository_Buffer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Buffer'
+type GroupRepository_Buffer_Call struct {
+	*mock.Call
+}
+
+// Buffer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - m notification.Message
+//   - groupWait time.Duration
+func (_e *GroupRepository_Expecter) Buffer(ctx interface{}, m interface{}, groupWait interface{}) *GroupRepository_Buffer_Call {
+	return &GroupRepository_Buffer_Call{Call: _e.mock.On("Buffer", ctx, m, groupWait)}
+}
+
+func (_c *GroupRepository_Buffer_Call) Run(run func(ctx context.Context, m notification.Message, groupWait time.Duration)) *GroupRepository_Buffer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(notification.Message), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *GroupRepository_Buffer_Call) Return(_a0 error) *GroupRepository_Buffer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// Commit provides a mock function with given fields: ctx
+func (_m *GroupRepository) Commit(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GroupRepository_Commit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Commit'
+type GroupRepository_Commit_Call struct {
+	*mock.Call
+}
+
+// Commit is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *GroupRepository_Expecter) Commit(ctx interface{}) *GroupRepository_Commit_Call {
+	return &GroupRepository_Commit_Call{Call: _e.mock.On("Commit", ctx)}
+}
+
+func (_c *GroupRepository_Commit_Call) Run(run func(ctx context.Context)) *GroupRepository_Commit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *GroupRepository_Commit_Call) Return(_a0 error) *GroupRepository_Commit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// ListDue provides a mock function with given fields: ctx, batchSize
+func (_m *GroupRepository) ListDue(ctx context.Context, batchSize int) ([]notification.Group, error) {
+	ret := _m.Called(ctx, batchSize)
+
+	var r0 []notification.Group
+	if rf, ok := ret.Get(0).(func(context.Context, int) []notification.Group); ok {
+		r0 = rf(ctx, batchSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notification.Group)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, batchSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupRepository_ListDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDue'
+type GroupRepository_ListDue_Call struct {
+	*mock.Call
+}
+
+// ListDue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - batchSize int
+func (_e *GroupRepository_Expecter) ListDue(ctx interface{}, batchSize interface{}) *GroupRepository_ListDue_Call {
+	return &GroupRepository_ListDue_Call{Call: _e.mock.On("ListDue", ctx, batchSize)}
+}
+
+func (_c *GroupRepository_ListDue_Call) Run(run func(ctx context.Context, batchSize int)) *GroupRepository_ListDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *GroupRepository_ListDue_Call) Return(_a0 []notification.Group, _a1 error) *GroupRepository_ListDue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// MarkFlushed provides a mock function with given fields: ctx, g
+func (_m *GroupRepository) MarkFlushed(ctx context.Context, g notification.Group) error {
+	ret := _m.Called(ctx, g)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, notification.Group) error); ok {
+		r0 = rf(ctx, g)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GroupRepository_MarkFlushed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkFlushed'
+type GroupRepository_MarkFlushed_Call struct {
+	*mock.Call
+}
+
+// MarkFlushed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - g notification.Group
+func (_e *GroupRepository_Expecter) MarkFlushed(ctx interface{}, g interface{}) *GroupRepository_MarkFlushed_Call {
+	return &GroupRepository_MarkFlushed_Call{Call: _e.mock.On("MarkFlushed", ctx, g)}
+}
+
+func (_c *GroupRepository_MarkFlushed_Call) Run(run func(ctx context.Context, g notification.Group)) *GroupRepository_MarkFlushed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(notification.Group))
+	})
+	return _c
+}
+
+func (_c *GroupRepository_MarkFlushed_Call) Return(_a0 error) *GroupRepository_MarkFlushed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// Rollback provides a mock function with given fields: ctx, err
+func (_m *GroupRepository) Rollback(ctx context.Context, err error) error {
+	ret := _m.Called(ctx, err)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, error) error); ok {
+		r0 = rf(ctx, err)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GroupRepository_Rollback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rollback'
+type GroupRepository_Rollback_Call struct {
+	*mock.Call
+}
+
+// Rollback is a helper method to define mock.On call
+//   - ctx context.Context
+//   - err error
+func (_e *GroupRepository_Expecter) Rollback(ctx interface{}, err interface{}) *GroupRepository_Rollback_Call {
+	return &GroupRepository_Rollback_Call{Call: _e.mock.On("Rollback", ctx, err)}
+}
+
+func (_c *GroupRepository_Rollback_Call) Run(run func(ctx context.Context, err error)) *GroupRepository_Rollback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(error))
+	})
+	return _c
+}
+
+func (_c *GroupRepository_Rollback_Call) Return(_a0 error) *GroupRepository_Rollback_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// WithTransaction provides a mock function with given fields: ctx
+func (_m *GroupRepository) WithTransaction(ctx context.Context) context.Context {
+	ret := _m.Called(ctx)
+
+	var r0 context.Context
+	if rf, ok := ret.Get(0).(func(context.Context) context.Context); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context.Context)
+		}
+	}
+
+	return r0
+}
+
+// GroupRepository_WithTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTransaction'
+type GroupRepository_WithTransaction_Call struct {
+	*mock.Call
+}
+
+// WithTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *GroupRepository_Expecter) WithTransaction(ctx interface{}) *GroupRepository_WithTransaction_Call {
+	return &GroupRepository_WithTransaction_Call{Call: _e.mock.On("WithTransaction", ctx)}
+}
+
+func (_c *GroupRepository_WithTransaction_Call) Run(run func(ctx context.Context)) *GroupRepository_WithTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *GroupRepository_WithTransaction_Call) Return(_a0 context.Context) *GroupRepository_WithTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+type mockConstructorTestingTNewGroupRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewGroupRepository creates a new instance of GroupRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewGroupRepository(t mockConstructorTestingTNewGroupRepository) *GroupRepository {
+	mock := &GroupRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,90 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	notification "github.com/odpf/siren/core/notification"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MessageGrouper is an autogenerated mock type for the MessageGrouper type
+type MessageGrouper struct {
+	mock.Mock
+}
+
+type MessageGrouper_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MessageGrouper) EXPECT() *MessageGrouper_Expecter {
+	return &MessageGrouper_Expecter{mock: &_m.Mock}
+}
+
+// Buffer provides a mock function with given fields: ctx, ms
+func (_m *MessageGrouper) Buffer(ctx context.Context, ms ...notification.Message) error {
+	_va := make([]interface{}, len(ms))
+	for _i := range ms {
+		_va[_i] = ms[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...notification.Message) error); ok {
+		r0 = rf(ctx, ms...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MessageGrouper_Buffer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Buffer'
+type MessageGrouper_Buffer_Call struct {
+	*mock.Call
+}
+
+// Buffer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ms ...notification.Message
+func (_e *MessageGrouper_Expecter) Buffer(ctx interface{}, ms ...interface{}) *MessageGrouper_Buffer_Call {
+	return &MessageGrouper_Buffer_Call{Call: _e.mock.On("Buffer",
+		append([]interface{}{ctx}, ms...)...)}
+}
+
+func (_c *MessageGrouper_Buffer_Call) Run(run func(ctx context.Context, ms ...notification.Message)) *MessageGrouper_Buffer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]notification.Message, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(notification.Message)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MessageGrouper_Buffer_Call) Return(_a0 error) *MessageGrouper_Buffer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+type mockConstructorTestingTNewMessageGrouper interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMessageGrouper creates a new instance of MessageGrouper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMessageGrouper(t mockConstructorTestingTNewMessageGrouper) *MessageGrouper {
+	mock := &MessageGrouper{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -22,13 +22,13 @@ func (_m *Notifier) EXPECT() *Notifier_Expecter {
 	return &Notifier_Expecter{mock: &_m.Mock}
 }
 
-// GetSystemDefaultTemplate provides a mock function with given fields:
-func (_m *Notifier) GetSystemDefaultTemplate() string {
-	ret := _m.Called()
+// GetSystemDefaultTemplate provides a mock function with given fields: notificationConfigMap
+func (_m *Notifier) GetSystemDefaultTemplate(notificationConfigMap map[string]interface{}) string {
+	ret := _m.Called(notificationConfigMap)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func() string); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(map[string]interface{}) string); ok {
+		r0 = rf(notificationConfigMap)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
@@ -42,13 +42,14 @@ type Notifier_GetSystemDefaultTemplate_Call struct {
 }
 
 // GetSystemDefaultTemplate is a helper method to define mock.On call
-func (_e *Notifier_Expecter) GetSystemDefaultTemplate() *Notifier_GetSystemDefaultTemplate_Call {
-	return &Notifier_GetSystemDefaultTemplate_Call{Call: _e.mock.On("GetSystemDefaultTemplate")}
+//   - notificationConfigMap map[string]interface{}
+func (_e *Notifier_Expecter) GetSystemDefaultTemplate(notificationConfigMap interface{}) *Notifier_GetSystemDefaultTemplate_Call {
+	return &Notifier_GetSystemDefaultTemplate_Call{Call: _e.mock.On("GetSystemDefaultTemplate", notificationConfigMap)}
 }
 
-func (_c *Notifier_GetSystemDefaultTemplate_Call) Run(run func()) *Notifier_GetSystemDefaultTemplate_Call {
+func (_c *Notifier_GetSystemDefaultTemplate_Call) Run(run func(notificationConfigMap map[string]interface{})) *Notifier_GetSystemDefaultTemplate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(map[string]interface{}))
 	})
 	return _c
 }
@@ -153,24 +154,31 @@ func (_c *Notifier_PreHookQueueTransformConfigs_Call) Return(_a0 map[string]inte
 }
 
 // Send provides a mock function with given fields: ctx, message
-func (_m *Notifier) Send(ctx context.Context, message notification.Message) (bool, error) {
+func (_m *Notifier) Send(ctx context.Context, message notification.Message) (string, bool, error) {
 	ret := _m.Called(ctx, message)
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func(context.Context, notification.Message) bool); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, notification.Message) string); ok {
 		r0 = rf(ctx, message)
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(string)
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, notification.Message) error); ok {
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(context.Context, notification.Message) bool); ok {
 		r1 = rf(ctx, message)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(bool)
 	}
 
-	return r0, r1
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, notification.Message) error); ok {
+		r2 = rf(ctx, message)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // Notifier_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
@@ -192,8 +200,8 @@ func (_c *Notifier_Send_Call) Run(run func(ctx context.Context, message notifica
 	return _c
 }
 
-func (_c *Notifier_Send_Call) Return(_a0 bool, _a1 error) *Notifier_Send_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *Notifier_Send_Call) Return(externalID string, retryable bool, err error) *Notifier_Send_Call {
+	_c.Call.Return(externalID, retryable, err)
 	return _c
 }
 
@@ -62,6 +62,44 @@ func (_c *Queuer_Cleanup_Call) Return(_a0 error) *Queuer_Cleanup_Call {
 	return _c
 }
 
+// DelayCallback provides a mock function with given fields: ctx, ms
+func (_m *Queuer) DelayCallback(ctx context.Context, ms notification.Message) error {
+	ret := _m.Called(ctx, ms)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, notification.Message) error); ok {
+		r0 = rf(ctx, ms)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Queuer_DelayCallback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DelayCallback'
+type Queuer_DelayCallback_Call struct {
+	*mock.Call
+}
+
+// DelayCallback is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ms notification.Message
+func (_e *Queuer_Expecter) DelayCallback(ctx interface{}, ms interface{}) *Queuer_DelayCallback_Call {
+	return &Queuer_DelayCallback_Call{Call: _e.mock.On("DelayCallback", ctx, ms)}
+}
+
+func (_c *Queuer_DelayCallback_Call) Run(run func(ctx context.Context, ms notification.Message)) *Queuer_DelayCallback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(notification.Message))
+	})
+	return _c
+}
+
+func (_c *Queuer_DelayCallback_Call) Return(_a0 error) *Queuer_DelayCallback_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 // Dequeue provides a mock function with given fields: ctx, receiverTypes, batchSize, handlerFn
 func (_m *Queuer) Dequeue(ctx context.Context, receiverTypes []string, batchSize int, handlerFn func(context.Context, []notification.Message) error) error {
 	ret := _m.Called(ctx, receiverTypes, batchSize, handlerFn)
@@ -102,6 +140,65 @@ func (_c *Queuer_Dequeue_Call) Return(_a0 error) *Queuer_Dequeue_Call {
 	return _c
 }
 
+// DiscardMessages provides a mock function with given fields: ctx, ids
+func (_m *Queuer) DiscardMessages(ctx context.Context, ids ...string) (int64, error) {
+	_va := make([]interface{}, len(ids))
+	for _i := range ids {
+		_va[_i] = ids[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, ...string) int64); ok {
+		r0 = rf(ctx, ids...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...string) error); ok {
+		r1 = rf(ctx, ids...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Queuer_DiscardMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiscardMessages'
+type Queuer_DiscardMessages_Call struct {
+	*mock.Call
+}
+
+// DiscardMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids ...string
+func (_e *Queuer_Expecter) DiscardMessages(ctx interface{}, ids ...interface{}) *Queuer_DiscardMessages_Call {
+	return &Queuer_DiscardMessages_Call{Call: _e.mock.On("DiscardMessages",
+		append([]interface{}{ctx}, ids...)...)}
+}
+
+func (_c *Queuer_DiscardMessages_Call) Run(run func(ctx context.Context, ids ...string)) *Queuer_DiscardMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Queuer_DiscardMessages_Call) Return(_a0 int64, _a1 error) *Queuer_DiscardMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // Enqueue provides a mock function with given fields: ctx, ms
 func (_m *Queuer) Enqueue(ctx context.Context, ms ...notification.Message) error {
 	_va := make([]interface{}, len(ms))
@@ -192,6 +289,157 @@ func (_c *Queuer_ErrorCallback_Call) Return(_a0 error) *Queuer_ErrorCallback_Cal
 	return _c
 }
 
+// GetMessage provides a mock function with given fields: ctx, id
+func (_m *Queuer) GetMessage(ctx context.Context, id string) (notification.Message, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 notification.Message
+	if rf, ok := ret.Get(0).(func(context.Context, string) notification.Message); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(notification.Message)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Queuer_GetMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMessage'
+type Queuer_GetMessage_Call struct {
+	*mock.Call
+}
+
+// GetMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *Queuer_Expecter) GetMessage(ctx interface{}, id interface{}) *Queuer_GetMessage_Call {
+	return &Queuer_GetMessage_Call{Call: _e.mock.On("GetMessage", ctx, id)}
+}
+
+func (_c *Queuer_GetMessage_Call) Run(run func(ctx context.Context, id string)) *Queuer_GetMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Queuer_GetMessage_Call) Return(_a0 notification.Message, _a1 error) *Queuer_GetMessage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// ListMessages provides a mock function with given fields: ctx, filter
+func (_m *Queuer) ListMessages(ctx context.Context, filter queues.FilterMessage) ([]notification.Message, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []notification.Message
+	if rf, ok := ret.Get(0).(func(context.Context, queues.FilterMessage) []notification.Message); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notification.Message)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, queues.FilterMessage) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Queuer_ListMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMessages'
+type Queuer_ListMessages_Call struct {
+	*mock.Call
+}
+
+// ListMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter queues.FilterMessage
+func (_e *Queuer_Expecter) ListMessages(ctx interface{}, filter interface{}) *Queuer_ListMessages_Call {
+	return &Queuer_ListMessages_Call{Call: _e.mock.On("ListMessages", ctx, filter)}
+}
+
+func (_c *Queuer_ListMessages_Call) Run(run func(ctx context.Context, filter queues.FilterMessage)) *Queuer_ListMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(queues.FilterMessage))
+	})
+	return _c
+}
+
+func (_c *Queuer_ListMessages_Call) Return(_a0 []notification.Message, _a1 error) *Queuer_ListMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// RequeueMessages provides a mock function with given fields: ctx, ids
+func (_m *Queuer) RequeueMessages(ctx context.Context, ids ...string) (int64, error) {
+	_va := make([]interface{}, len(ids))
+	for _i := range ids {
+		_va[_i] = ids[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, ...string) int64); ok {
+		r0 = rf(ctx, ids...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...string) error); ok {
+		r1 = rf(ctx, ids...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Queuer_RequeueMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequeueMessages'
+type Queuer_RequeueMessages_Call struct {
+	*mock.Call
+}
+
+// RequeueMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids ...string
+func (_e *Queuer_Expecter) RequeueMessages(ctx interface{}, ids ...interface{}) *Queuer_RequeueMessages_Call {
+	return &Queuer_RequeueMessages_Call{Call: _e.mock.On("RequeueMessages",
+		append([]interface{}{ctx}, ids...)...)}
+}
+
+func (_c *Queuer_RequeueMessages_Call) Run(run func(ctx context.Context, ids ...string)) *Queuer_RequeueMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Queuer_RequeueMessages_Call) Return(_a0 int64, _a1 error) *Queuer_RequeueMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // Stop provides a mock function with given fields: ctx
 func (_m *Queuer) Stop(ctx context.Context) error {
 	ret := _m.Called(ctx)
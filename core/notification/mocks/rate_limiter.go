@@ -0,0 +1,85 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	time "time"
+
+	notification "github.com/odpf/siren/core/notification"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RateLimiter is an autogenerated mock type for the RateLimiter type
+type RateLimiter struct {
+	mock.Mock
+}
+
+type RateLimiter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RateLimiter) EXPECT() *RateLimiter_Expecter {
+	return &RateLimiter_Expecter{mock: &_m.Mock}
+}
+
+// Take provides a mock function with given fields: ctx, key, limit
+func (_m *RateLimiter) Take(ctx context.Context, key string, limit notification.RateLimitConfig) (time.Duration, error) {
+	ret := _m.Called(ctx, key, limit)
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func(context.Context, string, notification.RateLimitConfig) time.Duration); ok {
+		r0 = rf(ctx, key, limit)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, notification.RateLimitConfig) error); ok {
+		r1 = rf(ctx, key, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RateLimiter_Take_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Take'
+type RateLimiter_Take_Call struct {
+	*mock.Call
+}
+
+// Take is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - limit notification.RateLimitConfig
+func (_e *RateLimiter_Expecter) Take(ctx interface{}, key interface{}, limit interface{}) *RateLimiter_Take_Call {
+	return &RateLimiter_Take_Call{Call: _e.mock.On("Take", ctx, key, limit)}
+}
+
+func (_c *RateLimiter_Take_Call) Run(run func(ctx context.Context, key string, limit notification.RateLimitConfig)) *RateLimiter_Take_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(notification.RateLimitConfig))
+	})
+	return _c
+}
+
+func (_c *RateLimiter_Take_Call) Return(_a0 time.Duration, _a1 error) *RateLimiter_Take_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+type mockConstructorTestingTNewRateLimiter interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRateLimiter creates a new instance of RateLimiter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRateLimiter(t mockConstructorTestingTNewRateLimiter) *RateLimiter {
+	mock := &RateLimiter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,163 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	notification "github.com/odpf/siren/core/notification"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ThreadRepository is an autogenerated mock type for the ThreadRepository type
+type ThreadRepository struct {
+	mock.Mock
+}
+
+type ThreadRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ThreadRepository) EXPECT() *ThreadRepository_Expecter {
+	return &ThreadRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, t
+func (_m *ThreadRepository) Create(ctx context.Context, t notification.Thread) error {
+	ret := _m.Called(ctx, t)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, notification.Thread) error); ok {
+		r0 = rf(ctx, t)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ThreadRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ThreadRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - t notification.Thread
+func (_e *ThreadRepository_Expecter) Create(ctx interface{}, t interface{}) *ThreadRepository_Create_Call {
+	return &ThreadRepository_Create_Call{Call: _e.mock.On("Create", ctx, t)}
+}
+
+func (_c *ThreadRepository_Create_Call) Run(run func(ctx context.Context, t notification.Thread)) *ThreadRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(notification.Thread))
+	})
+	return _c
+}
+
+func (_c *ThreadRepository_Create_Call) Return(_a0 error) *ThreadRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, uniqueKey, receiverType, destination
+func (_m *ThreadRepository) Delete(ctx context.Context, uniqueKey string, receiverType string, destination string) error {
+	ret := _m.Called(ctx, uniqueKey, receiverType, destination)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, uniqueKey, receiverType, destination)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ThreadRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type ThreadRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uniqueKey string
+//   - receiverType string
+//   - destination string
+func (_e *ThreadRepository_Expecter) Delete(ctx interface{}, uniqueKey interface{}, receiverType interface{}, destination interface{}) *ThreadRepository_Delete_Call {
+	return &ThreadRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, uniqueKey, receiverType, destination)}
+}
+
+func (_c *ThreadRepository_Delete_Call) Run(run func(ctx context.Context, uniqueKey string, receiverType string, destination string)) *ThreadRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ThreadRepository_Delete_Call) Return(_a0 error) *ThreadRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, uniqueKey, receiverType, destination
+func (_m *ThreadRepository) Get(ctx context.Context, uniqueKey string, receiverType string, destination string) (notification.Thread, error) {
+	ret := _m.Called(ctx, uniqueKey, receiverType, destination)
+
+	var r0 notification.Thread
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) notification.Thread); ok {
+		r0 = rf(ctx, uniqueKey, receiverType, destination)
+	} else {
+		r0 = ret.Get(0).(notification.Thread)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, uniqueKey, receiverType, destination)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ThreadRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type ThreadRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uniqueKey string
+//   - receiverType string
+//   - destination string
+func (_e *ThreadRepository_Expecter) Get(ctx interface{}, uniqueKey interface{}, receiverType interface{}, destination interface{}) *ThreadRepository_Get_Call {
+	return &ThreadRepository_Get_Call{Call: _e.mock.On("Get", ctx, uniqueKey, receiverType, destination)}
+}
+
+func (_c *ThreadRepository_Get_Call) Run(run func(ctx context.Context, uniqueKey string, receiverType string, destination string)) *ThreadRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ThreadRepository_Get_Call) Return(_a0 notification.Thread, _a1 error) *ThreadRepository_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+type mockConstructorTestingTNewThreadRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewThreadRepository creates a new instance of ThreadRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewThreadRepository(t mockConstructorTestingTNewThreadRepository) *ThreadRepository {
+	mock := &ThreadRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
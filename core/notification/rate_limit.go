@@ -0,0 +1,41 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+//go:generate mockery --name=RateLimiter -r --case underscore --with-expecter --structname RateLimiter --filename rate_limiter.go --output=./mocks
+type RateLimiter interface {
+	// Take takes a token from the bucket of the key, it returns zero if a token is taken
+	// or how long to wait until a token is available otherwise
+	Take(ctx context.Context, key string, limit RateLimitConfig) (time.Duration, error)
+}
+
+// RateLimitConfig is the token bucket of a receiver, the bucket holds up to burst tokens
+// and is refilled with rate tokens per second
+type RateLimitConfig struct {
+	Rate  float64 `mapstructure:"rate" yaml:"rate"`
+	Burst int     `mapstructure:"burst" yaml:"burst" default:"1"`
+}
+
+// IsEnabled returns true if the rate limit is configured
+func (c RateLimitConfig) IsEnabled() bool {
+	return c.Rate > 0
+}
+
+// ReceiverID returns the id of the receiver the message is sent to
+func (m Message) ReceiverID() string {
+	receiverID, _ := m.Details[DetailsKeyReceiverID].(string)
+	return receiverID
+}
+
+// rateLimitKey buckets messages of the same receiver, messages without receiver id
+// share the bucket of their receiver type
+func rateLimitKey(m Message) string {
+	if receiverID := m.ReceiverID(); receiverID != "" {
+		return fmt.Sprintf("%s:%s", m.ReceiverType, receiverID)
+	}
+	return m.ReceiverType
+}
@@ -10,8 +10,9 @@ import (
 type Notifier interface {
 	PreHookQueueTransformConfigs(ctx context.Context, notificationConfigMap map[string]interface{}) (map[string]interface{}, error)
 	PostHookQueueTransformConfigs(ctx context.Context, notificationConfigMap map[string]interface{}) (map[string]interface{}, error)
-	GetSystemDefaultTemplate() string
-	Send(ctx context.Context, message Message) (bool, error)
+	GetSystemDefaultTemplate(notificationConfigMap map[string]interface{}) string
+	// Send sends the message and returns the id of the sent message in the receiver, if any
+	Send(ctx context.Context, message Message) (externalID string, retryable bool, err error)
 }
 
 //go:generate mockery --name=Queuer -r --case underscore --with-expecter --structname Queuer --filename queuer.go --output=./mocks
@@ -20,6 +21,11 @@ type Queuer interface {
 	Dequeue(ctx context.Context, receiverTypes []string, batchSize int, handlerFn func(context.Context, []Message) error) error
 	SuccessCallback(ctx context.Context, ms Message) error
 	ErrorCallback(ctx context.Context, ms Message) error
+	DelayCallback(ctx context.Context, ms Message) error
+	ListMessages(ctx context.Context, filter queues.FilterMessage) ([]Message, error)
+	GetMessage(ctx context.Context, id string) (Message, error)
+	RequeueMessages(ctx context.Context, ids ...string) (int64, error)
+	DiscardMessages(ctx context.Context, ids ...string) (int64, error)
 	Type() string
 	Cleanup(ctx context.Context, filter queues.FilterCleanup) error
 	Stop(ctx context.Context) error
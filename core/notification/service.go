@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
 	saltlog "github.com/odpf/salt/log"
 	"go.opencensus.io/trace"
 
@@ -14,6 +15,8 @@ import (
 	"github.com/odpf/siren/core/subscription"
 	"github.com/odpf/siren/pkg/errors"
 	"github.com/odpf/siren/pkg/telemetry"
+	"github.com/odpf/siren/plugins"
+	"github.com/odpf/siren/plugins/queues"
 )
 
 //go:generate mockery --name=Dispatcher -r --case underscore --with-expecter --structname Dispatcher --filename dispatcher.go --output=./mocks
@@ -59,6 +62,7 @@ type Service struct {
 	alertService          AlertService
 	notifierPlugins       map[string]Notifier
 	dispatcher            map[string]Dispatcher
+	grouper               MessageGrouper
 	messagingTracer       *telemetry.MessagingTracer
 }
 
@@ -71,6 +75,7 @@ type Deps struct {
 	AlertService              AlertService
 	DispatchReceiverService   Dispatcher
 	DispatchSubscriberService Dispatcher
+	Grouper                   MessageGrouper
 }
 
 // NewService creates a new notification service
@@ -106,6 +111,7 @@ func NewService(
 			TypeReceiver:   dispatchReceiverService,
 			TypeSubscriber: dispatchSubscriberService,
 		},
+		grouper:         deps.Grouper,
 		notifierPlugins: notifierPlugins,
 	}
 
@@ -164,6 +170,16 @@ func (s *Service) Dispatch(ctx context.Context, n Notification) error {
 		return fmt.Errorf("failed updating silence status: %w", err)
 	}
 
+	if s.grouper != nil {
+		var groupedMessages []Message
+		messages, groupedMessages = splitGroupedMessages(messages)
+		if len(groupedMessages) != 0 {
+			if err := s.grouper.Buffer(ctx, groupedMessages...); err != nil {
+				return fmt.Errorf("failed buffering grouped messages: %w", err)
+			}
+		}
+	}
+
 	if len(messages) == 0 {
 		s.logger.Info("no messages to enqueue")
 		return nil
@@ -176,6 +192,19 @@ func (s *Service) Dispatch(ctx context.Context, n Notification) error {
 	return nil
 }
 
+// splitGroupedMessages separates messages that have a group key from the others
+func splitGroupedMessages(messages []Message) ([]Message, []Message) {
+	var ungrouped, grouped []Message
+	for _, m := range messages {
+		if m.GroupKey.IsEmpty() {
+			ungrouped = append(ungrouped, m)
+		} else {
+			grouped = append(grouped, m)
+		}
+	}
+	return ungrouped, grouped
+}
+
 func (s *Service) CheckAndInsertIdempotency(ctx context.Context, scope, key string) (uint64, error) {
 	idempt, err := s.idempotencyRepository.InsertOnConflictReturning(ctx, scope, key)
 	if err != nil {
@@ -198,3 +227,79 @@ func (s *Service) RemoveIdempotencies(ctx context.Context, TTL time.Duration) er
 		TTL: TTL,
 	})
 }
+
+// ListMessages returns messages in the queue matching the filter
+func (s *Service) ListMessages(ctx context.Context, filter queues.FilterMessage) ([]Message, error) {
+	messages, err := s.q.ListMessages(ctx, filter)
+	if err != nil {
+		return nil, s.inspectionError(err)
+	}
+	return messages, nil
+}
+
+// GetMessage returns a message in the queue with its last error and try history
+func (s *Service) GetMessage(ctx context.Context, id string) (Message, error) {
+	message, err := s.q.GetMessage(ctx, id)
+	if err != nil {
+		return Message{}, s.inspectionError(err)
+	}
+	return message, nil
+}
+
+// RequeueMessages puts failed messages back to the queue to be retried, returning the number of requeued messages
+func (s *Service) RequeueMessages(ctx context.Context, ids ...string) (int64, error) {
+	if len(ids) == 0 {
+		return 0, errors.ErrInvalid.WithMsgf("no message ids to requeue")
+	}
+	count, err := s.q.RequeueMessages(ctx, ids...)
+	if err != nil {
+		return 0, s.inspectionError(err)
+	}
+	return count, nil
+}
+
+// ReplayMessages enqueues a new copy of each message, the original messages are left untouched
+// it returns ids of the new messages
+func (s *Service) ReplayMessages(ctx context.Context, ids ...string) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, errors.ErrInvalid.WithMsgf("no message ids to replay")
+	}
+
+	var replayed []Message
+	for _, id := range ids {
+		message, err := s.q.GetMessage(ctx, id)
+		if err != nil {
+			return nil, s.inspectionError(err)
+		}
+		replayed = append(replayed, message.Replay(uuid.NewString(), time.Now()))
+	}
+
+	if err := s.q.Enqueue(ctx, replayed...); err != nil {
+		return nil, fmt.Errorf("failed to enqueue replayed messages: %w", err)
+	}
+
+	var newIDs []string
+	for _, m := range replayed {
+		newIDs = append(newIDs, m.ID)
+	}
+	return newIDs, nil
+}
+
+// DiscardMessages removes messages from the queue, returning the number of discarded messages
+func (s *Service) DiscardMessages(ctx context.Context, ids ...string) (int64, error) {
+	if len(ids) == 0 {
+		return 0, errors.ErrInvalid.WithMsgf("no message ids to discard")
+	}
+	count, err := s.q.DiscardMessages(ctx, ids...)
+	if err != nil {
+		return 0, s.inspectionError(err)
+	}
+	return count, nil
+}
+
+func (s *Service) inspectionError(err error) error {
+	if errors.Is(err, plugins.ErrNotImplemented) {
+		return errors.ErrInvalid.WithMsgf("message inspection is not supported by %s queue", s.q.Type())
+	}
+	return err
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	saltlog "github.com/odpf/salt/log"
 	"github.com/odpf/siren/core/log"
 	"github.com/odpf/siren/core/notification"
 	"github.com/odpf/siren/core/notification/mocks"
 	"github.com/odpf/siren/pkg/errors"
+	"github.com/odpf/siren/plugins"
 	"github.com/odpf/siren/plugins/queues"
 	"github.com/stretchr/testify/mock"
 )
@@ -229,3 +232,215 @@ func TestService_Dispatch(t *testing.T) {
 		})
 	}
 }
+
+func TestService_DispatchWithGrouper(t *testing.T) {
+	groupedMessage := notification.Message{
+		ID: "456",
+		GroupKey: notification.GroupKey{
+			UniqueKey:      "unique-key",
+			SubscriptionID: 1,
+			ReceiverID:     2,
+		},
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(*mocks.Queuer, *mocks.MessageGrouper)
+		wantErr bool
+	}{
+		{
+			name: "should return error if buffer grouped messages return error",
+			setup: func(_ *mocks.Queuer, g *mocks.MessageGrouper) {
+				g.EXPECT().Buffer(mock.AnythingOfType("*context.valueCtx"), groupedMessage).Return(errors.New("some error"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "should buffer grouped messages and enqueue the others",
+			setup: func(q *mocks.Queuer, g *mocks.MessageGrouper) {
+				g.EXPECT().Buffer(mock.AnythingOfType("*context.valueCtx"), groupedMessage).Return(nil)
+				q.EXPECT().Enqueue(mock.AnythingOfType("*context.valueCtx"), notification.Message{ID: "123"}).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mockQueuer       = new(mocks.Queuer)
+				mockRepository   = new(mocks.Repository)
+				mockDispatcher   = new(mocks.Dispatcher)
+				mockLogService   = new(mocks.LogService)
+				mockAlertService = new(mocks.AlertService)
+				mockGrouper      = new(mocks.MessageGrouper)
+				n                = notification.Notification{
+					Type: notification.TypeSubscriber,
+					Labels: map[string]string{
+						"k1": "v1",
+					},
+				}
+			)
+
+			mockRepository.EXPECT().Create(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("notification.Notification")).Return(n, nil)
+			mockDispatcher.EXPECT().PrepareMessage(mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("notification.Notification")).Return([]notification.Message{{ID: "123"}, groupedMessage}, []log.Notification{{ReceiverID: 123}}, false, nil)
+			mockLogService.EXPECT().LogNotifications(mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("log.Notification")).Return(nil)
+			mockAlertService.EXPECT().UpdateSilenceStatus(mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("[]int64"), mock.AnythingOfType("bool"), mock.AnythingOfType("bool")).Return(nil)
+
+			if tt.setup != nil {
+				tt.setup(mockQueuer, mockGrouper)
+			}
+
+			mockQueuer.EXPECT().Type().Return(queues.KindPostgres.String())
+			s := notification.NewService(
+				saltlog.NewNoop(),
+				mockRepository,
+				mockQueuer,
+				nil,
+				notification.Deps{
+					AlertService:              mockAlertService,
+					LogService:                mockLogService,
+					DispatchReceiverService:   mockDispatcher,
+					DispatchSubscriberService: mockDispatcher,
+					Grouper:                   mockGrouper,
+				},
+			)
+			if err := s.Dispatch(context.TODO(), n); (err != nil) != tt.wantErr {
+				t.Errorf("Service.Dispatch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			mockGrouper.AssertExpectations(t)
+			mockQueuer.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_ListMessages(t *testing.T) {
+	filter := queues.FilterMessage{Status: string(notification.MessageStatusFailed), Error: "timeout"}
+	testCases := []struct {
+		name    string
+		setup   func(*mocks.Queuer)
+		want    []notification.Message
+		wantErr error
+	}{
+		{
+			name: "should return invalid error if queue does not support inspection",
+			setup: func(q *mocks.Queuer) {
+				q.EXPECT().ListMessages(mock.AnythingOfType("*context.emptyCtx"), filter).Return(nil, plugins.ErrNotImplemented)
+			},
+			wantErr: errors.New("message inspection is not supported by inmemory queue"),
+		},
+		{
+			name: "should return error as is if queue returning some error",
+			setup: func(q *mocks.Queuer) {
+				q.EXPECT().ListMessages(mock.AnythingOfType("*context.emptyCtx"), filter).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("some error"),
+		},
+		{
+			name: "should return messages if queue returning messages",
+			setup: func(q *mocks.Queuer) {
+				q.EXPECT().ListMessages(mock.AnythingOfType("*context.emptyCtx"), filter).Return([]notification.Message{{ID: "1"}}, nil)
+			},
+			want: []notification.Message{{ID: "1"}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockQueuer := new(mocks.Queuer)
+
+			mockQueuer.EXPECT().Type().Return(queues.KindInMemory.String())
+			if tc.setup != nil {
+				tc.setup(mockQueuer)
+			}
+
+			ns := notification.NewService(saltlog.NewNoop(), nil, mockQueuer, nil, notification.Deps{})
+
+			got, err := ns.ListMessages(context.Background(), filter)
+			if tc.wantErr != nil {
+				if err == nil || err.Error() != tc.wantErr.Error() {
+					t.Fatalf("NotificationService.ListMessages() error = %v, wantErr %v", err, tc.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("NotificationService.ListMessages() unexpected error = %v", err)
+			}
+			if diff := cmp.Diff(got, tc.want, cmpopts.IgnoreUnexported(notification.Message{})); diff != "" {
+				t.Errorf("NotificationService.ListMessages() diff = %v", diff)
+			}
+
+			mockQueuer.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_ReplayMessages(t *testing.T) {
+	failedMessage := notification.Message{
+		ID:           "old-id",
+		Status:       notification.MessageStatusFailed,
+		ReceiverType: testPluginType,
+		Configs:      map[string]interface{}{"url": "http://localhost"},
+		Details:      map[string]interface{}{"title": "alert"},
+		LastError:    "some error",
+		MaxTries:     3,
+		TryCount:     3,
+		Attempts: []notification.MessageAttempt{
+			{TryCount: 3, Status: notification.MessageStatusFailed, Error: "some error"},
+		},
+	}
+	testCases := []struct {
+		name    string
+		ids     []string
+		setup   func(*mocks.Queuer)
+		wantErr bool
+	}{
+		{
+			name:    "should return error if no ids",
+			wantErr: true,
+		},
+		{
+			name: "should return error if message not found",
+			ids:  []string{"old-id"},
+			setup: func(q *mocks.Queuer) {
+				q.EXPECT().GetMessage(mock.AnythingOfType("*context.emptyCtx"), "old-id").Return(notification.Message{}, errors.ErrNotFound)
+			},
+			wantErr: true,
+		},
+		{
+			name: "should enqueue a fresh copy of the message",
+			ids:  []string{"old-id"},
+			setup: func(q *mocks.Queuer) {
+				q.EXPECT().GetMessage(mock.AnythingOfType("*context.emptyCtx"), "old-id").Return(failedMessage, nil)
+				q.EXPECT().Enqueue(mock.AnythingOfType("*context.emptyCtx"), mock.MatchedBy(func(m notification.Message) bool {
+					return m.ID != "old-id" &&
+						m.Status == notification.MessageStatusEnqueued &&
+						m.TryCount == 0 &&
+						m.LastError == "" &&
+						len(m.Attempts) == 0 &&
+						m.MaxTries == failedMessage.MaxTries &&
+						cmp.Equal(m.Details, failedMessage.Details) &&
+						cmp.Equal(m.Configs, failedMessage.Configs)
+				})).Return(nil)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockQueuer := new(mocks.Queuer)
+
+			mockQueuer.EXPECT().Type().Return(queues.KindPostgres.String())
+			if tc.setup != nil {
+				tc.setup(mockQueuer)
+			}
+
+			ns := notification.NewService(saltlog.NewNoop(), nil, mockQueuer, nil, notification.Deps{})
+
+			got, err := ns.ReplayMessages(context.Background(), tc.ids...)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("NotificationService.ReplayMessages() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if !tc.wantErr && len(got) != len(tc.ids) {
+				t.Errorf("NotificationService.ReplayMessages() got %d ids, want %d", len(got), len(tc.ids))
+			}
+
+			mockQueuer.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package notification
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockery --name=ThreadRepository -r --case underscore --with-expecter --structname ThreadRepository --filename thread_repository.go --output=./mocks
+type ThreadRepository interface {
+	Get(ctx context.Context, uniqueKey, receiverType, destination string) (Thread, error)
+	Create(ctx context.Context, t Thread) error
+	Delete(ctx context.Context, uniqueKey, receiverType, destination string) error
+}
+
+// Thread is the first message sent to a destination for a unique key.
+// Later messages of the same unique key are sent as replies of the thread.
+type Thread struct {
+	UniqueKey    string
+	ReceiverType string
+	Destination  string
+	ExternalID   string
+	// the id of the channel in the receiver the thread is posted to, e.g. the slack DM channel of a user
+	ChannelID string
+	CreatedAt time.Time
+}
+
+// UniqueKey returns the unique key of the notification the message is built from
+func (m Message) UniqueKey() string {
+	uniqueKey, _ := m.Details[DetailsKeyUniqueKey].(string)
+	return uniqueKey
+}
@@ -21,6 +21,7 @@ type Provider struct {
 	Name        string                 `json:"name"`
 	Type        string                 `json:"type"`
 	Credentials map[string]interface{} `json:"credentials"`
+	Config      map[string]interface{} `json:"config"`
 	Labels      map[string]string      `json:"labels"`
 	CreatedAt   time.Time              `json:"created_at"`
 	UpdatedAt   time.Time              `json:"updated_at"`
@@ -6,15 +6,20 @@ import (
 	"github.com/odpf/siren/pkg/errors"
 )
 
+// ConfigValidator validates the config of a provider type
+type ConfigValidator func(config map[string]interface{}) error
+
 // Service handles business logic
 type Service struct {
-	repository Repository
+	repository               Repository
+	configValidatorsRegistry map[string]ConfigValidator
 }
 
 // NewService returns repository struct
-func NewService(repository Repository) *Service {
+func NewService(repository Repository, configValidatorsRegistry map[string]ConfigValidator) *Service {
 	return &Service{
-		repository: repository,
+		repository:               repository,
+		configValidatorsRegistry: configValidatorsRegistry,
 	}
 }
 
@@ -27,6 +32,10 @@ func (s *Service) Create(ctx context.Context, prov *Provider) error {
 		return errors.ErrInvalid.WithMsgf("provider is nil")
 	}
 
+	if err := s.validateConfig(prov); err != nil {
+		return err
+	}
+
 	err := s.repository.Create(ctx, prov)
 	if err != nil {
 		if errors.Is(err, ErrDuplicate) {
@@ -54,6 +63,10 @@ func (s *Service) Update(ctx context.Context, prov *Provider) error {
 		return errors.ErrInvalid.WithMsgf("provider is nil")
 	}
 
+	if err := s.validateConfig(prov); err != nil {
+		return err
+	}
+
 	err := s.repository.Update(ctx, prov)
 	if err != nil {
 		if errors.Is(err, ErrDuplicate) {
@@ -71,3 +84,14 @@ func (s *Service) Update(ctx context.Context, prov *Provider) error {
 func (s *Service) Delete(ctx context.Context, id uint64) error {
 	return s.repository.Delete(ctx, id)
 }
+
+func (s *Service) validateConfig(prov *Provider) error {
+	validate, ok := s.configValidatorsRegistry[prov.Type]
+	if !ok {
+		return nil
+	}
+	if err := validate(prov.Config); err != nil {
+		return errors.ErrInvalid.WithMsgf("invalid %s provider config: %s", prov.Type, err.Error())
+	}
+	return nil
+}
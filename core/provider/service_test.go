@@ -22,7 +22,7 @@ func TestList(t *testing.T) {
 
 	t.Run("should call repository List method and return result in domain's type", func(t *testing.T) {
 		repositoryMock := &mocks.ProviderRepository{}
-		dummyService := provider.NewService(repositoryMock)
+		dummyService := provider.NewService(repositoryMock, nil)
 		dummyProviders := []provider.Provider{
 			{
 				ID:          10,
@@ -45,7 +45,7 @@ func TestList(t *testing.T) {
 
 	t.Run("should call repository List method and return error if any", func(t *testing.T) {
 		repositoryMock := &mocks.ProviderRepository{}
-		dummyService := provider.NewService(repositoryMock)
+		dummyService := provider.NewService(repositoryMock, nil)
 		repositoryMock.EXPECT().List(mock.AnythingOfType("*context.emptyCtx"), provider.Filter{}).Return(nil, errors.New("random error")).Once()
 		result, err := dummyService.List(ctx, provider.Filter{})
 		assert.Nil(t, result)
@@ -75,7 +75,7 @@ func TestCreate(t *testing.T) {
 
 	t.Run("should call repository Create method and return result in domain's type", func(t *testing.T) {
 		repositoryMock := &mocks.ProviderRepository{}
-		dummyService := provider.NewService(repositoryMock)
+		dummyService := provider.NewService(repositoryMock, nil)
 		repositoryMock.EXPECT().Create(mock.AnythingOfType("*context.emptyCtx"), dummyProvider).Return(nil).Once()
 		err := dummyService.Create(ctx, dummyProvider)
 		assert.Nil(t, err)
@@ -84,7 +84,7 @@ func TestCreate(t *testing.T) {
 
 	t.Run("should call repository Create method and return error if any", func(t *testing.T) {
 		repositoryMock := &mocks.ProviderRepository{}
-		dummyService := provider.NewService(repositoryMock)
+		dummyService := provider.NewService(repositoryMock, nil)
 		repositoryMock.EXPECT().Create(mock.AnythingOfType("*context.emptyCtx"), dummyProvider).Return(errors.New("random error")).Once()
 		err := dummyService.Create(ctx, dummyProvider)
 		assert.EqualError(t, err, "random error")
@@ -93,12 +93,23 @@ func TestCreate(t *testing.T) {
 
 	t.Run("should call repository Create method and return conflict error if duplicated", func(t *testing.T) {
 		repositoryMock := &mocks.ProviderRepository{}
-		dummyService := provider.NewService(repositoryMock)
+		dummyService := provider.NewService(repositoryMock, nil)
 		repositoryMock.EXPECT().Create(mock.AnythingOfType("*context.emptyCtx"), dummyProvider).Return(provider.ErrDuplicate).Once()
 		err := dummyService.Create(ctx, dummyProvider)
 		assert.EqualError(t, err, "urn already exist")
 		repositoryMock.AssertExpectations(t)
 	})
+
+	t.Run("should return error invalid and not create provider if config is invalid", func(t *testing.T) {
+		repositoryMock := &mocks.ProviderRepository{}
+		dummyService := provider.NewService(repositoryMock, map[string]provider.ConfigValidator{
+			"bar": func(config map[string]interface{}) error { return errors.New("mapping is missing") },
+		})
+		err := dummyService.Create(ctx, dummyProvider)
+		assert.True(t, errors.Is(err, errors.ErrInvalid))
+		assert.EqualError(t, err, "invalid bar provider config: mapping is missing")
+		repositoryMock.AssertExpectations(t)
+	})
 }
 
 func TestGetProvider(t *testing.T) {
@@ -122,7 +133,7 @@ func TestGetProvider(t *testing.T) {
 
 	t.Run("should call repository Get method and return result in domain's type", func(t *testing.T) {
 		repositoryMock := &mocks.ProviderRepository{}
-		dummyService := provider.NewService(repositoryMock)
+		dummyService := provider.NewService(repositoryMock, nil)
 		repositoryMock.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), dummyProviderID).Return(dummyProvider, nil).Once()
 		result, err := dummyService.Get(ctx, dummyProviderID)
 		assert.Nil(t, err)
@@ -132,7 +143,7 @@ func TestGetProvider(t *testing.T) {
 
 	t.Run("should call repository Get method and return error if any", func(t *testing.T) {
 		repositoryMock := &mocks.ProviderRepository{}
-		dummyService := provider.NewService(repositoryMock)
+		dummyService := provider.NewService(repositoryMock, nil)
 		repositoryMock.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), dummyProviderID).Return(nil, errors.New("random error")).Once()
 		result, err := dummyService.Get(ctx, dummyProviderID)
 		assert.Empty(t, result)
@@ -142,7 +153,7 @@ func TestGetProvider(t *testing.T) {
 
 	t.Run("should call repository Get method and return error if repository return not found error", func(t *testing.T) {
 		repositoryMock := &mocks.ProviderRepository{}
-		dummyService := provider.NewService(repositoryMock)
+		dummyService := provider.NewService(repositoryMock, nil)
 		repositoryMock.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), dummyProviderID).Return(nil, provider.NotFoundError{}).Once()
 		result, err := dummyService.Get(ctx, dummyProviderID)
 		assert.Empty(t, result)
@@ -172,7 +183,7 @@ func TestUpdateProvider(t *testing.T) {
 
 	t.Run("should call repository Update method and return result in domain's type", func(t *testing.T) {
 		repositoryMock := &mocks.ProviderRepository{}
-		dummyService := provider.NewService(repositoryMock)
+		dummyService := provider.NewService(repositoryMock, nil)
 		repositoryMock.EXPECT().Update(mock.AnythingOfType("*context.emptyCtx"), dummyProvider).Return(nil).Once()
 		err := dummyService.Update(ctx, dummyProvider)
 		assert.Nil(t, err)
@@ -181,7 +192,7 @@ func TestUpdateProvider(t *testing.T) {
 
 	t.Run("should call repository Update method and return error if any", func(t *testing.T) {
 		repositoryMock := &mocks.ProviderRepository{}
-		dummyService := provider.NewService(repositoryMock)
+		dummyService := provider.NewService(repositoryMock, nil)
 		repositoryMock.EXPECT().Update(mock.AnythingOfType("*context.emptyCtx"), dummyProvider).Return(errors.New("random error")).Once()
 		err := dummyService.Update(ctx, dummyProvider)
 		assert.EqualError(t, err, "random error")
@@ -190,7 +201,7 @@ func TestUpdateProvider(t *testing.T) {
 
 	t.Run("should call repository Update method and return error not found if repository return not found error", func(t *testing.T) {
 		repositoryMock := &mocks.ProviderRepository{}
-		dummyService := provider.NewService(repositoryMock)
+		dummyService := provider.NewService(repositoryMock, nil)
 		repositoryMock.EXPECT().Update(mock.AnythingOfType("*context.emptyCtx"), dummyProvider).Return(provider.NotFoundError{}).Once()
 		err := dummyService.Update(ctx, dummyProvider)
 		assert.EqualError(t, err, "provider not found")
@@ -199,12 +210,23 @@ func TestUpdateProvider(t *testing.T) {
 
 	t.Run("should call repository Update method and return conflict error if repository return duplicate error", func(t *testing.T) {
 		repositoryMock := &mocks.ProviderRepository{}
-		dummyService := provider.NewService(repositoryMock)
+		dummyService := provider.NewService(repositoryMock, nil)
 		repositoryMock.EXPECT().Update(mock.AnythingOfType("*context.emptyCtx"), dummyProvider).Return(provider.ErrDuplicate).Once()
 		err := dummyService.Update(ctx, dummyProvider)
 		assert.EqualError(t, err, "urn already exist")
 		repositoryMock.AssertExpectations(t)
 	})
+
+	t.Run("should return error invalid and not update provider if config is invalid", func(t *testing.T) {
+		repositoryMock := &mocks.ProviderRepository{}
+		dummyService := provider.NewService(repositoryMock, map[string]provider.ConfigValidator{
+			"bar": func(config map[string]interface{}) error { return errors.New("mapping is missing") },
+		})
+		err := dummyService.Update(ctx, dummyProvider)
+		assert.True(t, errors.Is(err, errors.ErrInvalid))
+		assert.EqualError(t, err, "invalid bar provider config: mapping is missing")
+		repositoryMock.AssertExpectations(t)
+	})
 }
 
 func TestDeleteProvider(t *testing.T) {
@@ -213,7 +235,7 @@ func TestDeleteProvider(t *testing.T) {
 
 	t.Run("should call repository Delete method and return nil if no error", func(t *testing.T) {
 		repositoryMock := &mocks.ProviderRepository{}
-		dummyService := provider.NewService(repositoryMock)
+		dummyService := provider.NewService(repositoryMock, nil)
 		repositoryMock.EXPECT().Delete(mock.AnythingOfType("*context.emptyCtx"), providerID).Return(nil).Once()
 		err := dummyService.Delete(ctx, providerID)
 		assert.Nil(t, err)
@@ -222,7 +244,7 @@ func TestDeleteProvider(t *testing.T) {
 
 	t.Run("should call repository Delete method and return error if any", func(t *testing.T) {
 		repositoryMock := &mocks.ProviderRepository{}
-		dummyService := provider.NewService(repositoryMock)
+		dummyService := provider.NewService(repositoryMock, nil)
 		repositoryMock.EXPECT().Delete(mock.AnythingOfType("*context.emptyCtx"), providerID).Return(errors.New("random error")).Once()
 		err := dummyService.Delete(ctx, providerID)
 		assert.EqualError(t, err, "random error")
@@ -1,11 +1,19 @@
 package provider
 
 const (
-	TypeCortex string = "cortex"
+	TypeCortex     string = "cortex"
+	TypePrometheus string = "prometheus"
+	TypeMimir      string = "mimir"
+	TypeLoki       string = "loki"
+	TypeWebhook    string = "webhook"
 )
 
 var SupportedTypes = []string{
 	TypeCortex,
+	TypePrometheus,
+	TypeMimir,
+	TypeLoki,
+	TypeWebhook,
 }
 
 func IsTypeSupported(providerType string) bool {
@@ -5,6 +5,11 @@ const (
 	TypeHTTP      string = "http"
 	TypePagerDuty string = "pagerduty"
 	TypeFile      string = "file"
+	TypeMSTeams   string = "msteams"
+	TypeEmail     string = "email"
+	TypeOpsgenie  string = "opsgenie"
+	TypeTelegram  string = "telegram"
+	TypeDiscord   string = "discord"
 )
 
 var SupportedTypes = []string{
@@ -12,6 +17,11 @@ var SupportedTypes = []string{
 	TypeHTTP,
 	TypePagerDuty,
 	TypeFile,
+	TypeMSTeams,
+	TypeEmail,
+	TypeOpsgenie,
+	TypeTelegram,
+	TypeDiscord,
 }
 
 func IsTypeSupported(receiverType string) bool {
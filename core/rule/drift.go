@@ -0,0 +1,306 @@
+package rule
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/odpf/siren/core/namespace"
+	"github.com/odpf/siren/core/template"
+	"github.com/odpf/siren/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// DriftMissing is a rule that exists in siren but not in the provider
+	DriftMissing = "missing"
+	// DriftExtra is a rule that exists in the provider but not in siren or is disabled in siren
+	DriftExtra = "extra"
+	// DriftModified is a rule that exists in both but has different definition
+	DriftModified = "modified"
+)
+
+// RuleGroup is a rule group read from a provider
+type RuleGroup struct {
+	Namespace string
+	Name      string
+	Rules     []RuleNode
+}
+
+// RuleNode is a single alerting or recording rule with a normalized yaml body
+type RuleNode struct {
+	Name string
+	Body string
+}
+
+// Drift is a difference of a single rule node between siren and the provider
+type Drift struct {
+	// RuleID is the id of siren rule that owns the rule node, zero if the node is not managed by siren
+	RuleID     uint64 `json:"rule_id"`
+	Namespace  string `json:"namespace"`
+	GroupName  string `json:"group_name"`
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Expected   string `json:"expected"`
+	Actual     string `json:"actual"`
+	Reconciled bool   `json:"reconciled"`
+	// Error is why the drift could not be reconciled
+	Error string `json:"error,omitempty"`
+}
+
+// DriftReport is the result of comparing rules of a namespace in siren with the provider
+type DriftReport struct {
+	NamespaceID  uint64  `json:"namespace_id"`
+	NamespaceURN string  `json:"namespace_urn"`
+	Drifts       []Drift `json:"drifts"`
+}
+
+// FailedCount returns the number of drifts that could not be reconciled
+func (r DriftReport) FailedCount() int {
+	var count int
+	for _, d := range r.Drifts {
+		if d.Error != "" {
+			count++
+		}
+	}
+	return count
+}
+
+// ParseRuleNodes parses a yaml list of rules into rule nodes with normalized body,
+// so rules rendered by siren and rules read from provider could be compared
+func ParseRuleNodes(body string) ([]RuleNode, error) {
+	var rawNodes []map[string]interface{}
+	if err := yaml.Unmarshal([]byte(body), &rawNodes); err != nil {
+		return nil, err
+	}
+
+	nodes := make([]RuleNode, 0, len(rawNodes))
+	for _, rn := range rawNodes {
+		name, _ := rn["alert"].(string)
+		if name == "" {
+			name, _ = rn["record"].(string)
+		}
+		if name == "" {
+			return nil, fmt.Errorf("rule has no alert or record name")
+		}
+
+		normalized, err := yaml.Marshal(removeEmpty(rn))
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, RuleNode{
+			Name: name,
+			Body: string(normalized),
+		})
+	}
+	return nodes, nil
+}
+
+func removeEmpty(m map[string]interface{}) map[string]interface{} {
+	for k, v := range m {
+		switch val := v.(type) {
+		case nil:
+			delete(m, k)
+		case string:
+			if val == "" {
+				delete(m, k)
+			}
+		case map[string]interface{}:
+			if len(removeEmpty(val)) == 0 {
+				delete(m, k)
+			}
+		}
+	}
+	return m
+}
+
+type ruleNodeKey struct {
+	namespace string
+	groupName string
+	name      string
+}
+
+type expectedRuleNode struct {
+	ruleID  uint64
+	enabled bool
+	body    string
+}
+
+// driftDetection holds siren rules and their templates used to detect drift of a namespace
+type driftDetection struct {
+	report    *DriftReport
+	rules     map[uint64]Rule
+	templates map[string]*template.Template
+}
+
+// DetectDrift compares rules of the namespace stored in siren with rules in the provider
+func (s *Service) DetectDrift(ctx context.Context, namespaceID uint64) (*DriftReport, error) {
+	ns, err := s.namespaceService.Get(ctx, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	pluginService, err := s.getProviderPluginService(ns.Provider.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	dd, err := s.detectDrift(ctx, ns, pluginService)
+	if err != nil {
+		return nil, err
+	}
+	return dd.report, nil
+}
+
+// Reconcile detects drift of the namespace and re-uploads siren version of the drifted rules.
+// Rule nodes in the provider that are not owned by any siren rule are only reported.
+// A rule that failed to be uploaded is reported with the error on its drifts, the rest are still reconciled.
+func (s *Service) Reconcile(ctx context.Context, namespaceID uint64) (*DriftReport, error) {
+	ns, err := s.namespaceService.Get(ctx, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	pluginService, err := s.getProviderPluginService(ns.Provider.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	dd, err := s.detectDrift(ctx, ns, pluginService)
+	if err != nil {
+		return nil, err
+	}
+
+	uploadErrs := map[uint64]error{}
+	for i, d := range dd.report.Drifts {
+		if d.RuleID == 0 {
+			continue
+		}
+
+		uploadErr, uploaded := uploadErrs[d.RuleID]
+		if !uploaded {
+			rl := dd.rules[d.RuleID]
+			if err := pluginService.UpsertRule(ctx, ns.URN, ns.Provider, &rl, dd.templates[rl.Template]); err != nil {
+				uploadErr = fmt.Errorf("cannot reconcile rule %d: %w", rl.ID, err)
+			}
+			uploadErrs[d.RuleID] = uploadErr
+		}
+
+		if uploadErr != nil {
+			dd.report.Drifts[i].Error = uploadErr.Error()
+			continue
+		}
+		dd.report.Drifts[i].Reconciled = true
+	}
+
+	return dd.report, nil
+}
+
+func (s *Service) detectDrift(ctx context.Context, ns *namespace.Namespace, pluginService RuleUploader) (*driftDetection, error) {
+	rules, err := s.repository.List(ctx, Filter{NamespaceID: ns.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		rulesByID = make(map[uint64]Rule, len(rules))
+		expected  = map[ruleNodeKey]expectedRuleNode{}
+		templates = map[string]*template.Template{}
+	)
+	for _, rl := range rules {
+		rulesByID[rl.ID] = rl
+
+		tmpl, ok := templates[rl.Template]
+		if !ok {
+			if tmpl, err = s.templateService.GetByName(ctx, rl.Template); err != nil {
+				return nil, err
+			}
+			templates[rl.Template] = tmpl
+		}
+
+		inputValues := make(map[string]string)
+		for _, v := range rl.Variables {
+			inputValues[v.Name] = v.Value
+		}
+
+		renderedRule, err := template.RenderWithEnrichedDefault(tmpl.Body, tmpl.Variables, inputValues)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes, err := ParseRuleNodes(renderedRule)
+		if err != nil {
+			return nil, errors.ErrInvalid.WithMsgf("cannot parse rule %d", rl.ID).WithCausef(err.Error())
+		}
+
+		for _, n := range nodes {
+			expected[ruleNodeKey{rl.Namespace, rl.GroupName, n.Name}] = expectedRuleNode{
+				ruleID:  rl.ID,
+				enabled: rl.Enabled,
+				body:    n.Body,
+			}
+		}
+	}
+
+	ruleGroups, err := pluginService.ListRuleGroups(ctx, ns.URN, ns.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		drifts = []Drift{}
+		seen   = map[ruleNodeKey]bool{}
+	)
+	for _, rg := range ruleGroups {
+		for _, n := range rg.Rules {
+			key := ruleNodeKey{rg.Namespace, rg.Name, n.Name}
+			seen[key] = true
+
+			exp, ok := expected[key]
+			switch {
+			case !ok:
+				drifts = append(drifts, newDrift(key, 0, DriftExtra, "", n.Body))
+			case !exp.enabled:
+				drifts = append(drifts, newDrift(key, exp.ruleID, DriftExtra, "", n.Body))
+			case exp.body != n.Body:
+				drifts = append(drifts, newDrift(key, exp.ruleID, DriftModified, exp.body, n.Body))
+			}
+		}
+	}
+
+	for key, exp := range expected {
+		if exp.enabled && !seen[key] {
+			drifts = append(drifts, newDrift(key, exp.ruleID, DriftMissing, exp.body, ""))
+		}
+	}
+
+	sort.Slice(drifts, func(i, j int) bool {
+		a := strings.Join([]string{drifts[i].Namespace, drifts[i].GroupName, drifts[i].Name}, "/")
+		b := strings.Join([]string{drifts[j].Namespace, drifts[j].GroupName, drifts[j].Name}, "/")
+		return a < b
+	})
+
+	return &driftDetection{
+		report: &DriftReport{
+			NamespaceID:  ns.ID,
+			NamespaceURN: ns.URN,
+			Drifts:       drifts,
+		},
+		rules:     rulesByID,
+		templates: templates,
+	}, nil
+}
+
+func newDrift(key ruleNodeKey, ruleID uint64, status, expected, actual string) Drift {
+	return Drift{
+		RuleID:    ruleID,
+		Namespace: key.namespace,
+		GroupName: key.groupName,
+		Name:      key.name,
+		Status:    status,
+		Expected:  expected,
+		Actual:    actual,
+	}
+}
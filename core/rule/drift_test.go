@@ -0,0 +1,224 @@
+package rule_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/google/go-cmp/cmp"
+	"github.com/odpf/siren/core/namespace"
+	"github.com/odpf/siren/core/provider"
+	"github.com/odpf/siren/core/rule"
+	"github.com/odpf/siren/core/rule/mocks"
+	"github.com/odpf/siren/core/template"
+	"github.com/odpf/siren/pkg/errors"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestParseRuleNodes(t *testing.T) {
+	t.Run("should normalize rule nodes with different formatting", func(t *testing.T) {
+		a, err := rule.ParseRuleNodes(heredoc.Doc(`
+			- alert: cpu high
+			  expr: cpu > 90
+			  for: '5m'
+			  labels:
+			    team: odpf
+			    severity: CRITICAL
+			  annotations: {}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := rule.ParseRuleNodes(heredoc.Doc(`
+			- for: 5m
+			  alert: "cpu high"
+			  labels: {severity: CRITICAL, team: odpf}
+			  expr: cpu > 90`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(a, b); diff != "" {
+			t.Errorf("ParseRuleNodes() diff = %v", diff)
+		}
+	})
+
+	t.Run("should return error if rule has no name", func(t *testing.T) {
+		_, err := rule.ParseRuleNodes("- expr: cpu > 90")
+		if err == nil {
+			t.Fatal("expected error but got nil")
+		}
+	})
+}
+
+func TestService_DetectDriftAndReconcile(t *testing.T) {
+	var (
+		namespaceID = uint64(3)
+		ns          = &namespace.Namespace{
+			ID:  namespaceID,
+			URN: "odpf",
+			Provider: provider.Provider{
+				Type: provider.TypeCortex,
+			},
+		}
+		cpuTemplate = &template.Template{
+			Name: "cpu",
+			Body: heredoc.Doc(`
+				- alert: cpu high
+				  expr: cpu > [[.threshold]]
+				- alert: cpu low
+				  expr: cpu < [[.threshold]]`),
+			Variables: []template.Variable{{Name: "threshold", Default: "50"}},
+		}
+		memTemplate = &template.Template{
+			Name: "mem",
+			Body: heredoc.Doc(`
+				- alert: mem high
+				  expr: mem > [[.threshold]]`),
+			Variables: []template.Variable{{Name: "threshold", Default: "80"}},
+		}
+		rules = []rule.Rule{
+			{ID: 1, Enabled: true, Namespace: "system", GroupName: "cpu", Template: "cpu", ProviderNamespace: namespaceID, Variables: []rule.RuleVariable{{Name: "threshold", Value: "90"}}},
+			{ID: 2, Enabled: false, Namespace: "system", GroupName: "mem", Template: "mem", ProviderNamespace: namespaceID},
+			{ID: 3, Enabled: true, Namespace: "kafka", GroupName: "mem", Template: "mem", ProviderNamespace: namespaceID},
+		}
+		nodes = func(t *testing.T, body string) []rule.RuleNode {
+			n, err := rule.ParseRuleNodes(body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return n
+		}
+	)
+
+	setup := func(t *testing.T, rr *mocks.RuleRepository, ts *mocks.TemplateService, nss *mocks.NamespaceService, ru *mocks.RuleUploader) {
+		nss.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), namespaceID).Return(ns, nil)
+		rr.EXPECT().List(mock.AnythingOfType("*context.emptyCtx"), rule.Filter{NamespaceID: namespaceID}).Return(rules, nil)
+		ts.EXPECT().GetByName(mock.AnythingOfType("*context.emptyCtx"), "cpu").Return(cpuTemplate, nil).Once()
+		ts.EXPECT().GetByName(mock.AnythingOfType("*context.emptyCtx"), "mem").Return(memTemplate, nil).Once()
+		ru.EXPECT().ListRuleGroups(mock.AnythingOfType("*context.emptyCtx"), "odpf", ns.Provider).Return([]rule.RuleGroup{
+			{
+				Namespace: "system",
+				Name:      "cpu",
+				Rules: nodes(t, heredoc.Doc(`
+					- alert: cpu high
+					  expr: cpu > 90
+					- alert: cpu low
+					  expr: cpu < 10
+					- alert: manual
+					  expr: up == 0`)),
+			},
+			{
+				Namespace: "system",
+				Name:      "mem",
+				Rules: nodes(t, heredoc.Doc(`
+					- alert: mem high
+					  expr: mem > 80`)),
+			},
+		}, nil)
+	}
+
+	expectedDrifts := []rule.Drift{
+		{RuleID: 3, Namespace: "kafka", GroupName: "mem", Name: "mem high", Status: rule.DriftMissing, Expected: "alert: mem high\nexpr: mem > 80\n"},
+		{RuleID: 1, Namespace: "system", GroupName: "cpu", Name: "cpu low", Status: rule.DriftModified, Expected: "alert: cpu low\nexpr: cpu < 90\n", Actual: "alert: cpu low\nexpr: cpu < 10\n"},
+		{RuleID: 0, Namespace: "system", GroupName: "cpu", Name: "manual", Status: rule.DriftExtra, Actual: "alert: manual\nexpr: up == 0\n"},
+		{RuleID: 2, Namespace: "system", GroupName: "mem", Name: "mem high", Status: rule.DriftExtra, Actual: "alert: mem high\nexpr: mem > 80\n"},
+	}
+
+	newService := func() (*rule.Service, *mocks.RuleRepository, *mocks.TemplateService, *mocks.NamespaceService, *mocks.RuleUploader) {
+		var (
+			repositoryMock       = new(mocks.RuleRepository)
+			templateServiceMock  = new(mocks.TemplateService)
+			namespaceServiceMock = new(mocks.NamespaceService)
+			ruleUploaderMock     = new(mocks.RuleUploader)
+		)
+		return rule.NewService(repositoryMock, templateServiceMock, namespaceServiceMock, map[string]rule.RuleUploader{
+			provider.TypeCortex: ruleUploaderMock,
+		}), repositoryMock, templateServiceMock, namespaceServiceMock, ruleUploaderMock
+	}
+
+	t.Run("should report missing, extra and modified rules", func(t *testing.T) {
+		svc, rr, ts, nsMock, ru := newService()
+		setup(t, rr, ts, nsMock, ru)
+
+		report, err := svc.DetectDrift(context.Background(), namespaceID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(&rule.DriftReport{NamespaceID: namespaceID, NamespaceURN: "odpf", Drifts: expectedDrifts}, report); diff != "" {
+			t.Errorf("DetectDrift() diff = %v", diff)
+		}
+		ru.AssertExpectations(t)
+	})
+
+	t.Run("should re-upload drifted siren rules once and only report unowned rules", func(t *testing.T) {
+		svc, rr, ts, nsMock, ru := newService()
+		setup(t, rr, ts, nsMock, ru)
+		for _, id := range []int{0, 1, 2} {
+			rl := rules[id]
+			ru.EXPECT().UpsertRule(mock.AnythingOfType("*context.emptyCtx"), "odpf", ns.Provider, &rl, mock.AnythingOfType("*template.Template")).Return(nil).Once()
+		}
+
+		report, err := svc.Reconcile(context.Background(), namespaceID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, d := range report.Drifts {
+			if d.Reconciled != (d.RuleID != 0) {
+				t.Errorf("drift %s/%s/%s reconciled = %v", d.Namespace, d.GroupName, d.Name, d.Reconciled)
+			}
+		}
+		ru.AssertExpectations(t)
+	})
+
+	t.Run("should report error of rule failed to upload and reconcile the rest", func(t *testing.T) {
+		svc, rr, ts, nsMock, ru := newService()
+		setup(t, rr, ts, nsMock, ru)
+		for _, id := range []int{0, 1, 2} {
+			rl := rules[id]
+			var err error
+			if rl.ID == 3 {
+				err = errors.New("some error")
+			}
+			ru.EXPECT().UpsertRule(mock.AnythingOfType("*context.emptyCtx"), "odpf", ns.Provider, &rl, mock.AnythingOfType("*template.Template")).Return(err).Once()
+		}
+
+		report, err := svc.Reconcile(context.Background(), namespaceID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, d := range report.Drifts {
+			switch d.RuleID {
+			case 0:
+				if d.Reconciled || d.Error != "" {
+					t.Errorf("unowned drift %s/%s/%s should only be reported", d.Namespace, d.GroupName, d.Name)
+				}
+			case 3:
+				if d.Reconciled || d.Error != "cannot reconcile rule 3: some error" {
+					t.Errorf("drift %s/%s/%s reconciled = %v, error = %q", d.Namespace, d.GroupName, d.Name, d.Reconciled, d.Error)
+				}
+			default:
+				if !d.Reconciled || d.Error != "" {
+					t.Errorf("drift %s/%s/%s reconciled = %v, error = %q", d.Namespace, d.GroupName, d.Name, d.Reconciled, d.Error)
+				}
+			}
+		}
+		if got := report.FailedCount(); got != 1 {
+			t.Errorf("FailedCount() = %d, want 1", got)
+		}
+		ru.AssertExpectations(t)
+	})
+
+	t.Run("should return error if provider does not return rule groups", func(t *testing.T) {
+		svc, rr, ts, nsMock, ru := newService()
+		nsMock.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), namespaceID).Return(ns, nil)
+		rr.EXPECT().List(mock.AnythingOfType("*context.emptyCtx"), rule.Filter{NamespaceID: namespaceID}).Return(nil, nil)
+		ru.EXPECT().ListRuleGroups(mock.AnythingOfType("*context.emptyCtx"), "odpf", ns.Provider).Return(nil, errors.New("some error"))
+
+		_, err := svc.DetectDrift(context.Background(), namespaceID)
+		if err == nil || err.Error() != "some error" {
+			t.Fatalf("got error %v", err)
+		}
+		ts.AssertExpectations(t)
+	})
+}
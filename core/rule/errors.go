@@ -2,9 +2,34 @@ package rule
 
 import (
 	"errors"
+	"fmt"
 )
 
 var (
 	ErrDuplicate = errors.New("rule conflicted with existing")
 	ErrRelation  = errors.New("provider's namespace does not exist")
 )
+
+type NotFoundError struct {
+	ID uint64
+}
+
+func (err NotFoundError) Error() string {
+	if err.ID != 0 {
+		return fmt.Sprintf("rule with id %d not found", err.ID)
+	}
+
+	return "rule not found"
+}
+
+type RevisionNotFoundError struct {
+	ID uint64
+}
+
+func (err RevisionNotFoundError) Error() string {
+	if err.ID != 0 {
+		return fmt.Sprintf("rule revision with id %d not found", err.ID)
+	}
+
+	return "rule revision not found"
+}
@@ -59,6 +59,176 @@ func (_c *RuleRepository_Commit_Call) Return(_a0 error) *RuleRepository_Commit_C
 	return _c
 }
 
+// CreateRevision provides a mock function with given fields: _a0, _a1
+func (_m *RuleRepository) CreateRevision(_a0 context.Context, _a1 *rule.Revision) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rule.Revision) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RuleRepository_CreateRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRevision'
+type RuleRepository_CreateRevision_Call struct {
+	*mock.Call
+}
+
+// CreateRevision is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rule.Revision
+func (_e *RuleRepository_Expecter) CreateRevision(_a0 interface{}, _a1 interface{}) *RuleRepository_CreateRevision_Call {
+	return &RuleRepository_CreateRevision_Call{Call: _e.mock.On("CreateRevision", _a0, _a1)}
+}
+
+func (_c *RuleRepository_CreateRevision_Call) Run(run func(_a0 context.Context, _a1 *rule.Revision)) *RuleRepository_CreateRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rule.Revision))
+	})
+	return _c
+}
+
+func (_c *RuleRepository_CreateRevision_Call) Return(_a0 error) *RuleRepository_CreateRevision_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// Delete provides a mock function with given fields: _a0, _a1
+func (_m *RuleRepository) Delete(_a0 context.Context, _a1 uint64) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RuleRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type RuleRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uint64
+func (_e *RuleRepository_Expecter) Delete(_a0 interface{}, _a1 interface{}) *RuleRepository_Delete_Call {
+	return &RuleRepository_Delete_Call{Call: _e.mock.On("Delete", _a0, _a1)}
+}
+
+func (_c *RuleRepository_Delete_Call) Run(run func(_a0 context.Context, _a1 uint64)) *RuleRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *RuleRepository_Delete_Call) Return(_a0 error) *RuleRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// Get provides a mock function with given fields: _a0, _a1
+func (_m *RuleRepository) Get(_a0 context.Context, _a1 uint64) (*rule.Rule, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *rule.Rule
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *rule.Rule); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rule.Rule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type RuleRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uint64
+func (_e *RuleRepository_Expecter) Get(_a0 interface{}, _a1 interface{}) *RuleRepository_Get_Call {
+	return &RuleRepository_Get_Call{Call: _e.mock.On("Get", _a0, _a1)}
+}
+
+func (_c *RuleRepository_Get_Call) Run(run func(_a0 context.Context, _a1 uint64)) *RuleRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *RuleRepository_Get_Call) Return(_a0 *rule.Rule, _a1 error) *RuleRepository_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetRevision provides a mock function with given fields: _a0, _a1
+func (_m *RuleRepository) GetRevision(_a0 context.Context, _a1 uint64) (*rule.Revision, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *rule.Revision
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *rule.Revision); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rule.Revision)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleRepository_GetRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevision'
+type RuleRepository_GetRevision_Call struct {
+	*mock.Call
+}
+
+// GetRevision is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uint64
+func (_e *RuleRepository_Expecter) GetRevision(_a0 interface{}, _a1 interface{}) *RuleRepository_GetRevision_Call {
+	return &RuleRepository_GetRevision_Call{Call: _e.mock.On("GetRevision", _a0, _a1)}
+}
+
+func (_c *RuleRepository_GetRevision_Call) Run(run func(_a0 context.Context, _a1 uint64)) *RuleRepository_GetRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *RuleRepository_GetRevision_Call) Return(_a0 *rule.Revision, _a1 error) *RuleRepository_GetRevision_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // List provides a mock function with given fields: _a0, _a1
 func (_m *RuleRepository) List(_a0 context.Context, _a1 rule.Filter) ([]rule.Rule, error) {
 	ret := _m.Called(_a0, _a1)
@@ -106,6 +276,53 @@ func (_c *RuleRepository_List_Call) Return(_a0 []rule.Rule, _a1 error) *RuleRepo
 	return _c
 }
 
+// ListRevisions provides a mock function with given fields: _a0, _a1
+func (_m *RuleRepository) ListRevisions(_a0 context.Context, _a1 uint64) ([]rule.Revision, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []rule.Revision
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []rule.Revision); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]rule.Revision)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleRepository_ListRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRevisions'
+type RuleRepository_ListRevisions_Call struct {
+	*mock.Call
+}
+
+// ListRevisions is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uint64
+func (_e *RuleRepository_Expecter) ListRevisions(_a0 interface{}, _a1 interface{}) *RuleRepository_ListRevisions_Call {
+	return &RuleRepository_ListRevisions_Call{Call: _e.mock.On("ListRevisions", _a0, _a1)}
+}
+
+func (_c *RuleRepository_ListRevisions_Call) Run(run func(_a0 context.Context, _a1 uint64)) *RuleRepository_ListRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *RuleRepository_ListRevisions_Call) Return(_a0 []rule.Revision, _a1 error) *RuleRepository_ListRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // Rollback provides a mock function with given fields: ctx, err
 func (_m *RuleRepository) Rollback(ctx context.Context, err error) error {
 	ret := _m.Called(ctx, err)
@@ -26,6 +26,145 @@ func (_m *RuleUploader) EXPECT() *RuleUploader_Expecter {
 	return &RuleUploader_Expecter{mock: &_m.Mock}
 }
 
+// DeleteRule provides a mock function with given fields: ctx, namespaceURN, prov, rl, templateToDelete
+func (_m *RuleUploader) DeleteRule(ctx context.Context, namespaceURN string, prov provider.Provider, rl *rule.Rule, templateToDelete *template.Template) error {
+	ret := _m.Called(ctx, namespaceURN, prov, rl, templateToDelete)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, provider.Provider, *rule.Rule, *template.Template) error); ok {
+		r0 = rf(ctx, namespaceURN, prov, rl, templateToDelete)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RuleUploader_DeleteRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRule'
+type RuleUploader_DeleteRule_Call struct {
+	*mock.Call
+}
+
+// DeleteRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceURN string
+//   - prov provider.Provider
+//   - rl *rule.Rule
+//   - templateToDelete *template.Template
+func (_e *RuleUploader_Expecter) DeleteRule(ctx interface{}, namespaceURN interface{}, prov interface{}, rl interface{}, templateToDelete interface{}) *RuleUploader_DeleteRule_Call {
+	return &RuleUploader_DeleteRule_Call{Call: _e.mock.On("DeleteRule", ctx, namespaceURN, prov, rl, templateToDelete)}
+}
+
+func (_c *RuleUploader_DeleteRule_Call) Run(run func(ctx context.Context, namespaceURN string, prov provider.Provider, rl *rule.Rule, templateToDelete *template.Template)) *RuleUploader_DeleteRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(provider.Provider), args[3].(*rule.Rule), args[4].(*template.Template))
+	})
+	return _c
+}
+
+func (_c *RuleUploader_DeleteRule_Call) Return(_a0 error) *RuleUploader_DeleteRule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// ListRuleGroups provides a mock function with given fields: ctx, namespaceURN, prov
+func (_m *RuleUploader) ListRuleGroups(ctx context.Context, namespaceURN string, prov provider.Provider) ([]rule.RuleGroup, error) {
+	ret := _m.Called(ctx, namespaceURN, prov)
+
+	var r0 []rule.RuleGroup
+	if rf, ok := ret.Get(0).(func(context.Context, string, provider.Provider) []rule.RuleGroup); ok {
+		r0 = rf(ctx, namespaceURN, prov)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]rule.RuleGroup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, provider.Provider) error); ok {
+		r1 = rf(ctx, namespaceURN, prov)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleUploader_ListRuleGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRuleGroups'
+type RuleUploader_ListRuleGroups_Call struct {
+	*mock.Call
+}
+
+// ListRuleGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceURN string
+//   - prov provider.Provider
+func (_e *RuleUploader_Expecter) ListRuleGroups(ctx interface{}, namespaceURN interface{}, prov interface{}) *RuleUploader_ListRuleGroups_Call {
+	return &RuleUploader_ListRuleGroups_Call{Call: _e.mock.On("ListRuleGroups", ctx, namespaceURN, prov)}
+}
+
+func (_c *RuleUploader_ListRuleGroups_Call) Run(run func(ctx context.Context, namespaceURN string, prov provider.Provider)) *RuleUploader_ListRuleGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(provider.Provider))
+	})
+	return _c
+}
+
+func (_c *RuleUploader_ListRuleGroups_Call) Return(_a0 []rule.RuleGroup, _a1 error) *RuleUploader_ListRuleGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// PreviewRule provides a mock function with given fields: ctx, namespaceURN, prov, rl, templateToUpdate
+func (_m *RuleUploader) PreviewRule(ctx context.Context, namespaceURN string, prov provider.Provider, rl *rule.Rule, templateToUpdate *template.Template) (*rule.Preview, error) {
+	ret := _m.Called(ctx, namespaceURN, prov, rl, templateToUpdate)
+
+	var r0 *rule.Preview
+	if rf, ok := ret.Get(0).(func(context.Context, string, provider.Provider, *rule.Rule, *template.Template) *rule.Preview); ok {
+		r0 = rf(ctx, namespaceURN, prov, rl, templateToUpdate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rule.Preview)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, provider.Provider, *rule.Rule, *template.Template) error); ok {
+		r1 = rf(ctx, namespaceURN, prov, rl, templateToUpdate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleUploader_PreviewRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreviewRule'
+type RuleUploader_PreviewRule_Call struct {
+	*mock.Call
+}
+
+// PreviewRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceURN string
+//   - prov provider.Provider
+//   - rl *rule.Rule
+//   - templateToUpdate *template.Template
+func (_e *RuleUploader_Expecter) PreviewRule(ctx interface{}, namespaceURN interface{}, prov interface{}, rl interface{}, templateToUpdate interface{}) *RuleUploader_PreviewRule_Call {
+	return &RuleUploader_PreviewRule_Call{Call: _e.mock.On("PreviewRule", ctx, namespaceURN, prov, rl, templateToUpdate)}
+}
+
+func (_c *RuleUploader_PreviewRule_Call) Run(run func(ctx context.Context, namespaceURN string, prov provider.Provider, rl *rule.Rule, templateToUpdate *template.Template)) *RuleUploader_PreviewRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(provider.Provider), args[3].(*rule.Rule), args[4].(*template.Template))
+	})
+	return _c
+}
+
+func (_c *RuleUploader_PreviewRule_Call) Return(_a0 *rule.Preview, _a1 error) *RuleUploader_PreviewRule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // UpsertRule provides a mock function with given fields: ctx, namespaceURN, prov, rl, templateToUpdate
 func (_m *RuleUploader) UpsertRule(ctx context.Context, namespaceURN string, prov provider.Provider, rl *rule.Rule, templateToUpdate *template.Template) error {
 	ret := _m.Called(ctx, namespaceURN, prov, rl, templateToUpdate)
@@ -0,0 +1,42 @@
+package rule
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// Preview is a rule group in the provider before and after a rule is applied, in yaml
+type Preview struct {
+	Current  string `json:"current"`
+	Proposed string `json:"proposed"`
+	Diff     string `json:"diff"`
+}
+
+func unifiedDiff(namespace, groupName, current, proposed string) (string, error) {
+	if current == proposed {
+		return "", nil
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(current),
+		B:        splitLines(proposed),
+		FromFile: fmt.Sprintf("a/%s/%s", namespace, groupName),
+		ToFile:   fmt.Sprintf("b/%s/%s", namespace, groupName),
+		Context:  3,
+	})
+}
+
+// splitLines splits text into lines keeping the line endings,
+// unlike difflib.SplitLines it does not add an empty line for a trailing newline
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
@@ -0,0 +1,35 @@
+package rule
+
+import "time"
+
+// Revision is an immutable snapshot of a rule taken every time the rule is upserted or deleted,
+// body is the rule rendered with the template at that time and is empty for a deletion
+type Revision struct {
+	ID                uint64         `json:"id"`
+	RuleID            uint64         `json:"rule_id"`
+	Name              string         `json:"name"`
+	Enabled           bool           `json:"enabled"`
+	GroupName         string         `json:"group_name"`
+	Namespace         string         `json:"namespace"`
+	Template          string         `json:"template"`
+	Variables         []RuleVariable `json:"variables"`
+	ProviderNamespace uint64         `json:"provider_namespace"`
+	Body              string         `json:"body"`
+	Deleted           bool           `json:"deleted"`
+	Actor             string         `json:"actor"`
+	CreatedAt         time.Time      `json:"created_at"`
+}
+
+// Rule returns the rule as it was at the revision
+func (r Revision) Rule() *Rule {
+	return &Rule{
+		ID:                r.RuleID,
+		Name:              r.Name,
+		Enabled:           r.Enabled,
+		GroupName:         r.GroupName,
+		Namespace:         r.Namespace,
+		Template:          r.Template,
+		Variables:         r.Variables,
+		ProviderNamespace: r.ProviderNamespace,
+	}
+}
@@ -10,6 +10,11 @@ type Repository interface {
 	Transactor
 	Upsert(context.Context, *Rule) error
 	List(context.Context, Filter) ([]Rule, error)
+	Get(context.Context, uint64) (*Rule, error)
+	Delete(context.Context, uint64) error
+	CreateRevision(context.Context, *Revision) error
+	ListRevisions(context.Context, uint64) ([]Revision, error)
+	GetRevision(context.Context, uint64) (*Revision, error)
 }
 
 type Transactor interface {
@@ -37,3 +42,22 @@ type Rule struct {
 	CreatedAt         time.Time      `json:"created_at"`
 	UpdatedAt         time.Time      `json:"updated_at"`
 }
+
+// SyncResult is the outcome of re-rendering and uploading a rule to its provider
+type SyncResult struct {
+	RuleID    uint64
+	Name      string
+	Namespace string
+	Error     error
+}
+
+// FailedRuleIDs returns ids of rules failed to sync, they could be passed back to sync to retry
+func FailedRuleIDs(results []SyncResult) []uint64 {
+	ids := []uint64{}
+	for _, r := range results {
+		if r.Error != nil {
+			ids = append(ids, r.RuleID)
+		}
+	}
+	return ids
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/odpf/siren/core/namespace"
 	"github.com/odpf/siren/core/template"
+	"github.com/odpf/siren/pkg/actor"
 	"github.com/odpf/siren/pkg/errors"
 )
 
@@ -65,23 +66,16 @@ func NewService(
 }
 
 func (s *Service) Upsert(ctx context.Context, rl *Rule) error {
-	ns, err := s.namespaceService.Get(ctx, rl.ProviderNamespace)
+	ns, templateToUpdate, err := s.prepare(ctx, rl)
 	if err != nil {
 		return err
 	}
 
-	templateToUpdate, err := s.templateService.GetByName(ctx, rl.Template)
+	body, err := renderRule(rl, templateToUpdate)
 	if err != nil {
 		return err
 	}
 
-	templateVariables := templateToUpdate.Variables
-	finalRuleVariables := mergeRuleVariablesWithDefaults(templateVariables, rl.Variables)
-	rl.Variables = finalRuleVariables
-
-	rl.Name = fmt.Sprintf("%s_%s_%s_%s_%s_%s", namePrefix, ns.Provider.URN,
-		ns.URN, rl.Namespace, rl.GroupName, rl.Template)
-
 	ctx = s.repository.WithTransaction(ctx)
 	if err = s.repository.Upsert(ctx, rl); err != nil {
 		if err := s.repository.Rollback(ctx, err); err != nil {
@@ -90,6 +84,24 @@ func (s *Service) Upsert(ctx context.Context, rl *Rule) error {
 		return err
 	}
 
+	if err := s.repository.CreateRevision(ctx, &Revision{
+		RuleID:            rl.ID,
+		Name:              rl.Name,
+		Enabled:           rl.Enabled,
+		GroupName:         rl.GroupName,
+		Namespace:         rl.Namespace,
+		Template:          rl.Template,
+		Variables:         rl.Variables,
+		ProviderNamespace: rl.ProviderNamespace,
+		Body:              body,
+		Actor:             actor.FromContext(ctx),
+	}); err != nil {
+		if err := s.repository.Rollback(ctx, err); err != nil {
+			return err
+		}
+		return err
+	}
+
 	pluginService, err := s.getProviderPluginService(ns.Provider.Type)
 	if err != nil {
 		if err := s.repository.Rollback(ctx, err); err != nil {
@@ -112,6 +124,214 @@ func (s *Service) Upsert(ctx context.Context, rl *Rule) error {
 	return nil
 }
 
+// ListRevisions returns revisions of a rule, the latest comes first
+func (s *Service) ListRevisions(ctx context.Context, ruleID uint64) ([]Revision, error) {
+	return s.repository.ListRevisions(ctx, ruleID)
+}
+
+func (s *Service) GetRevision(ctx context.Context, id uint64) (*Revision, error) {
+	rev, err := s.repository.GetRevision(ctx, id)
+	if err != nil {
+		if errors.As(err, new(RevisionNotFoundError)) {
+			return nil, errors.ErrNotFound.WithMsgf(err.Error())
+		}
+		return nil, err
+	}
+	return rev, nil
+}
+
+// Rollback upserts the rule as it was at the revision and uploads it to the provider.
+// The rule is rendered with the current version of its template, so the rollback is
+// rejected if the rendered body differs from the body stored in the revision, i.e. the
+// template has changed since then. A revision recording a deletion cannot be rolled back to.
+// The rollback itself is recorded as a new revision.
+func (s *Service) Rollback(ctx context.Context, revisionID uint64) (*Rule, error) {
+	rev, err := s.GetRevision(ctx, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if rev.Deleted {
+		return nil, errors.ErrInvalid.WithMsgf("rule revision %d records the deletion of the rule, roll back to a revision before it", rev.ID)
+	}
+
+	rl := rev.Rule()
+
+	tmpl, err := s.templateService.GetByName(ctx, rl.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := renderRule(&Rule{Variables: mergeRuleVariablesWithDefaults(tmpl.Variables, rl.Variables)}, tmpl)
+	if err != nil {
+		return nil, err
+	}
+
+	if body != rev.Body {
+		return nil, errors.ErrInvalid.WithMsgf("template %q has changed since rule revision %d, rolling back would upload a body that never existed at the revision", rl.Template, rev.ID)
+	}
+
+	if err := s.Upsert(ctx, rl); err != nil {
+		return nil, err
+	}
+
+	return rl, nil
+}
+
+// SyncByTemplate re-renders rules of a template and uploads them to their providers,
+// it is used to bring rules up to date after their template is changed. If ruleIDs is
+// not empty, only those rules are synced so that rules failed previously could be retried.
+// A rule failed to sync does not stop the others, the outcome of each rule is returned.
+func (s *Service) SyncByTemplate(ctx context.Context, templateName string, ruleIDs []uint64) ([]SyncResult, error) {
+	rules, err := s.repository.List(ctx, Filter{TemplateName: templateName})
+	if err != nil {
+		return nil, err
+	}
+
+	selectedIDs := make(map[uint64]bool, len(ruleIDs))
+	for _, id := range ruleIDs {
+		selectedIDs[id] = true
+	}
+
+	results := make([]SyncResult, 0, len(rules))
+	for _, rl := range rules {
+		rl := rl
+		if len(selectedIDs) > 0 && !selectedIDs[rl.ID] {
+			continue
+		}
+		err := s.Upsert(ctx, &rl)
+		results = append(results, SyncResult{
+			RuleID:    rl.ID,
+			Name:      rl.Name,
+			Namespace: rl.Namespace,
+			Error:     err,
+		})
+	}
+
+	return results, nil
+}
+
+// Delete removes the rule from siren and from the provider, the rule is rendered
+// with its template to find the rule nodes to be removed from the provider.
+// The deletion is recorded as a revision of the actor.
+func (s *Service) Delete(ctx context.Context, id uint64) error {
+	rl, err := s.repository.Get(ctx, id)
+	if err != nil {
+		if errors.As(err, new(NotFoundError)) {
+			return errors.ErrNotFound.WithMsgf(err.Error())
+		}
+		return err
+	}
+
+	ns, err := s.namespaceService.Get(ctx, rl.ProviderNamespace)
+	if err != nil {
+		return err
+	}
+
+	templateToDelete, err := s.templateService.GetByName(ctx, rl.Template)
+	if err != nil {
+		return err
+	}
+
+	pluginService, err := s.getProviderPluginService(ns.Provider.Type)
+	if err != nil {
+		return err
+	}
+
+	ctx = s.repository.WithTransaction(ctx)
+	if err := s.repository.Delete(ctx, rl.ID); err != nil {
+		if err := s.repository.Rollback(ctx, err); err != nil {
+			return err
+		}
+		return err
+	}
+
+	if err := s.repository.CreateRevision(ctx, &Revision{
+		RuleID:            rl.ID,
+		Name:              rl.Name,
+		Enabled:           rl.Enabled,
+		GroupName:         rl.GroupName,
+		Namespace:         rl.Namespace,
+		Template:          rl.Template,
+		Variables:         rl.Variables,
+		ProviderNamespace: rl.ProviderNamespace,
+		Deleted:           true,
+		Actor:             actor.FromContext(ctx),
+	}); err != nil {
+		if err := s.repository.Rollback(ctx, err); err != nil {
+			return err
+		}
+		return err
+	}
+
+	if err := pluginService.DeleteRule(ctx, ns.URN, ns.Provider, rl, templateToDelete); err != nil {
+		if err := s.repository.Rollback(ctx, err); err != nil {
+			return err
+		}
+		return err
+	}
+
+	if err := s.repository.Commit(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Preview renders the rule and returns the rule group in the provider before and after
+// the rule is applied without storing the rule nor uploading it to the provider
+func (s *Service) Preview(ctx context.Context, rl *Rule) (*Preview, error) {
+	ns, templateToUpdate, err := s.prepare(ctx, rl)
+	if err != nil {
+		return nil, err
+	}
+
+	pluginService, err := s.getProviderPluginService(ns.Provider.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	preview, err := pluginService.PreviewRule(ctx, ns.URN, ns.Provider, rl, templateToUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	preview.Diff, err = unifiedDiff(rl.Namespace, rl.GroupName, preview.Current, preview.Proposed)
+	if err != nil {
+		return nil, err
+	}
+
+	return preview, nil
+}
+
+// prepare fills rule variables with template defaults and generates rule name
+func (s *Service) prepare(ctx context.Context, rl *Rule) (*namespace.Namespace, *template.Template, error) {
+	ns, err := s.namespaceService.Get(ctx, rl.ProviderNamespace)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	templateToUpdate, err := s.templateService.GetByName(ctx, rl.Template)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	templateVariables := templateToUpdate.Variables
+	finalRuleVariables := mergeRuleVariablesWithDefaults(templateVariables, rl.Variables)
+	rl.Variables = finalRuleVariables
+
+	rl.Name = fmt.Sprintf("%s_%s_%s_%s_%s_%s", namePrefix, ns.Provider.URN,
+		ns.URN, rl.Namespace, rl.GroupName, rl.Template)
+
+	return ns, templateToUpdate, nil
+}
+
+// IsProviderSupported returns true if rules could be uploaded to the provider type
+func (s *Service) IsProviderSupported(providerType string) bool {
+	_, exist := s.ruleUploadersRegistry[providerType]
+	return exist
+}
+
 func (s *Service) getProviderPluginService(providerType string) (RuleUploader, error) {
 	pluginService, exist := s.ruleUploadersRegistry[providerType]
 	if !exist {
@@ -124,6 +344,14 @@ func (s *Service) List(ctx context.Context, flt Filter) ([]Rule, error) {
 	return s.repository.List(ctx, flt)
 }
 
+func renderRule(rl *Rule, tmpl *template.Template) (string, error) {
+	inputValues := make(map[string]string)
+	for _, v := range rl.Variables {
+		inputValues[v.Name] = v.Value
+	}
+	return template.RenderWithEnrichedDefault(tmpl.Body, tmpl.Variables, inputValues)
+}
+
 func mergeRuleVariablesWithDefaults(templateVariables []template.Variable, ruleVariables []RuleVariable) []RuleVariable {
 	var finalRuleVariables []RuleVariable
 	for j := 0; j < len(templateVariables); j++ {
@@ -9,6 +9,7 @@ import (
 	"github.com/odpf/siren/core/rule"
 	"github.com/odpf/siren/core/rule/mocks"
 	"github.com/odpf/siren/core/template"
+	"github.com/odpf/siren/pkg/actor"
 	"github.com/odpf/siren/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	mock "github.com/stretchr/testify/mock"
@@ -115,6 +116,27 @@ func TestService_Upsert(t *testing.T) {
 				},
 				ErrString: "some error",
 			},
+			{
+				Description: "should rollback and return error if create revision return error",
+				Rule: &rule.Rule{
+					Name:      "foo",
+					Namespace: "namespace",
+				},
+				Setup: func(rr *mocks.RuleRepository, ts *mocks.TemplateService, ns *mocks.NamespaceService, ru *mocks.RuleUploader) {
+					ts.EXPECT().GetByName(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("string")).Return(&template.Template{}, nil)
+					ns.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("uint64")).Return(&namespace.Namespace{
+						Provider: provider.Provider{
+							Type: provider.TypeCortex,
+						},
+					}, nil)
+
+					rr.EXPECT().WithTransaction(ctx).Return(ctx)
+					rr.EXPECT().Upsert(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*rule.Rule")).Return(nil)
+					rr.EXPECT().CreateRevision(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*rule.Revision")).Return(errors.New("some error"))
+					rr.EXPECT().Rollback(ctx, mock.Anything).Return(nil)
+				},
+				ErrString: "some error",
+			},
 			{
 				Description: "should commit if upsert repository and post rule return nil error",
 				Rule: &rule.Rule{
@@ -138,6 +160,7 @@ func TestService_Upsert(t *testing.T) {
 
 					rr.EXPECT().WithTransaction(ctx).Return(ctx)
 					rr.EXPECT().Upsert(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*rule.Rule")).Return(nil)
+					rr.EXPECT().CreateRevision(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*rule.Revision")).Return(nil)
 					ru.EXPECT().UpsertRule(mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("provider.Provider"), mock.AnythingOfType("*rule.Rule"), mock.AnythingOfType("*template.Template")).Return(nil)
 					rr.EXPECT().Commit(ctx).Return(nil)
 				},
@@ -175,6 +198,7 @@ func TestService_Upsert(t *testing.T) {
 
 					rr.EXPECT().WithTransaction(ctx).Return(ctx)
 					rr.EXPECT().Upsert(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*rule.Rule")).Return(nil)
+					rr.EXPECT().CreateRevision(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*rule.Revision")).Return(nil)
 					ru.EXPECT().UpsertRule(mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("provider.Provider"), mock.AnythingOfType("*rule.Rule"), mock.AnythingOfType("*template.Template")).Return(errors.New("some error"))
 					rr.EXPECT().Rollback(ctx, mock.Anything).Return(nil)
 				},
@@ -213,6 +237,7 @@ func TestService_Upsert(t *testing.T) {
 
 					rr.EXPECT().WithTransaction(ctx).Return(ctx)
 					rr.EXPECT().Upsert(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*rule.Rule")).Return(nil)
+					rr.EXPECT().CreateRevision(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*rule.Revision")).Return(nil)
 					ru.EXPECT().UpsertRule(mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("provider.Provider"), mock.AnythingOfType("*rule.Rule"), mock.AnythingOfType("*template.Template")).Return(errors.New("some error"))
 					rr.EXPECT().Rollback(ctx, mock.Anything).Return(errors.New("rollback error"))
 				},
@@ -251,6 +276,7 @@ func TestService_Upsert(t *testing.T) {
 
 					rr.EXPECT().WithTransaction(ctx).Return(ctx)
 					rr.EXPECT().Upsert(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*rule.Rule")).Return(nil)
+					rr.EXPECT().CreateRevision(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*rule.Revision")).Return(nil)
 					ru.EXPECT().UpsertRule(mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("provider.Provider"), mock.AnythingOfType("*rule.Rule"), mock.AnythingOfType("*template.Template")).Return(nil)
 					rr.EXPECT().Commit(ctx).Return(errors.New("some commit error"))
 				},
@@ -345,3 +371,549 @@ func TestService_List(t *testing.T) {
 		repositoryMock.AssertExpectations(t)
 	})
 }
+
+func TestService_Delete(t *testing.T) {
+	type testCase struct {
+		Description string
+		Setup       func(*mocks.RuleRepository, *mocks.TemplateService, *mocks.NamespaceService, *mocks.RuleUploader)
+		ErrString   string
+	}
+	var (
+		ctx       = context.TODO()
+		ruleID    = uint64(10)
+		dummyRule = &rule.Rule{
+			ID:                ruleID,
+			Name:              "foo",
+			Namespace:         "namespace",
+			GroupName:         "group",
+			Template:          "template",
+			ProviderNamespace: 1,
+		}
+		testCases = []testCase{
+			{
+				Description: "should return error not found if rule does not exist",
+				Setup: func(rr *mocks.RuleRepository, ts *mocks.TemplateService, ns *mocks.NamespaceService, ru *mocks.RuleUploader) {
+					rr.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), ruleID).Return(nil, rule.NotFoundError{ID: ruleID})
+				},
+				ErrString: "rule with id 10 not found",
+			},
+			{
+				Description: "should return error if get rule return error",
+				Setup: func(rr *mocks.RuleRepository, ts *mocks.TemplateService, ns *mocks.NamespaceService, ru *mocks.RuleUploader) {
+					rr.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), ruleID).Return(nil, errors.New("some error"))
+				},
+				ErrString: "some error",
+			},
+			{
+				Description: "should return error if namespace service return error",
+				Setup: func(rr *mocks.RuleRepository, ts *mocks.TemplateService, ns *mocks.NamespaceService, ru *mocks.RuleUploader) {
+					rr.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), ruleID).Return(dummyRule, nil)
+					ns.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), dummyRule.ProviderNamespace).Return(nil, errors.New("some error"))
+				},
+				ErrString: "some error",
+			},
+			{
+				Description: "should return error if template service return error",
+				Setup: func(rr *mocks.RuleRepository, ts *mocks.TemplateService, ns *mocks.NamespaceService, ru *mocks.RuleUploader) {
+					rr.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), ruleID).Return(dummyRule, nil)
+					ns.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), dummyRule.ProviderNamespace).Return(&namespace.Namespace{}, nil)
+					ts.EXPECT().GetByName(mock.AnythingOfType("*context.emptyCtx"), dummyRule.Template).Return(nil, errors.New("some error"))
+				},
+				ErrString: "some error",
+			},
+			{
+				Description: "should return error if provider type is not supported",
+				Setup: func(rr *mocks.RuleRepository, ts *mocks.TemplateService, ns *mocks.NamespaceService, ru *mocks.RuleUploader) {
+					rr.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), ruleID).Return(dummyRule, nil)
+					ns.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), dummyRule.ProviderNamespace).Return(&namespace.Namespace{
+						Provider: provider.Provider{
+							Type: "random",
+						},
+					}, nil)
+					ts.EXPECT().GetByName(mock.AnythingOfType("*context.emptyCtx"), dummyRule.Template).Return(&template.Template{}, nil)
+				},
+				ErrString: "unsupported provider type: \"random\"",
+			},
+			{
+				Description: "should rollback and return error if delete repository return error",
+				Setup: func(rr *mocks.RuleRepository, ts *mocks.TemplateService, ns *mocks.NamespaceService, ru *mocks.RuleUploader) {
+					rr.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), ruleID).Return(dummyRule, nil)
+					ns.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), dummyRule.ProviderNamespace).Return(&namespace.Namespace{
+						Provider: provider.Provider{
+							Type: provider.TypeCortex,
+						},
+					}, nil)
+					ts.EXPECT().GetByName(mock.AnythingOfType("*context.emptyCtx"), dummyRule.Template).Return(&template.Template{}, nil)
+
+					rr.EXPECT().WithTransaction(ctx).Return(ctx)
+					rr.EXPECT().Delete(mock.AnythingOfType("*context.emptyCtx"), ruleID).Return(errors.New("some error"))
+					rr.EXPECT().Rollback(ctx, mock.Anything).Return(nil)
+				},
+				ErrString: "some error",
+			},
+			{
+				Description: "should rollback and return error if create deletion revision return error",
+				Setup: func(rr *mocks.RuleRepository, ts *mocks.TemplateService, ns *mocks.NamespaceService, ru *mocks.RuleUploader) {
+					rr.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), ruleID).Return(dummyRule, nil)
+					ns.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), dummyRule.ProviderNamespace).Return(&namespace.Namespace{
+						Provider: provider.Provider{
+							Type: provider.TypeCortex,
+						},
+					}, nil)
+					ts.EXPECT().GetByName(mock.AnythingOfType("*context.emptyCtx"), dummyRule.Template).Return(&template.Template{}, nil)
+
+					rr.EXPECT().WithTransaction(ctx).Return(ctx)
+					rr.EXPECT().Delete(mock.AnythingOfType("*context.emptyCtx"), ruleID).Return(nil)
+					rr.EXPECT().CreateRevision(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*rule.Revision")).Return(errors.New("some error"))
+					rr.EXPECT().Rollback(ctx, mock.Anything).Return(nil)
+				},
+				ErrString: "some error",
+			},
+			{
+				Description: "should rollback and return error if delete rule in provider return error",
+				Setup: func(rr *mocks.RuleRepository, ts *mocks.TemplateService, ns *mocks.NamespaceService, ru *mocks.RuleUploader) {
+					rr.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), ruleID).Return(dummyRule, nil)
+					ns.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), dummyRule.ProviderNamespace).Return(&namespace.Namespace{
+						Provider: provider.Provider{
+							Type: provider.TypeCortex,
+						},
+					}, nil)
+					ts.EXPECT().GetByName(mock.AnythingOfType("*context.emptyCtx"), dummyRule.Template).Return(&template.Template{}, nil)
+
+					rr.EXPECT().WithTransaction(ctx).Return(ctx)
+					rr.EXPECT().Delete(mock.AnythingOfType("*context.emptyCtx"), ruleID).Return(nil)
+					rr.EXPECT().CreateRevision(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*rule.Revision")).Return(nil)
+					ru.EXPECT().DeleteRule(mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("provider.Provider"), dummyRule, mock.AnythingOfType("*template.Template")).Return(errors.New("some error"))
+					rr.EXPECT().Rollback(ctx, mock.Anything).Return(errors.New("rollback error"))
+				},
+				ErrString: "rollback error",
+			},
+			{
+				Description: "should return error if transaction commit return error",
+				Setup: func(rr *mocks.RuleRepository, ts *mocks.TemplateService, ns *mocks.NamespaceService, ru *mocks.RuleUploader) {
+					rr.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), ruleID).Return(dummyRule, nil)
+					ns.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), dummyRule.ProviderNamespace).Return(&namespace.Namespace{
+						Provider: provider.Provider{
+							Type: provider.TypeCortex,
+						},
+					}, nil)
+					ts.EXPECT().GetByName(mock.AnythingOfType("*context.emptyCtx"), dummyRule.Template).Return(&template.Template{}, nil)
+
+					rr.EXPECT().WithTransaction(ctx).Return(ctx)
+					rr.EXPECT().Delete(mock.AnythingOfType("*context.emptyCtx"), ruleID).Return(nil)
+					rr.EXPECT().CreateRevision(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*rule.Revision")).Return(nil)
+					ru.EXPECT().DeleteRule(mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("provider.Provider"), dummyRule, mock.AnythingOfType("*template.Template")).Return(nil)
+					rr.EXPECT().Commit(ctx).Return(errors.New("some commit error"))
+				},
+				ErrString: "some commit error",
+			},
+			{
+				Description: "should commit if delete repository and delete rule in provider return nil error",
+				Setup: func(rr *mocks.RuleRepository, ts *mocks.TemplateService, ns *mocks.NamespaceService, ru *mocks.RuleUploader) {
+					rr.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), ruleID).Return(dummyRule, nil)
+					ns.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), dummyRule.ProviderNamespace).Return(&namespace.Namespace{
+						URN: "tenant",
+						Provider: provider.Provider{
+							Type: provider.TypeCortex,
+						},
+					}, nil)
+					ts.EXPECT().GetByName(mock.AnythingOfType("*context.emptyCtx"), dummyRule.Template).Return(&template.Template{}, nil)
+
+					rr.EXPECT().WithTransaction(ctx).Return(ctx)
+					rr.EXPECT().Delete(mock.AnythingOfType("*context.emptyCtx"), ruleID).Return(nil)
+					rr.EXPECT().CreateRevision(mock.AnythingOfType("*context.emptyCtx"), &rule.Revision{
+						RuleID:            ruleID,
+						Name:              "foo",
+						GroupName:         "group",
+						Namespace:         "namespace",
+						Template:          "template",
+						ProviderNamespace: 1,
+						Deleted:           true,
+					}).Return(nil)
+					ru.EXPECT().DeleteRule(mock.Anything, "tenant", mock.AnythingOfType("provider.Provider"), dummyRule, mock.AnythingOfType("*template.Template")).Return(nil)
+					rr.EXPECT().Commit(ctx).Return(nil)
+				},
+			},
+		}
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			var (
+				repositoryMock       = new(mocks.RuleRepository)
+				templateServiceMock  = new(mocks.TemplateService)
+				namespaceServiceMock = new(mocks.NamespaceService)
+				ruleUploaderMock     = new(mocks.RuleUploader)
+			)
+			svc := rule.NewService(
+				repositoryMock,
+				templateServiceMock,
+				namespaceServiceMock,
+				map[string]rule.RuleUploader{
+					provider.TypeCortex: ruleUploaderMock,
+				},
+			)
+
+			tc.Setup(repositoryMock, templateServiceMock, namespaceServiceMock, ruleUploaderMock)
+
+			err := svc.Delete(ctx, ruleID)
+			if tc.ErrString != "" {
+				if err == nil || tc.ErrString != err.Error() {
+					t.Fatalf("got error %v, expected was %s", err, tc.ErrString)
+				}
+			} else if err != nil {
+				t.Fatalf("got error %s, expected was nil", err.Error())
+			}
+
+			repositoryMock.AssertExpectations(t)
+			templateServiceMock.AssertExpectations(t)
+			namespaceServiceMock.AssertExpectations(t)
+			ruleUploaderMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Preview(t *testing.T) {
+	type testCase struct {
+		Description     string
+		Setup           func(*mocks.RuleRepository, *mocks.TemplateService, *mocks.NamespaceService, *mocks.RuleUploader)
+		ExpectedPreview *rule.Preview
+		ErrString       string
+	}
+	var (
+		ctx       = context.TODO()
+		dummyRule = &rule.Rule{
+			Enabled:           true,
+			Namespace:         "namespace",
+			GroupName:         "group",
+			Template:          "template",
+			ProviderNamespace: 1,
+		}
+		dummyNamespace = &namespace.Namespace{
+			URN: "tenant",
+			Provider: provider.Provider{
+				URN:  "provider",
+				Type: provider.TypeCortex,
+			},
+		}
+		testCases = []testCase{
+			{
+				Description: "should return error if namespace service return error",
+				Setup: func(rr *mocks.RuleRepository, ts *mocks.TemplateService, ns *mocks.NamespaceService, ru *mocks.RuleUploader) {
+					ns.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), dummyRule.ProviderNamespace).Return(nil, errors.New("some error"))
+				},
+				ErrString: "some error",
+			},
+			{
+				Description: "should return error if template service return error",
+				Setup: func(rr *mocks.RuleRepository, ts *mocks.TemplateService, ns *mocks.NamespaceService, ru *mocks.RuleUploader) {
+					ns.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), dummyRule.ProviderNamespace).Return(dummyNamespace, nil)
+					ts.EXPECT().GetByName(mock.AnythingOfType("*context.emptyCtx"), dummyRule.Template).Return(nil, errors.New("some error"))
+				},
+				ErrString: "some error",
+			},
+			{
+				Description: "should return error if provider type is not supported",
+				Setup: func(rr *mocks.RuleRepository, ts *mocks.TemplateService, ns *mocks.NamespaceService, ru *mocks.RuleUploader) {
+					ns.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), dummyRule.ProviderNamespace).Return(&namespace.Namespace{
+						Provider: provider.Provider{
+							Type: "random",
+						},
+					}, nil)
+					ts.EXPECT().GetByName(mock.AnythingOfType("*context.emptyCtx"), dummyRule.Template).Return(&template.Template{}, nil)
+				},
+				ErrString: "unsupported provider type: \"random\"",
+			},
+			{
+				Description: "should return error if preview rule in provider return error",
+				Setup: func(rr *mocks.RuleRepository, ts *mocks.TemplateService, ns *mocks.NamespaceService, ru *mocks.RuleUploader) {
+					ns.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), dummyRule.ProviderNamespace).Return(dummyNamespace, nil)
+					ts.EXPECT().GetByName(mock.AnythingOfType("*context.emptyCtx"), dummyRule.Template).Return(&template.Template{}, nil)
+					ru.EXPECT().PreviewRule(mock.AnythingOfType("*context.emptyCtx"), "tenant", dummyNamespace.Provider, dummyRule, mock.AnythingOfType("*template.Template")).Return(nil, errors.New("some error"))
+				},
+				ErrString: "some error",
+			},
+			{
+				Description: "should return empty diff if rule group is not changed",
+				Setup: func(rr *mocks.RuleRepository, ts *mocks.TemplateService, ns *mocks.NamespaceService, ru *mocks.RuleUploader) {
+					ns.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), dummyRule.ProviderNamespace).Return(dummyNamespace, nil)
+					ts.EXPECT().GetByName(mock.AnythingOfType("*context.emptyCtx"), dummyRule.Template).Return(&template.Template{}, nil)
+					ru.EXPECT().PreviewRule(mock.AnythingOfType("*context.emptyCtx"), "tenant", dummyNamespace.Provider, dummyRule, mock.AnythingOfType("*template.Template")).Return(&rule.Preview{
+						Current:  "name: group\n",
+						Proposed: "name: group\n",
+					}, nil)
+				},
+				ExpectedPreview: &rule.Preview{
+					Current:  "name: group\n",
+					Proposed: "name: group\n",
+				},
+			},
+			{
+				Description: "should return unified diff of rule group without touching the repository",
+				Setup: func(rr *mocks.RuleRepository, ts *mocks.TemplateService, ns *mocks.NamespaceService, ru *mocks.RuleUploader) {
+					ns.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), dummyRule.ProviderNamespace).Return(dummyNamespace, nil)
+					ts.EXPECT().GetByName(mock.AnythingOfType("*context.emptyCtx"), dummyRule.Template).Return(&template.Template{}, nil)
+					ru.EXPECT().PreviewRule(mock.AnythingOfType("*context.emptyCtx"), "tenant", dummyNamespace.Provider, dummyRule, mock.AnythingOfType("*template.Template")).Return(&rule.Preview{
+						Current:  "name: group\nrules:\n    - alert: foo\n      expr: up == 0\n",
+						Proposed: "name: group\nrules:\n    - alert: foo\n      expr: up == 1\n",
+					}, nil)
+				},
+				ExpectedPreview: &rule.Preview{
+					Current:  "name: group\nrules:\n    - alert: foo\n      expr: up == 0\n",
+					Proposed: "name: group\nrules:\n    - alert: foo\n      expr: up == 1\n",
+					Diff: "--- a/namespace/group\n" +
+						"+++ b/namespace/group\n" +
+						"@@ -1,4 +1,4 @@\n" +
+						" name: group\n" +
+						" rules:\n" +
+						"     - alert: foo\n" +
+						"-      expr: up == 0\n" +
+						"+      expr: up == 1\n",
+				},
+			},
+		}
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			var (
+				repositoryMock       = new(mocks.RuleRepository)
+				templateServiceMock  = new(mocks.TemplateService)
+				namespaceServiceMock = new(mocks.NamespaceService)
+				ruleUploaderMock     = new(mocks.RuleUploader)
+			)
+			svc := rule.NewService(
+				repositoryMock,
+				templateServiceMock,
+				namespaceServiceMock,
+				map[string]rule.RuleUploader{
+					provider.TypeCortex: ruleUploaderMock,
+				},
+			)
+
+			tc.Setup(repositoryMock, templateServiceMock, namespaceServiceMock, ruleUploaderMock)
+
+			got, err := svc.Preview(ctx, dummyRule)
+			if tc.ErrString != "" {
+				if err == nil || tc.ErrString != err.Error() {
+					t.Fatalf("got error %v, expected was %s", err, tc.ErrString)
+				}
+			} else if err != nil {
+				t.Fatalf("got error %s, expected was nil", err.Error())
+			}
+			assert.Equal(t, tc.ExpectedPreview, got)
+
+			repositoryMock.AssertExpectations(t)
+			templateServiceMock.AssertExpectations(t)
+			namespaceServiceMock.AssertExpectations(t)
+			ruleUploaderMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Rollback(t *testing.T) {
+	var (
+		revision = &rule.Revision{
+			ID:                3,
+			RuleID:            10,
+			Name:              "siren_api_provider_tenant_namespace_group_template",
+			Enabled:           true,
+			GroupName:         "group",
+			Namespace:         "namespace",
+			Template:          "template",
+			Variables:         []rule.RuleVariable{{Name: "warning", Value: "80"}},
+			ProviderNamespace: 1,
+			Body:              "- alert: foo\n  expr: up > 80\n",
+		}
+		tmpl = &template.Template{
+			Name:      "template",
+			Body:      "- alert: foo\n  expr: up > [[.warning]]\n",
+			Variables: []template.Variable{{Name: "warning", Type: "int", Default: "85"}},
+		}
+		ns = &namespace.Namespace{
+			URN: "tenant",
+			Provider: provider.Provider{
+				URN:  "provider",
+				Type: provider.TypeCortex,
+			},
+		}
+	)
+
+	t.Run("should return error not found if revision does not exist", func(t *testing.T) {
+		repositoryMock := new(mocks.RuleRepository)
+		repositoryMock.EXPECT().GetRevision(mock.AnythingOfType("*context.emptyCtx"), uint64(3)).Return(nil, rule.RevisionNotFoundError{ID: 3})
+
+		svc := rule.NewService(repositoryMock, nil, nil, nil)
+		_, err := svc.Rollback(context.TODO(), 3)
+
+		assert.EqualError(t, err, "rule revision with id 3 not found")
+		repositoryMock.AssertExpectations(t)
+	})
+
+	t.Run("should return error invalid if revision records a deletion", func(t *testing.T) {
+		repositoryMock := new(mocks.RuleRepository)
+		deletion := *revision
+		deletion.Body = ""
+		deletion.Deleted = true
+		repositoryMock.EXPECT().GetRevision(mock.AnythingOfType("*context.emptyCtx"), uint64(3)).Return(&deletion, nil)
+
+		svc := rule.NewService(repositoryMock, nil, nil, nil)
+		_, err := svc.Rollback(context.TODO(), 3)
+
+		assert.True(t, errors.Is(err, errors.ErrInvalid))
+		assert.EqualError(t, err, "rule revision 3 records the deletion of the rule, roll back to a revision before it")
+		repositoryMock.AssertExpectations(t)
+	})
+
+	t.Run("should return error invalid if template has changed since the revision", func(t *testing.T) {
+		var (
+			ctx                 = context.TODO()
+			repositoryMock      = new(mocks.RuleRepository)
+			templateServiceMock = new(mocks.TemplateService)
+		)
+		repositoryMock.EXPECT().GetRevision(ctx, uint64(3)).Return(revision, nil)
+		templateServiceMock.EXPECT().GetByName(ctx, "template").Return(&template.Template{
+			Name:      "template",
+			Body:      "- alert: foo\n  expr: up >= [[.warning]]\n",
+			Variables: tmpl.Variables,
+		}, nil)
+
+		svc := rule.NewService(repositoryMock, templateServiceMock, nil, nil)
+		_, err := svc.Rollback(ctx, 3)
+
+		assert.True(t, errors.Is(err, errors.ErrInvalid))
+		assert.EqualError(t, err, "template \"template\" has changed since rule revision 3, rolling back would upload a body that never existed at the revision")
+		repositoryMock.AssertExpectations(t)
+		templateServiceMock.AssertExpectations(t)
+	})
+
+	t.Run("should upsert rule of the revision and record the rollback as a new revision of the actor", func(t *testing.T) {
+		var (
+			ctx                  = actor.WithContext(context.TODO(), "user@odpf.io")
+			repositoryMock       = new(mocks.RuleRepository)
+			templateServiceMock  = new(mocks.TemplateService)
+			namespaceServiceMock = new(mocks.NamespaceService)
+			ruleUploaderMock     = new(mocks.RuleUploader)
+		)
+		repositoryMock.EXPECT().GetRevision(ctx, uint64(3)).Return(revision, nil)
+		namespaceServiceMock.EXPECT().Get(ctx, uint64(1)).Return(ns, nil)
+		templateServiceMock.EXPECT().GetByName(ctx, "template").Return(tmpl, nil)
+		repositoryMock.EXPECT().WithTransaction(ctx).Return(ctx)
+		repositoryMock.EXPECT().Upsert(ctx, mock.MatchedBy(func(rl *rule.Rule) bool {
+			return rl.GroupName == "group" && rl.Variables[0].Value == "80"
+		})).Return(nil)
+		repositoryMock.EXPECT().CreateRevision(ctx, mock.MatchedBy(func(rev *rule.Revision) bool {
+			return rev.RuleID == 10 && rev.Actor == "user@odpf.io" && rev.Body == "- alert: foo\n  expr: up > 80\n"
+		})).Return(nil)
+		ruleUploaderMock.EXPECT().UpsertRule(ctx, "tenant", ns.Provider, mock.AnythingOfType("*rule.Rule"), tmpl).Return(nil)
+		repositoryMock.EXPECT().Commit(ctx).Return(nil)
+
+		svc := rule.NewService(repositoryMock, templateServiceMock, namespaceServiceMock, map[string]rule.RuleUploader{
+			provider.TypeCortex: ruleUploaderMock,
+		})
+		rl, err := svc.Rollback(ctx, 3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "siren_api_provider_tenant_namespace_group_template", rl.Name)
+		repositoryMock.AssertExpectations(t)
+		templateServiceMock.AssertExpectations(t)
+		namespaceServiceMock.AssertExpectations(t)
+		ruleUploaderMock.AssertExpectations(t)
+	})
+}
+
+func TestService_SyncByTemplate(t *testing.T) {
+	var (
+		ctx = context.TODO()
+		ns  = &namespace.Namespace{
+			URN: "tenant",
+			Provider: provider.Provider{
+				Type: provider.TypeCortex,
+			},
+		}
+	)
+
+	t.Run("should return error if list rules return error", func(t *testing.T) {
+		repositoryMock := new(mocks.RuleRepository)
+		repositoryMock.EXPECT().List(mock.AnythingOfType("*context.emptyCtx"), rule.Filter{TemplateName: "template"}).Return(nil, errors.New("some error"))
+
+		svc := rule.NewService(repositoryMock, nil, nil, nil)
+		_, err := svc.SyncByTemplate(ctx, "template", nil)
+
+		assert.EqualError(t, err, "some error")
+		repositoryMock.AssertExpectations(t)
+	})
+
+	t.Run("should upsert all rules of the template and report the failed ones", func(t *testing.T) {
+		var (
+			repositoryMock       = new(mocks.RuleRepository)
+			templateServiceMock  = new(mocks.TemplateService)
+			namespaceServiceMock = new(mocks.NamespaceService)
+			ruleUploaderMock     = new(mocks.RuleUploader)
+		)
+		repositoryMock.EXPECT().List(mock.AnythingOfType("*context.emptyCtx"), rule.Filter{TemplateName: "template"}).Return([]rule.Rule{
+			{ID: 1, GroupName: "group-1", Template: "template", ProviderNamespace: 1},
+			{ID: 2, GroupName: "group-2", Template: "template", ProviderNamespace: 1},
+		}, nil)
+		namespaceServiceMock.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), uint64(1)).Return(ns, nil)
+		templateServiceMock.EXPECT().GetByName(mock.AnythingOfType("*context.emptyCtx"), "template").Return(&template.Template{}, nil)
+		repositoryMock.EXPECT().WithTransaction(ctx).Return(ctx)
+		repositoryMock.EXPECT().Upsert(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*rule.Rule")).Return(nil)
+		repositoryMock.EXPECT().CreateRevision(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*rule.Revision")).Return(nil)
+		ruleUploaderMock.EXPECT().UpsertRule(mock.AnythingOfType("*context.emptyCtx"), "tenant", ns.Provider, mock.MatchedBy(func(rl *rule.Rule) bool {
+			return rl.ID == 1
+		}), mock.AnythingOfType("*template.Template")).Return(nil)
+		ruleUploaderMock.EXPECT().UpsertRule(mock.AnythingOfType("*context.emptyCtx"), "tenant", ns.Provider, mock.MatchedBy(func(rl *rule.Rule) bool {
+			return rl.ID == 2
+		}), mock.AnythingOfType("*template.Template")).Return(errors.New("cortex error"))
+		repositoryMock.EXPECT().Commit(ctx).Return(nil).Once()
+		repositoryMock.EXPECT().Rollback(ctx, mock.Anything).Return(nil).Once()
+
+		svc := rule.NewService(repositoryMock, templateServiceMock, namespaceServiceMock, map[string]rule.RuleUploader{
+			provider.TypeCortex: ruleUploaderMock,
+		})
+		results, err := svc.SyncByTemplate(ctx, "template", nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []rule.SyncResult{
+			{RuleID: 1, Name: "siren_api__tenant__group-1_template"},
+			{RuleID: 2, Name: "siren_api__tenant__group-2_template", Error: errors.New("cortex error")},
+		}, results)
+		assert.Equal(t, []uint64{2}, rule.FailedRuleIDs(results))
+		repositoryMock.AssertExpectations(t)
+		ruleUploaderMock.AssertExpectations(t)
+	})
+
+	t.Run("should only upsert the selected rules of the template", func(t *testing.T) {
+		var (
+			repositoryMock       = new(mocks.RuleRepository)
+			templateServiceMock  = new(mocks.TemplateService)
+			namespaceServiceMock = new(mocks.NamespaceService)
+			ruleUploaderMock     = new(mocks.RuleUploader)
+		)
+		repositoryMock.EXPECT().List(mock.AnythingOfType("*context.emptyCtx"), rule.Filter{TemplateName: "template"}).Return([]rule.Rule{
+			{ID: 1, GroupName: "group-1", Template: "template", ProviderNamespace: 1},
+			{ID: 2, GroupName: "group-2", Template: "template", ProviderNamespace: 1},
+		}, nil)
+		namespaceServiceMock.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), uint64(1)).Return(ns, nil)
+		templateServiceMock.EXPECT().GetByName(mock.AnythingOfType("*context.emptyCtx"), "template").Return(&template.Template{}, nil)
+		repositoryMock.EXPECT().WithTransaction(ctx).Return(ctx)
+		repositoryMock.EXPECT().Upsert(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*rule.Rule")).Return(nil)
+		repositoryMock.EXPECT().CreateRevision(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*rule.Revision")).Return(nil)
+		ruleUploaderMock.EXPECT().UpsertRule(mock.AnythingOfType("*context.emptyCtx"), "tenant", ns.Provider, mock.MatchedBy(func(rl *rule.Rule) bool {
+			return rl.ID == 2
+		}), mock.AnythingOfType("*template.Template")).Return(nil).Once()
+		repositoryMock.EXPECT().Commit(ctx).Return(nil).Once()
+
+		svc := rule.NewService(repositoryMock, templateServiceMock, namespaceServiceMock, map[string]rule.RuleUploader{
+			provider.TypeCortex: ruleUploaderMock,
+		})
+		results, err := svc.SyncByTemplate(ctx, "template", []uint64{2})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []rule.SyncResult{{RuleID: 2, Name: "siren_api__tenant__group-2_template"}}, results)
+		assert.Empty(t, rule.FailedRuleIDs(results))
+		repositoryMock.AssertExpectations(t)
+		ruleUploaderMock.AssertExpectations(t)
+	})
+}
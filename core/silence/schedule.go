@@ -0,0 +1,74 @@
+package silence
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// Schedule is a recurring silence window
+// the window starts at every cron occurrence and lasts for the duration
+type Schedule struct {
+	Cron     string        `json:"cron"`
+	Duration time.Duration `json:"duration"`
+	TimeZone string        `json:"time_zone"`
+}
+
+// Window is a single occurrence of a schedule
+type Window struct {
+	StartsAt time.Time `json:"starts_at"`
+	EndsAt   time.Time `json:"ends_at"`
+}
+
+func (sc Schedule) Validate() error {
+	if sc.Cron == "" {
+		return fmt.Errorf("schedule cron cannot be empty")
+	}
+	if sc.Duration <= 0 {
+		return fmt.Errorf("schedule duration should be greater than zero")
+	}
+	if _, err := sc.parse(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (sc Schedule) parse() (cron.Schedule, error) {
+	loc, err := time.LoadLocation(sc.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule time zone '%s': %w", sc.TimeZone, err)
+	}
+
+	cronSchedule, err := cronParser.Parse(sc.Cron)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule cron '%s': %w", sc.Cron, err)
+	}
+
+	if specSchedule, ok := cronSchedule.(*cron.SpecSchedule); ok {
+		specSchedule.Location = loc
+	}
+
+	return cronSchedule, nil
+}
+
+// WindowAt returns the occurrence of the schedule that contains the time
+func (sc Schedule) WindowAt(t time.Time) (Window, bool, error) {
+	cronSchedule, err := sc.parse()
+	if err != nil {
+		return Window{}, false, err
+	}
+
+	// the latest occurrence within (t - duration, t] is the only one that could cover t
+	startsAt := cronSchedule.Next(t.Add(-sc.Duration))
+	if startsAt.IsZero() || startsAt.After(t) {
+		return Window{}, false, nil
+	}
+
+	return Window{
+		StartsAt: startsAt,
+		EndsAt:   startsAt.Add(sc.Duration),
+	}, true, nil
+}
@@ -0,0 +1,136 @@
+package silence_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/odpf/siren/core/silence"
+)
+
+func TestSchedule_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		sc      silence.Schedule
+		wantErr bool
+	}{
+		{
+			name:    "should return error if cron is empty",
+			sc:      silence.Schedule{Duration: time.Hour},
+			wantErr: true,
+		},
+		{
+			name:    "should return error if duration is zero",
+			sc:      silence.Schedule{Cron: "0 2 * * 0"},
+			wantErr: true,
+		},
+		{
+			name:    "should return error if cron is invalid",
+			sc:      silence.Schedule{Cron: "every sunday", Duration: time.Hour},
+			wantErr: true,
+		},
+		{
+			name:    "should return error if time zone is unknown",
+			sc:      silence.Schedule{Cron: "0 2 * * 0", Duration: time.Hour, TimeZone: "Mars/Olympus"},
+			wantErr: true,
+		},
+		{
+			name: "should return no error if schedule is valid",
+			sc:   silence.Schedule{Cron: "0 2 * * 0", Duration: 2 * time.Hour, TimeZone: "Asia/Jakarta"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.sc.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Schedule.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSchedule_WindowAt(t *testing.T) {
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// every sunday 02:00-04:00
+	sc := silence.Schedule{
+		Cron:     "0 2 * * 0",
+		Duration: 2 * time.Hour,
+		TimeZone: "UTC",
+	}
+
+	tests := []struct {
+		name   string
+		sc     silence.Schedule
+		at     time.Time
+		want   silence.Window
+		wantOK bool
+	}{
+		{
+			name: "should return no window if time is before the occurrence",
+			sc:   sc,
+			at:   time.Date(2022, 12, 4, 1, 59, 0, 0, time.UTC),
+		},
+		{
+			name: "should return the window if time is at the start of the occurrence",
+			sc:   sc,
+			at:   time.Date(2022, 12, 4, 2, 0, 0, 0, time.UTC),
+			want: silence.Window{
+				StartsAt: time.Date(2022, 12, 4, 2, 0, 0, 0, time.UTC),
+				EndsAt:   time.Date(2022, 12, 4, 4, 0, 0, 0, time.UTC),
+			},
+			wantOK: true,
+		},
+		{
+			name: "should return the window if time is within the occurrence",
+			sc:   sc,
+			at:   time.Date(2022, 12, 4, 3, 30, 0, 0, time.UTC),
+			want: silence.Window{
+				StartsAt: time.Date(2022, 12, 4, 2, 0, 0, 0, time.UTC),
+				EndsAt:   time.Date(2022, 12, 4, 4, 0, 0, 0, time.UTC),
+			},
+			wantOK: true,
+		},
+		{
+			name: "should return no window if time is at the end of the occurrence",
+			sc:   sc,
+			at:   time.Date(2022, 12, 4, 4, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "should return no window on other days",
+			sc:   sc,
+			at:   time.Date(2022, 12, 5, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "should evaluate cron in the schedule time zone",
+			sc: silence.Schedule{
+				Cron:     "0 2 * * 0",
+				Duration: 2 * time.Hour,
+				TimeZone: "Asia/Jakarta",
+			},
+			// sunday 03:00 in jakarta
+			at: time.Date(2022, 12, 3, 20, 0, 0, 0, time.UTC),
+			want: silence.Window{
+				StartsAt: time.Date(2022, 12, 4, 2, 0, 0, 0, jakarta),
+				EndsAt:   time.Date(2022, 12, 4, 4, 0, 0, 0, jakarta),
+			},
+			wantOK: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotOK, err := tt.sc.WindowAt(tt.at)
+			if err != nil {
+				t.Fatalf("Schedule.WindowAt() error = %v", err)
+			}
+			if gotOK != tt.wantOK {
+				t.Errorf("Schedule.WindowAt() ok = %v, want %v", gotOK, tt.wantOK)
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("Schedule.WindowAt() diff = %v", diff)
+			}
+		})
+	}
+}
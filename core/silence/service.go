@@ -32,3 +32,8 @@ func (s *Service) Get(ctx context.Context, id string) (Silence, error) {
 func (s *Service) Delete(ctx context.Context, id string) error {
 	return s.repository.SoftDelete(ctx, id)
 }
+
+// ExpireLapsed marks all silences that already passed their ends at as expired
+func (s *Service) ExpireLapsed(ctx context.Context) error {
+	return s.repository.ExpireLapsed(ctx)
+}
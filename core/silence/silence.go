@@ -16,6 +16,7 @@ type Repository interface {
 	List(context.Context, Filter) ([]Silence, error)
 	Get(ctx context.Context, id string) (Silence, error)
 	SoftDelete(ctx context.Context, id string) error
+	ExpireLapsed(ctx context.Context) error
 }
 
 type Silence struct {
@@ -26,6 +27,9 @@ type Silence struct {
 	TargetExpression map[string]interface{} `json:"target_expression"`
 	Creator          string                 `json:"creator"`
 	Comment          string                 `json:"comment"`
+	StartsAt         time.Time              `json:"starts_at"`
+	EndsAt           time.Time              `json:"ends_at"`
+	Schedule         Schedule               `json:"schedule"`
 	CreatedAt        time.Time              `json:"created_at"`
 	DeletedAt        time.Time              `json:"deleted_at"`
 }
@@ -40,12 +44,52 @@ func (s Silence) Validate() error {
 		if len(s.TargetExpression) == 0 {
 			return fmt.Errorf("target expression cannot be empty and should be kv labels for type '%s'", TypeMatchers)
 		}
+	case TypeRecurring:
+		if len(s.TargetExpression) == 0 {
+			return fmt.Errorf("target expression cannot be empty and should be kv labels for type '%s'", TypeRecurring)
+		}
+		if err := s.Schedule.Validate(); err != nil {
+			return err
+		}
 	default:
-		return fmt.Errorf("unknown silence type '%s', should be '%s' or '%s'", s.Type, TypeMatchers, TypeSubscription)
+		return fmt.Errorf("unknown silence type '%s', should be '%s', '%s', or '%s'", s.Type, TypeMatchers, TypeSubscription, TypeRecurring)
+	}
+
+	if !s.StartsAt.IsZero() && !s.EndsAt.IsZero() && !s.EndsAt.After(s.StartsAt) {
+		return fmt.Errorf("ends at '%s' should be after starts at '%s'", s.EndsAt.Format(time.RFC3339), s.StartsAt.Format(time.RFC3339))
 	}
 	return nil
 }
 
+// IsActiveAt returns true if the time is within the silence window
+// an empty starts at or ends at means the window is unbounded on that side
+// recurring silences are only active within one of their scheduled windows
+func (s Silence) IsActiveAt(t time.Time) bool {
+	if !s.StartsAt.IsZero() && t.Before(s.StartsAt) {
+		return false
+	}
+	if !s.EndsAt.IsZero() && !t.Before(s.EndsAt) {
+		return false
+	}
+	if s.Type == TypeRecurring {
+		_, ok := s.RecurringWindowAt(t)
+		return ok
+	}
+	return true
+}
+
+// RecurringWindowAt returns the scheduled window of a recurring silence that contains the time
+func (s Silence) RecurringWindowAt(t time.Time) (Window, bool) {
+	if s.Type != TypeRecurring {
+		return Window{}, false
+	}
+	w, ok, err := s.Schedule.WindowAt(t)
+	if err != nil {
+		return Window{}, false
+	}
+	return w, ok
+}
+
 func (s Silence) subscriptionRule() (string, error) {
 	if s.Type != TypeSubscription {
 		return "", fmt.Errorf("silence id '%s' type is not subscription, type is '%s' instead", s.ID, s.Type)
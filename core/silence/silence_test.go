@@ -2,6 +2,7 @@ package silence_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/odpf/siren/core/receiver"
@@ -201,6 +202,50 @@ func TestSilence_Validate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "should return error if ends at is not after starts at",
+			sil: silence.Silence{
+				Type:     silence.TypeSubscription,
+				TargetID: 1,
+				StartsAt: time.Date(2022, 1, 1, 2, 0, 0, 0, time.UTC),
+				EndsAt:   time.Date(2022, 1, 1, 1, 0, 0, 0, time.UTC),
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return error if type recurring and schedule is invalid",
+			sil: silence.Silence{
+				Type: silence.TypeRecurring,
+				TargetExpression: map[string]interface{}{
+					"k1": "v1",
+				},
+				Schedule: silence.Schedule{
+					Cron: "0 2 * * 0",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return no error if type recurring and schedule is valid",
+			sil: silence.Silence{
+				Type: silence.TypeRecurring,
+				TargetExpression: map[string]interface{}{
+					"k1": "v1",
+				},
+				Schedule: silence.Schedule{
+					Cron:     "0 2 * * 0",
+					Duration: 2 * time.Hour,
+				},
+			},
+		},
+		{
+			name: "should return no error if only ends at is set",
+			sil: silence.Silence{
+				Type:     silence.TypeSubscription,
+				TargetID: 1,
+				EndsAt:   time.Date(2022, 1, 1, 1, 0, 0, 0, time.UTC),
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -210,3 +255,88 @@ func TestSilence_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestSilence_IsActiveAt(t *testing.T) {
+	var (
+		startsAt = time.Date(2022, 1, 1, 1, 0, 0, 0, time.UTC)
+		endsAt   = time.Date(2022, 1, 1, 3, 0, 0, 0, time.UTC)
+	)
+	tests := []struct {
+		name string
+		sil  silence.Silence
+		at   time.Time
+		want bool
+	}{
+		{
+			name: "should return true if no window is set",
+			sil:  silence.Silence{},
+			at:   startsAt,
+			want: true,
+		},
+		{
+			name: "should return false if time is before starts at",
+			sil:  silence.Silence{StartsAt: startsAt, EndsAt: endsAt},
+			at:   startsAt.Add(-time.Minute),
+			want: false,
+		},
+		{
+			name: "should return true if time is equal to starts at",
+			sil:  silence.Silence{StartsAt: startsAt, EndsAt: endsAt},
+			at:   startsAt,
+			want: true,
+		},
+		{
+			name: "should return false if time is equal to ends at",
+			sil:  silence.Silence{StartsAt: startsAt, EndsAt: endsAt},
+			at:   endsAt,
+			want: false,
+		},
+		{
+			name: "should return true if time is before ends at and starts at is empty",
+			sil:  silence.Silence{EndsAt: endsAt},
+			at:   startsAt.Add(-time.Hour),
+			want: true,
+		},
+		{
+			name: "should return false if time is after ends at",
+			sil:  silence.Silence{EndsAt: endsAt},
+			at:   endsAt.Add(time.Minute),
+			want: false,
+		},
+		{
+			name: "should return true if recurring silence is within its scheduled window",
+			sil: silence.Silence{
+				Type:     silence.TypeRecurring,
+				Schedule: silence.Schedule{Cron: "0 2 * * *", Duration: 2 * time.Hour},
+			},
+			at:   startsAt.Add(2 * time.Hour),
+			want: true,
+		},
+		{
+			name: "should return false if recurring silence is outside its scheduled window",
+			sil: silence.Silence{
+				Type:     silence.TypeRecurring,
+				Schedule: silence.Schedule{Cron: "0 2 * * *", Duration: 2 * time.Hour},
+			},
+			at:   startsAt,
+			want: false,
+		},
+		{
+			name: "should return false if recurring silence is within its scheduled window but after ends at",
+			sil: silence.Silence{
+				Type:     silence.TypeRecurring,
+				EndsAt:   startsAt,
+				Schedule: silence.Schedule{Cron: "0 2 * * *", Duration: 2 * time.Hour},
+			},
+			at:   startsAt.Add(2 * time.Hour),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.sil.IsActiveAt(tt.at); got != tt.want {
+				t.Errorf("Silence.IsActiveAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,11 +3,13 @@ package silence
 const (
 	TypeMatchers     = "Matchers"
 	TypeSubscription = "subscription"
+	TypeRecurring    = "recurring"
 )
 
 func IsTypeValid(silenceTypeStr string) bool {
 	if silenceTypeStr == TypeMatchers ||
-		silenceTypeStr == TypeSubscription {
+		silenceTypeStr == TypeSubscription ||
+		silenceTypeStr == TypeRecurring {
 		return true
 	}
 	return false
@@ -20,3 +20,15 @@ func (err NotFoundError) Error() string {
 
 	return "template not found"
 }
+
+type RevisionNotFoundError struct {
+	ID uint64
+}
+
+func (err RevisionNotFoundError) Error() string {
+	if err.ID != 0 {
+		return fmt.Sprintf("template revision with id %d not found", err.ID)
+	}
+
+	return "template revision not found"
+}
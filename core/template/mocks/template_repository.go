@@ -22,6 +22,81 @@ func (_m *TemplateRepository) EXPECT() *TemplateRepository_Expecter {
 	return &TemplateRepository_Expecter{mock: &_m.Mock}
 }
 
+// Commit provides a mock function with given fields: ctx
+func (_m *TemplateRepository) Commit(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TemplateRepository_Commit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Commit'
+type TemplateRepository_Commit_Call struct {
+	*mock.Call
+}
+
+// Commit is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *TemplateRepository_Expecter) Commit(ctx interface{}) *TemplateRepository_Commit_Call {
+	return &TemplateRepository_Commit_Call{Call: _e.mock.On("Commit", ctx)}
+}
+
+func (_c *TemplateRepository_Commit_Call) Run(run func(ctx context.Context)) *TemplateRepository_Commit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *TemplateRepository_Commit_Call) Return(_a0 error) *TemplateRepository_Commit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// CreateRevision provides a mock function with given fields: _a0, _a1
+func (_m *TemplateRepository) CreateRevision(_a0 context.Context, _a1 *template.Revision) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *template.Revision) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TemplateRepository_CreateRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRevision'
+type TemplateRepository_CreateRevision_Call struct {
+	*mock.Call
+}
+
+// CreateRevision is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *template.Revision
+func (_e *TemplateRepository_Expecter) CreateRevision(_a0 interface{}, _a1 interface{}) *TemplateRepository_CreateRevision_Call {
+	return &TemplateRepository_CreateRevision_Call{Call: _e.mock.On("CreateRevision", _a0, _a1)}
+}
+
+func (_c *TemplateRepository_CreateRevision_Call) Run(run func(_a0 context.Context, _a1 *template.Revision)) *TemplateRepository_CreateRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*template.Revision))
+	})
+	return _c
+}
+
+func (_c *TemplateRepository_CreateRevision_Call) Return(_a0 error) *TemplateRepository_CreateRevision_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 // Delete provides a mock function with given fields: _a0, _a1
 func (_m *TemplateRepository) Delete(_a0 context.Context, _a1 string) error {
 	ret := _m.Called(_a0, _a1)
@@ -107,6 +182,53 @@ func (_c *TemplateRepository_GetByName_Call) Return(_a0 *template.Template, _a1
 	return _c
 }
 
+// GetRevision provides a mock function with given fields: _a0, _a1
+func (_m *TemplateRepository) GetRevision(_a0 context.Context, _a1 uint64) (*template.Revision, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *template.Revision
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *template.Revision); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*template.Revision)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TemplateRepository_GetRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevision'
+type TemplateRepository_GetRevision_Call struct {
+	*mock.Call
+}
+
+// GetRevision is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uint64
+func (_e *TemplateRepository_Expecter) GetRevision(_a0 interface{}, _a1 interface{}) *TemplateRepository_GetRevision_Call {
+	return &TemplateRepository_GetRevision_Call{Call: _e.mock.On("GetRevision", _a0, _a1)}
+}
+
+func (_c *TemplateRepository_GetRevision_Call) Run(run func(_a0 context.Context, _a1 uint64)) *TemplateRepository_GetRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *TemplateRepository_GetRevision_Call) Return(_a0 *template.Revision, _a1 error) *TemplateRepository_GetRevision_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // List provides a mock function with given fields: _a0, _a1
 func (_m *TemplateRepository) List(_a0 context.Context, _a1 template.Filter) ([]template.Template, error) {
 	ret := _m.Called(_a0, _a1)
@@ -154,6 +276,91 @@ func (_c *TemplateRepository_List_Call) Return(_a0 []template.Template, _a1 erro
 	return _c
 }
 
+// ListRevisions provides a mock function with given fields: _a0, _a1
+func (_m *TemplateRepository) ListRevisions(_a0 context.Context, _a1 string) ([]template.Revision, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []template.Revision
+	if rf, ok := ret.Get(0).(func(context.Context, string) []template.Revision); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]template.Revision)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TemplateRepository_ListRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRevisions'
+type TemplateRepository_ListRevisions_Call struct {
+	*mock.Call
+}
+
+// ListRevisions is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 string
+func (_e *TemplateRepository_Expecter) ListRevisions(_a0 interface{}, _a1 interface{}) *TemplateRepository_ListRevisions_Call {
+	return &TemplateRepository_ListRevisions_Call{Call: _e.mock.On("ListRevisions", _a0, _a1)}
+}
+
+func (_c *TemplateRepository_ListRevisions_Call) Run(run func(_a0 context.Context, _a1 string)) *TemplateRepository_ListRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TemplateRepository_ListRevisions_Call) Return(_a0 []template.Revision, _a1 error) *TemplateRepository_ListRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// Rollback provides a mock function with given fields: ctx, err
+func (_m *TemplateRepository) Rollback(ctx context.Context, err error) error {
+	ret := _m.Called(ctx, err)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, error) error); ok {
+		r0 = rf(ctx, err)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TemplateRepository_Rollback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rollback'
+type TemplateRepository_Rollback_Call struct {
+	*mock.Call
+}
+
+// Rollback is a helper method to define mock.On call
+//   - ctx context.Context
+//   - err error
+func (_e *TemplateRepository_Expecter) Rollback(ctx interface{}, err interface{}) *TemplateRepository_Rollback_Call {
+	return &TemplateRepository_Rollback_Call{Call: _e.mock.On("Rollback", ctx, err)}
+}
+
+func (_c *TemplateRepository_Rollback_Call) Run(run func(ctx context.Context, err error)) *TemplateRepository_Rollback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(error))
+	})
+	return _c
+}
+
+func (_c *TemplateRepository_Rollback_Call) Return(_a0 error) *TemplateRepository_Rollback_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 // Upsert provides a mock function with given fields: _a0, _a1
 func (_m *TemplateRepository) Upsert(_a0 context.Context, _a1 *template.Template) error {
 	ret := _m.Called(_a0, _a1)
@@ -192,6 +399,45 @@ func (_c *TemplateRepository_Upsert_Call) Return(_a0 error) *TemplateRepository_
 	return _c
 }
 
+// WithTransaction provides a mock function with given fields: ctx
+func (_m *TemplateRepository) WithTransaction(ctx context.Context) context.Context {
+	ret := _m.Called(ctx)
+
+	var r0 context.Context
+	if rf, ok := ret.Get(0).(func(context.Context) context.Context); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context.Context)
+		}
+	}
+
+	return r0
+}
+
+// TemplateRepository_WithTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTransaction'
+type TemplateRepository_WithTransaction_Call struct {
+	*mock.Call
+}
+
+// WithTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *TemplateRepository_Expecter) WithTransaction(ctx interface{}) *TemplateRepository_WithTransaction_Call {
+	return &TemplateRepository_WithTransaction_Call{Call: _e.mock.On("WithTransaction", ctx)}
+}
+
+func (_c *TemplateRepository_WithTransaction_Call) Run(run func(ctx context.Context)) *TemplateRepository_WithTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *TemplateRepository_WithTransaction_Call) Return(_a0 context.Context) *TemplateRepository_WithTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 type mockConstructorTestingTNewTemplateRepository interface {
 	mock.TestingT
 	Cleanup(func())
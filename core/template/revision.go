@@ -0,0 +1,26 @@
+package template
+
+import "time"
+
+// Revision is an immutable snapshot of a template taken every time the template is upserted
+type Revision struct {
+	ID         uint64     `json:"id"`
+	TemplateID uint64     `json:"template_id"`
+	Name       string     `json:"name"`
+	Body       string     `json:"body"`
+	Tags       []string   `json:"tags"`
+	Variables  []Variable `json:"variables"`
+	Actor      string     `json:"actor"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// Template returns the template as it was at the revision
+func (r Revision) Template() *Template {
+	return &Template{
+		ID:        r.TemplateID,
+		Name:      r.Name,
+		Body:      r.Body,
+		Tags:      r.Tags,
+		Variables: r.Variables,
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	texttemplate "text/template"
 
+	"github.com/odpf/siren/pkg/actor"
 	"github.com/odpf/siren/pkg/errors"
 )
 
@@ -24,17 +25,71 @@ func NewService(repository Repository) *Service {
 	return &Service{repository}
 }
 
+// Upsert stores the template and appends a revision of it in the same transaction
 func (s *Service) Upsert(ctx context.Context, template *Template) error {
-	err := s.repository.Upsert(ctx, template)
-	if err != nil {
+	ctx = s.repository.WithTransaction(ctx)
+	if err := s.repository.Upsert(ctx, template); err != nil {
+		if err := s.repository.Rollback(ctx, err); err != nil {
+			return err
+		}
 		if errors.Is(err, ErrDuplicate) {
 			return errors.ErrConflict.WithMsgf(err.Error())
 		}
 		return err
 	}
+
+	if err := s.repository.CreateRevision(ctx, &Revision{
+		TemplateID: template.ID,
+		Name:       template.Name,
+		Body:       template.Body,
+		Tags:       template.Tags,
+		Variables:  template.Variables,
+		Actor:      actor.FromContext(ctx),
+	}); err != nil {
+		if err := s.repository.Rollback(ctx, err); err != nil {
+			return err
+		}
+		return err
+	}
+
+	if err := s.repository.Commit(ctx); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// ListRevisions returns revisions of a template, the latest comes first
+func (s *Service) ListRevisions(ctx context.Context, name string) ([]Revision, error) {
+	return s.repository.ListRevisions(ctx, name)
+}
+
+func (s *Service) GetRevision(ctx context.Context, id uint64) (*Revision, error) {
+	rev, err := s.repository.GetRevision(ctx, id)
+	if err != nil {
+		if errors.As(err, new(RevisionNotFoundError)) {
+			return nil, errors.ErrNotFound.WithMsgf(err.Error())
+		}
+		return nil, err
+	}
+	return rev, nil
+}
+
+// Rollback upserts the template as it was at the revision, the rollback itself is recorded as a new revision
+func (s *Service) Rollback(ctx context.Context, revisionID uint64) (*Template, error) {
+	rev, err := s.GetRevision(ctx, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl := rev.Template()
+	if err := s.Upsert(ctx, tmpl); err != nil {
+		return nil, err
+	}
+
+	return tmpl, nil
+}
+
 func (s *Service) List(ctx context.Context, flt Filter) ([]Template, error) {
 	return s.repository.List(ctx, flt)
 }
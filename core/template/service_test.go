@@ -8,6 +8,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/odpf/siren/core/template"
 	"github.com/odpf/siren/core/template/mocks"
+	"github.com/odpf/siren/pkg/actor"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -23,11 +24,13 @@ func TestService_Upsert(t *testing.T) {
 		{
 			Description: "should return error if upsert repository error",
 			Setup: func(tr *mocks.TemplateRepository) {
+				tr.EXPECT().WithTransaction(mock.AnythingOfType("*context.emptyCtx")).Return(context.TODO())
 				tr.EXPECT().Upsert(mock.AnythingOfType("*context.emptyCtx"), &template.Template{
 					ID:   1,
 					Name: "template-1",
 					Body: "body of a template",
 				}).Return(errors.New("some error"))
+				tr.EXPECT().Rollback(mock.AnythingOfType("*context.emptyCtx"), mock.Anything).Return(nil)
 			},
 			Tmpl: &template.Template{
 				ID:   1,
@@ -39,11 +42,13 @@ func TestService_Upsert(t *testing.T) {
 		{
 			Description: "should return error conflict if upsert repository return error duplicate",
 			Setup: func(tr *mocks.TemplateRepository) {
+				tr.EXPECT().WithTransaction(mock.AnythingOfType("*context.emptyCtx")).Return(context.TODO())
 				tr.EXPECT().Upsert(mock.AnythingOfType("*context.emptyCtx"), &template.Template{
 					ID:   1,
 					Name: "template-1",
 					Body: "body of a template",
 				}).Return(template.ErrDuplicate)
+				tr.EXPECT().Rollback(mock.AnythingOfType("*context.emptyCtx"), mock.Anything).Return(nil)
 			},
 			Tmpl: &template.Template{
 				ID:   1,
@@ -53,13 +58,50 @@ func TestService_Upsert(t *testing.T) {
 			Err: errors.New("name already exist"),
 		},
 		{
-			Description: "should return nil error if upsert repository not error",
+			Description: "should rollback and return error if create revision return error",
 			Setup: func(tr *mocks.TemplateRepository) {
+				tr.EXPECT().WithTransaction(mock.AnythingOfType("*context.emptyCtx")).Return(context.TODO())
+				tr.EXPECT().Upsert(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*template.Template")).Return(nil)
+				tr.EXPECT().CreateRevision(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*template.Revision")).Return(errors.New("some error"))
+				tr.EXPECT().Rollback(mock.AnythingOfType("*context.emptyCtx"), mock.Anything).Return(errors.New("rollback error"))
+			},
+			Tmpl: &template.Template{
+				ID:   1,
+				Name: "template-1",
+				Body: "body of a template",
+			},
+			Err: errors.New("rollback error"),
+		},
+		{
+			Description: "should return error if commit return error",
+			Setup: func(tr *mocks.TemplateRepository) {
+				tr.EXPECT().WithTransaction(mock.AnythingOfType("*context.emptyCtx")).Return(context.TODO())
+				tr.EXPECT().Upsert(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*template.Template")).Return(nil)
+				tr.EXPECT().CreateRevision(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*template.Revision")).Return(nil)
+				tr.EXPECT().Commit(mock.AnythingOfType("*context.emptyCtx")).Return(errors.New("some commit error"))
+			},
+			Tmpl: &template.Template{
+				ID:   1,
+				Name: "template-1",
+				Body: "body of a template",
+			},
+			Err: errors.New("some commit error"),
+		},
+		{
+			Description: "should return nil error and record revision if upsert repository not error",
+			Setup: func(tr *mocks.TemplateRepository) {
+				tr.EXPECT().WithTransaction(mock.AnythingOfType("*context.emptyCtx")).Return(context.TODO())
 				tr.EXPECT().Upsert(mock.AnythingOfType("*context.emptyCtx"), &template.Template{
 					ID:   1,
 					Name: "template-1",
 					Body: "body of a template",
 				}).Return(nil)
+				tr.EXPECT().CreateRevision(mock.AnythingOfType("*context.emptyCtx"), &template.Revision{
+					TemplateID: 1,
+					Name:       "template-1",
+					Body:       "body of a template",
+				}).Return(nil)
+				tr.EXPECT().Commit(mock.AnythingOfType("*context.emptyCtx")).Return(nil)
 			},
 			Tmpl: &template.Template{
 				ID:   1,
@@ -291,3 +333,68 @@ func TestService_Delete(t *testing.T) {
 		repositoryMock.AssertExpectations(t)
 	})
 }
+
+func TestService_GetRevision(t *testing.T) {
+	t.Run("should return error not found if revision does not exist", func(t *testing.T) {
+		repositoryMock := new(mocks.TemplateRepository)
+		repositoryMock.EXPECT().GetRevision(mock.AnythingOfType("*context.emptyCtx"), uint64(3)).Return(nil, template.RevisionNotFoundError{ID: 3})
+
+		_, err := template.NewService(repositoryMock).GetRevision(context.TODO(), 3)
+
+		assert.EqualError(t, err, "template revision with id 3 not found")
+		repositoryMock.AssertExpectations(t)
+	})
+}
+
+func TestService_Rollback(t *testing.T) {
+	var (
+		revision = &template.Revision{
+			ID:         3,
+			TemplateID: 1,
+			Name:       "template-1",
+			Body:       "old body",
+			Tags:       []string{"tag"},
+			Variables:  []template.Variable{{Name: "for", Type: "string", Default: "5m"}},
+			Actor:      "someone@odpf.io",
+		}
+	)
+
+	t.Run("should return error if get revision return error", func(t *testing.T) {
+		repositoryMock := new(mocks.TemplateRepository)
+		repositoryMock.EXPECT().GetRevision(mock.AnythingOfType("*context.emptyCtx"), uint64(3)).Return(nil, errors.New("some error"))
+
+		_, err := template.NewService(repositoryMock).Rollback(context.TODO(), 3)
+
+		assert.EqualError(t, err, "some error")
+		repositoryMock.AssertExpectations(t)
+	})
+
+	t.Run("should upsert template of the revision and record the rollback as a new revision of the actor", func(t *testing.T) {
+		ctx := actor.WithContext(context.TODO(), "user@odpf.io")
+		repositoryMock := new(mocks.TemplateRepository)
+		repositoryMock.EXPECT().GetRevision(ctx, uint64(3)).Return(revision, nil)
+		repositoryMock.EXPECT().WithTransaction(ctx).Return(ctx)
+		repositoryMock.EXPECT().Upsert(ctx, &template.Template{
+			ID:        1,
+			Name:      "template-1",
+			Body:      "old body",
+			Tags:      []string{"tag"},
+			Variables: []template.Variable{{Name: "for", Type: "string", Default: "5m"}},
+		}).Return(nil)
+		repositoryMock.EXPECT().CreateRevision(ctx, &template.Revision{
+			TemplateID: 1,
+			Name:       "template-1",
+			Body:       "old body",
+			Tags:       []string{"tag"},
+			Variables:  []template.Variable{{Name: "for", Type: "string", Default: "5m"}},
+			Actor:      "user@odpf.io",
+		}).Return(nil)
+		repositoryMock.EXPECT().Commit(ctx).Return(nil)
+
+		tmpl, err := template.NewService(repositoryMock).Rollback(ctx, 3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "old body", tmpl.Body)
+		repositoryMock.AssertExpectations(t)
+	})
+}
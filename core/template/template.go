@@ -11,10 +11,20 @@ const (
 
 //go:generate mockery --name=Repository -r --case underscore --with-expecter --structname TemplateRepository --filename template_repository.go --output=./mocks
 type Repository interface {
+	Transactor
 	Upsert(context.Context, *Template) error
 	List(context.Context, Filter) ([]Template, error)
 	GetByName(context.Context, string) (*Template, error)
 	Delete(context.Context, string) error
+	CreateRevision(context.Context, *Revision) error
+	ListRevisions(context.Context, string) ([]Revision, error)
+	GetRevision(context.Context, uint64) (*Revision, error)
+}
+
+type Transactor interface {
+	WithTransaction(ctx context.Context) context.Context
+	Rollback(ctx context.Context, err error) error
+	Commit(ctx context.Context) error
 }
 
 type Variable struct {
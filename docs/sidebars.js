@@ -59,6 +59,10 @@ module.exports = {
       label: "Providers",
       items: [
         "providers/cortexmetrics",
+        "providers/prometheus",
+        "providers/mimir",
+        "providers/loki",
+        "providers/webhook",
       ],
     },
     {
@@ -67,7 +71,12 @@ module.exports = {
       items: [
         "receivers/slack",
         "receivers/pagerduty",
+        "receivers/opsgenie",
+        "receivers/telegram",
+        "receivers/discord",
         "receivers/http",
+        "receivers/msteams",
+        "receivers/email",
         "receivers/file",
       ],
     },
@@ -13,6 +13,7 @@ import (
 	"github.com/odpf/siren/core/silence"
 	"github.com/odpf/siren/core/subscription"
 	"github.com/odpf/siren/core/template"
+	"github.com/odpf/siren/plugins/queues"
 )
 
 //go:generate mockery --name=AlertService -r --case underscore --with-expecter --structname AlertService --filename alert_service.go --output=./mocks
@@ -52,6 +53,15 @@ type ReceiverService interface {
 type RuleService interface {
 	Upsert(context.Context, *rule.Rule) error
 	List(context.Context, rule.Filter) ([]rule.Rule, error)
+	Preview(context.Context, *rule.Rule) (*rule.Preview, error)
+	Delete(context.Context, uint64) error
+	DetectDrift(context.Context, uint64) (*rule.DriftReport, error)
+	Reconcile(context.Context, uint64) (*rule.DriftReport, error)
+	ListRevisions(context.Context, uint64) ([]rule.Revision, error)
+	GetRevision(context.Context, uint64) (*rule.Revision, error)
+	Rollback(context.Context, uint64) (*rule.Rule, error)
+	SyncByTemplate(context.Context, string, []uint64) ([]rule.SyncResult, error)
+	IsProviderSupported(string) bool
 }
 
 //go:generate mockery --name=SubscriptionService -r --case underscore --with-expecter --structname SubscriptionService --filename subscription_service.go --output=./mocks
@@ -70,6 +80,9 @@ type TemplateService interface {
 	GetByName(context.Context, string) (*template.Template, error)
 	Delete(context.Context, string) error
 	Render(context.Context, string, map[string]string) (string, error)
+	ListRevisions(context.Context, string) ([]template.Revision, error)
+	GetRevision(context.Context, uint64) (*template.Revision, error)
+	Rollback(context.Context, uint64) (*template.Template, error)
 }
 
 //go:generate mockery --name=NotificationService -r --case underscore --with-expecter --structname NotificationService --filename notification_service.go --output=./mocks
@@ -78,6 +91,11 @@ type NotificationService interface {
 	CheckAndInsertIdempotency(ctx context.Context, scope, key string) (uint64, error)
 	MarkIdempotencyAsSuccess(ctx context.Context, id uint64) error
 	RemoveIdempotencies(ctx context.Context, TTL time.Duration) error
+	ListMessages(ctx context.Context, filter queues.FilterMessage) ([]notification.Message, error)
+	GetMessage(ctx context.Context, id string) (notification.Message, error)
+	RequeueMessages(ctx context.Context, ids ...string) (int64, error)
+	ReplayMessages(ctx context.Context, ids ...string) ([]string, error)
+	DiscardMessages(ctx context.Context, ids ...string) (int64, error)
 }
 
 //go:generate mockery --name=SilenceService -r --case underscore --with-expecter --structname SilenceService --filename silence_service.go --output=./mocks
@@ -86,6 +104,7 @@ type SilenceService interface {
 	List(ctx context.Context, filter silence.Filter) ([]silence.Silence, error)
 	Get(ctx context.Context, id string) (silence.Silence, error)
 	Delete(ctx context.Context, id string) error
+	ExpireLapsed(ctx context.Context) error
 }
 
 type Deps struct {
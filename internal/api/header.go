@@ -8,11 +8,13 @@ import (
 
 type HeadersConfig struct {
 	IdempotencyKey string `mapstructure:"idempotency_key" yaml:"idempotency_key" default:"Idempotency-Key"`
+	Actor          string `mapstructure:"actor" yaml:"actor" default:"X-Actor"`
 }
 
 func SupportedHeaders(cfg HeadersConfig) map[string]bool {
 	return map[string]bool{
 		cfg.IdempotencyKey: true,
+		cfg.Actor:          true,
 	}
 }
 
@@ -4,11 +4,11 @@ package mocks
 
 import (
 	context "context"
+	time "time"
 
 	notification "github.com/odpf/siren/core/notification"
+	queues "github.com/odpf/siren/plugins/queues"
 	mock "github.com/stretchr/testify/mock"
-
-	time "time"
 )
 
 // NotificationService is an autogenerated mock type for the NotificationService type
@@ -70,6 +70,65 @@ func (_c *NotificationService_CheckAndInsertIdempotency_Call) Return(_a0 uint64,
 	return _c
 }
 
+// DiscardMessages provides a mock function with given fields: ctx, ids
+func (_m *NotificationService) DiscardMessages(ctx context.Context, ids ...string) (int64, error) {
+	_va := make([]interface{}, len(ids))
+	for _i := range ids {
+		_va[_i] = ids[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, ...string) int64); ok {
+		r0 = rf(ctx, ids...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...string) error); ok {
+		r1 = rf(ctx, ids...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationService_DiscardMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiscardMessages'
+type NotificationService_DiscardMessages_Call struct {
+	*mock.Call
+}
+
+// DiscardMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids ...string
+func (_e *NotificationService_Expecter) DiscardMessages(ctx interface{}, ids ...interface{}) *NotificationService_DiscardMessages_Call {
+	return &NotificationService_DiscardMessages_Call{Call: _e.mock.On("DiscardMessages",
+		append([]interface{}{ctx}, ids...)...)}
+}
+
+func (_c *NotificationService_DiscardMessages_Call) Run(run func(ctx context.Context, ids ...string)) *NotificationService_DiscardMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NotificationService_DiscardMessages_Call) Return(_a0 int64, _a1 error) *NotificationService_DiscardMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // Dispatch provides a mock function with given fields: ctx, n
 func (_m *NotificationService) Dispatch(ctx context.Context, n notification.Notification) error {
 	ret := _m.Called(ctx, n)
@@ -108,6 +167,98 @@ func (_c *NotificationService_Dispatch_Call) Return(_a0 error) *NotificationServ
 	return _c
 }
 
+// GetMessage provides a mock function with given fields: ctx, id
+func (_m *NotificationService) GetMessage(ctx context.Context, id string) (notification.Message, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 notification.Message
+	if rf, ok := ret.Get(0).(func(context.Context, string) notification.Message); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(notification.Message)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationService_GetMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMessage'
+type NotificationService_GetMessage_Call struct {
+	*mock.Call
+}
+
+// GetMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *NotificationService_Expecter) GetMessage(ctx interface{}, id interface{}) *NotificationService_GetMessage_Call {
+	return &NotificationService_GetMessage_Call{Call: _e.mock.On("GetMessage", ctx, id)}
+}
+
+func (_c *NotificationService_GetMessage_Call) Run(run func(ctx context.Context, id string)) *NotificationService_GetMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *NotificationService_GetMessage_Call) Return(_a0 notification.Message, _a1 error) *NotificationService_GetMessage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// ListMessages provides a mock function with given fields: ctx, filter
+func (_m *NotificationService) ListMessages(ctx context.Context, filter queues.FilterMessage) ([]notification.Message, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []notification.Message
+	if rf, ok := ret.Get(0).(func(context.Context, queues.FilterMessage) []notification.Message); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notification.Message)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, queues.FilterMessage) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationService_ListMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMessages'
+type NotificationService_ListMessages_Call struct {
+	*mock.Call
+}
+
+// ListMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter queues.FilterMessage
+func (_e *NotificationService_Expecter) ListMessages(ctx interface{}, filter interface{}) *NotificationService_ListMessages_Call {
+	return &NotificationService_ListMessages_Call{Call: _e.mock.On("ListMessages", ctx, filter)}
+}
+
+func (_c *NotificationService_ListMessages_Call) Run(run func(ctx context.Context, filter queues.FilterMessage)) *NotificationService_ListMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(queues.FilterMessage))
+	})
+	return _c
+}
+
+func (_c *NotificationService_ListMessages_Call) Return(_a0 []notification.Message, _a1 error) *NotificationService_ListMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // MarkIdempotencyAsSuccess provides a mock function with given fields: ctx, id
 func (_m *NotificationService) MarkIdempotencyAsSuccess(ctx context.Context, id uint64) error {
 	ret := _m.Called(ctx, id)
@@ -184,6 +335,126 @@ func (_c *NotificationService_RemoveIdempotencies_Call) Return(_a0 error) *Notif
 	return _c
 }
 
+// ReplayMessages provides a mock function with given fields: ctx, ids
+func (_m *NotificationService) ReplayMessages(ctx context.Context, ids ...string) ([]string, error) {
+	_va := make([]interface{}, len(ids))
+	for _i := range ids {
+		_va[_i] = ids[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, ...string) []string); ok {
+		r0 = rf(ctx, ids...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...string) error); ok {
+		r1 = rf(ctx, ids...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationService_ReplayMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayMessages'
+type NotificationService_ReplayMessages_Call struct {
+	*mock.Call
+}
+
+// ReplayMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids ...string
+func (_e *NotificationService_Expecter) ReplayMessages(ctx interface{}, ids ...interface{}) *NotificationService_ReplayMessages_Call {
+	return &NotificationService_ReplayMessages_Call{Call: _e.mock.On("ReplayMessages",
+		append([]interface{}{ctx}, ids...)...)}
+}
+
+func (_c *NotificationService_ReplayMessages_Call) Run(run func(ctx context.Context, ids ...string)) *NotificationService_ReplayMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NotificationService_ReplayMessages_Call) Return(_a0 []string, _a1 error) *NotificationService_ReplayMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// RequeueMessages provides a mock function with given fields: ctx, ids
+func (_m *NotificationService) RequeueMessages(ctx context.Context, ids ...string) (int64, error) {
+	_va := make([]interface{}, len(ids))
+	for _i := range ids {
+		_va[_i] = ids[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, ...string) int64); ok {
+		r0 = rf(ctx, ids...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...string) error); ok {
+		r1 = rf(ctx, ids...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationService_RequeueMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequeueMessages'
+type NotificationService_RequeueMessages_Call struct {
+	*mock.Call
+}
+
+// RequeueMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids ...string
+func (_e *NotificationService_Expecter) RequeueMessages(ctx interface{}, ids ...interface{}) *NotificationService_RequeueMessages_Call {
+	return &NotificationService_RequeueMessages_Call{Call: _e.mock.On("RequeueMessages",
+		append([]interface{}{ctx}, ids...)...)}
+}
+
+func (_c *NotificationService_RequeueMessages_Call) Run(run func(ctx context.Context, ids ...string)) *NotificationService_RequeueMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *NotificationService_RequeueMessages_Call) Return(_a0 int64, _a1 error) *NotificationService_RequeueMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 type mockConstructorTestingTNewNotificationService interface {
 	mock.TestingT
 	Cleanup(func())
@@ -22,6 +22,175 @@ func (_m *RuleService) EXPECT() *RuleService_Expecter {
 	return &RuleService_Expecter{mock: &_m.Mock}
 }
 
+// Delete provides a mock function with given fields: _a0, _a1
+func (_m *RuleService) Delete(_a0 context.Context, _a1 uint64) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RuleService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type RuleService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uint64
+func (_e *RuleService_Expecter) Delete(_a0 interface{}, _a1 interface{}) *RuleService_Delete_Call {
+	return &RuleService_Delete_Call{Call: _e.mock.On("Delete", _a0, _a1)}
+}
+
+func (_c *RuleService_Delete_Call) Run(run func(_a0 context.Context, _a1 uint64)) *RuleService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *RuleService_Delete_Call) Return(_a0 error) *RuleService_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// DetectDrift provides a mock function with given fields: _a0, _a1
+func (_m *RuleService) DetectDrift(_a0 context.Context, _a1 uint64) (*rule.DriftReport, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *rule.DriftReport
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *rule.DriftReport); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rule.DriftReport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleService_DetectDrift_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DetectDrift'
+type RuleService_DetectDrift_Call struct {
+	*mock.Call
+}
+
+// DetectDrift is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uint64
+func (_e *RuleService_Expecter) DetectDrift(_a0 interface{}, _a1 interface{}) *RuleService_DetectDrift_Call {
+	return &RuleService_DetectDrift_Call{Call: _e.mock.On("DetectDrift", _a0, _a1)}
+}
+
+func (_c *RuleService_DetectDrift_Call) Run(run func(_a0 context.Context, _a1 uint64)) *RuleService_DetectDrift_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *RuleService_DetectDrift_Call) Return(_a0 *rule.DriftReport, _a1 error) *RuleService_DetectDrift_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetRevision provides a mock function with given fields: _a0, _a1
+func (_m *RuleService) GetRevision(_a0 context.Context, _a1 uint64) (*rule.Revision, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *rule.Revision
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *rule.Revision); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rule.Revision)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleService_GetRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevision'
+type RuleService_GetRevision_Call struct {
+	*mock.Call
+}
+
+// GetRevision is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uint64
+func (_e *RuleService_Expecter) GetRevision(_a0 interface{}, _a1 interface{}) *RuleService_GetRevision_Call {
+	return &RuleService_GetRevision_Call{Call: _e.mock.On("GetRevision", _a0, _a1)}
+}
+
+func (_c *RuleService_GetRevision_Call) Run(run func(_a0 context.Context, _a1 uint64)) *RuleService_GetRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *RuleService_GetRevision_Call) Return(_a0 *rule.Revision, _a1 error) *RuleService_GetRevision_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// IsProviderSupported provides a mock function with given fields: _a0
+func (_m *RuleService) IsProviderSupported(_a0 string) bool {
+	ret := _m.Called(_a0)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// RuleService_IsProviderSupported_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsProviderSupported'
+type RuleService_IsProviderSupported_Call struct {
+	*mock.Call
+}
+
+// IsProviderSupported is a helper method to define mock.On call
+//   - _a0 string
+func (_e *RuleService_Expecter) IsProviderSupported(_a0 interface{}) *RuleService_IsProviderSupported_Call {
+	return &RuleService_IsProviderSupported_Call{Call: _e.mock.On("IsProviderSupported", _a0)}
+}
+
+func (_c *RuleService_IsProviderSupported_Call) Run(run func(_a0 string)) *RuleService_IsProviderSupported_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *RuleService_IsProviderSupported_Call) Return(_a0 bool) *RuleService_IsProviderSupported_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 // List provides a mock function with given fields: _a0, _a1
 func (_m *RuleService) List(_a0 context.Context, _a1 rule.Filter) ([]rule.Rule, error) {
 	ret := _m.Called(_a0, _a1)
@@ -69,6 +238,242 @@ func (_c *RuleService_List_Call) Return(_a0 []rule.Rule, _a1 error) *RuleService
 	return _c
 }
 
+// ListRevisions provides a mock function with given fields: _a0, _a1
+func (_m *RuleService) ListRevisions(_a0 context.Context, _a1 uint64) ([]rule.Revision, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []rule.Revision
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []rule.Revision); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]rule.Revision)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleService_ListRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRevisions'
+type RuleService_ListRevisions_Call struct {
+	*mock.Call
+}
+
+// ListRevisions is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uint64
+func (_e *RuleService_Expecter) ListRevisions(_a0 interface{}, _a1 interface{}) *RuleService_ListRevisions_Call {
+	return &RuleService_ListRevisions_Call{Call: _e.mock.On("ListRevisions", _a0, _a1)}
+}
+
+func (_c *RuleService_ListRevisions_Call) Run(run func(_a0 context.Context, _a1 uint64)) *RuleService_ListRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *RuleService_ListRevisions_Call) Return(_a0 []rule.Revision, _a1 error) *RuleService_ListRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// Preview provides a mock function with given fields: _a0, _a1
+func (_m *RuleService) Preview(_a0 context.Context, _a1 *rule.Rule) (*rule.Preview, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *rule.Preview
+	if rf, ok := ret.Get(0).(func(context.Context, *rule.Rule) *rule.Preview); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rule.Preview)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rule.Rule) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleService_Preview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Preview'
+type RuleService_Preview_Call struct {
+	*mock.Call
+}
+
+// Preview is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rule.Rule
+func (_e *RuleService_Expecter) Preview(_a0 interface{}, _a1 interface{}) *RuleService_Preview_Call {
+	return &RuleService_Preview_Call{Call: _e.mock.On("Preview", _a0, _a1)}
+}
+
+func (_c *RuleService_Preview_Call) Run(run func(_a0 context.Context, _a1 *rule.Rule)) *RuleService_Preview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rule.Rule))
+	})
+	return _c
+}
+
+func (_c *RuleService_Preview_Call) Return(_a0 *rule.Preview, _a1 error) *RuleService_Preview_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// Reconcile provides a mock function with given fields: _a0, _a1
+func (_m *RuleService) Reconcile(_a0 context.Context, _a1 uint64) (*rule.DriftReport, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *rule.DriftReport
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *rule.DriftReport); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rule.DriftReport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleService_Reconcile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reconcile'
+type RuleService_Reconcile_Call struct {
+	*mock.Call
+}
+
+// Reconcile is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uint64
+func (_e *RuleService_Expecter) Reconcile(_a0 interface{}, _a1 interface{}) *RuleService_Reconcile_Call {
+	return &RuleService_Reconcile_Call{Call: _e.mock.On("Reconcile", _a0, _a1)}
+}
+
+func (_c *RuleService_Reconcile_Call) Run(run func(_a0 context.Context, _a1 uint64)) *RuleService_Reconcile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *RuleService_Reconcile_Call) Return(_a0 *rule.DriftReport, _a1 error) *RuleService_Reconcile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// Rollback provides a mock function with given fields: _a0, _a1
+func (_m *RuleService) Rollback(_a0 context.Context, _a1 uint64) (*rule.Rule, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *rule.Rule
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *rule.Rule); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rule.Rule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleService_Rollback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rollback'
+type RuleService_Rollback_Call struct {
+	*mock.Call
+}
+
+// Rollback is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uint64
+func (_e *RuleService_Expecter) Rollback(_a0 interface{}, _a1 interface{}) *RuleService_Rollback_Call {
+	return &RuleService_Rollback_Call{Call: _e.mock.On("Rollback", _a0, _a1)}
+}
+
+func (_c *RuleService_Rollback_Call) Run(run func(_a0 context.Context, _a1 uint64)) *RuleService_Rollback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *RuleService_Rollback_Call) Return(_a0 *rule.Rule, _a1 error) *RuleService_Rollback_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// SyncByTemplate provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RuleService) SyncByTemplate(_a0 context.Context, _a1 string, _a2 []uint64) ([]rule.SyncResult, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []rule.SyncResult
+	if rf, ok := ret.Get(0).(func(context.Context, string, []uint64) []rule.SyncResult); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]rule.SyncResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []uint64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleService_SyncByTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncByTemplate'
+type RuleService_SyncByTemplate_Call struct {
+	*mock.Call
+}
+
+// SyncByTemplate is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 string
+//   - _a2 []uint64
+func (_e *RuleService_Expecter) SyncByTemplate(_a0 interface{}, _a1 interface{}, _a2 interface{}) *RuleService_SyncByTemplate_Call {
+	return &RuleService_SyncByTemplate_Call{Call: _e.mock.On("SyncByTemplate", _a0, _a1, _a2)}
+}
+
+func (_c *RuleService_SyncByTemplate_Call) Run(run func(_a0 context.Context, _a1 string, _a2 []uint64)) *RuleService_SyncByTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]uint64))
+	})
+	return _c
+}
+
+func (_c *RuleService_SyncByTemplate_Call) Return(_a0 []rule.SyncResult, _a1 error) *RuleService_SyncByTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // Upsert provides a mock function with given fields: _a0, _a1
 func (_m *RuleService) Upsert(_a0 context.Context, _a1 *rule.Rule) error {
 	ret := _m.Called(_a0, _a1)
@@ -105,6 +105,43 @@ func (_c *SilenceService_Delete_Call) Return(_a0 error) *SilenceService_Delete_C
 	return _c
 }
 
+// ExpireLapsed provides a mock function with given fields: ctx
+func (_m *SilenceService) ExpireLapsed(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SilenceService_ExpireLapsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireLapsed'
+type SilenceService_ExpireLapsed_Call struct {
+	*mock.Call
+}
+
+// ExpireLapsed is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SilenceService_Expecter) ExpireLapsed(ctx interface{}) *SilenceService_ExpireLapsed_Call {
+	return &SilenceService_ExpireLapsed_Call{Call: _e.mock.On("ExpireLapsed", ctx)}
+}
+
+func (_c *SilenceService_ExpireLapsed_Call) Run(run func(ctx context.Context)) *SilenceService_ExpireLapsed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SilenceService_ExpireLapsed_Call) Return(_a0 error) *SilenceService_ExpireLapsed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 // Get provides a mock function with given fields: ctx, id
 func (_m *SilenceService) Get(ctx context.Context, id string) (silence.Silence, error) {
 	ret := _m.Called(ctx, id)
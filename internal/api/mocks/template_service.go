@@ -107,6 +107,53 @@ func (_c *TemplateService_GetByName_Call) Return(_a0 *template.Template, _a1 err
 	return _c
 }
 
+// GetRevision provides a mock function with given fields: _a0, _a1
+func (_m *TemplateService) GetRevision(_a0 context.Context, _a1 uint64) (*template.Revision, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *template.Revision
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *template.Revision); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*template.Revision)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TemplateService_GetRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevision'
+type TemplateService_GetRevision_Call struct {
+	*mock.Call
+}
+
+// GetRevision is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uint64
+func (_e *TemplateService_Expecter) GetRevision(_a0 interface{}, _a1 interface{}) *TemplateService_GetRevision_Call {
+	return &TemplateService_GetRevision_Call{Call: _e.mock.On("GetRevision", _a0, _a1)}
+}
+
+func (_c *TemplateService_GetRevision_Call) Run(run func(_a0 context.Context, _a1 uint64)) *TemplateService_GetRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *TemplateService_GetRevision_Call) Return(_a0 *template.Revision, _a1 error) *TemplateService_GetRevision_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // List provides a mock function with given fields: _a0, _a1
 func (_m *TemplateService) List(_a0 context.Context, _a1 template.Filter) ([]template.Template, error) {
 	ret := _m.Called(_a0, _a1)
@@ -154,6 +201,53 @@ func (_c *TemplateService_List_Call) Return(_a0 []template.Template, _a1 error)
 	return _c
 }
 
+// ListRevisions provides a mock function with given fields: _a0, _a1
+func (_m *TemplateService) ListRevisions(_a0 context.Context, _a1 string) ([]template.Revision, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []template.Revision
+	if rf, ok := ret.Get(0).(func(context.Context, string) []template.Revision); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]template.Revision)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TemplateService_ListRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRevisions'
+type TemplateService_ListRevisions_Call struct {
+	*mock.Call
+}
+
+// ListRevisions is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 string
+func (_e *TemplateService_Expecter) ListRevisions(_a0 interface{}, _a1 interface{}) *TemplateService_ListRevisions_Call {
+	return &TemplateService_ListRevisions_Call{Call: _e.mock.On("ListRevisions", _a0, _a1)}
+}
+
+func (_c *TemplateService_ListRevisions_Call) Run(run func(_a0 context.Context, _a1 string)) *TemplateService_ListRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TemplateService_ListRevisions_Call) Return(_a0 []template.Revision, _a1 error) *TemplateService_ListRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // Render provides a mock function with given fields: _a0, _a1, _a2
 func (_m *TemplateService) Render(_a0 context.Context, _a1 string, _a2 map[string]string) (string, error) {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -200,6 +294,53 @@ func (_c *TemplateService_Render_Call) Return(_a0 string, _a1 error) *TemplateSe
 	return _c
 }
 
+// Rollback provides a mock function with given fields: _a0, _a1
+func (_m *TemplateService) Rollback(_a0 context.Context, _a1 uint64) (*template.Template, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *template.Template
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *template.Template); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*template.Template)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TemplateService_Rollback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rollback'
+type TemplateService_Rollback_Call struct {
+	*mock.Call
+}
+
+// Rollback is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uint64
+func (_e *TemplateService_Expecter) Rollback(_a0 interface{}, _a1 interface{}) *TemplateService_Rollback_Call {
+	return &TemplateService_Rollback_Call{Call: _e.mock.On("Rollback", _a0, _a1)}
+}
+
+func (_c *TemplateService_Rollback_Call) Run(run func(_a0 context.Context, _a1 uint64)) *TemplateService_Rollback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *TemplateService_Rollback_Call) Return(_a0 *template.Template, _a1 error) *TemplateService_Rollback_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // Upsert provides a mock function with given fields: _a0, _a1
 func (_m *TemplateService) Upsert(_a0 context.Context, _a1 *template.Template) error {
 	ret := _m.Called(_a0, _a1)
@@ -0,0 +1,130 @@
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	"github.com/odpf/siren/core/notification"
+	"github.com/odpf/siren/plugins/queues"
+	sirenv1beta1 "github.com/odpf/siren/proto/odpf/siren/v1beta1"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *GRPCServer) ListMessages(ctx context.Context, req *sirenv1beta1.ListMessagesRequest) (*sirenv1beta1.ListMessagesResponse, error) {
+	messages, err := s.notificationService.ListMessages(ctx, queues.FilterMessage{
+		Status:       req.GetStatus(),
+		ReceiverType: req.GetReceiverType(),
+		StartTime:    int64(req.GetStartTime()),
+		EndTime:      int64(req.GetEndTime()),
+		Error:        req.GetError(),
+		Limit:        req.GetLimit(),
+	})
+	if err != nil {
+		return nil, s.generateRPCErr(err)
+	}
+
+	var messagesProto []*sirenv1beta1.NotificationMessage
+	for _, msg := range messages {
+		msgProto, err := notificationMessageToProto(msg)
+		if err != nil {
+			return nil, s.generateRPCErr(err)
+		}
+		messagesProto = append(messagesProto, msgProto)
+	}
+
+	return &sirenv1beta1.ListMessagesResponse{
+		Messages: messagesProto,
+	}, nil
+}
+
+func (s *GRPCServer) GetMessage(ctx context.Context, req *sirenv1beta1.GetMessageRequest) (*sirenv1beta1.GetMessageResponse, error) {
+	msg, err := s.notificationService.GetMessage(ctx, req.GetId())
+	if err != nil {
+		return nil, s.generateRPCErr(err)
+	}
+
+	msgProto, err := notificationMessageToProto(msg)
+	if err != nil {
+		return nil, s.generateRPCErr(err)
+	}
+
+	return &sirenv1beta1.GetMessageResponse{
+		Message: msgProto,
+	}, nil
+}
+
+func (s *GRPCServer) RequeueMessages(ctx context.Context, req *sirenv1beta1.RequeueMessagesRequest) (*sirenv1beta1.RequeueMessagesResponse, error) {
+	count, err := s.notificationService.RequeueMessages(ctx, req.GetIds()...)
+	if err != nil {
+		return nil, s.generateRPCErr(err)
+	}
+
+	return &sirenv1beta1.RequeueMessagesResponse{
+		Count: uint64(count),
+	}, nil
+}
+
+func (s *GRPCServer) ReplayMessages(ctx context.Context, req *sirenv1beta1.ReplayMessagesRequest) (*sirenv1beta1.ReplayMessagesResponse, error) {
+	ids, err := s.notificationService.ReplayMessages(ctx, req.GetIds()...)
+	if err != nil {
+		return nil, s.generateRPCErr(err)
+	}
+
+	return &sirenv1beta1.ReplayMessagesResponse{
+		Ids: ids,
+	}, nil
+}
+
+func (s *GRPCServer) DiscardMessages(ctx context.Context, req *sirenv1beta1.DiscardMessagesRequest) (*sirenv1beta1.DiscardMessagesResponse, error) {
+	count, err := s.notificationService.DiscardMessages(ctx, req.GetIds()...)
+	if err != nil {
+		return nil, s.generateRPCErr(err)
+	}
+
+	return &sirenv1beta1.DiscardMessagesResponse{
+		Count: uint64(count),
+	}, nil
+}
+
+// notificationMessageToProto leaves out the receiver configs since they could contain secrets
+func notificationMessageToProto(msg notification.Message) (*sirenv1beta1.NotificationMessage, error) {
+	details, err := structpb.NewStruct(msg.Details)
+	if err != nil {
+		return nil, err
+	}
+
+	var attempts []*sirenv1beta1.NotificationMessageAttempt
+	for _, at := range msg.Attempts {
+		attempts = append(attempts, &sirenv1beta1.NotificationMessageAttempt{
+			TryCount: uint64(at.TryCount),
+			Status:   string(at.Status),
+			Error:    at.Error,
+			At:       timestamppb.New(at.At),
+		})
+	}
+
+	return &sirenv1beta1.NotificationMessage{
+		Id:            msg.ID,
+		Status:        string(msg.Status),
+		ReceiverType:  msg.ReceiverType,
+		Details:       details,
+		LastError:     msg.LastError,
+		MaxTries:      uint64(msg.MaxTries),
+		TryCount:      uint64(msg.TryCount),
+		Retryable:     msg.Retryable,
+		ExternalId:    msg.ExternalID,
+		Attempts:      attempts,
+		ExpiredAt:     optionalTimestampToProto(msg.ExpiredAt),
+		NextAttemptAt: optionalTimestampToProto(msg.NextAttemptAt),
+		CreatedAt:     timestamppb.New(msg.CreatedAt),
+		UpdatedAt:     timestamppb.New(msg.UpdatedAt),
+	}, nil
+}
+
+func optionalTimestampToProto(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
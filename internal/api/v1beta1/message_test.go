@@ -0,0 +1,176 @@
+package v1beta1_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/odpf/salt/log"
+	"github.com/odpf/siren/core/notification"
+	"github.com/odpf/siren/internal/api"
+	"github.com/odpf/siren/internal/api/mocks"
+	"github.com/odpf/siren/internal/api/v1beta1"
+	"github.com/odpf/siren/pkg/errors"
+	"github.com/odpf/siren/plugins/queues"
+	sirenv1beta1 "github.com/odpf/siren/proto/odpf/siren/v1beta1"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGRPCServer_GetMessage(t *testing.T) {
+	var (
+		createdAt = time.Date(2022, 1, 1, 1, 0, 0, 0, time.UTC)
+		triedAt   = time.Date(2022, 1, 1, 1, 5, 0, 0, time.UTC)
+	)
+	tests := []struct {
+		name    string
+		setup   func(*mocks.NotificationService)
+		want    *sirenv1beta1.GetMessageResponse
+		wantErr string
+	}{
+		{
+			name: "return message with try history without receiver configs",
+			setup: func(ns *mocks.NotificationService) {
+				ns.EXPECT().GetMessage(mock.AnythingOfType("*context.emptyCtx"), "1").Return(notification.Message{
+					ID:           "1",
+					Status:       notification.MessageStatusFailed,
+					ReceiverType: "slack",
+					Configs:      map[string]interface{}{"token": "secret"},
+					Details:      map[string]interface{}{"title": "alert"},
+					LastError:    "timeout",
+					MaxTries:     3,
+					TryCount:     1,
+					Retryable:    true,
+					Attempts: []notification.MessageAttempt{
+						{TryCount: 1, Status: notification.MessageStatusFailed, Error: "timeout", At: triedAt},
+					},
+					CreatedAt: createdAt,
+					UpdatedAt: triedAt,
+				}, nil)
+			},
+			want: &sirenv1beta1.GetMessageResponse{
+				Message: &sirenv1beta1.NotificationMessage{
+					Id:           "1",
+					Status:       "failed",
+					ReceiverType: "slack",
+					Details: &structpb.Struct{
+						Fields: map[string]*structpb.Value{
+							"title": structpb.NewStringValue("alert"),
+						},
+					},
+					LastError: "timeout",
+					MaxTries:  3,
+					TryCount:  1,
+					Retryable: true,
+					Attempts: []*sirenv1beta1.NotificationMessageAttempt{
+						{TryCount: 1, Status: "failed", Error: "timeout", At: timestamppb.New(triedAt)},
+					},
+					CreatedAt: timestamppb.New(createdAt),
+					UpdatedAt: timestamppb.New(triedAt),
+				},
+			},
+		},
+		{
+			name: "return not found error if message not found",
+			setup: func(ns *mocks.NotificationService) {
+				ns.EXPECT().GetMessage(mock.AnythingOfType("*context.emptyCtx"), "1").Return(notification.Message{}, errors.ErrNotFound.WithMsgf("message with id \"1\" not found"))
+			},
+			wantErr: "rpc error: code = NotFound desc = message with id \"1\" not found",
+		},
+	}
+	for _, tt := range tests {
+		ctx := context.TODO()
+		t.Run(tt.name, func(t *testing.T) {
+			mockNotificationService := new(mocks.NotificationService)
+
+			if tt.setup != nil {
+				tt.setup(mockNotificationService)
+			}
+
+			s := v1beta1.NewGRPCServer(nil, log.NewNoop(), api.HeadersConfig{}, &api.Deps{NotificationService: mockNotificationService})
+			got, err := s.GetMessage(ctx, &sirenv1beta1.GetMessageRequest{Id: "1"})
+			if err != nil {
+				if err.Error() != tt.wantErr {
+					t.Fatalf("GRPCServer.GetMessage() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if diff := cmp.Diff(got, tt.want, protocmp.Transform()); diff != "" {
+				t.Errorf("GRPCServer.GetMessage() diff = %v", diff)
+			}
+		})
+	}
+}
+
+func TestGRPCServer_ListMessages(t *testing.T) {
+	t.Run("should pass filter to service and return invalid argument error if queue does not support inspection", func(t *testing.T) {
+		mockNotificationService := new(mocks.NotificationService)
+		mockNotificationService.EXPECT().ListMessages(mock.AnythingOfType("*context.emptyCtx"), queues.FilterMessage{
+			Status:       "failed",
+			ReceiverType: "slack",
+			StartTime:    100,
+			EndTime:      200,
+			Error:        "timeout",
+			Limit:        10,
+		}).Return(nil, errors.ErrInvalid.WithMsgf("message inspection is not supported by inmemory queue"))
+
+		s := v1beta1.NewGRPCServer(nil, log.NewNoop(), api.HeadersConfig{}, &api.Deps{NotificationService: mockNotificationService})
+		_, err := s.ListMessages(context.TODO(), &sirenv1beta1.ListMessagesRequest{
+			Status:       "failed",
+			ReceiverType: "slack",
+			StartTime:    100,
+			EndTime:      200,
+			Error:        "timeout",
+			Limit:        10,
+		})
+		if err == nil || err.Error() != "rpc error: code = InvalidArgument desc = message inspection is not supported by inmemory queue" {
+			t.Errorf("GRPCServer.ListMessages() error = %v", err)
+		}
+	})
+}
+
+func TestGRPCServer_RequeueMessages(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(*mocks.NotificationService)
+		want    *sirenv1beta1.RequeueMessagesResponse
+		wantErr bool
+	}{
+		{
+			name: "return number of requeued messages",
+			setup: func(ns *mocks.NotificationService) {
+				ns.EXPECT().RequeueMessages(mock.AnythingOfType("*context.emptyCtx"), "1", "2").Return(1, nil)
+			},
+			want: &sirenv1beta1.RequeueMessagesResponse{Count: 1},
+		},
+		{
+			name: "return error if service requeue return error",
+			setup: func(ns *mocks.NotificationService) {
+				ns.EXPECT().RequeueMessages(mock.AnythingOfType("*context.emptyCtx"), "1", "2").Return(0, errors.New("some error"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		ctx := context.TODO()
+		t.Run(tt.name, func(t *testing.T) {
+			mockNotificationService := new(mocks.NotificationService)
+
+			if tt.setup != nil {
+				tt.setup(mockNotificationService)
+			}
+
+			s := v1beta1.NewGRPCServer(nil, log.NewNoop(), api.HeadersConfig{}, &api.Deps{NotificationService: mockNotificationService})
+			got, err := s.RequeueMessages(ctx, &sirenv1beta1.RequeueMessagesRequest{Ids: []string{"1", "2"}})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GRPCServer.RequeueMessages() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(got, tt.want, protocmp.Transform()); diff != "" {
+				t.Errorf("GRPCServer.RequeueMessages() diff = %v", diff)
+			}
+		})
+	}
+}
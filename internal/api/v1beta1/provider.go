@@ -26,6 +26,11 @@ func (s *GRPCServer) ListProviders(ctx context.Context, req *sirenv1beta1.ListPr
 			return nil, s.generateRPCErr(fmt.Errorf("failed to fetch provider credentials: %w", err))
 		}
 
+		config, err := structpb.NewStruct(provider.Config)
+		if err != nil {
+			return nil, s.generateRPCErr(fmt.Errorf("failed to fetch provider config: %w", err))
+		}
+
 		item := &sirenv1beta1.Provider{
 			Id:          provider.ID,
 			Urn:         provider.URN,
@@ -34,6 +39,7 @@ func (s *GRPCServer) ListProviders(ctx context.Context, req *sirenv1beta1.ListPr
 			Name:        provider.Name,
 			Credentials: credentials,
 			Labels:      provider.Labels,
+			Config:      config,
 			CreatedAt:   timestamppb.New(provider.CreatedAt),
 			UpdatedAt:   timestamppb.New(provider.UpdatedAt),
 		}
@@ -52,6 +58,7 @@ func (s *GRPCServer) CreateProvider(ctx context.Context, req *sirenv1beta1.Creat
 		Type:        req.GetType(),
 		Credentials: req.GetCredentials().AsMap(),
 		Labels:      req.GetLabels(),
+		Config:      req.GetConfig().AsMap(),
 	}
 
 	if err := s.providerService.Create(ctx, prv); err != nil {
@@ -74,6 +81,11 @@ func (s *GRPCServer) GetProvider(ctx context.Context, req *sirenv1beta1.GetProvi
 		return nil, s.generateRPCErr(fmt.Errorf("failed to fetch provider credentials: %w", err))
 	}
 
+	grpcConfig, err := structpb.NewStruct(fetchedProvider.Config)
+	if err != nil {
+		return nil, s.generateRPCErr(fmt.Errorf("failed to fetch provider config: %w", err))
+	}
+
 	return &sirenv1beta1.GetProviderResponse{
 		Provider: &sirenv1beta1.Provider{
 			Id:          fetchedProvider.ID,
@@ -83,6 +95,7 @@ func (s *GRPCServer) GetProvider(ctx context.Context, req *sirenv1beta1.GetProvi
 			Type:        fetchedProvider.Type,
 			Credentials: grpcCredentials,
 			Labels:      fetchedProvider.Labels,
+			Config:      grpcConfig,
 			CreatedAt:   timestamppb.New(fetchedProvider.CreatedAt),
 			UpdatedAt:   timestamppb.New(fetchedProvider.UpdatedAt),
 		},
@@ -97,6 +110,7 @@ func (s *GRPCServer) UpdateProvider(ctx context.Context, req *sirenv1beta1.Updat
 		Type:        req.GetType(),
 		Credentials: req.GetCredentials().AsMap(),
 		Labels:      req.GetLabels(),
+		Config:      req.GetConfig().AsMap(),
 	}
 
 	if err := s.providerService.Update(ctx, prv); err != nil {
@@ -95,6 +95,8 @@ func TestGRPCServer_CreateProvider(t *testing.T) {
 
 	testID := uint64(88)
 	credentialsData, _ := structpb.NewStruct(credentials)
+	config := map[string]interface{}{"mapping": map[string]interface{}{"resource": "alert.resource"}}
+	configData, _ := structpb.NewStruct(config)
 
 	payload := &provider.Provider{
 		Host:        "foo",
@@ -102,6 +104,7 @@ func TestGRPCServer_CreateProvider(t *testing.T) {
 		Name:        "foo",
 		Credentials: credentials,
 		Labels:      labels,
+		Config:      config,
 	}
 
 	dummyReq := &sirenv1beta1.CreateProviderRequest{
@@ -110,6 +113,7 @@ func TestGRPCServer_CreateProvider(t *testing.T) {
 		Name:        "foo",
 		Credentials: credentialsData,
 		Labels:      labels,
+		Config:      configData,
 	}
 
 	t.Run("should create provider object", func(t *testing.T) {
@@ -258,6 +262,8 @@ func TestGRPCServer_UpdateProvider(t *testing.T) {
 
 	testID := uint64(88)
 	credentialsData, _ := structpb.NewStruct(credentials)
+	config := map[string]interface{}{"mapping": map[string]interface{}{"resource": "alert.resource"}}
+	configData, _ := structpb.NewStruct(config)
 
 	payload := &provider.Provider{
 		Host:        "foo",
@@ -265,6 +271,7 @@ func TestGRPCServer_UpdateProvider(t *testing.T) {
 		Name:        "foo",
 		Credentials: credentials,
 		Labels:      labels,
+		Config:      config,
 	}
 
 	dummyReq := &sirenv1beta1.UpdateProviderRequest{
@@ -273,6 +280,7 @@ func TestGRPCServer_UpdateProvider(t *testing.T) {
 		Name:        "foo",
 		Credentials: credentialsData,
 		Labels:      labels,
+		Config:      configData,
 	}
 
 	t.Run("should update provider object", func(t *testing.T) {
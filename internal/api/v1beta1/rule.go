@@ -72,7 +72,7 @@ func (s *GRPCServer) UpdateRule(ctx context.Context, req *sirenv1beta1.UpdateRul
 		Variables:         variables,
 	}
 
-	if err := s.ruleService.Upsert(ctx, rl); err != nil {
+	if err := s.ruleService.Upsert(s.withActor(ctx), rl); err != nil {
 		return nil, s.generateRPCErr(err)
 	}
 
@@ -102,3 +102,176 @@ func (s *GRPCServer) UpdateRule(ctx context.Context, req *sirenv1beta1.UpdateRul
 	}
 	return res, nil
 }
+
+func (s *GRPCServer) PreviewRule(ctx context.Context, req *sirenv1beta1.PreviewRuleRequest) (*sirenv1beta1.PreviewRuleResponse, error) {
+	variables := make([]rule.RuleVariable, 0)
+	for _, variable := range req.Variables {
+		variables = append(variables, rule.RuleVariable{
+			Name:        variable.Name,
+			Type:        variable.Type,
+			Value:       variable.Value,
+			Description: variable.Description,
+		})
+	}
+
+	rl := &rule.Rule{
+		Enabled:           req.GetEnabled(),
+		GroupName:         req.GetGroupName(),
+		Namespace:         req.GetNamespace(),
+		Template:          req.GetTemplate(),
+		ProviderNamespace: req.GetProviderNamespace(),
+		Variables:         variables,
+	}
+
+	preview, err := s.ruleService.Preview(ctx, rl)
+	if err != nil {
+		return nil, s.generateRPCErr(err)
+	}
+
+	responseVariables := make([]*sirenv1beta1.Variables, 0)
+	for _, variable := range rl.Variables {
+		responseVariables = append(responseVariables, &sirenv1beta1.Variables{
+			Name:        variable.Name,
+			Type:        variable.Type,
+			Value:       variable.Value,
+			Description: variable.Description,
+		})
+	}
+
+	return &sirenv1beta1.PreviewRuleResponse{
+		Rule: &sirenv1beta1.Rule{
+			Name:              rl.Name,
+			Enabled:           rl.Enabled,
+			GroupName:         rl.GroupName,
+			Namespace:         rl.Namespace,
+			Template:          rl.Template,
+			Variables:         responseVariables,
+			ProviderNamespace: rl.ProviderNamespace,
+		},
+		Current:  preview.Current,
+		Proposed: preview.Proposed,
+		Diff:     preview.Diff,
+	}, nil
+}
+
+func (s *GRPCServer) ListRuleRevisions(ctx context.Context, req *sirenv1beta1.ListRuleRevisionsRequest) (*sirenv1beta1.ListRuleRevisionsResponse, error) {
+	revisions, err := s.ruleService.ListRevisions(ctx, req.GetRuleId())
+	if err != nil {
+		return nil, s.generateRPCErr(err)
+	}
+
+	items := []*sirenv1beta1.RuleRevision{}
+	for _, rev := range revisions {
+		items = append(items, ruleRevisionToProto(rev))
+	}
+
+	return &sirenv1beta1.ListRuleRevisionsResponse{
+		Revisions: items,
+	}, nil
+}
+
+func (s *GRPCServer) GetRuleRevision(ctx context.Context, req *sirenv1beta1.GetRuleRevisionRequest) (*sirenv1beta1.GetRuleRevisionResponse, error) {
+	rev, err := s.ruleService.GetRevision(ctx, req.GetId())
+	if err != nil {
+		return nil, s.generateRPCErr(err)
+	}
+
+	return &sirenv1beta1.GetRuleRevisionResponse{
+		Revision: ruleRevisionToProto(*rev),
+	}, nil
+}
+
+func (s *GRPCServer) RollbackRule(ctx context.Context, req *sirenv1beta1.RollbackRuleRequest) (*sirenv1beta1.RollbackRuleResponse, error) {
+	rl, err := s.ruleService.Rollback(s.withActor(ctx), req.GetRevisionId())
+	if err != nil {
+		return nil, s.generateRPCErr(err)
+	}
+
+	variables := make([]*sirenv1beta1.Variables, 0)
+	for _, variable := range rl.Variables {
+		variables = append(variables, &sirenv1beta1.Variables{
+			Name:        variable.Name,
+			Type:        variable.Type,
+			Value:       variable.Value,
+			Description: variable.Description,
+		})
+	}
+
+	return &sirenv1beta1.RollbackRuleResponse{
+		Rule: &sirenv1beta1.Rule{
+			Id:                rl.ID,
+			Name:              rl.Name,
+			Enabled:           rl.Enabled,
+			GroupName:         rl.GroupName,
+			Namespace:         rl.Namespace,
+			Template:          rl.Template,
+			Variables:         variables,
+			ProviderNamespace: rl.ProviderNamespace,
+			CreatedAt:         timestamppb.New(rl.CreatedAt),
+			UpdatedAt:         timestamppb.New(rl.UpdatedAt),
+		},
+	}, nil
+}
+
+func ruleRevisionToProto(rev rule.Revision) *sirenv1beta1.RuleRevision {
+	variables := make([]*sirenv1beta1.Variables, 0)
+	for _, variable := range rev.Variables {
+		variables = append(variables, &sirenv1beta1.Variables{
+			Name:        variable.Name,
+			Type:        variable.Type,
+			Value:       variable.Value,
+			Description: variable.Description,
+		})
+	}
+
+	return &sirenv1beta1.RuleRevision{
+		Id:                rev.ID,
+		RuleId:            rev.RuleID,
+		Name:              rev.Name,
+		Enabled:           rev.Enabled,
+		GroupName:         rev.GroupName,
+		Namespace:         rev.Namespace,
+		Template:          rev.Template,
+		Variables:         variables,
+		ProviderNamespace: rev.ProviderNamespace,
+		Body:              rev.Body,
+		Deleted:           rev.Deleted,
+		Actor:             rev.Actor,
+		CreatedAt:         timestamppb.New(rev.CreatedAt),
+	}
+}
+
+func (s *GRPCServer) DeleteRule(ctx context.Context, req *sirenv1beta1.DeleteRuleRequest) (*sirenv1beta1.DeleteRuleResponse, error) {
+	if err := s.ruleService.Delete(s.withActor(ctx), req.GetId()); err != nil {
+		return nil, s.generateRPCErr(err)
+	}
+
+	return &sirenv1beta1.DeleteRuleResponse{}, nil
+}
+
+func (s *GRPCServer) GetRuleDrift(ctx context.Context, req *sirenv1beta1.GetRuleDriftRequest) (*sirenv1beta1.GetRuleDriftResponse, error) {
+	report, err := s.ruleService.DetectDrift(ctx, req.GetProviderNamespace())
+	if err != nil {
+		return nil, s.generateRPCErr(err)
+	}
+
+	drifts := make([]*sirenv1beta1.RuleDrift, 0, len(report.Drifts))
+	for _, d := range report.Drifts {
+		drifts = append(drifts, &sirenv1beta1.RuleDrift{
+			RuleId:     d.RuleID,
+			Namespace:  d.Namespace,
+			GroupName:  d.GroupName,
+			Name:       d.Name,
+			Status:     d.Status,
+			Expected:   d.Expected,
+			Actual:     d.Actual,
+			Reconciled: d.Reconciled,
+		})
+	}
+
+	return &sirenv1beta1.GetRuleDriftResponse{
+		ProviderNamespace: report.NamespaceID,
+		NamespaceUrn:      report.NamespaceURN,
+		Drifts:            drifts,
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/odpf/salt/log"
+	"github.com/odpf/siren/pkg/actor"
 	"github.com/odpf/siren/pkg/errors"
 
 	"github.com/odpf/siren/core/rule"
@@ -14,6 +15,8 @@ import (
 	"github.com/odpf/siren/internal/api/v1beta1"
 	sirenv1beta1 "github.com/odpf/siren/proto/odpf/siren/v1beta1"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestGRPCServer_ListRules(t *testing.T) {
@@ -166,3 +169,205 @@ func TestGRPCServer_UpdateRules(t *testing.T) {
 		assert.EqualError(t, err, "rpc error: code = Internal desc = some unexpected error occurred")
 	})
 }
+
+func TestGRPCServer_DeleteRule(t *testing.T) {
+	ruleID := uint64(10)
+	dummyReq := &sirenv1beta1.DeleteRuleRequest{
+		Id: ruleID,
+	}
+
+	t.Run("should delete rule", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := v1beta1.NewGRPCServer(nil, log.NewNoop(), api.HeadersConfig{}, &api.Deps{RuleService: mockedRuleService})
+
+		mockedRuleService.EXPECT().Delete(mock.AnythingOfType("*context.emptyCtx"), ruleID).Return(nil).Once()
+		res, err := dummyGRPCServer.DeleteRule(context.Background(), dummyReq)
+		assert.Nil(t, err)
+		assert.Equal(t, "", res.String())
+		mockedRuleService.AssertExpectations(t)
+	})
+
+	t.Run("should delete rule as the actor", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := v1beta1.NewGRPCServer(nil, log.NewNoop(), api.HeadersConfig{Actor: "X-Actor"}, &api.Deps{RuleService: mockedRuleService})
+
+		actorCtx := mock.MatchedBy(func(ctx context.Context) bool {
+			return actor.FromContext(ctx) == "user@odpf.io"
+		})
+		mockedRuleService.EXPECT().Delete(actorCtx, ruleID).Return(nil).Once()
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("X-Actor", "user@odpf.io"))
+		_, err := dummyGRPCServer.DeleteRule(ctx, dummyReq)
+		assert.Nil(t, err)
+		mockedRuleService.AssertExpectations(t)
+	})
+
+	t.Run("should return error NotFound if rule does not exist", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := v1beta1.NewGRPCServer(nil, log.NewNoop(), api.HeadersConfig{}, &api.Deps{RuleService: mockedRuleService})
+
+		mockedRuleService.EXPECT().Delete(mock.AnythingOfType("*context.emptyCtx"), ruleID).Return(errors.ErrNotFound).Once()
+		res, err := dummyGRPCServer.DeleteRule(context.Background(), dummyReq)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = requested entity not found")
+		mockedRuleService.AssertExpectations(t)
+	})
+
+	t.Run("should return error Internal if deleting rule failed", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := v1beta1.NewGRPCServer(nil, log.NewNoop(), api.HeadersConfig{}, &api.Deps{RuleService: mockedRuleService})
+
+		mockedRuleService.EXPECT().Delete(mock.AnythingOfType("*context.emptyCtx"), ruleID).Return(errors.New("random error")).Once()
+		res, err := dummyGRPCServer.DeleteRule(context.Background(), dummyReq)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = some unexpected error occurred")
+		mockedRuleService.AssertExpectations(t)
+	})
+}
+
+func TestGRPCServer_PreviewRule(t *testing.T) {
+	dummyReq := &sirenv1beta1.PreviewRuleRequest{
+		Enabled:           true,
+		GroupName:         "group",
+		Namespace:         "namespace",
+		Template:          "template",
+		ProviderNamespace: 1,
+		Variables: []*sirenv1beta1.Variables{
+			{Name: "for", Value: "10m"},
+		},
+	}
+
+	t.Run("should return preview of the rule", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := v1beta1.NewGRPCServer(nil, log.NewNoop(), api.HeadersConfig{}, &api.Deps{RuleService: mockedRuleService})
+
+		mockedRuleService.EXPECT().Preview(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*rule.Rule")).
+			Run(func(ctx context.Context, rl *rule.Rule) {
+				rl.Name = "siren_api_provider_namespace_namespace_group_template"
+			}).
+			Return(&rule.Preview{
+				Current:  "",
+				Proposed: "name: group\n",
+				Diff:     "--- a/namespace/group\n+++ b/namespace/group\n@@ -0,0 +1 @@\n+name: group\n",
+			}, nil).Once()
+		res, err := dummyGRPCServer.PreviewRule(context.Background(), dummyReq)
+		assert.Nil(t, err)
+		assert.Equal(t, "siren_api_provider_namespace_namespace_group_template", res.GetRule().GetName())
+		assert.Equal(t, "10m", res.GetRule().GetVariables()[0].GetValue())
+		assert.Equal(t, "name: group\n", res.GetProposed())
+		assert.Equal(t, "--- a/namespace/group\n+++ b/namespace/group\n@@ -0,0 +1 @@\n+name: group\n", res.GetDiff())
+		mockedRuleService.AssertExpectations(t)
+	})
+
+	t.Run("should return error InvalidArgument if rule is invalid", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := v1beta1.NewGRPCServer(nil, log.NewNoop(), api.HeadersConfig{}, &api.Deps{RuleService: mockedRuleService})
+
+		mockedRuleService.EXPECT().Preview(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*rule.Rule")).Return(nil, errors.ErrInvalid.WithMsgf("invalid rule")).Once()
+		res, err := dummyGRPCServer.PreviewRule(context.Background(), dummyReq)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid rule")
+		mockedRuleService.AssertExpectations(t)
+	})
+
+	t.Run("should return error Internal if previewing rule failed", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := v1beta1.NewGRPCServer(nil, log.NewNoop(), api.HeadersConfig{}, &api.Deps{RuleService: mockedRuleService})
+
+		mockedRuleService.EXPECT().Preview(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("*rule.Rule")).Return(nil, errors.New("random error")).Once()
+		res, err := dummyGRPCServer.PreviewRule(context.Background(), dummyReq)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = some unexpected error occurred")
+		mockedRuleService.AssertExpectations(t)
+	})
+}
+
+func TestGRPCServer_GetRuleDrift(t *testing.T) {
+	namespaceID := uint64(3)
+	dummyReq := &sirenv1beta1.GetRuleDriftRequest{
+		ProviderNamespace: namespaceID,
+	}
+
+	t.Run("should return drift report of the namespace", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := v1beta1.NewGRPCServer(nil, log.NewNoop(), api.HeadersConfig{}, &api.Deps{RuleService: mockedRuleService})
+
+		mockedRuleService.EXPECT().DetectDrift(mock.AnythingOfType("*context.emptyCtx"), namespaceID).Return(&rule.DriftReport{
+			NamespaceID:  namespaceID,
+			NamespaceURN: "odpf",
+			Drifts: []rule.Drift{
+				{
+					RuleID:    1,
+					Namespace: "system",
+					GroupName: "cpu",
+					Name:      "cpu high",
+					Status:    rule.DriftMissing,
+					Expected:  "alert: cpu high\n",
+				},
+			},
+		}, nil).Once()
+		res, err := dummyGRPCServer.GetRuleDrift(context.Background(), dummyReq)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(3), res.GetProviderNamespace())
+		assert.Equal(t, "odpf", res.GetNamespaceUrn())
+		assert.Equal(t, 1, len(res.GetDrifts()))
+		assert.Equal(t, uint64(1), res.GetDrifts()[0].GetRuleId())
+		assert.Equal(t, "missing", res.GetDrifts()[0].GetStatus())
+		assert.Equal(t, "alert: cpu high\n", res.GetDrifts()[0].GetExpected())
+		mockedRuleService.AssertExpectations(t)
+	})
+
+	t.Run("should return error NotFound if namespace does not exist", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := v1beta1.NewGRPCServer(nil, log.NewNoop(), api.HeadersConfig{}, &api.Deps{RuleService: mockedRuleService})
+
+		mockedRuleService.EXPECT().DetectDrift(mock.AnythingOfType("*context.emptyCtx"), namespaceID).Return(nil, errors.ErrNotFound).Once()
+		res, err := dummyGRPCServer.GetRuleDrift(context.Background(), dummyReq)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = requested entity not found")
+		mockedRuleService.AssertExpectations(t)
+	})
+}
+
+func TestGRPCServer_ListRuleRevisions(t *testing.T) {
+	t.Run("should return revisions of the rule", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := v1beta1.NewGRPCServer(nil, log.NewNoop(), api.HeadersConfig{}, &api.Deps{RuleService: mockedRuleService})
+
+		mockedRuleService.EXPECT().ListRevisions(mock.AnythingOfType("*context.emptyCtx"), uint64(10)).Return([]rule.Revision{
+			{ID: 2, RuleID: 10, Body: "- alert: foo\n", Variables: []rule.RuleVariable{{Name: "for", Value: "10m"}}, Actor: "user@odpf.io"},
+		}, nil).Once()
+		res, err := dummyGRPCServer.ListRuleRevisions(context.Background(), &sirenv1beta1.ListRuleRevisionsRequest{RuleId: 10})
+		assert.Nil(t, err)
+		assert.Len(t, res.GetRevisions(), 1)
+		assert.Equal(t, "10m", res.GetRevisions()[0].GetVariables()[0].GetValue())
+		assert.Equal(t, "user@odpf.io", res.GetRevisions()[0].GetActor())
+		mockedRuleService.AssertExpectations(t)
+	})
+}
+
+func TestGRPCServer_RollbackRule(t *testing.T) {
+	t.Run("should rollback rule to the revision", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := v1beta1.NewGRPCServer(nil, log.NewNoop(), api.HeadersConfig{}, &api.Deps{RuleService: mockedRuleService})
+
+		mockedRuleService.EXPECT().Rollback(mock.AnythingOfType("*context.emptyCtx"), uint64(2)).Return(&rule.Rule{
+			ID:        10,
+			GroupName: "group",
+		}, nil).Once()
+		res, err := dummyGRPCServer.RollbackRule(context.Background(), &sirenv1beta1.RollbackRuleRequest{RevisionId: 2})
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(10), res.GetRule().GetId())
+		mockedRuleService.AssertExpectations(t)
+	})
+
+	t.Run("should return error NotFound if revision does not exist", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := v1beta1.NewGRPCServer(nil, log.NewNoop(), api.HeadersConfig{}, &api.Deps{RuleService: mockedRuleService})
+
+		mockedRuleService.EXPECT().Rollback(mock.AnythingOfType("*context.emptyCtx"), uint64(2)).Return(nil, errors.ErrNotFound).Once()
+		res, err := dummyGRPCServer.RollbackRule(context.Background(), &sirenv1beta1.RollbackRuleRequest{RevisionId: 2})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = requested entity not found")
+		mockedRuleService.AssertExpectations(t)
+	})
+}
@@ -5,17 +5,36 @@ import (
 
 	"github.com/odpf/siren/core/silence"
 	sirenv1beta1 "github.com/odpf/siren/proto/odpf/siren/v1beta1"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *GRPCServer) CreateSilence(ctx context.Context, req *sirenv1beta1.CreateSilenceRequest) (*sirenv1beta1.CreateSilenceResponse, error) {
-	id, err := s.silenceService.Create(ctx, silence.Silence{
+	sil := silence.Silence{
 		NamespaceID:      req.GetNamespaceId(),
 		Type:             req.GetType(),
 		TargetID:         req.GetTargetId(),
 		TargetExpression: req.GetTargetExpression().AsMap(),
-	})
+	}
+
+	if req.GetStartsAt() != nil {
+		sil.StartsAt = req.GetStartsAt().AsTime()
+	}
+
+	if req.GetEndsAt() != nil {
+		sil.EndsAt = req.GetEndsAt().AsTime()
+	}
+
+	if req.GetSchedule() != nil {
+		sil.Schedule = silence.Schedule{
+			Cron:     req.GetSchedule().GetCron(),
+			Duration: req.GetSchedule().GetDuration().AsDuration(),
+			TimeZone: req.GetSchedule().GetTimeZone(),
+		}
+	}
+
+	id, err := s.silenceService.Create(ctx, sil)
 	if err != nil {
 		return nil, s.generateRPCErr(err)
 	}
@@ -49,6 +68,9 @@ func (s *GRPCServer) ListSilences(ctx context.Context, req *sirenv1beta1.ListSil
 			Type:             si.Type,
 			TargetId:         si.TargetID,
 			TargetExpression: targetExpression,
+			StartsAt:         timestamppb.New(si.StartsAt),
+			EndsAt:           timestamppb.New(si.EndsAt),
+			Schedule:         silenceScheduleToProto(si.Schedule),
 			CreatedAt:        timestamppb.New(si.CreatedAt),
 			DeletedAt:        timestamppb.New(si.DeletedAt),
 		})
@@ -77,6 +99,9 @@ func (s *GRPCServer) GetSilence(ctx context.Context, req *sirenv1beta1.GetSilenc
 			Type:             sil.Type,
 			TargetId:         sil.TargetID,
 			TargetExpression: targetExpression,
+			StartsAt:         timestamppb.New(sil.StartsAt),
+			EndsAt:           timestamppb.New(sil.EndsAt),
+			Schedule:         silenceScheduleToProto(sil.Schedule),
 			CreatedAt:        timestamppb.New(sil.CreatedAt),
 			DeletedAt:        timestamppb.New(sil.DeletedAt),
 		},
@@ -90,3 +115,14 @@ func (s *GRPCServer) ExpireSilence(ctx context.Context, req *sirenv1beta1.Expire
 
 	return &sirenv1beta1.ExpireSilenceResponse{}, nil
 }
+
+func silenceScheduleToProto(sc silence.Schedule) *sirenv1beta1.SilenceSchedule {
+	if sc.Cron == "" {
+		return nil
+	}
+	return &sirenv1beta1.SilenceSchedule{
+		Cron:     sc.Cron,
+		Duration: durationpb.New(sc.Duration),
+		TimeZone: sc.TimeZone,
+	}
+}
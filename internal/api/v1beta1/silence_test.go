@@ -16,6 +16,7 @@ import (
 	sirenv1beta1 "github.com/odpf/siren/proto/odpf/siren/v1beta1"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -54,6 +55,64 @@ func TestGRPCServer_CreateSilence(t *testing.T) {
 				Id: "123",
 			},
 		},
+		{
+			name: "return silence id when successfully created silence with window",
+			setup: func(ss *mocks.SilenceService) {
+				ss.EXPECT().Create(mock.AnythingOfType("*context.emptyCtx"), silence.Silence{
+					NamespaceID:      mockSilenceData.NamespaceID,
+					Type:             mockSilenceData.Type,
+					TargetExpression: mockSilenceData.TargetExpression,
+					StartsAt:         time.Date(2022, 1, 1, 1, 0, 0, 0, time.UTC),
+					EndsAt:           time.Date(2022, 1, 1, 3, 0, 0, 0, time.UTC),
+				}).Return("123", nil)
+			},
+			req: &sirenv1beta1.CreateSilenceRequest{
+				NamespaceId: mockSilenceData.NamespaceID,
+				Type:        mockSilenceData.Type,
+				TargetExpression: &structpb.Struct{
+					Fields: map[string]*structpb.Value{
+						"key1": structpb.NewStringValue("value1"),
+					},
+				},
+				StartsAt: timestamppb.New(time.Date(2022, 1, 1, 1, 0, 0, 0, time.UTC)),
+				EndsAt:   timestamppb.New(time.Date(2022, 1, 1, 3, 0, 0, 0, time.UTC)),
+			},
+			want: &sirenv1beta1.CreateSilenceResponse{
+				Id: "123",
+			},
+		},
+		{
+			name: "return silence id when successfully created recurring silence",
+			setup: func(ss *mocks.SilenceService) {
+				ss.EXPECT().Create(mock.AnythingOfType("*context.emptyCtx"), silence.Silence{
+					NamespaceID:      mockSilenceData.NamespaceID,
+					Type:             silence.TypeRecurring,
+					TargetExpression: mockSilenceData.TargetExpression,
+					Schedule: silence.Schedule{
+						Cron:     "0 2 * * 0",
+						Duration: 2 * time.Hour,
+						TimeZone: "Asia/Jakarta",
+					},
+				}).Return("123", nil)
+			},
+			req: &sirenv1beta1.CreateSilenceRequest{
+				NamespaceId: mockSilenceData.NamespaceID,
+				Type:        silence.TypeRecurring,
+				TargetExpression: &structpb.Struct{
+					Fields: map[string]*structpb.Value{
+						"key1": structpb.NewStringValue("value1"),
+					},
+				},
+				Schedule: &sirenv1beta1.SilenceSchedule{
+					Cron:     "0 2 * * 0",
+					Duration: durationpb.New(2 * time.Hour),
+					TimeZone: "Asia/Jakarta",
+				},
+			},
+			want: &sirenv1beta1.CreateSilenceResponse{
+				Id: "123",
+			},
+		},
 		{
 			name: "return error if service create return error",
 			setup: func(ss *mocks.SilenceService) {
@@ -124,6 +183,8 @@ func TestGRPCServer_ListSilences(t *testing.T) {
 							"key1": structpb.NewStringValue("value1"),
 						},
 					},
+					StartsAt:  timestamppb.New(time.Time{}),
+					EndsAt:    timestamppb.New(time.Time{}),
 					CreatedAt: timestamppb.New(time.Time{}),
 				},
 			},
@@ -192,7 +253,43 @@ func TestGRPCServer_GetSilence(t *testing.T) {
 						"key1": structpb.NewStringValue("value1"),
 					},
 				},
+				StartsAt:  timestamppb.New(time.Time{}),
+				EndsAt:    timestamppb.New(time.Time{}),
+				CreatedAt: timestamppb.New(time.Time{}),
+			},
+		},
+		{
+			name: "return silence with schedule when successfully get recurring silence",
+			setup: func(ss *mocks.SilenceService) {
+				ss.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), mockSilenceData.ID).Return(silence.Silence{
+					ID:               mockSilenceData.ID,
+					NamespaceID:      mockSilenceData.NamespaceID,
+					Type:             silence.TypeRecurring,
+					TargetExpression: mockSilenceData.TargetExpression,
+					Schedule: silence.Schedule{
+						Cron:     "0 2 * * 0",
+						Duration: 2 * time.Hour,
+					},
+				}, nil)
+			},
+			req: &sirenv1beta1.GetSilenceRequest{
+				Id: mockSilenceData.ID,
+			},
+			want: &sirenv1beta1.Silence{
+				NamespaceId: mockSilenceData.NamespaceID,
+				Type:        silence.TypeRecurring,
+				TargetExpression: &structpb.Struct{
+					Fields: map[string]*structpb.Value{
+						"key1": structpb.NewStringValue("value1"),
+					},
+				},
+				StartsAt:  timestamppb.New(time.Time{}),
+				EndsAt:    timestamppb.New(time.Time{}),
 				CreatedAt: timestamppb.New(time.Time{}),
+				Schedule: &sirenv1beta1.SilenceSchedule{
+					Cron:     "0 2 * * 0",
+					Duration: durationpb.New(2 * time.Hour),
+				},
 			},
 		},
 		{
@@ -3,6 +3,7 @@ package v1beta1
 import (
 	"context"
 
+	"github.com/odpf/siren/core/rule"
 	"github.com/odpf/siren/core/template"
 	sirenv1beta1 "github.com/odpf/siren/proto/odpf/siren/v1beta1"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -91,12 +92,39 @@ func (s *GRPCServer) UpsertTemplate(ctx context.Context, req *sirenv1beta1.Upser
 		Variables: variables,
 	}
 
+	ctx = s.withActor(ctx)
+
 	if err := s.templateService.Upsert(ctx, tmpl); err != nil {
 		return nil, s.generateRPCErr(err)
 	}
 
+	var ruleSyncResults []*sirenv1beta1.RuleSyncResult
+	if req.GetSyncRules() {
+		results, err := s.ruleService.SyncByTemplate(ctx, tmpl.Name, nil)
+		if err != nil {
+			return nil, s.generateRPCErr(err)
+		}
+		ruleSyncResults = ruleSyncResultsToProto(results)
+	}
+
 	return &sirenv1beta1.UpsertTemplateResponse{
-		Id: tmpl.ID,
+		Id:              tmpl.ID,
+		RuleSyncResults: ruleSyncResults,
+	}, nil
+}
+
+func (s *GRPCServer) SyncTemplateRules(ctx context.Context, req *sirenv1beta1.SyncTemplateRulesRequest) (*sirenv1beta1.SyncTemplateRulesResponse, error) {
+	if _, err := s.templateService.GetByName(ctx, req.GetName()); err != nil {
+		return nil, s.generateRPCErr(err)
+	}
+
+	results, err := s.ruleService.SyncByTemplate(s.withActor(ctx), req.GetName(), req.GetRuleIds())
+	if err != nil {
+		return nil, s.generateRPCErr(err)
+	}
+
+	return &sirenv1beta1.SyncTemplateRulesResponse{
+		RuleSyncResults: ruleSyncResultsToProto(results),
 	}, nil
 }
 
@@ -108,6 +136,112 @@ func (s *GRPCServer) DeleteTemplate(ctx context.Context, req *sirenv1beta1.Delet
 	return &sirenv1beta1.DeleteTemplateResponse{}, nil
 }
 
+func (s *GRPCServer) ListTemplateRevisions(ctx context.Context, req *sirenv1beta1.ListTemplateRevisionsRequest) (*sirenv1beta1.ListTemplateRevisionsResponse, error) {
+	revisions, err := s.templateService.ListRevisions(ctx, req.GetName())
+	if err != nil {
+		return nil, s.generateRPCErr(err)
+	}
+
+	items := []*sirenv1beta1.TemplateRevision{}
+	for _, rev := range revisions {
+		items = append(items, templateRevisionToProto(rev))
+	}
+
+	return &sirenv1beta1.ListTemplateRevisionsResponse{
+		Revisions: items,
+	}, nil
+}
+
+func (s *GRPCServer) GetTemplateRevision(ctx context.Context, req *sirenv1beta1.GetTemplateRevisionRequest) (*sirenv1beta1.GetTemplateRevisionResponse, error) {
+	rev, err := s.templateService.GetRevision(ctx, req.GetId())
+	if err != nil {
+		return nil, s.generateRPCErr(err)
+	}
+
+	return &sirenv1beta1.GetTemplateRevisionResponse{
+		Revision: templateRevisionToProto(*rev),
+	}, nil
+}
+
+// RollbackTemplate restores the template to the revision then re-uploads all rules of the template
+// so the providers are in line with the restored template
+func (s *GRPCServer) RollbackTemplate(ctx context.Context, req *sirenv1beta1.RollbackTemplateRequest) (*sirenv1beta1.RollbackTemplateResponse, error) {
+	ctx = s.withActor(ctx)
+
+	tmpl, err := s.templateService.Rollback(ctx, req.GetRevisionId())
+	if err != nil {
+		return nil, s.generateRPCErr(err)
+	}
+
+	results, err := s.ruleService.SyncByTemplate(ctx, tmpl.Name, nil)
+	if err != nil {
+		return nil, s.generateRPCErr(err)
+	}
+
+	variables := make([]*sirenv1beta1.TemplateVariables, 0)
+	for _, variable := range tmpl.Variables {
+		variables = append(variables, &sirenv1beta1.TemplateVariables{
+			Name:        variable.Name,
+			Type:        variable.Type,
+			Default:     variable.Default,
+			Description: variable.Description,
+		})
+	}
+
+	return &sirenv1beta1.RollbackTemplateResponse{
+		Template: &sirenv1beta1.Template{
+			Id:        tmpl.ID,
+			Name:      tmpl.Name,
+			Body:      tmpl.Body,
+			Tags:      tmpl.Tags,
+			CreatedAt: timestamppb.New(tmpl.CreatedAt),
+			UpdatedAt: timestamppb.New(tmpl.UpdatedAt),
+			Variables: variables,
+		},
+		RuleSyncResults: ruleSyncResultsToProto(results),
+	}, nil
+}
+
+func ruleSyncResultsToProto(results []rule.SyncResult) []*sirenv1beta1.RuleSyncResult {
+	items := make([]*sirenv1beta1.RuleSyncResult, 0, len(results))
+	for _, r := range results {
+		item := &sirenv1beta1.RuleSyncResult{
+			RuleId:    r.RuleID,
+			Name:      r.Name,
+			Namespace: r.Namespace,
+			Success:   r.Error == nil,
+		}
+		if r.Error != nil {
+			item.Error = r.Error.Error()
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+func templateRevisionToProto(rev template.Revision) *sirenv1beta1.TemplateRevision {
+	variables := make([]*sirenv1beta1.TemplateVariables, 0)
+	for _, variable := range rev.Variables {
+		variables = append(variables, &sirenv1beta1.TemplateVariables{
+			Name:        variable.Name,
+			Type:        variable.Type,
+			Default:     variable.Default,
+			Description: variable.Description,
+		})
+	}
+
+	return &sirenv1beta1.TemplateRevision{
+		Id:         rev.ID,
+		TemplateId: rev.TemplateID,
+		Name:       rev.Name,
+		Body:       rev.Body,
+		Tags:       rev.Tags,
+		Variables:  variables,
+		Actor:      rev.Actor,
+		CreatedAt:  timestamppb.New(rev.CreatedAt),
+	}
+}
+
 func (s *GRPCServer) RenderTemplate(ctx context.Context, req *sirenv1beta1.RenderTemplateRequest) (*sirenv1beta1.RenderTemplateResponse, error) {
 	body, err := s.templateService.Render(ctx, req.GetName(), req.GetVariables())
 	if err != nil {
@@ -5,14 +5,18 @@ import (
 	"testing"
 
 	"github.com/odpf/salt/log"
+	"github.com/odpf/siren/core/rule"
 	"github.com/odpf/siren/core/template"
 	"github.com/odpf/siren/internal/api"
 	"github.com/odpf/siren/internal/api/mocks"
 	"github.com/odpf/siren/internal/api/v1beta1"
+	"github.com/odpf/siren/pkg/actor"
 	"github.com/odpf/siren/pkg/errors"
 	sirenv1beta1 "github.com/odpf/siren/proto/odpf/siren/v1beta1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestGRPCServer_ListTemplates(t *testing.T) {
@@ -207,6 +211,27 @@ func TestGRPCServer_UpsertTemplate(t *testing.T) {
 		mockedTemplateService.AssertExpectations(t)
 	})
 
+	t.Run("should sync rules of the template if sync_rules is set", func(t *testing.T) {
+		mockedTemplateService := &mocks.TemplateService{}
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := v1beta1.NewGRPCServer(nil, log.NewNoop(), api.HeadersConfig{}, &api.Deps{TemplateService: mockedTemplateService, RuleService: mockedRuleService})
+		syncReq := proto.Clone(dummyReq).(*sirenv1beta1.UpsertTemplateRequest)
+		syncReq.SyncRules = true
+
+		mockedTemplateService.EXPECT().Upsert(mock.AnythingOfType("*context.emptyCtx"), tmpl).Return(nil).Once()
+		mockedRuleService.EXPECT().SyncByTemplate(mock.AnythingOfType("*context.emptyCtx"), "foo", []uint64(nil)).Return([]rule.SyncResult{
+			{RuleID: 2, Name: "rule-2", Namespace: "ns", Error: errors.New("cortex error")},
+		}, nil).Once()
+		res, err := dummyGRPCServer.UpsertTemplate(context.Background(), syncReq)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(2), res.GetRuleSyncResults()[0].GetRuleId())
+		assert.Equal(t, "ns", res.GetRuleSyncResults()[0].GetNamespace())
+		assert.False(t, res.GetRuleSyncResults()[0].GetSuccess())
+		assert.Equal(t, "cortex error", res.GetRuleSyncResults()[0].GetError())
+		mockedTemplateService.AssertExpectations(t)
+		mockedRuleService.AssertExpectations(t)
+	})
+
 	t.Run("should return error AlreadyExists if upsert template return err conflict", func(t *testing.T) {
 		mockedTemplateService := &mocks.TemplateService{}
 		dummyGRPCServer := v1beta1.NewGRPCServer(nil, log.NewNoop(), api.HeadersConfig{}, &api.Deps{TemplateService: mockedTemplateService})
@@ -290,3 +315,125 @@ func TestGRPCServer_RenderTemplate(t *testing.T) {
 		mockedTemplateService.AssertExpectations(t)
 	})
 }
+
+func TestGRPCServer_ListTemplateRevisions(t *testing.T) {
+	t.Run("should return revisions of the template", func(t *testing.T) {
+		mockedTemplateService := &mocks.TemplateService{}
+		dummyGRPCServer := v1beta1.NewGRPCServer(nil, log.NewNoop(), api.HeadersConfig{}, &api.Deps{TemplateService: mockedTemplateService})
+
+		mockedTemplateService.EXPECT().ListRevisions(mock.AnythingOfType("*context.emptyCtx"), "foo").Return([]template.Revision{
+			{ID: 2, TemplateID: 1, Name: "foo", Body: "new", Actor: "user@odpf.io"},
+			{ID: 1, TemplateID: 1, Name: "foo", Body: "old"},
+		}, nil).Once()
+		res, err := dummyGRPCServer.ListTemplateRevisions(context.Background(), &sirenv1beta1.ListTemplateRevisionsRequest{Name: "foo"})
+		assert.Nil(t, err)
+		assert.Len(t, res.GetRevisions(), 2)
+		assert.Equal(t, "new", res.GetRevisions()[0].GetBody())
+		assert.Equal(t, "user@odpf.io", res.GetRevisions()[0].GetActor())
+		mockedTemplateService.AssertExpectations(t)
+	})
+}
+
+func TestGRPCServer_GetTemplateRevision(t *testing.T) {
+	t.Run("should return error NotFound if revision does not exist", func(t *testing.T) {
+		mockedTemplateService := &mocks.TemplateService{}
+		dummyGRPCServer := v1beta1.NewGRPCServer(nil, log.NewNoop(), api.HeadersConfig{}, &api.Deps{TemplateService: mockedTemplateService})
+
+		mockedTemplateService.EXPECT().GetRevision(mock.AnythingOfType("*context.emptyCtx"), uint64(3)).Return(nil, errors.ErrNotFound).Once()
+		res, err := dummyGRPCServer.GetTemplateRevision(context.Background(), &sirenv1beta1.GetTemplateRevisionRequest{Id: 3})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = requested entity not found")
+		mockedTemplateService.AssertExpectations(t)
+	})
+}
+
+func TestGRPCServer_RollbackTemplate(t *testing.T) {
+	var (
+		headers = api.HeadersConfig{Actor: "X-Actor"}
+		ctx     = metadata.NewIncomingContext(context.Background(), metadata.Pairs("X-Actor", "user@odpf.io"))
+		tmpl    = &template.Template{ID: 1, Name: "foo", Body: "old"}
+	)
+
+	t.Run("should rollback template as the actor and sync rules of the template", func(t *testing.T) {
+		mockedTemplateService := &mocks.TemplateService{}
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := v1beta1.NewGRPCServer(nil, log.NewNoop(), headers, &api.Deps{TemplateService: mockedTemplateService, RuleService: mockedRuleService})
+
+		actorCtx := mock.MatchedBy(func(ctx context.Context) bool {
+			return actor.FromContext(ctx) == "user@odpf.io"
+		})
+		mockedTemplateService.EXPECT().Rollback(actorCtx, uint64(3)).Return(tmpl, nil).Once()
+		mockedRuleService.EXPECT().SyncByTemplate(actorCtx, "foo", []uint64(nil)).Return([]rule.SyncResult{
+			{RuleID: 1, Name: "rule-1"},
+			{RuleID: 2, Name: "rule-2", Error: errors.New("cortex error")},
+		}, nil).Once()
+		res, err := dummyGRPCServer.RollbackTemplate(ctx, &sirenv1beta1.RollbackTemplateRequest{RevisionId: 3})
+		assert.Nil(t, err)
+		assert.Equal(t, "old", res.GetTemplate().GetBody())
+		assert.Len(t, res.GetRuleSyncResults(), 2)
+		assert.True(t, res.GetRuleSyncResults()[0].GetSuccess())
+		assert.False(t, res.GetRuleSyncResults()[1].GetSuccess())
+		assert.Equal(t, "cortex error", res.GetRuleSyncResults()[1].GetError())
+		mockedTemplateService.AssertExpectations(t)
+		mockedRuleService.AssertExpectations(t)
+	})
+
+	t.Run("should return error Internal if listing rules to sync failed", func(t *testing.T) {
+		mockedTemplateService := &mocks.TemplateService{}
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := v1beta1.NewGRPCServer(nil, log.NewNoop(), headers, &api.Deps{TemplateService: mockedTemplateService, RuleService: mockedRuleService})
+
+		mockedTemplateService.EXPECT().Rollback(mock.Anything, uint64(3)).Return(tmpl, nil).Once()
+		mockedRuleService.EXPECT().SyncByTemplate(mock.Anything, "foo", []uint64(nil)).Return(nil, errors.New("random error")).Once()
+		res, err := dummyGRPCServer.RollbackTemplate(ctx, &sirenv1beta1.RollbackTemplateRequest{RevisionId: 3})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = some unexpected error occurred")
+		mockedTemplateService.AssertExpectations(t)
+		mockedRuleService.AssertExpectations(t)
+	})
+}
+
+func TestGRPCServer_SyncTemplateRules(t *testing.T) {
+	t.Run("should return error NotFound if template does not exist", func(t *testing.T) {
+		mockedTemplateService := &mocks.TemplateService{}
+		dummyGRPCServer := v1beta1.NewGRPCServer(nil, log.NewNoop(), api.HeadersConfig{}, &api.Deps{TemplateService: mockedTemplateService})
+
+		mockedTemplateService.EXPECT().GetByName(mock.AnythingOfType("*context.emptyCtx"), "foo").Return(nil, errors.ErrNotFound).Once()
+		res, err := dummyGRPCServer.SyncTemplateRules(context.Background(), &sirenv1beta1.SyncTemplateRulesRequest{Name: "foo"})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = requested entity not found")
+		mockedTemplateService.AssertExpectations(t)
+	})
+
+	t.Run("should sync only the requested rules of the template", func(t *testing.T) {
+		mockedTemplateService := &mocks.TemplateService{}
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := v1beta1.NewGRPCServer(nil, log.NewNoop(), api.HeadersConfig{}, &api.Deps{TemplateService: mockedTemplateService, RuleService: mockedRuleService})
+
+		mockedTemplateService.EXPECT().GetByName(mock.AnythingOfType("*context.emptyCtx"), "foo").Return(&template.Template{Name: "foo"}, nil).Once()
+		mockedRuleService.EXPECT().SyncByTemplate(mock.AnythingOfType("*context.emptyCtx"), "foo", []uint64{2}).Return([]rule.SyncResult{
+			{RuleID: 2, Name: "rule-2"},
+		}, nil).Once()
+		res, err := dummyGRPCServer.SyncTemplateRules(context.Background(), &sirenv1beta1.SyncTemplateRulesRequest{Name: "foo", RuleIds: []uint64{2}})
+		assert.Nil(t, err)
+		assert.Len(t, res.GetRuleSyncResults(), 1)
+		assert.Equal(t, "rule-2", res.GetRuleSyncResults()[0].GetName())
+		assert.True(t, res.GetRuleSyncResults()[0].GetSuccess())
+		mockedTemplateService.AssertExpectations(t)
+		mockedRuleService.AssertExpectations(t)
+	})
+
+	t.Run("should return error Internal if syncing rules failed", func(t *testing.T) {
+		mockedTemplateService := &mocks.TemplateService{}
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := v1beta1.NewGRPCServer(nil, log.NewNoop(), api.HeadersConfig{}, &api.Deps{TemplateService: mockedTemplateService, RuleService: mockedRuleService})
+
+		mockedTemplateService.EXPECT().GetByName(mock.AnythingOfType("*context.emptyCtx"), "foo").Return(&template.Template{Name: "foo"}, nil).Once()
+		mockedRuleService.EXPECT().SyncByTemplate(mock.AnythingOfType("*context.emptyCtx"), "foo", []uint64(nil)).Return(nil, errors.New("random error")).Once()
+		res, err := dummyGRPCServer.SyncTemplateRules(context.Background(), &sirenv1beta1.SyncTemplateRulesRequest{Name: "foo"})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = some unexpected error occurred")
+		mockedTemplateService.AssertExpectations(t)
+		mockedRuleService.AssertExpectations(t)
+	})
+}
@@ -1,12 +1,15 @@
 package v1beta1
 
 import (
+	"context"
+
 	"github.com/newrelic/go-agent/v3/newrelic"
 	"github.com/odpf/salt/log"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/odpf/siren/internal/api"
+	"github.com/odpf/siren/pkg/actor"
 	"github.com/odpf/siren/pkg/errors"
 	sirenv1beta1 "github.com/odpf/siren/proto/odpf/siren/v1beta1"
 )
@@ -48,6 +51,11 @@ func NewGRPCServer(
 	}
 }
 
+// withActor carries the actor header of the request to the services to be recorded in revisions
+func (s *GRPCServer) withActor(ctx context.Context) context.Context {
+	return actor.WithContext(ctx, api.GetHeaderString(ctx, s.headers.Actor))
+}
+
 func (s *GRPCServer) generateRPCErr(e error) error {
 	var err = errors.E(e)
 
@@ -0,0 +1,9 @@
+package jobs
+
+import (
+	"context"
+)
+
+func (h *handler) ExpireSilences(ctx context.Context) error {
+	return h.silenceService.ExpireLapsed(ctx)
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/odpf/salt/log"
+	"github.com/odpf/siren/core/namespace"
+	"github.com/odpf/siren/core/rule"
 )
 
 //go:generate mockery --name=NotificationService -r --case underscore --with-expecter --structname NotificationService --filename notification_service.go --output=./mocks
@@ -12,17 +14,43 @@ type NotificationService interface {
 	RemoveIdempotencies(ctx context.Context, TTL time.Duration) error
 }
 
+//go:generate mockery --name=SilenceService -r --case underscore --with-expecter --structname SilenceService --filename silence_service.go --output=./mocks
+type SilenceService interface {
+	ExpireLapsed(ctx context.Context) error
+}
+
+//go:generate mockery --name=NamespaceService -r --case underscore --with-expecter --structname NamespaceService --filename namespace_service.go --output=./mocks
+type NamespaceService interface {
+	List(ctx context.Context) ([]namespace.Namespace, error)
+}
+
+//go:generate mockery --name=RuleService -r --case underscore --with-expecter --structname RuleService --filename rule_service.go --output=./mocks
+type RuleService interface {
+	DetectDrift(ctx context.Context, namespaceID uint64) (*rule.DriftReport, error)
+	Reconcile(ctx context.Context, namespaceID uint64) (*rule.DriftReport, error)
+	IsProviderSupported(providerType string) bool
+}
+
 type handler struct {
 	logger              log.Logger
 	notificationService NotificationService
+	silenceService      SilenceService
+	namespaceService    NamespaceService
+	ruleService         RuleService
 }
 
 func NewHandler(
 	logger log.Logger,
 	notificationService NotificationService,
+	silenceService SilenceService,
+	namespaceService NamespaceService,
+	ruleService RuleService,
 ) *handler {
 	return &handler{
 		logger:              logger,
 		notificationService: notificationService,
+		silenceService:      silenceService,
+		namespaceService:    namespaceService,
+		ruleService:         ruleService,
 	}
 }
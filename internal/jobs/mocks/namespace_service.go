@@ -0,0 +1,84 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	namespace "github.com/odpf/siren/core/namespace"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NamespaceService is an autogenerated mock type for the NamespaceService type
+type NamespaceService struct {
+	mock.Mock
+}
+
+type NamespaceService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NamespaceService) EXPECT() *NamespaceService_Expecter {
+	return &NamespaceService_Expecter{mock: &_m.Mock}
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *NamespaceService) List(ctx context.Context) ([]namespace.Namespace, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []namespace.Namespace
+	if rf, ok := ret.Get(0).(func(context.Context) []namespace.Namespace); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]namespace.Namespace)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NamespaceService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type NamespaceService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *NamespaceService_Expecter) List(ctx interface{}) *NamespaceService_List_Call {
+	return &NamespaceService_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *NamespaceService_List_Call) Run(run func(ctx context.Context)) *NamespaceService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *NamespaceService_List_Call) Return(_a0 []namespace.Namespace, _a1 error) *NamespaceService_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+type mockConstructorTestingTNewNamespaceService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewNamespaceService creates a new instance of NamespaceService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewNamespaceService(t mockConstructorTestingTNewNamespaceService) *NamespaceService {
+	mock := &NamespaceService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,169 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	rule "github.com/odpf/siren/core/rule"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RuleService is an autogenerated mock type for the RuleService type
+type RuleService struct {
+	mock.Mock
+}
+
+type RuleService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RuleService) EXPECT() *RuleService_Expecter {
+	return &RuleService_Expecter{mock: &_m.Mock}
+}
+
+// DetectDrift provides a mock function with given fields: ctx, namespaceID
+func (_m *RuleService) DetectDrift(ctx context.Context, namespaceID uint64) (*rule.DriftReport, error) {
+	ret := _m.Called(ctx, namespaceID)
+
+	var r0 *rule.DriftReport
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *rule.DriftReport); ok {
+		r0 = rf(ctx, namespaceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rule.DriftReport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, namespaceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleService_DetectDrift_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DetectDrift'
+type RuleService_DetectDrift_Call struct {
+	*mock.Call
+}
+
+// DetectDrift is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceID uint64
+func (_e *RuleService_Expecter) DetectDrift(ctx interface{}, namespaceID interface{}) *RuleService_DetectDrift_Call {
+	return &RuleService_DetectDrift_Call{Call: _e.mock.On("DetectDrift", ctx, namespaceID)}
+}
+
+func (_c *RuleService_DetectDrift_Call) Run(run func(ctx context.Context, namespaceID uint64)) *RuleService_DetectDrift_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *RuleService_DetectDrift_Call) Return(_a0 *rule.DriftReport, _a1 error) *RuleService_DetectDrift_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// IsProviderSupported provides a mock function with given fields: providerType
+func (_m *RuleService) IsProviderSupported(providerType string) bool {
+	ret := _m.Called(providerType)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(providerType)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// RuleService_IsProviderSupported_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsProviderSupported'
+type RuleService_IsProviderSupported_Call struct {
+	*mock.Call
+}
+
+// IsProviderSupported is a helper method to define mock.On call
+//   - providerType string
+func (_e *RuleService_Expecter) IsProviderSupported(providerType interface{}) *RuleService_IsProviderSupported_Call {
+	return &RuleService_IsProviderSupported_Call{Call: _e.mock.On("IsProviderSupported", providerType)}
+}
+
+func (_c *RuleService_IsProviderSupported_Call) Run(run func(providerType string)) *RuleService_IsProviderSupported_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *RuleService_IsProviderSupported_Call) Return(_a0 bool) *RuleService_IsProviderSupported_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// Reconcile provides a mock function with given fields: ctx, namespaceID
+func (_m *RuleService) Reconcile(ctx context.Context, namespaceID uint64) (*rule.DriftReport, error) {
+	ret := _m.Called(ctx, namespaceID)
+
+	var r0 *rule.DriftReport
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *rule.DriftReport); ok {
+		r0 = rf(ctx, namespaceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rule.DriftReport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, namespaceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleService_Reconcile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reconcile'
+type RuleService_Reconcile_Call struct {
+	*mock.Call
+}
+
+// Reconcile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespaceID uint64
+func (_e *RuleService_Expecter) Reconcile(ctx interface{}, namespaceID interface{}) *RuleService_Reconcile_Call {
+	return &RuleService_Reconcile_Call{Call: _e.mock.On("Reconcile", ctx, namespaceID)}
+}
+
+func (_c *RuleService_Reconcile_Call) Run(run func(ctx context.Context, namespaceID uint64)) *RuleService_Reconcile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *RuleService_Reconcile_Call) Return(_a0 *rule.DriftReport, _a1 error) *RuleService_Reconcile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+type mockConstructorTestingTNewRuleService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRuleService creates a new instance of RuleService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRuleService(t mockConstructorTestingTNewRuleService) *RuleService {
+	mock := &RuleService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
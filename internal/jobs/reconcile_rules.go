@@ -0,0 +1,53 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/odpf/siren/core/rule"
+	"github.com/odpf/siren/pkg/errors"
+)
+
+// ReconcileRules compares rules of every namespace with the provider and
+// re-uploads siren version of drifted rules if repush is true.
+// Namespaces of providers that do not manage rules are skipped.
+func (h *handler) ReconcileRules(ctx context.Context, repush bool) ([]rule.DriftReport, error) {
+	namespaces, err := h.namespaceService.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		reports     = []rule.DriftReport{}
+		failedCount = 0
+	)
+	for _, ns := range namespaces {
+		if !h.ruleService.IsProviderSupported(ns.Provider.Type) {
+			h.logger.Debug("skipping rules reconciliation of namespace, provider does not manage rules", "namespace", ns.URN, "provider_type", ns.Provider.Type)
+			continue
+		}
+
+		var report *rule.DriftReport
+		if repush {
+			report, err = h.ruleService.Reconcile(ctx, ns.ID)
+		} else {
+			report, err = h.ruleService.DetectDrift(ctx, ns.ID)
+		}
+		if err != nil {
+			h.logger.Error("failed to reconcile rules of namespace", "namespace", ns.URN, "err", errors.Verbose(err))
+			failedCount++
+			continue
+		}
+		if report.FailedCount() > 0 {
+			h.logger.Error("failed to reconcile some rules of namespace", "namespace", ns.URN, "failed_drifts", report.FailedCount())
+			failedCount++
+		}
+		reports = append(reports, *report)
+	}
+
+	if failedCount > 0 {
+		return reports, fmt.Errorf("failed to reconcile rules of %d namespace(s)", failedCount)
+	}
+
+	return reports, nil
+}
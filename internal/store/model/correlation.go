@@ -0,0 +1,38 @@
+package model
+
+import (
+	"time"
+
+	"github.com/odpf/siren/core/notification"
+	"github.com/odpf/siren/pkg/pgc"
+)
+
+type NotificationCorrelation struct {
+	ID           uint64              `db:"id"`
+	Fingerprint  string              `db:"fingerprint"`
+	ReceiverType string              `db:"receiver_type"`
+	Destination  string              `db:"destination"`
+	Identifiers  pgc.StringStringMap `db:"identifiers"`
+	CreatedAt    time.Time           `db:"created_at"`
+	UpdatedAt    time.Time           `db:"updated_at"`
+}
+
+func (nc *NotificationCorrelation) FromDomain(c notification.Correlation) {
+	nc.Fingerprint = c.Fingerprint
+	nc.ReceiverType = c.ReceiverType
+	nc.Destination = c.Destination
+	nc.Identifiers = pgc.StringStringMap(c.Identifiers)
+	nc.CreatedAt = c.CreatedAt
+	nc.UpdatedAt = c.UpdatedAt
+}
+
+func (nc *NotificationCorrelation) ToDomain() notification.Correlation {
+	return notification.Correlation{
+		Fingerprint:  nc.Fingerprint,
+		ReceiverType: nc.ReceiverType,
+		Destination:  nc.Destination,
+		Identifiers:  nc.Identifiers,
+		CreatedAt:    nc.CreatedAt,
+		UpdatedAt:    nc.UpdatedAt,
+	}
+}
@@ -0,0 +1,83 @@
+package model
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/odpf/siren/core/notification"
+)
+
+type NotificationGroup struct {
+	ID             uint64         `db:"id"`
+	UniqueKey      string         `db:"unique_key"`
+	SubscriptionID uint64         `db:"subscription_id"`
+	ReceiverID     uint64         `db:"receiver_id"`
+	Messages       GroupMessages  `db:"messages"`
+	FlushAt        sql.NullTime   `db:"flush_at"`
+	LastSentAt     sql.NullTime   `db:"last_sent_at"`
+	LastSentHash   sql.NullString `db:"last_sent_hash"`
+	CreatedAt      time.Time      `db:"created_at"`
+	UpdatedAt      time.Time      `db:"updated_at"`
+}
+
+type GroupMessages []notification.Message
+
+func (gm *GroupMessages) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("failed type assertion to []byte")
+	}
+	return json.Unmarshal(b, &gm)
+}
+
+func (gm GroupMessages) Value() (driver.Value, error) {
+	if gm == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(gm)
+}
+
+func (ng *NotificationGroup) FromDomain(g notification.Group) {
+	ng.UniqueKey = g.UniqueKey
+	ng.SubscriptionID = g.SubscriptionID
+	ng.ReceiverID = g.ReceiverID
+	ng.Messages = GroupMessages(g.Messages)
+
+	if g.FlushAt.IsZero() {
+		ng.FlushAt = sql.NullTime{Valid: false}
+	} else {
+		ng.FlushAt = sql.NullTime{Time: g.FlushAt, Valid: true}
+	}
+
+	if g.LastSentAt.IsZero() {
+		ng.LastSentAt = sql.NullTime{Valid: false}
+	} else {
+		ng.LastSentAt = sql.NullTime{Time: g.LastSentAt, Valid: true}
+	}
+
+	if g.LastSentHash == "" {
+		ng.LastSentHash = sql.NullString{Valid: false}
+	} else {
+		ng.LastSentHash = sql.NullString{String: g.LastSentHash, Valid: true}
+	}
+}
+
+func (ng *NotificationGroup) ToDomain() notification.Group {
+	return notification.Group{
+		GroupKey: notification.GroupKey{
+			UniqueKey:      ng.UniqueKey,
+			SubscriptionID: ng.SubscriptionID,
+			ReceiverID:     ng.ReceiverID,
+		},
+		Messages:     ng.Messages,
+		FlushAt:      ng.FlushAt.Time,
+		LastSentAt:   ng.LastSentAt.Time,
+		LastSentHash: ng.LastSentHash.String,
+	}
+}
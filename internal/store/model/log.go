@@ -2,6 +2,9 @@ package model
 
 import (
 	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/lib/pq"
@@ -16,9 +19,30 @@ type NotificationLog struct {
 	ReceiverID     sql.NullInt64  `db:"receiver_id"`
 	AlertIDs       pq.Int64Array  `db:"alert_ids"`
 	SilenceIDs     pq.StringArray `db:"silence_ids"`
+	SilenceWindows SilenceWindows `db:"silence_windows"`
 	CreatedAt      time.Time      `db:"created_at"`
 }
 
+type SilenceWindows []log.SilenceWindow
+
+func (sw *SilenceWindows) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("failed type assertion to []byte")
+	}
+	return json.Unmarshal(b, &sw)
+}
+
+func (sw SilenceWindows) Value() (driver.Value, error) {
+	if len(sw) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(sw)
+}
+
 func (ns *NotificationLog) FromDomain(d log.Notification) {
 	ns.ID = d.ID
 
@@ -32,6 +56,7 @@ func (ns *NotificationLog) FromDomain(d log.Notification) {
 	ns.SubscriptionID = d.SubscriptionID
 	ns.AlertIDs = pq.Int64Array(d.AlertIDs)
 	ns.SilenceIDs = pq.StringArray(d.SilenceIDs)
+	ns.SilenceWindows = SilenceWindows(d.SilenceWindows)
 
 	if d.ReceiverID == 0 {
 		ns.ReceiverID = sql.NullInt64{Valid: false}
@@ -51,6 +76,7 @@ func (ns *NotificationLog) ToDomain() log.Notification {
 		ReceiverID:     uint64(ns.ReceiverID.Int64),
 		AlertIDs:       ns.AlertIDs,
 		SilenceIDs:     ns.SilenceIDs,
+		SilenceWindows: ns.SilenceWindows,
 		CreatedAt:      ns.CreatedAt,
 	}
 }
@@ -14,6 +14,7 @@ type Provider struct {
 	Name        string                 `db:"name"`
 	Type        string                 `db:"type"`
 	Credentials pgc.StringInterfaceMap `db:"credentials"`
+	Config      pgc.StringInterfaceMap `db:"config"`
 	Labels      pgc.StringStringMap    `db:"labels"`
 	CreatedAt   time.Time              `db:"created_at"`
 	UpdatedAt   time.Time              `db:"updated_at"`
@@ -26,6 +27,7 @@ func (p *Provider) FromDomain(t provider.Provider) {
 	p.Name = t.Name
 	p.Type = t.Type
 	p.Credentials = t.Credentials
+	p.Config = t.Config
 	p.Labels = t.Labels
 	p.CreatedAt = t.CreatedAt
 	p.UpdatedAt = t.UpdatedAt
@@ -39,6 +41,7 @@ func (p *Provider) ToDomain() *provider.Provider {
 		URN:         p.URN,
 		Type:        p.Type,
 		Credentials: p.Credentials,
+		Config:      p.Config,
 		Labels:      p.Labels,
 		CreatedAt:   p.CreatedAt,
 		UpdatedAt:   p.UpdatedAt,
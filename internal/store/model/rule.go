@@ -1,6 +1,7 @@
 package model
 
 import (
+	"database/sql"
 	"encoding/json"
 	"time"
 
@@ -64,3 +65,73 @@ func (rl *Rule) ToDomain() (*rule.Rule, error) {
 		UpdatedAt:         rl.UpdatedAt,
 	}, nil
 }
+
+type RuleRevision struct {
+	ID                uint64         `db:"id"`
+	RuleID            uint64         `db:"rule_id"`
+	Name              string         `db:"name"`
+	Namespace         string         `db:"namespace"`
+	GroupName         string         `db:"group_name"`
+	Template          string         `db:"template"`
+	Enabled           bool           `db:"enabled"`
+	Variables         string         `db:"variables"`
+	ProviderNamespace uint64         `db:"provider_namespace"`
+	Body              string         `db:"body"`
+	Deleted           bool           `db:"deleted"`
+	Actor             sql.NullString `db:"actor"`
+	CreatedAt         time.Time      `db:"created_at"`
+}
+
+func (rev *RuleRevision) FromDomain(r rule.Revision) error {
+	rev.ID = r.ID
+	rev.RuleID = r.RuleID
+	rev.Name = r.Name
+	rev.Namespace = r.Namespace
+	rev.GroupName = r.GroupName
+	rev.Template = r.Template
+	rev.Enabled = r.Enabled
+
+	jsonString, err := json.Marshal(r.Variables)
+	if err != nil {
+		return err
+	}
+	rev.Variables = string(jsonString)
+
+	rev.ProviderNamespace = r.ProviderNamespace
+	rev.Body = r.Body
+	rev.Deleted = r.Deleted
+
+	if r.Actor == "" {
+		rev.Actor = sql.NullString{Valid: false}
+	} else {
+		rev.Actor = sql.NullString{String: r.Actor, Valid: true}
+	}
+
+	rev.CreatedAt = r.CreatedAt
+	return nil
+}
+
+func (rev *RuleRevision) ToDomain() (*rule.Revision, error) {
+	if rev == nil {
+		return nil, errors.New("rule revision model is nil")
+	}
+	var variables []rule.RuleVariable
+	if err := json.Unmarshal([]byte(rev.Variables), &variables); err != nil {
+		return nil, err
+	}
+	return &rule.Revision{
+		ID:                rev.ID,
+		RuleID:            rev.RuleID,
+		Name:              rev.Name,
+		Enabled:           rev.Enabled,
+		GroupName:         rev.GroupName,
+		Namespace:         rev.Namespace,
+		Template:          rev.Template,
+		Variables:         variables,
+		ProviderNamespace: rev.ProviderNamespace,
+		Body:              rev.Body,
+		Deleted:           rev.Deleted,
+		Actor:             rev.Actor.String,
+		CreatedAt:         rev.CreatedAt,
+	}, nil
+}
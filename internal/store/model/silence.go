@@ -16,6 +16,11 @@ type Silence struct {
 	TargetExpression pgc.StringInterfaceMap `db:"target_expression"`
 	Creator          sql.NullString         `db:"creator"`
 	Comment          sql.NullString         `db:"comment"`
+	StartsAt         sql.NullTime           `db:"starts_at"`
+	EndsAt           sql.NullTime           `db:"ends_at"`
+	ScheduleCron     sql.NullString         `db:"schedule_cron"`
+	ScheduleDuration sql.NullString         `db:"schedule_duration"`
+	ScheduleTimeZone sql.NullString         `db:"schedule_time_zone"`
 	CreatedAt        time.Time              `db:"created_at"`
 	DeletedAt        sql.NullTime           `db:"deleted_at"`
 }
@@ -45,6 +50,28 @@ func (s *Silence) FromDomain(sil silence.Silence) {
 		s.Comment = sql.NullString{String: sil.Comment, Valid: true}
 	}
 
+	if sil.StartsAt.IsZero() {
+		s.StartsAt = sql.NullTime{Valid: false}
+	} else {
+		s.StartsAt = sql.NullTime{Time: sil.StartsAt, Valid: true}
+	}
+
+	if sil.EndsAt.IsZero() {
+		s.EndsAt = sql.NullTime{Valid: false}
+	} else {
+		s.EndsAt = sql.NullTime{Time: sil.EndsAt, Valid: true}
+	}
+
+	if sil.Schedule.Cron == "" {
+		s.ScheduleCron = sql.NullString{Valid: false}
+		s.ScheduleDuration = sql.NullString{Valid: false}
+		s.ScheduleTimeZone = sql.NullString{Valid: false}
+	} else {
+		s.ScheduleCron = sql.NullString{String: sil.Schedule.Cron, Valid: true}
+		s.ScheduleDuration = sql.NullString{String: sil.Schedule.Duration.String(), Valid: true}
+		s.ScheduleTimeZone = sql.NullString{String: sil.Schedule.TimeZone, Valid: true}
+	}
+
 	s.CreatedAt = sil.CreatedAt
 
 	if sil.DeletedAt.IsZero() {
@@ -55,13 +82,23 @@ func (s *Silence) FromDomain(sil silence.Silence) {
 }
 
 func (s *Silence) ToDomain() *silence.Silence {
+	// duration is always written from a valid time.Duration
+	scheduleDuration, _ := time.ParseDuration(s.ScheduleDuration.String)
+
 	return &silence.Silence{
 		ID:               s.ID,
 		NamespaceID:      s.NamespaceID,
 		Type:             s.Type,
 		TargetID:         uint64(s.TargetID.Int64),
 		TargetExpression: s.TargetExpression,
-		CreatedAt:        s.CreatedAt,
-		DeletedAt:        s.DeletedAt.Time,
+		StartsAt:         s.StartsAt.Time,
+		EndsAt:           s.EndsAt.Time,
+		Schedule: silence.Schedule{
+			Cron:     s.ScheduleCron.String,
+			Duration: scheduleDuration,
+			TimeZone: s.ScheduleTimeZone.String,
+		},
+		CreatedAt: s.CreatedAt,
+		DeletedAt: s.DeletedAt.Time,
 	}
 }
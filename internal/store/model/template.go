@@ -1,6 +1,7 @@
 package model
 
 import (
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"time"
@@ -54,3 +55,56 @@ func (tmp *Template) ToDomain() (*template.Template, error) {
 		Variables: variables,
 	}, nil
 }
+
+type TemplateRevision struct {
+	ID         uint64         `db:"id"`
+	TemplateID uint64         `db:"template_id"`
+	Name       string         `db:"name"`
+	Body       string         `db:"body"`
+	Tags       pq.StringArray `db:"tags"`
+	Variables  string         `db:"variables"`
+	Actor      sql.NullString `db:"actor"`
+	CreatedAt  time.Time      `db:"created_at"`
+}
+
+func (rev *TemplateRevision) FromDomain(r template.Revision) error {
+	rev.ID = r.ID
+	rev.TemplateID = r.TemplateID
+	rev.Name = r.Name
+	rev.Body = r.Body
+	rev.Tags = r.Tags
+	jsonString, err := json.Marshal(r.Variables)
+	if err != nil {
+		return err
+	}
+	rev.Variables = string(jsonString)
+
+	if r.Actor == "" {
+		rev.Actor = sql.NullString{Valid: false}
+	} else {
+		rev.Actor = sql.NullString{String: r.Actor, Valid: true}
+	}
+
+	rev.CreatedAt = r.CreatedAt
+	return nil
+}
+
+func (rev *TemplateRevision) ToDomain() (*template.Revision, error) {
+	if rev == nil {
+		return nil, errors.New("template revision model is nil")
+	}
+	var variables []template.Variable
+	if err := json.Unmarshal([]byte(rev.Variables), &variables); err != nil {
+		return nil, err
+	}
+	return &template.Revision{
+		ID:         rev.ID,
+		TemplateID: rev.TemplateID,
+		Name:       rev.Name,
+		Body:       rev.Body,
+		Tags:       rev.Tags,
+		Variables:  variables,
+		Actor:      rev.Actor.String,
+		CreatedAt:  rev.CreatedAt,
+	}, nil
+}
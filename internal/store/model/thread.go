@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+
+	"github.com/odpf/siren/core/notification"
+)
+
+type NotificationThread struct {
+	ID           uint64    `db:"id"`
+	UniqueKey    string    `db:"unique_key"`
+	ReceiverType string    `db:"receiver_type"`
+	Destination  string    `db:"destination"`
+	ExternalID   string    `db:"external_id"`
+	ChannelID    string    `db:"channel_id"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+func (nt *NotificationThread) FromDomain(t notification.Thread) {
+	nt.UniqueKey = t.UniqueKey
+	nt.ReceiverType = t.ReceiverType
+	nt.Destination = t.Destination
+	nt.ExternalID = t.ExternalID
+	nt.ChannelID = t.ChannelID
+	nt.CreatedAt = t.CreatedAt
+}
+
+func (nt *NotificationThread) ToDomain() notification.Thread {
+	return notification.Thread{
+		UniqueKey:    nt.UniqueKey,
+		ReceiverType: nt.ReceiverType,
+		Destination:  nt.Destination,
+		ExternalID:   nt.ExternalID,
+		ChannelID:    nt.ChannelID,
+		CreatedAt:    nt.CreatedAt,
+	}
+}
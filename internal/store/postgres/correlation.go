@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/odpf/siren/core/notification"
+	"github.com/odpf/siren/internal/store/model"
+	"github.com/odpf/siren/pkg/errors"
+	"github.com/odpf/siren/pkg/pgc"
+)
+
+const correlationUpsertQuery = `
+INSERT INTO notification_correlations (fingerprint, receiver_type, destination, identifiers, created_at, updated_at)
+    VALUES ($1, $2, $3, $4, now(), now())
+ON CONFLICT (fingerprint, receiver_type, destination) DO UPDATE SET identifiers = $4, updated_at = now()
+`
+
+const correlationGetQuery = `
+SELECT * FROM notification_correlations WHERE fingerprint = $1 AND receiver_type = $2 AND destination = $3
+`
+
+// CorrelationRepository talks to the store to read or upsert identifiers of sent notifications
+type CorrelationRepository struct {
+	client    *pgc.Client
+	tableName string
+}
+
+// NewCorrelationRepository returns CorrelationRepository struct
+func NewCorrelationRepository(client *pgc.Client) *CorrelationRepository {
+	return &CorrelationRepository{
+		client:    client,
+		tableName: "notification_correlations",
+	}
+}
+
+func (r *CorrelationRepository) Upsert(ctx context.Context, c notification.Correlation) error {
+	correlationModel := new(model.NotificationCorrelation)
+	correlationModel.FromDomain(c)
+
+	if _, err := r.client.ExecContext(ctx, pgc.OpInsert, r.tableName, correlationUpsertQuery,
+		correlationModel.Fingerprint,
+		correlationModel.ReceiverType,
+		correlationModel.Destination,
+		correlationModel.Identifiers,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *CorrelationRepository) Get(ctx context.Context, fingerprint, receiverType, destination string) (notification.Correlation, error) {
+	var correlationModel model.NotificationCorrelation
+	if err := r.client.QueryRowxContext(ctx, pgc.OpSelect, r.tableName, correlationGetQuery,
+		fingerprint, receiverType, destination,
+	).StructScan(&correlationModel); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return notification.Correlation{}, errors.ErrNotFound
+		}
+		return notification.Correlation{}, err
+	}
+
+	return correlationModel.ToDomain(), nil
+}
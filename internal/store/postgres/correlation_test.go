@@ -0,0 +1,116 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/odpf/salt/db"
+	"github.com/odpf/salt/dockertestx"
+	"github.com/odpf/salt/log"
+	"github.com/ory/dockertest/v3"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/odpf/siren/core/notification"
+	"github.com/odpf/siren/internal/store/postgres"
+	"github.com/odpf/siren/pkg/errors"
+	"github.com/odpf/siren/pkg/pgc"
+)
+
+type CorrelationRepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	client     *pgc.Client
+	pool       *dockertest.Pool
+	resource   *dockertest.Resource
+	repository *postgres.CorrelationRepository
+}
+
+func (s *CorrelationRepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := log.NewZap()
+	dpg, err := dockertestx.CreatePostgres(
+		dockertestx.PostgresWithDetail(
+			pgUser, pgPass, pgDBName,
+		),
+		dockertestx.PostgresWithVersionTag("13"),
+	)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.pool = dpg.GetPool()
+	s.resource = dpg.GetResource()
+
+	dbConfig.URL = dpg.GetExternalConnString()
+	dbc, err := db.New(dbConfig)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.client, err = pgc.NewClient(logger, dbc)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	s.ctx = context.TODO()
+	s.Require().NoError(migrate(s.ctx, logger, s.client, dbConfig))
+	s.repository = postgres.NewCorrelationRepository(s.client)
+}
+
+func (s *CorrelationRepositoryTestSuite) TearDownTest() {
+	if err := s.cleanup(); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *CorrelationRepositoryTestSuite) TearDownSuite() {
+	// Clean tests
+	if err := purgeDocker(s.pool, s.resource); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *CorrelationRepositoryTestSuite) cleanup() error {
+	queries := []string{
+		"TRUNCATE TABLE notification_correlations RESTART IDENTITY CASCADE",
+	}
+	return execQueries(context.TODO(), s.client, queries)
+}
+
+func (s *CorrelationRepositoryTestSuite) TestUpsertAndGet() {
+	s.Run("should return not found if correlation does not exist", func() {
+		_, err := s.repository.Get(s.ctx, "fingerprint", "slack", "channel-id")
+		s.Assert().ErrorIs(err, errors.ErrNotFound)
+	})
+
+	s.Run("should get the latest identifiers of the same fingerprint and destination", func() {
+		err := s.repository.Upsert(s.ctx, notification.Correlation{
+			Fingerprint:  "fingerprint",
+			ReceiverType: "slack",
+			Destination:  "channel-id",
+			Identifiers:  map[string]string{"ts": "1"},
+		})
+		s.Require().NoError(err)
+
+		err = s.repository.Upsert(s.ctx, notification.Correlation{
+			Fingerprint:  "fingerprint",
+			ReceiverType: "slack",
+			Destination:  "channel-id",
+			Identifiers:  map[string]string{"ts": "2"},
+		})
+		s.Require().NoError(err)
+
+		got, err := s.repository.Get(s.ctx, "fingerprint", "slack", "channel-id")
+		s.Require().NoError(err)
+		s.Assert().Equal(map[string]string{"ts": "2"}, got.Identifiers)
+	})
+
+	s.Run("should not mix identifiers of different destination", func() {
+		_, err := s.repository.Get(s.ctx, "fingerprint", "slack", "another-channel-id")
+		s.Assert().ErrorIs(err, errors.ErrNotFound)
+	})
+}
+
+func TestCorrelationRepository(t *testing.T) {
+	suite.Run(t, new(CorrelationRepositoryTestSuite))
+}
@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/odpf/siren/core/notification"
+	"github.com/odpf/siren/internal/store/model"
+	"github.com/odpf/siren/pkg/pgc"
+)
+
+// a new window is only opened if the group has nothing buffered
+const groupBufferQuery = `
+INSERT INTO notification_groups (unique_key, subscription_id, receiver_id, messages, flush_at, created_at, updated_at)
+    VALUES ($1, $2, $3, jsonb_build_array($4::jsonb), now() + make_interval(secs => $5), now(), now())
+ON CONFLICT (unique_key, subscription_id, receiver_id) DO UPDATE SET
+    messages = notification_groups.messages || jsonb_build_array($4::jsonb),
+    flush_at = COALESCE(notification_groups.flush_at, now() + make_interval(secs => $5)),
+    updated_at = now()
+`
+
+const groupListDueQuery = `
+SELECT * FROM notification_groups
+WHERE flush_at IS NOT NULL AND flush_at <= now()
+ORDER BY flush_at
+FOR UPDATE SKIP LOCKED
+LIMIT $1
+`
+
+const groupMarkFlushedQuery = `
+UPDATE notification_groups
+SET messages = '[]'::jsonb, flush_at = NULL, last_sent_at = $4, last_sent_hash = $5, updated_at = now()
+WHERE unique_key = $1 AND subscription_id = $2 AND receiver_id = $3
+RETURNING id
+`
+
+// GroupRepository talks to the store to read or insert notification groups
+type GroupRepository struct {
+	client    *pgc.Client
+	tableName string
+}
+
+// NewGroupRepository returns GroupRepository struct
+func NewGroupRepository(client *pgc.Client) *GroupRepository {
+	return &GroupRepository{
+		client:    client,
+		tableName: "notification_groups",
+	}
+}
+
+func (r *GroupRepository) Buffer(ctx context.Context, m notification.Message, groupWait time.Duration) error {
+	messageJSON, err := json.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("cannot marshal message %s: %w", m.ID, err)
+	}
+
+	if _, err := r.client.ExecContext(ctx, pgc.OpInsert, r.tableName, groupBufferQuery,
+		m.GroupKey.UniqueKey,
+		m.GroupKey.SubscriptionID,
+		m.GroupKey.ReceiverID,
+		string(messageJSON),
+		groupWait.Seconds(),
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *GroupRepository) ListDue(ctx context.Context, batchSize int) ([]notification.Group, error) {
+	rows, err := r.client.QueryxContext(ctx, pgc.OpSelectAll, r.tableName, groupListDueQuery, batchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var groups []notification.Group
+	for rows.Next() {
+		var groupModel model.NotificationGroup
+		if err := rows.StructScan(&groupModel); err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, groupModel.ToDomain())
+	}
+
+	return groups, nil
+}
+
+func (r *GroupRepository) MarkFlushed(ctx context.Context, g notification.Group) error {
+	groupModel := new(model.NotificationGroup)
+	groupModel.FromDomain(g)
+
+	// query row is used instead of exec so the update runs within the transaction holding the lock
+	var id uint64
+	if err := r.client.QueryRowxContext(ctx, pgc.OpUpdate, r.tableName, groupMarkFlushedQuery,
+		groupModel.UniqueKey,
+		groupModel.SubscriptionID,
+		groupModel.ReceiverID,
+		groupModel.LastSentAt,
+		groupModel.LastSentHash,
+	).Scan(&id); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *GroupRepository) WithTransaction(ctx context.Context) context.Context {
+	return r.client.WithTransaction(ctx, nil)
+}
+
+func (r *GroupRepository) Rollback(ctx context.Context, err error) error {
+	if txErr := r.client.Rollback(ctx); txErr != nil {
+		return fmt.Errorf("rollback error %s with error: %w", txErr.Error(), err)
+	}
+	return nil
+}
+
+func (r *GroupRepository) Commit(ctx context.Context) error {
+	return r.client.Commit(ctx)
+}
@@ -0,0 +1,147 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/odpf/salt/db"
+	"github.com/odpf/salt/dockertestx"
+	"github.com/odpf/salt/log"
+	"github.com/ory/dockertest/v3"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/odpf/siren/core/notification"
+	"github.com/odpf/siren/internal/store/postgres"
+	"github.com/odpf/siren/pkg/pgc"
+)
+
+type GroupRepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	client     *pgc.Client
+	pool       *dockertest.Pool
+	resource   *dockertest.Resource
+	repository *postgres.GroupRepository
+}
+
+func (s *GroupRepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := log.NewZap()
+	dpg, err := dockertestx.CreatePostgres(
+		dockertestx.PostgresWithDetail(
+			pgUser, pgPass, pgDBName,
+		),
+		dockertestx.PostgresWithVersionTag("13"),
+	)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.pool = dpg.GetPool()
+	s.resource = dpg.GetResource()
+
+	dbConfig.URL = dpg.GetExternalConnString()
+	dbc, err := db.New(dbConfig)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.client, err = pgc.NewClient(logger, dbc)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	s.ctx = context.TODO()
+	s.Require().NoError(migrate(s.ctx, logger, s.client, dbConfig))
+	s.repository = postgres.NewGroupRepository(s.client)
+}
+
+func (s *GroupRepositoryTestSuite) TearDownTest() {
+	if err := s.cleanup(); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *GroupRepositoryTestSuite) TearDownSuite() {
+	// Clean tests
+	if err := purgeDocker(s.pool, s.resource); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *GroupRepositoryTestSuite) cleanup() error {
+	queries := []string{
+		"TRUNCATE TABLE notification_groups RESTART IDENTITY CASCADE",
+	}
+	return execQueries(context.TODO(), s.client, queries)
+}
+
+func (s *GroupRepositoryTestSuite) TestBufferAndFlush() {
+	groupKey := notification.GroupKey{
+		UniqueKey:      "unique-key",
+		SubscriptionID: 1,
+		ReceiverID:     2,
+	}
+
+	s.Run("should not list group that is still within group wait", func() {
+		err := s.repository.Buffer(s.ctx, notification.Message{ID: "1", GroupKey: groupKey}, time.Hour)
+		s.Require().NoError(err)
+
+		groups, err := s.repository.ListDue(s.ctx, 10)
+		s.Require().NoError(err)
+		s.Assert().Len(groups, 0)
+	})
+
+	s.Run("should append message to the group and keep the window", func() {
+		err := s.repository.Buffer(s.ctx, notification.Message{ID: "2", GroupKey: groupKey}, 0)
+		s.Require().NoError(err)
+
+		groups, err := s.repository.ListDue(s.ctx, 10)
+		s.Require().NoError(err)
+		s.Assert().Len(groups, 0)
+	})
+
+	s.Run("should list group that passed group wait with all buffered messages", func() {
+		err := s.repository.Buffer(s.ctx, notification.Message{ID: "3", GroupKey: notification.GroupKey{
+			UniqueKey:      "another-key",
+			SubscriptionID: 1,
+			ReceiverID:     2,
+		}}, 0)
+		s.Require().NoError(err)
+		err = s.repository.Buffer(s.ctx, notification.Message{ID: "4", GroupKey: notification.GroupKey{
+			UniqueKey:      "another-key",
+			SubscriptionID: 1,
+			ReceiverID:     2,
+		}}, 0)
+		s.Require().NoError(err)
+
+		groups, err := s.repository.ListDue(s.ctx, 10)
+		s.Require().NoError(err)
+		s.Require().Len(groups, 1)
+		s.Assert().Equal("another-key", groups[0].UniqueKey)
+		s.Assert().Len(groups[0].Messages, 2)
+	})
+
+	s.Run("should clear buffered messages and record last sent after marked flushed", func() {
+		ctx := s.repository.WithTransaction(s.ctx)
+
+		groups, err := s.repository.ListDue(ctx, 10)
+		s.Require().NoError(err)
+		s.Require().Len(groups, 1)
+
+		grp := groups[0]
+		grp.LastSentAt = time.Now()
+		grp.LastSentHash = "hash"
+		s.Require().NoError(s.repository.MarkFlushed(ctx, grp))
+		s.Require().NoError(s.repository.Commit(ctx))
+
+		groups, err = s.repository.ListDue(s.ctx, 10)
+		s.Require().NoError(err)
+		s.Assert().Len(groups, 0)
+	})
+}
+
+func TestGroupRepository(t *testing.T) {
+	suite.Run(t, new(GroupRepositoryTestSuite))
+}
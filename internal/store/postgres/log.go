@@ -14,8 +14,8 @@ const notificationLogTableName = "notification_log"
 
 const notificationLogInsertNamedQuery = `
 INSERT INTO notification_log
-	(namespace_id, notification_id, subscription_id, alert_ids, receiver_id, silence_ids, created_at)
-    VALUES (:namespace_id, :notification_id, :subscription_id, :alert_ids, :receiver_id, :silence_ids, now())
+	(namespace_id, notification_id, subscription_id, alert_ids, receiver_id, silence_ids, silence_windows, created_at)
+    VALUES (:namespace_id, :notification_id, :subscription_id, :alert_ids, :receiver_id, :silence_ids, :silence_windows, now())
 `
 
 // LogRepository talks to the store to read or insert data
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS silences_idx_ends_at;
+
+ALTER TABLE
+  silences
+DROP COLUMN IF EXISTS starts_at,
+DROP COLUMN IF EXISTS ends_at;
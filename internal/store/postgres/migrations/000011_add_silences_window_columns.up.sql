@@ -0,0 +1,6 @@
+ALTER TABLE
+  silences
+ADD COLUMN IF NOT EXISTS starts_at timestamptz,
+ADD COLUMN IF NOT EXISTS ends_at timestamptz;
+
+CREATE INDEX IF NOT EXISTS silences_idx_ends_at ON silences (ends_at) WHERE deleted_at IS NULL;
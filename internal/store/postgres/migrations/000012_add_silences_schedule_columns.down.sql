@@ -0,0 +1,9 @@
+ALTER TABLE
+  notification_log
+DROP COLUMN IF EXISTS silence_windows;
+
+ALTER TABLE
+  silences
+DROP COLUMN IF EXISTS schedule_cron,
+DROP COLUMN IF EXISTS schedule_duration,
+DROP COLUMN IF EXISTS schedule_time_zone;
@@ -0,0 +1,9 @@
+ALTER TABLE
+  silences
+ADD COLUMN IF NOT EXISTS schedule_cron text,
+ADD COLUMN IF NOT EXISTS schedule_duration text,
+ADD COLUMN IF NOT EXISTS schedule_time_zone text;
+
+ALTER TABLE
+  notification_log
+ADD COLUMN IF NOT EXISTS silence_windows jsonb;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS notification_groups (
+  id bigserial PRIMARY KEY,
+  unique_key text NOT NULL,
+  subscription_id bigint NOT NULL,
+  receiver_id bigint NOT NULL,
+  messages jsonb NOT NULL DEFAULT '[]'::jsonb,
+  flush_at timestamptz,
+  last_sent_at timestamptz,
+  last_sent_hash text,
+  created_at timestamptz NOT NULL,
+  updated_at timestamptz NOT NULL,
+  UNIQUE (unique_key, subscription_id, receiver_id)
+);
+
+CREATE INDEX IF NOT EXISTS notification_groups_idx_flush_at ON notification_groups (flush_at) WHERE flush_at IS NOT NULL;
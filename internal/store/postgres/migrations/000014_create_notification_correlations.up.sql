@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS notification_correlations (
+  id bigserial PRIMARY KEY,
+  fingerprint text NOT NULL,
+  receiver_type text NOT NULL,
+  destination text NOT NULL,
+  identifiers jsonb NOT NULL DEFAULT '{}'::jsonb,
+  created_at timestamptz NOT NULL,
+  updated_at timestamptz NOT NULL,
+  UNIQUE (fingerprint, receiver_type, destination)
+);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS notification_threads (
+  id bigserial PRIMARY KEY,
+  unique_key text NOT NULL,
+  receiver_type text NOT NULL,
+  destination text NOT NULL,
+  external_id text NOT NULL,
+  channel_id text NOT NULL,
+  created_at timestamptz NOT NULL,
+  UNIQUE (unique_key, receiver_type, destination)
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS rule_revisions;
+DROP TABLE IF EXISTS template_revisions;
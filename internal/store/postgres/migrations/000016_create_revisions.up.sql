@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS template_revisions (
+  id bigserial PRIMARY KEY,
+  template_id bigint NOT NULL,
+  name text NOT NULL,
+  body text,
+  tags text[],
+  variables jsonb,
+  actor text,
+  created_at timestamptz NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_template_revisions_name ON template_revisions (name, id);
+
+CREATE TABLE IF NOT EXISTS rule_revisions (
+  id bigserial PRIMARY KEY,
+  rule_id bigint NOT NULL,
+  name text NOT NULL,
+  namespace text,
+  group_name text,
+  template text,
+  enabled boolean,
+  variables jsonb,
+  provider_namespace bigint,
+  body text,
+  deleted boolean NOT NULL DEFAULT false,
+  actor text,
+  created_at timestamptz NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_rule_revisions_rule_id ON rule_revisions (rule_id, id);
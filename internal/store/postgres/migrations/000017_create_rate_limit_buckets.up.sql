@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS rate_limit_buckets (
+  key text PRIMARY KEY,
+  tokens double precision NOT NULL,
+  taken boolean NOT NULL,
+  updated_at timestamptz NOT NULL
+);
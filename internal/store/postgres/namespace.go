@@ -40,6 +40,7 @@ var namespaceListQueryBuilder = sq.Select(`
 	p.type as "provider.type",
 	p.credentials as "provider.credentials",
 	p.labels as "provider.labels",
+	p.config as "provider.config",
 	p.created_at as "provider.created_at",
 	p.updated_at as "provider.updated_at"
 	`).From("namespaces n").
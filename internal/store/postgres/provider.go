@@ -12,13 +12,13 @@ import (
 )
 
 const providerInsertQuery = `
-INSERT INTO providers (host, urn, name, type, credentials, labels, created_at, updated_at)
-    VALUES ($1, $2, $3, $4, $5, $6, now(), now())
+INSERT INTO providers (host, urn, name, type, credentials, labels, config, created_at, updated_at)
+    VALUES ($1, $2, $3, $4, $5, $6, $7, now(), now())
 RETURNING *
 `
 
 const providerUpdateQuery = `
-UPDATE providers SET host=$2, urn=$3, name=$4, type=$5, credentials=$6, labels=$7, updated_at=now()
+UPDATE providers SET host=$2, urn=$3, name=$4, type=$5, credentials=$6, labels=$7, config=$8, updated_at=now()
 WHERE id = $1
 RETURNING *
 `
@@ -31,6 +31,7 @@ var providerListQueryBuilder = sq.Select(
 	"type",
 	"credentials",
 	"labels",
+	"config",
 	"created_at",
 	"updated_at",
 ).From("providers")
@@ -100,6 +101,7 @@ func (r ProviderRepository) Create(ctx context.Context, prov *provider.Provider)
 		provModel.Type,
 		provModel.Credentials,
 		provModel.Labels,
+		provModel.Config,
 	).StructScan(&createdProvider); err != nil {
 		err = pgc.CheckError(err)
 		if errors.Is(err, pgc.ErrDuplicateKey) {
@@ -149,6 +151,7 @@ func (r ProviderRepository) Update(ctx context.Context, provDomain *provider.Pro
 		provModel.Type,
 		provModel.Credentials,
 		provModel.Labels,
+		provModel.Config,
 	).StructScan(&updatedProvider); err != nil {
 		err = pgc.CheckError(err)
 		if errors.Is(err, sql.ErrNoRows) {
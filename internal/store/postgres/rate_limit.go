@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/odpf/siren/core/notification"
+	"github.com/odpf/siren/pkg/pgc"
+)
+
+// the bucket is refilled by the elapsed time since it was last updated and a token is taken
+// only if there is one, the row lock of the upsert keeps the bucket consistent across workers
+const rateLimitTakeQuery = `
+INSERT INTO rate_limit_buckets AS b (key, tokens, taken, updated_at)
+    VALUES ($1, $3::double precision - 1, true, now())
+ON CONFLICT (key) DO UPDATE SET
+    tokens = CASE
+        WHEN LEAST($3::double precision, b.tokens + EXTRACT(EPOCH FROM now() - b.updated_at)::double precision * $2::double precision) >= 1
+        THEN LEAST($3::double precision, b.tokens + EXTRACT(EPOCH FROM now() - b.updated_at)::double precision * $2::double precision) - 1
+        ELSE LEAST($3::double precision, b.tokens + EXTRACT(EPOCH FROM now() - b.updated_at)::double precision * $2::double precision)
+    END,
+    taken = LEAST($3::double precision, b.tokens + EXTRACT(EPOCH FROM now() - b.updated_at)::double precision * $2::double precision) >= 1,
+    updated_at = now()
+RETURNING tokens, taken
+`
+
+// RateLimitRepository talks to the store to take tokens of rate limit buckets shared by workers
+type RateLimitRepository struct {
+	client    *pgc.Client
+	tableName string
+}
+
+// NewRateLimitRepository returns RateLimitRepository struct
+func NewRateLimitRepository(client *pgc.Client) *RateLimitRepository {
+	return &RateLimitRepository{
+		client:    client,
+		tableName: "rate_limit_buckets",
+	}
+}
+
+func (r *RateLimitRepository) Take(ctx context.Context, key string, limit notification.RateLimitConfig) (time.Duration, error) {
+	burst := limit.Burst
+	if burst < 1 {
+		burst = 1
+	}
+
+	var (
+		tokens float64
+		taken  bool
+	)
+	if err := r.client.QueryRowxContext(ctx, pgc.OpInsert, r.tableName, rateLimitTakeQuery,
+		key, limit.Rate, burst,
+	).Scan(&tokens, &taken); err != nil {
+		return 0, err
+	}
+
+	if taken {
+		return 0, nil
+	}
+
+	return time.Duration((1 - tokens) / limit.Rate * float64(time.Second)), nil
+}
@@ -0,0 +1,113 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/odpf/salt/db"
+	"github.com/odpf/salt/dockertestx"
+	"github.com/odpf/salt/log"
+	"github.com/ory/dockertest/v3"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/odpf/siren/core/notification"
+	"github.com/odpf/siren/internal/store/postgres"
+	"github.com/odpf/siren/pkg/pgc"
+)
+
+type RateLimitRepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	client     *pgc.Client
+	pool       *dockertest.Pool
+	resource   *dockertest.Resource
+	repository *postgres.RateLimitRepository
+}
+
+func (s *RateLimitRepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := log.NewZap()
+	dpg, err := dockertestx.CreatePostgres(
+		dockertestx.PostgresWithDetail(
+			pgUser, pgPass, pgDBName,
+		),
+		dockertestx.PostgresWithVersionTag("13"),
+	)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.pool = dpg.GetPool()
+	s.resource = dpg.GetResource()
+
+	dbConfig.URL = dpg.GetExternalConnString()
+	dbc, err := db.New(dbConfig)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.client, err = pgc.NewClient(logger, dbc)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	s.ctx = context.TODO()
+	s.Require().NoError(migrate(s.ctx, logger, s.client, dbConfig))
+	s.repository = postgres.NewRateLimitRepository(s.client)
+}
+
+func (s *RateLimitRepositoryTestSuite) TearDownTest() {
+	if err := s.cleanup(); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *RateLimitRepositoryTestSuite) TearDownSuite() {
+	// Clean tests
+	if err := purgeDocker(s.pool, s.resource); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *RateLimitRepositoryTestSuite) cleanup() error {
+	queries := []string{
+		"TRUNCATE TABLE rate_limit_buckets RESTART IDENTITY CASCADE",
+	}
+	return execQueries(context.TODO(), s.client, queries)
+}
+
+func (s *RateLimitRepositoryTestSuite) TestTake() {
+	limit := notification.RateLimitConfig{Rate: 1, Burst: 2}
+
+	s.Run("should take tokens until the bucket is empty", func() {
+		for i := 0; i < limit.Burst; i++ {
+			wait, err := s.repository.Take(s.ctx, "slack:1", limit)
+			s.Require().NoError(err)
+			s.Assert().Zero(wait)
+		}
+
+		wait, err := s.repository.Take(s.ctx, "slack:1", limit)
+		s.Require().NoError(err)
+		s.Assert().Greater(wait, time.Duration(0))
+		s.Assert().LessOrEqual(wait, time.Second)
+	})
+
+	s.Run("should not share bucket between keys", func() {
+		wait, err := s.repository.Take(s.ctx, "slack:2", limit)
+		s.Require().NoError(err)
+		s.Assert().Zero(wait)
+	})
+
+	s.Run("should refill the bucket over time", func() {
+		time.Sleep(time.Second)
+
+		wait, err := s.repository.Take(s.ctx, "slack:1", limit)
+		s.Require().NoError(err)
+		s.Assert().Zero(wait)
+	})
+}
+
+func TestRateLimitRepository(t *testing.T) {
+	suite.Run(t, new(RateLimitRepositoryTestSuite))
+}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 
 	sq "github.com/Masterminds/squirrel"
@@ -24,6 +25,34 @@ DO UPDATE SET
 	($2, $3, $4, $5, $6, $7, now())
 RETURNING *`
 
+const ruleDeleteQuery = `
+DELETE from rules where id=$1 RETURNING id
+`
+
+const ruleRevisionInsertQuery = `
+INSERT INTO
+rule_revisions
+	(rule_id, name, namespace, group_name, template, enabled, variables, provider_namespace, body, deleted, actor, created_at)
+VALUES
+	($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, now())
+RETURNING *`
+
+var ruleRevisionListQueryBuilder = sq.Select(
+	"id",
+	"rule_id",
+	"name",
+	"namespace",
+	"group_name",
+	"template",
+	"enabled",
+	"variables",
+	"provider_namespace",
+	"body",
+	"deleted",
+	"actor",
+	"created_at",
+).From("rule_revisions")
+
 var ruleListQueryBuilder = sq.Select(
 	"id",
 	"name",
@@ -134,6 +163,125 @@ func (r *RuleRepository) List(ctx context.Context, flt rule.Filter) ([]rule.Rule
 	return rulesDomain, nil
 }
 
+func (r *RuleRepository) Get(ctx context.Context, id uint64) (*rule.Rule, error) {
+	query, args, err := ruleListQueryBuilder.
+		Where("id = ?", id).
+		PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var ruleModel model.Rule
+	if err := r.client.GetContext(ctx, pgc.OpSelect, r.tableName, &ruleModel, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, rule.NotFoundError{ID: id}
+		}
+		return nil, err
+	}
+
+	return ruleModel.ToDomain()
+}
+
+func (r *RuleRepository) Delete(ctx context.Context, id uint64) error {
+	// query through the transaction in ctx if any, so the deletion is rolled back when deleting from provider failed
+	var deletedID uint64
+	if err := r.client.QueryRowxContext(ctx, pgc.OpDelete, r.tableName, ruleDeleteQuery, id).Scan(&deletedID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *RuleRepository) CreateRevision(ctx context.Context, rev *rule.Revision) error {
+	if rev == nil {
+		return errors.New("rule revision domain is nil")
+	}
+
+	revisionModel := new(model.RuleRevision)
+	if err := revisionModel.FromDomain(*rev); err != nil {
+		return err
+	}
+
+	var insertedRevision model.RuleRevision
+	if err := r.client.QueryRowxContext(ctx, pgc.OpInsert, "rule_revisions", ruleRevisionInsertQuery,
+		revisionModel.RuleID,
+		revisionModel.Name,
+		revisionModel.Namespace,
+		revisionModel.GroupName,
+		revisionModel.Template,
+		revisionModel.Enabled,
+		revisionModel.Variables,
+		revisionModel.ProviderNamespace,
+		revisionModel.Body,
+		revisionModel.Deleted,
+		revisionModel.Actor,
+	).StructScan(&insertedRevision); err != nil {
+		return err
+	}
+
+	newRevision, err := insertedRevision.ToDomain()
+	if err != nil {
+		return err
+	}
+
+	*rev = *newRevision
+
+	return nil
+}
+
+func (r *RuleRepository) ListRevisions(ctx context.Context, ruleID uint64) ([]rule.Revision, error) {
+	query, args, err := ruleRevisionListQueryBuilder.
+		Where("rule_id = ?", ruleID).
+		OrderBy("id DESC").
+		PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.client.QueryxContext(ctx, pgc.OpSelectAll, "rule_revisions", query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisionsDomain []rule.Revision
+	for rows.Next() {
+		var revisionModel model.RuleRevision
+		if err := rows.StructScan(&revisionModel); err != nil {
+			return nil, err
+		}
+
+		rev, err := revisionModel.ToDomain()
+		if err != nil {
+			return nil, err
+		}
+		revisionsDomain = append(revisionsDomain, *rev)
+	}
+
+	return revisionsDomain, nil
+}
+
+func (r *RuleRepository) GetRevision(ctx context.Context, id uint64) (*rule.Revision, error) {
+	query, args, err := ruleRevisionListQueryBuilder.
+		Where("id = ?", id).
+		PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var revisionModel model.RuleRevision
+	if err := r.client.GetContext(ctx, pgc.OpSelect, "rule_revisions", &revisionModel, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, rule.RevisionNotFoundError{ID: id}
+		}
+		return nil, err
+	}
+
+	return revisionModel.ToDomain()
+}
+
 func (r *RuleRepository) WithTransaction(ctx context.Context) context.Context {
 	return r.client.WithTransaction(ctx, nil)
 }
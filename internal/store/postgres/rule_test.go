@@ -92,6 +92,7 @@ func (s *RuleRepositoryTestSuite) TearDownTest() {
 func (s *RuleRepositoryTestSuite) cleanup() error {
 	queries := []string{
 		"TRUNCATE TABLE rules RESTART IDENTITY CASCADE",
+		"TRUNCATE TABLE rule_revisions RESTART IDENTITY CASCADE",
 	}
 	return execQueries(context.TODO(), s.client, queries)
 }
@@ -343,6 +344,132 @@ func (s *RuleRepositoryTestSuite) TestUpsert() {
 	}
 }
 
+func (s *RuleRepositoryTestSuite) TestGet() {
+	type testCase struct {
+		Description  string
+		PassedID     uint64
+		ExpectedRule *rule.Rule
+		ErrString    string
+	}
+
+	var testCases = []testCase{
+		{
+			Description: "should get a rule",
+			PassedID:    1,
+			ExpectedRule: &rule.Rule{
+				ID:        1,
+				Name:      "prefix_provider-urn-1_namespace-urn-1_namespace-1_group-name-1_template-name-1",
+				GroupName: "group-name-1",
+				Namespace: "namespace-urn-1",
+				Template:  "template-name-1",
+				Variables: []rule.RuleVariable{
+					{
+						Name:  "WARN_THRESHOLD",
+						Value: "200",
+						Type:  "int",
+					},
+					{
+						Name:  "CRIT_THRESHOLD",
+						Value: "300",
+						Type:  "int",
+					},
+					{
+						Name:  "team",
+						Value: "odpf-app",
+					},
+					{
+						Name:  "name",
+						Value: "namespace-urn-1",
+					},
+					{
+						Name:  "entity",
+						Value: "odpf",
+					},
+				},
+				ProviderNamespace: 1,
+			},
+		},
+		{
+			Description: "should return not found if id not found",
+			PassedID:    1000,
+			ErrString:   "rule with id 1000 not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.Description, func() {
+			got, err := s.repository.Get(s.ctx, tc.PassedID)
+			if tc.ErrString != "" {
+				if err.Error() != tc.ErrString {
+					s.T().Fatalf("got error %s, expected was %s", err.Error(), tc.ErrString)
+				}
+			}
+			if !cmp.Equal(got, tc.ExpectedRule, cmpopts.IgnoreFields(rule.Rule{}, "CreatedAt", "UpdatedAt")) {
+				s.T().Fatalf("got result %+v, expected was %+v", got, tc.ExpectedRule)
+			}
+		})
+	}
+}
+
+func (s *RuleRepositoryTestSuite) TestDelete() {
+	s.Run("should delete a rule", func() {
+		err := s.repository.Delete(s.ctx, 1)
+		s.NoError(err)
+
+		_, err = s.repository.Get(s.ctx, 1)
+		s.ErrorIs(err, rule.NotFoundError{ID: 1})
+	})
+
+	s.Run("should keep the rule if deletion transaction is rolled back", func() {
+		ctx := s.repository.WithTransaction(context.Background())
+		err := s.repository.Delete(ctx, 2)
+		s.NoError(err)
+
+		err = s.repository.Rollback(ctx, nil)
+		s.NoError(err)
+
+		fetchedRule, err := s.repository.Get(s.ctx, 2)
+		s.NoError(err)
+		s.Equal(uint64(2), fetchedRule.ID)
+	})
+}
+
+func (s *RuleRepositoryTestSuite) TestRevisions() {
+	s.Run("should append revisions and list them from the latest", func() {
+		for _, value := range []string{"80", "90"} {
+			err := s.repository.CreateRevision(s.ctx, &rule.Revision{
+				RuleID:            1,
+				Name:              "prefix_provider-urn_namespace-urn_namespace-1_group-name-1_template-name-1",
+				Enabled:           true,
+				GroupName:         "group-name-1",
+				Namespace:         "namespace-1",
+				Template:          "template-name-1",
+				Variables:         []rule.RuleVariable{{Name: "warning", Value: value}},
+				ProviderNamespace: 1,
+				Body:              "- alert: foo\n  expr: up > " + value + "\n",
+				Actor:             "user@odpf.io",
+			})
+			s.NoError(err)
+		}
+
+		revisions, err := s.repository.ListRevisions(s.ctx, 1)
+		s.NoError(err)
+		s.Len(revisions, 2)
+		s.Equal("90", revisions[0].Variables[0].Value)
+		s.Equal("- alert: foo\n  expr: up > 80\n", revisions[1].Body)
+		s.Equal("user@odpf.io", revisions[1].Actor)
+
+		rev, err := s.repository.GetRevision(s.ctx, revisions[1].ID)
+		s.NoError(err)
+		s.Equal("80", rev.Variables[0].Value)
+	})
+
+	s.Run("should return not found error if revision does not exist", func() {
+		_, err := s.repository.GetRevision(s.ctx, 1000)
+		s.ErrorIs(err, rule.RevisionNotFoundError{ID: 1000})
+	})
+}
+
 func (s *RuleRepositoryTestSuite) TestTransaction() {
 	s.Run("successfully commit transaction", func() {
 		ctx := s.repository.WithTransaction(context.Background())
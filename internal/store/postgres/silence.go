@@ -13,8 +13,8 @@ import (
 )
 
 const silenceInsertQuery = `
-INSERT INTO silences (namespace_id, type, target_id, target_expression, creator, comment, created_at)
-    VALUES ($1, $2, $3, $4, $5, $6, now())
+INSERT INTO silences (namespace_id, type, target_id, target_expression, creator, comment, starts_at, ends_at, schedule_cron, schedule_duration, schedule_time_zone, created_at)
+    VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, now())
 RETURNING *
 `
 
@@ -26,6 +26,11 @@ var silenceListQueryBuilder = sq.Select(
 	"target_expression",
 	"creator",
 	"comment",
+	"starts_at",
+	"ends_at",
+	"schedule_cron",
+	"schedule_duration",
+	"schedule_time_zone",
 	"created_at",
 	"deleted_at",
 ).From("silences")
@@ -36,6 +41,11 @@ WHERE id = $1 AND deleted_at IS NULL
 RETURNING *
 `
 
+const silenceExpireLapsedQuery = `
+UPDATE silences SET deleted_at=now()
+WHERE deleted_at IS NULL AND ends_at IS NOT NULL AND ends_at <= now()
+`
+
 // SilenceRepository talks to the store to read or insert data
 type SilenceRepository struct {
 	client    *pgc.Client
@@ -59,6 +69,11 @@ func (r *SilenceRepository) Create(ctx context.Context, s silence.Silence) (stri
 		sModel.TargetExpression,
 		sModel.Creator,
 		sModel.Comment,
+		sModel.StartsAt,
+		sModel.EndsAt,
+		sModel.ScheduleCron,
+		sModel.ScheduleDuration,
+		sModel.ScheduleTimeZone,
 	).StructScan(&newSModel); err != nil {
 		err = pgc.CheckError(err)
 		if errors.Is(err, pgc.ErrForeignKeyViolation) {
@@ -75,6 +90,10 @@ func (r *SilenceRepository) List(ctx context.Context, flt silence.Filter) ([]sil
 
 	queryBuilder = queryBuilder.Where("deleted_at IS NULL")
 
+	// only silences within their window are applicable
+	queryBuilder = queryBuilder.Where("(starts_at IS NULL OR starts_at <= now())")
+	queryBuilder = queryBuilder.Where("(ends_at IS NULL OR ends_at > now())")
+
 	if flt.NamespaceID != 0 {
 		queryBuilder = queryBuilder.Where("namespace_id = ?", flt.NamespaceID)
 	}
@@ -146,3 +165,22 @@ func (r *SilenceRepository) SoftDelete(ctx context.Context, id string) error {
 
 	return nil
 }
+
+// ExpireLapsed soft deletes all silences that have passed their ends_at
+func (r *SilenceRepository) ExpireLapsed(ctx context.Context) error {
+	rows, err := r.client.ExecContext(ctx, pgc.OpUpdate, r.tableName, silenceExpireLapsedQuery)
+	if err != nil {
+		return err
+	}
+
+	ra, err := rows.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if ra == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
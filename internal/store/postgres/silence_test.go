@@ -3,6 +3,7 @@ package postgres_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -11,6 +12,7 @@ import (
 	"github.com/odpf/salt/log"
 	"github.com/odpf/siren/core/silence"
 	"github.com/odpf/siren/internal/store/postgres"
+	"github.com/odpf/siren/pkg/errors"
 	"github.com/odpf/siren/pkg/pgc"
 	"github.com/ory/dockertest/v3"
 	"github.com/stretchr/testify/suite"
@@ -130,6 +132,21 @@ func (s *SilenceRepositoryTestSuite) TestCreate() {
 				},
 			},
 		},
+		{
+			Description: "should create a silence type recurring",
+			SilenceToCreate: silence.Silence{
+				NamespaceID: 1,
+				Type:        silence.TypeRecurring,
+				TargetExpression: map[string]interface{}{
+					"key1": "val1",
+				},
+				Schedule: silence.Schedule{
+					Cron:     "0 2 * * 0",
+					Duration: 2 * time.Hour,
+					TimeZone: "Asia/Jakarta",
+				},
+			},
+		},
 		{
 			Description: "should return error if a silence is invalid",
 			SilenceToCreate: silence.Silence{
@@ -411,6 +428,70 @@ func (s *SilenceRepositoryTestSuite) TestSoftDelete() {
 	})
 }
 
+func (s *SilenceRepositoryTestSuite) TestListWindow() {
+	s.Run("should not return silences outside their window", func() {
+		_, err := s.repository.Create(s.ctx, silence.Silence{
+			NamespaceID: 1,
+			Type:        silence.TypeMatchers,
+			TargetExpression: map[string]interface{}{
+				"key1": "value1",
+			},
+			StartsAt: time.Now().Add(time.Hour),
+		})
+		s.Require().NoError(err)
+
+		_, err = s.repository.Create(s.ctx, silence.Silence{
+			NamespaceID: 1,
+			Type:        silence.TypeMatchers,
+			TargetExpression: map[string]interface{}{
+				"key1": "value1",
+			},
+			EndsAt: time.Now().Add(-time.Hour),
+		})
+		s.Require().NoError(err)
+
+		_, err = s.repository.Create(s.ctx, silence.Silence{
+			NamespaceID: 1,
+			Type:        silence.TypeMatchers,
+			TargetExpression: map[string]interface{}{
+				"key1": "value1",
+			},
+			StartsAt: time.Now().Add(-time.Hour),
+			EndsAt:   time.Now().Add(time.Hour),
+		})
+		s.Require().NoError(err)
+
+		silences, err := s.repository.List(s.ctx, silence.Filter{})
+		s.Require().NoError(err)
+		s.Require().Equal(5, len(silences))
+	})
+}
+
+func (s *SilenceRepositoryTestSuite) TestExpireLapsed() {
+	s.Run("should return not found if no silences have lapsed", func() {
+		err := s.repository.ExpireLapsed(s.ctx)
+		s.Assert().ErrorIs(err, errors.ErrNotFound)
+	})
+
+	s.Run("should expire silences that have passed their ends at", func() {
+		id, err := s.repository.Create(s.ctx, silence.Silence{
+			NamespaceID: 1,
+			Type:        silence.TypeMatchers,
+			TargetExpression: map[string]interface{}{
+				"key1": "value1",
+			},
+			EndsAt: time.Now().Add(-time.Minute),
+		})
+		s.Require().NoError(err)
+
+		err = s.repository.ExpireLapsed(s.ctx)
+		s.Assert().NoError(err)
+
+		_, err = s.repository.Get(s.ctx, id)
+		s.Assert().Error(err)
+	})
+}
+
 func TestSilenceRepository(t *testing.T) {
 	suite.Run(t, new(SilenceRepositoryTestSuite))
 }
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"fmt"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/odpf/siren/core/template"
@@ -24,6 +25,23 @@ const templateDeleteByNameQuery = `
 DELETE from templates where name=$1
 `
 
+const templateRevisionInsertQuery = `
+INSERT INTO template_revisions (template_id, name, body, tags, variables, actor, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, now())
+RETURNING *
+`
+
+var templateRevisionListQueryBuilder = sq.Select(
+	"id",
+	"template_id",
+	"name",
+	"body",
+	"tags",
+	"variables",
+	"actor",
+	"created_at",
+).From("template_revisions")
+
 var templateListQueryBuilder = sq.Select(
 	"id",
 	"name",
@@ -140,3 +158,98 @@ func (r TemplateRepository) Delete(ctx context.Context, name string) error {
 	}
 	return nil
 }
+
+func (r TemplateRepository) CreateRevision(ctx context.Context, rev *template.Revision) error {
+	if rev == nil {
+		return errors.New("template revision domain is nil")
+	}
+
+	revisionModel := new(model.TemplateRevision)
+	if err := revisionModel.FromDomain(*rev); err != nil {
+		return err
+	}
+
+	var insertedRevision model.TemplateRevision
+	if err := r.client.QueryRowxContext(ctx, pgc.OpInsert, "template_revisions", templateRevisionInsertQuery,
+		revisionModel.TemplateID,
+		revisionModel.Name,
+		revisionModel.Body,
+		revisionModel.Tags,
+		revisionModel.Variables,
+		revisionModel.Actor,
+	).StructScan(&insertedRevision); err != nil {
+		return err
+	}
+
+	newRevision, err := insertedRevision.ToDomain()
+	if err != nil {
+		return err
+	}
+
+	*rev = *newRevision
+
+	return nil
+}
+
+func (r TemplateRepository) ListRevisions(ctx context.Context, name string) ([]template.Revision, error) {
+	query, args, err := templateRevisionListQueryBuilder.
+		Where("name = ?", name).
+		OrderBy("id DESC").
+		PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.client.QueryxContext(ctx, pgc.OpSelectAll, "template_revisions", query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	revisionsDomain := []template.Revision{}
+	for rows.Next() {
+		var revisionModel model.TemplateRevision
+		if err := rows.StructScan(&revisionModel); err != nil {
+			return nil, err
+		}
+		rev, err := revisionModel.ToDomain()
+		if err != nil {
+			return nil, err
+		}
+		revisionsDomain = append(revisionsDomain, *rev)
+	}
+
+	return revisionsDomain, nil
+}
+
+func (r TemplateRepository) GetRevision(ctx context.Context, id uint64) (*template.Revision, error) {
+	query, args, err := templateRevisionListQueryBuilder.Where("id = ?", id).PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var revisionModel model.TemplateRevision
+	if err = r.client.GetContext(ctx, pgc.OpSelect, "template_revisions", &revisionModel, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, template.RevisionNotFoundError{ID: id}
+		}
+		return nil, err
+	}
+
+	return revisionModel.ToDomain()
+}
+
+func (r TemplateRepository) WithTransaction(ctx context.Context) context.Context {
+	return r.client.WithTransaction(ctx, nil)
+}
+
+func (r TemplateRepository) Rollback(ctx context.Context, err error) error {
+	if txErr := r.client.Rollback(ctx); txErr != nil {
+		return fmt.Errorf("rollback error %s with error: %w", txErr.Error(), err)
+	}
+	return nil
+}
+
+func (r TemplateRepository) Commit(ctx context.Context) error {
+	return r.client.Commit(ctx)
+}
@@ -82,6 +82,7 @@ func (s *TemplateRepositoryTestSuite) TearDownTest() {
 func (s *TemplateRepositoryTestSuite) cleanup() error {
 	queries := []string{
 		"TRUNCATE TABLE templates RESTART IDENTITY CASCADE",
+		"TRUNCATE TABLE template_revisions RESTART IDENTITY CASCADE",
 	}
 	return execQueries(context.TODO(), s.client, queries)
 }
@@ -363,6 +364,39 @@ func (s *TemplateRepositoryTestSuite) TestDelete() {
 	}
 }
 
+func (s *TemplateRepositoryTestSuite) TestRevisions() {
+	s.Run("should append revisions and list them from the latest", func() {
+		for _, body := range []string{"old body", "new body"} {
+			err := s.repository.CreateRevision(s.ctx, &template.Revision{
+				TemplateID: 1,
+				Name:       "zookeeper-pending-syncs",
+				Body:       body,
+				Tags:       []string{"zookeeper"},
+				Variables:  []template.Variable{{Name: "for", Type: "string", Default: "5m"}},
+				Actor:      "user@odpf.io",
+			})
+			s.NoError(err)
+		}
+
+		revisions, err := s.repository.ListRevisions(s.ctx, "zookeeper-pending-syncs")
+		s.NoError(err)
+		s.Len(revisions, 2)
+		s.Equal("new body", revisions[0].Body)
+		s.Equal("old body", revisions[1].Body)
+		s.Equal("user@odpf.io", revisions[1].Actor)
+		s.Equal([]template.Variable{{Name: "for", Type: "string", Default: "5m"}}, revisions[1].Variables)
+
+		rev, err := s.repository.GetRevision(s.ctx, revisions[1].ID)
+		s.NoError(err)
+		s.Equal("old body", rev.Body)
+	})
+
+	s.Run("should return not found error if revision does not exist", func() {
+		_, err := s.repository.GetRevision(s.ctx, 1000)
+		s.ErrorIs(err, template.RevisionNotFoundError{ID: 1000})
+	})
+}
+
 func TestTemplateRepository(t *testing.T) {
 	suite.Run(t, new(TemplateRepositoryTestSuite))
 }
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/odpf/siren/core/notification"
+	"github.com/odpf/siren/internal/store/model"
+	"github.com/odpf/siren/pkg/errors"
+	"github.com/odpf/siren/pkg/pgc"
+)
+
+// the first message of a thread wins if two messages are sent concurrently
+const threadInsertQuery = `
+INSERT INTO notification_threads (unique_key, receiver_type, destination, external_id, channel_id, created_at)
+    VALUES ($1, $2, $3, $4, $5, now())
+ON CONFLICT (unique_key, receiver_type, destination) DO NOTHING
+`
+
+const threadGetQuery = `
+SELECT * FROM notification_threads WHERE unique_key = $1 AND receiver_type = $2 AND destination = $3
+`
+
+const threadDeleteQuery = `
+DELETE FROM notification_threads WHERE unique_key = $1 AND receiver_type = $2 AND destination = $3
+`
+
+// ThreadRepository talks to the store to read or insert threads of sent notifications
+type ThreadRepository struct {
+	client    *pgc.Client
+	tableName string
+}
+
+// NewThreadRepository returns ThreadRepository struct
+func NewThreadRepository(client *pgc.Client) *ThreadRepository {
+	return &ThreadRepository{
+		client:    client,
+		tableName: "notification_threads",
+	}
+}
+
+func (r *ThreadRepository) Get(ctx context.Context, uniqueKey, receiverType, destination string) (notification.Thread, error) {
+	var threadModel model.NotificationThread
+	if err := r.client.QueryRowxContext(ctx, pgc.OpSelect, r.tableName, threadGetQuery,
+		uniqueKey, receiverType, destination,
+	).StructScan(&threadModel); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return notification.Thread{}, errors.ErrNotFound
+		}
+		return notification.Thread{}, err
+	}
+
+	return threadModel.ToDomain(), nil
+}
+
+func (r *ThreadRepository) Create(ctx context.Context, t notification.Thread) error {
+	threadModel := new(model.NotificationThread)
+	threadModel.FromDomain(t)
+
+	if _, err := r.client.ExecContext(ctx, pgc.OpInsert, r.tableName, threadInsertQuery,
+		threadModel.UniqueKey,
+		threadModel.ReceiverType,
+		threadModel.Destination,
+		threadModel.ExternalID,
+		threadModel.ChannelID,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ThreadRepository) Delete(ctx context.Context, uniqueKey, receiverType, destination string) error {
+	if _, err := r.client.ExecContext(ctx, pgc.OpDelete, r.tableName, threadDeleteQuery,
+		uniqueKey, receiverType, destination,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/odpf/salt/db"
+	"github.com/odpf/salt/dockertestx"
+	"github.com/odpf/salt/log"
+	"github.com/ory/dockertest/v3"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/odpf/siren/core/notification"
+	"github.com/odpf/siren/internal/store/postgres"
+	"github.com/odpf/siren/pkg/errors"
+	"github.com/odpf/siren/pkg/pgc"
+)
+
+type ThreadRepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	client     *pgc.Client
+	pool       *dockertest.Pool
+	resource   *dockertest.Resource
+	repository *postgres.ThreadRepository
+}
+
+func (s *ThreadRepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := log.NewZap()
+	dpg, err := dockertestx.CreatePostgres(
+		dockertestx.PostgresWithDetail(
+			pgUser, pgPass, pgDBName,
+		),
+		dockertestx.PostgresWithVersionTag("13"),
+	)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.pool = dpg.GetPool()
+	s.resource = dpg.GetResource()
+
+	dbConfig.URL = dpg.GetExternalConnString()
+	dbc, err := db.New(dbConfig)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.client, err = pgc.NewClient(logger, dbc)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	s.ctx = context.TODO()
+	s.Require().NoError(migrate(s.ctx, logger, s.client, dbConfig))
+	s.repository = postgres.NewThreadRepository(s.client)
+}
+
+func (s *ThreadRepositoryTestSuite) TearDownTest() {
+	if err := s.cleanup(); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *ThreadRepositoryTestSuite) TearDownSuite() {
+	// Clean tests
+	if err := purgeDocker(s.pool, s.resource); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *ThreadRepositoryTestSuite) cleanup() error {
+	queries := []string{
+		"TRUNCATE TABLE notification_threads RESTART IDENTITY CASCADE",
+	}
+	return execQueries(context.TODO(), s.client, queries)
+}
+
+func (s *ThreadRepositoryTestSuite) TestCreateGetAndDelete() {
+	s.Run("should return not found if thread does not exist", func() {
+		_, err := s.repository.Get(s.ctx, "unique-key", "slack", "odpf/channel")
+		s.Assert().ErrorIs(err, errors.ErrNotFound)
+	})
+
+	s.Run("should keep the first message of the thread", func() {
+		err := s.repository.Create(s.ctx, notification.Thread{
+			UniqueKey:    "unique-key",
+			ReceiverType: "slack",
+			Destination:  "odpf/channel",
+			ExternalID:   "1",
+			ChannelID:    "D1",
+		})
+		s.Require().NoError(err)
+
+		err = s.repository.Create(s.ctx, notification.Thread{
+			UniqueKey:    "unique-key",
+			ReceiverType: "slack",
+			Destination:  "odpf/channel",
+			ExternalID:   "2",
+			ChannelID:    "D2",
+		})
+		s.Require().NoError(err)
+
+		got, err := s.repository.Get(s.ctx, "unique-key", "slack", "odpf/channel")
+		s.Require().NoError(err)
+		s.Assert().Equal("1", got.ExternalID)
+		s.Assert().Equal("D1", got.ChannelID)
+	})
+
+	s.Run("should not get thread once deleted", func() {
+		err := s.repository.Delete(s.ctx, "unique-key", "slack", "odpf/channel")
+		s.Require().NoError(err)
+
+		_, err = s.repository.Get(s.ctx, "unique-key", "slack", "odpf/channel")
+		s.Assert().ErrorIs(err, errors.ErrNotFound)
+	})
+}
+
+func TestThreadRepository(t *testing.T) {
+	suite.Run(t, new(ThreadRepositoryTestSuite))
+}
@@ -0,0 +1,21 @@
+// Package actor carries the identity of whoever triggers a change through context,
+// so stores could record it without changing every method signature.
+package actor
+
+import "context"
+
+type contextKey struct{}
+
+// WithContext returns a copy of ctx carrying the actor, empty actor is ignored
+func WithContext(ctx context.Context, actor string) context.Context {
+	if actor == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, contextKey{}, actor)
+}
+
+// FromContext returns the actor carried by ctx or empty string if there is none
+func FromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(contextKey{}).(string)
+	return actor
+}
@@ -0,0 +1,21 @@
+package actor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/odpf/siren/pkg/actor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContext(t *testing.T) {
+	t.Run("should return actor carried by context", func(t *testing.T) {
+		ctx := actor.WithContext(context.Background(), "user@odpf.io")
+		assert.Equal(t, "user@odpf.io", actor.FromContext(ctx))
+	})
+
+	t.Run("should return empty string if context carries no actor", func(t *testing.T) {
+		assert.Equal(t, "", actor.FromContext(context.Background()))
+		assert.Equal(t, "", actor.FromContext(actor.WithContext(context.Background(), "")))
+	})
+}
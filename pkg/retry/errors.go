@@ -1,7 +1,12 @@
 package retry
 
+import "time"
+
 type RetryableError struct {
 	Err error
+	// RetryAfter overrides the wait duration before the next retry if set,
+	// e.g. when the upstream asks to wait with a rate limit response
+	RetryAfter time.Duration
 }
 
 func (rt RetryableError) Error() string {
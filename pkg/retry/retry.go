@@ -48,7 +48,8 @@ func (w *wrapper) Run(ctx context.Context, f func(ctx context.Context) error) er
 			return fmt.Errorf("context cancelled")
 		default:
 			err = f(ctx)
-			if err == nil || !errors.As(err, new(RetryableError)) {
+			var retryableErr RetryableError
+			if err == nil || !errors.As(err, &retryableErr) {
 				return err
 			}
 
@@ -68,7 +69,17 @@ func (w *wrapper) Run(ctx context.Context, f func(ctx context.Context) error) er
 				random := rand.New(rand.NewSource(time.Now().UnixNano()))
 				waitDuration = time.Duration(float64(waitDuration) * random.Float64())
 			}
-			time.Sleep(waitDuration)
+
+			// The upstream knows better when it is ready to accept the next call.
+			if retryableErr.RetryAfter > 0 {
+				waitDuration = retryableErr.RetryAfter
+			}
+
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("context cancelled")
+			case <-time.After(waitDuration):
+			}
 		}
 	}
 	return err
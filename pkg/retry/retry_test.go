@@ -141,3 +141,50 @@ func TestRetrier_RetryWithoutBackoff(t *testing.T) {
 		})
 	}
 }
+
+type testRetryAfter struct {
+	prevExecution time.Time
+	waitTimes     []time.Duration
+	retryAfter    time.Duration
+}
+
+func (tr *testRetryAfter) Execute(ctx context.Context) error {
+	now := time.Now()
+
+	if !tr.prevExecution.IsZero() {
+		durationSince := now.Sub(tr.prevExecution)
+		tr.waitTimes = append(tr.waitTimes, durationSince.Round(time.Millisecond))
+	}
+	tr.prevExecution = now
+
+	return retry.RetryableError{Err: errors.New("rate limited"), RetryAfter: tr.retryAfter}
+}
+
+func TestRetrier_RetryAfter(t *testing.T) {
+	t.Run("should wait for retry after of the error instead of the wait duration", func(t *testing.T) {
+		tr := &testRetryAfter{retryAfter: 30 * time.Millisecond}
+		rtr := retry.New(retry.Config{
+			MaxTries:     2,
+			WaitDuration: 5 * time.Millisecond,
+			Enable:       true,
+		})
+		_ = rtr.Run(context.TODO(), tr.Execute)
+
+		assert.InEpsilonSlice(t, []time.Duration{30 * time.Millisecond, 30 * time.Millisecond}, tr.waitTimes, 0.1)
+	})
+
+	t.Run("should stop waiting if context is cancelled", func(t *testing.T) {
+		tr := &testRetryAfter{retryAfter: time.Minute}
+		rtr := retry.New(retry.Config{
+			MaxTries: 2,
+			Enable:   true,
+		})
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err := rtr.Run(ctx, tr.Execute)
+
+		assert.EqualError(t, err, "context cancelled")
+		assert.Empty(t, tr.waitTimes)
+	})
+}
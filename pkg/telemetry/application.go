@@ -26,6 +26,12 @@ var (
 	MetricNotificationSubscriberNotFound = stats.Int64("notification.subscriber.notfound", "notification does not match any subscription", stats.UnitDimensionless)
 
 	MetricReceiverHookFailed = stats.Int64("receiver.hook.failed", "failed hook condition", stats.UnitDimensionless)
+
+	MetricNotificationGroupSuppressed = stats.Int64("notification.group.suppressed", "grouped messages suppressed within repeat interval", stats.UnitDimensionless)
+
+	MetricNotificationMessageThrottled = stats.Int64("notification.message.throttled", "messages put back to the queue by receiver rate limit", stats.UnitDimensionless)
+
+	MetricNotificationMessageReclaimed = stats.Int64("notification.message.reclaimed", "pending messages put back to the queue after visibility timeout", stats.UnitDimensionless)
 )
 
 func setupApplicationViews() error {
@@ -57,5 +63,26 @@ func setupApplicationViews() error {
 			Measure:     MetricReceiverHookFailed,
 			Aggregation: view.Count(),
 		},
+		&view.View{
+			Name:        MetricNotificationGroupSuppressed.Name(),
+			Description: MetricNotificationGroupSuppressed.Description(),
+			TagKeys:     []tag.Key{TagReceiverType},
+			Measure:     MetricNotificationGroupSuppressed,
+			Aggregation: view.Count(),
+		},
+		&view.View{
+			Name:        MetricNotificationMessageThrottled.Name(),
+			Description: MetricNotificationMessageThrottled.Description(),
+			TagKeys:     []tag.Key{TagReceiverType},
+			Measure:     MetricNotificationMessageThrottled,
+			Aggregation: view.Count(),
+		},
+		&view.View{
+			Name:        MetricNotificationMessageReclaimed.Name(),
+			Description: MetricNotificationMessageReclaimed.Description(),
+			TagKeys:     []tag.Key{TagReceiverType},
+			Measure:     MetricNotificationMessageReclaimed,
+			Aggregation: view.Count(),
+		},
 	)
 }
@@ -2,8 +2,14 @@ package providers
 
 import (
 	"github.com/odpf/siren/plugins/providers/cortex"
+	"github.com/odpf/siren/plugins/providers/loki"
+	"github.com/odpf/siren/plugins/providers/mimir"
+	"github.com/odpf/siren/plugins/providers/prometheus"
 )
 
 type Config struct {
-	Cortex cortex.AppConfig `mapstructure:"cortex"`
+	Cortex     cortex.AppConfig     `mapstructure:"cortex"`
+	Prometheus prometheus.AppConfig `mapstructure:"prometheus"`
+	Mimir      mimir.AppConfig      `mapstructure:"mimir"`
+	Loki       loki.AppConfig       `mapstructure:"loki"`
 }
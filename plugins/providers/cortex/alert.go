@@ -1,6 +1,14 @@
 package cortex
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/odpf/salt/log"
+	"github.com/odpf/siren/core/alert"
+)
 
 // GroupAlert contract is cortex/prometheus webhook_config contract
 // https://prometheus.io/docs/alerting/latest/configuration/#webhook_config
@@ -44,3 +52,69 @@ func (a Alert) Validate() error {
 
 	return nil
 }
+
+// TransformGroupAlert transforms an alertmanager webhook body to []alert.Alert
+// it is shared by providers that notify siren through an alertmanager webhook receiver
+func TransformGroupAlert(logger log.Logger, providerID uint64, namespaceID uint64, body map[string]interface{}) ([]alert.Alert, int, error) {
+	var groupAlert = &GroupAlert{}
+	if err := mapstructure.Decode(body, groupAlert); err != nil {
+		return nil, 0, err
+	}
+
+	var (
+		alerts        = make([]alert.Alert, 0)
+		badAlertCount = 0
+		firingLen     = 0
+	)
+
+	for _, item := range groupAlert.Alerts {
+
+		if err := item.Validate(); err != nil {
+			logger.Error(fmt.Sprintf("invalid alerts: %s", err.Error()), "group key", groupAlert.GroupKey, "alert detail", item)
+			badAlertCount++
+			continue
+		}
+
+		if item.Status == "firing" {
+			firingLen++
+		}
+
+		severity := item.Labels["severity"]
+		if item.Status == "resolved" {
+			severity = item.Status
+		}
+
+		startsAt, err := time.Parse(time.RFC3339Nano, item.StartsAt)
+		if err != nil {
+			badAlertCount++
+			break
+		}
+
+		alrt := alert.Alert{
+			ProviderID:   providerID,
+			NamespaceID:  namespaceID,
+			ResourceName: item.Annotations["resource"],
+			MetricName:   item.Annotations["metric_name"],
+			MetricValue:  item.Annotations["metric_value"],
+			Severity:     severity,
+			Rule:         item.Annotations["template"],
+			TriggeredAt:  startsAt,
+
+			GroupKey:     groupAlert.GroupKey,
+			Status:       item.Status,
+			Annotations:  item.Annotations,
+			Labels:       item.Labels,
+			GeneratorURL: item.GeneratorURL,
+			Fingerprint:  item.Fingerprint,
+		}
+
+		alerts = append(alerts, alrt)
+	}
+
+	if badAlertCount > 0 {
+		logger.Error("parameters are missing for alert", "group key", groupAlert.GroupKey, "alert count", badAlertCount)
+		return alerts, firingLen, nil
+	}
+
+	return alerts, firingLen, nil
+}
@@ -1,9 +1,15 @@
 package cortex
 
-import "github.com/odpf/siren/pkg/httpclient"
+import (
+	"github.com/odpf/siren/pkg/httpclient"
+	"github.com/prometheus/prometheus/pkg/rulefmt"
+)
 
 type ServiceOption func(*PluginService)
 
+// RuleValidator validates rendered rule nodes of a rule-group before they are previewed
+type RuleValidator func(groupName string, ruleNodes []rulefmt.RuleNode) error
+
 // WithCortexClient uses cortex-tools client passed in the argument
 func WithCortexClient(cc CortexCaller) ServiceOption {
 	return func(so *PluginService) {
@@ -17,3 +23,17 @@ func WithHTTPClient(cli *httpclient.Client) ServiceOption {
 		so.httpClient = cli
 	}
 }
+
+// WithRulerAPI talks to a ruler that serves cortex ruler api under different paths instead of cortex
+func WithRulerAPI(api RulerAPI) ServiceOption {
+	return func(so *PluginService) {
+		so.rulerAPI = &api
+	}
+}
+
+// WithRuleValidator replaces the default prometheus rule validation, e.g. for rulers with non PromQL expressions
+func WithRuleValidator(validator RuleValidator) ServiceOption {
+	return func(so *PluginService) {
+		so.ruleValidator = validator
+	}
+}
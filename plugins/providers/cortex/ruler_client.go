@@ -0,0 +1,151 @@
+package cortex
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/grafana/cortex-tools/pkg/client"
+	"github.com/grafana/cortex-tools/pkg/rules/rwrulefmt"
+	"github.com/odpf/siren/pkg/httpclient"
+	"gopkg.in/yaml.v3"
+)
+
+const tenantHeader = "X-Scope-OrgID"
+
+// RulerAPI is the api paths of a ruler compatible with cortex ruler api
+// alertmanager path is empty if the ruler does not serve alertmanager config api
+type RulerAPI struct {
+	RulesPath        string
+	AlertmanagerPath string
+}
+
+// RulerClient is a CortexCaller of rulers that serve cortex ruler api under different paths, e.g. grafana mimir and loki
+// every request is scoped to the tenant with X-Scope-OrgID header
+type RulerClient struct {
+	address    string
+	tenant     string
+	api        RulerAPI
+	httpClient *httpclient.Client
+}
+
+func NewRulerClient(address, tenant string, api RulerAPI, httpClient *httpclient.Client) *RulerClient {
+	return &RulerClient{
+		address:    strings.TrimSuffix(address, "/"),
+		tenant:     tenant,
+		api:        api,
+		httpClient: httpClient,
+	}
+}
+
+type alertmanagerConfigPayload struct {
+	TemplateFiles      map[string]string `yaml:"template_files"`
+	AlertmanagerConfig string            `yaml:"alertmanager_config"`
+}
+
+// CreateAlertmanagerConfig creates or replaces alertmanager config of the tenant
+func (c *RulerClient) CreateAlertmanagerConfig(ctx context.Context, cfg string, templates map[string]string) error {
+	if c.api.AlertmanagerPath == "" {
+		return errors.New("ruler does not support alertmanager config api")
+	}
+
+	payload, err := yaml.Marshal(&alertmanagerConfigPayload{
+		TemplateFiles:      templates,
+		AlertmanagerConfig: cfg,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, http.MethodPost, c.api.AlertmanagerPath, payload)
+	return err
+}
+
+// CreateRuleGroup creates or replaces a rule group in the namespace
+func (c *RulerClient) CreateRuleGroup(ctx context.Context, namespace string, rg rwrulefmt.RuleGroup) error {
+	payload, err := yaml.Marshal(&rg)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, http.MethodPost, c.api.RulesPath+"/"+url.PathEscape(namespace), payload)
+	return err
+}
+
+// DeleteRuleGroup deletes a rule group in the namespace
+func (c *RulerClient) DeleteRuleGroup(ctx context.Context, namespace, groupName string) error {
+	_, err := c.do(ctx, http.MethodDelete, c.api.RulesPath+"/"+url.PathEscape(namespace)+"/"+url.PathEscape(groupName), nil)
+	return err
+}
+
+// GetRuleGroup gets a rule group in the namespace, returns client.ErrResourceNotFound if not exist
+func (c *RulerClient) GetRuleGroup(ctx context.Context, namespace, groupName string) (*rwrulefmt.RuleGroup, error) {
+	body, err := c.do(ctx, http.MethodGet, c.api.RulesPath+"/"+url.PathEscape(namespace)+"/"+url.PathEscape(groupName), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rg := rwrulefmt.RuleGroup{}
+	if err := yaml.Unmarshal(body, &rg); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal response: %w", err)
+	}
+
+	return &rg, nil
+}
+
+// ListRules lists rule groups of all namespaces or a namespace if not empty
+func (c *RulerClient) ListRules(ctx context.Context, namespace string) (map[string][]rwrulefmt.RuleGroup, error) {
+	path := c.api.RulesPath
+	if namespace != "" {
+		path = path + "/" + url.PathEscape(namespace)
+	}
+
+	body, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleSet := map[string][]rwrulefmt.RuleGroup{}
+	if err := yaml.Unmarshal(body, &ruleSet); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal response: %w", err)
+	}
+
+	return ruleSet, nil
+}
+
+func (c *RulerClient) do(ctx context.Context, method, path string, payload []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.address+path, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set(tenantHeader, c.tenant)
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/yaml")
+	}
+
+	resp, err := c.httpClient.HTTP().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failure in http call: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, client.ErrResourceNotFound
+	}
+
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("server returned HTTP status %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	return body, nil
+}
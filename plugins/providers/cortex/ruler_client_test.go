@@ -0,0 +1,113 @@
+package cortex_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grafana/cortex-tools/pkg/client"
+	"github.com/grafana/cortex-tools/pkg/rules/rwrulefmt"
+	"github.com/odpf/siren/pkg/errors"
+	"github.com/odpf/siren/pkg/httpclient"
+	"github.com/odpf/siren/plugins/providers/cortex"
+	"github.com/prometheus/prometheus/pkg/rulefmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+var testRulerAPI = cortex.RulerAPI{
+	RulesPath:        "/ruler/rules",
+	AlertmanagerPath: "/am/config",
+}
+
+func TestRulerClient(t *testing.T) {
+	t.Run("should send requests to the ruler api path with tenant header", func(t *testing.T) {
+		type request struct {
+			method, path, tenant, body string
+		}
+		var requests []request
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			requests = append(requests, request{r.Method, r.URL.EscapedPath(), r.Header.Get("X-Scope-OrgID"), string(body)})
+			switch r.Method {
+			case http.MethodGet:
+				w.Write([]byte("name: cpu\nrules:\n  - alert: cpu high\n    expr: up == 0\n"))
+			case http.MethodPost:
+				w.WriteHeader(http.StatusAccepted)
+			}
+		}))
+		defer testServer.Close()
+
+		c := cortex.NewRulerClient(testServer.URL+"/", "tenant-1", testRulerAPI, httpclient.New(httpclient.Config{}))
+
+		rg := rwrulefmt.RuleGroup{RuleGroup: rulefmt.RuleGroup{Name: "cpu"}}
+		require.NoError(t, c.CreateRuleGroup(context.Background(), "system ns", rg))
+
+		got, err := c.GetRuleGroup(context.Background(), "system ns", "cpu")
+		require.NoError(t, err)
+		assert.Equal(t, "cpu", got.Name)
+		require.Len(t, got.Rules, 1)
+		assert.Equal(t, "up == 0", got.Rules[0].Expr.Value)
+
+		require.NoError(t, c.DeleteRuleGroup(context.Background(), "system ns", "cpu"))
+		require.NoError(t, c.CreateAlertmanagerConfig(context.Background(), "route: {}", map[string]string{"helper.tmpl": "tmpl"}))
+
+		expectedRuleGroupBody, _ := yaml.Marshal(&rg)
+		assert.Equal(t, []request{
+			{http.MethodPost, "/ruler/rules/system%20ns", "tenant-1", string(expectedRuleGroupBody)},
+			{http.MethodGet, "/ruler/rules/system%20ns/cpu", "tenant-1", ""},
+			{http.MethodDelete, "/ruler/rules/system%20ns/cpu", "tenant-1", ""},
+			{http.MethodPost, "/am/config", "tenant-1", "template_files:\n    helper.tmpl: tmpl\nalertmanager_config: 'route: {}'\n"},
+		}, requests)
+	})
+
+	t.Run("should return resource not found error if ruler returns 404", func(t *testing.T) {
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer testServer.Close()
+
+		c := cortex.NewRulerClient(testServer.URL, "tenant-1", testRulerAPI, httpclient.New(httpclient.Config{}))
+		_, err := c.GetRuleGroup(context.Background(), "system", "cpu")
+
+		assert.True(t, errors.Is(err, client.ErrResourceNotFound))
+	})
+
+	t.Run("should return error with response body if ruler returns error", func(t *testing.T) {
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte("invalid rule group\n"))
+		}))
+		defer testServer.Close()
+
+		c := cortex.NewRulerClient(testServer.URL, "tenant-1", testRulerAPI, httpclient.New(httpclient.Config{}))
+		err := c.CreateRuleGroup(context.Background(), "system", rwrulefmt.RuleGroup{})
+
+		assert.EqualError(t, err, "server returned HTTP status 400 Bad Request: invalid rule group")
+	})
+
+	t.Run("should return error if alertmanager config api is not supported", func(t *testing.T) {
+		c := cortex.NewRulerClient("http://ruler", "tenant-1", cortex.RulerAPI{RulesPath: "/rules"}, httpclient.New(httpclient.Config{}))
+		err := c.CreateAlertmanagerConfig(context.Background(), "route: {}", nil)
+
+		assert.EqualError(t, err, "ruler does not support alertmanager config api")
+	})
+
+	t.Run("should list rule groups of all namespaces", func(t *testing.T) {
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/ruler/rules", r.URL.Path)
+			w.Write([]byte("system:\n  - name: cpu\n    rules: []\n"))
+		}))
+		defer testServer.Close()
+
+		c := cortex.NewRulerClient(testServer.URL, "tenant-1", testRulerAPI, httpclient.New(httpclient.Config{}))
+		got, err := c.ListRules(context.Background(), "")
+
+		require.NoError(t, err)
+		require.Len(t, got["system"], 1)
+		assert.Equal(t, "cpu", got["system"][0].Name)
+	})
+}
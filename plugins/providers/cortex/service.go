@@ -4,12 +4,11 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strings"
 	texttemplate "text/template"
-	"time"
 
 	"github.com/grafana/cortex-tools/pkg/client"
 	"github.com/grafana/cortex-tools/pkg/rules/rwrulefmt"
-	"github.com/mitchellh/mapstructure"
 	"github.com/odpf/salt/log"
 	"github.com/odpf/siren/core/alert"
 	"github.com/odpf/siren/core/provider"
@@ -42,6 +41,8 @@ type PluginService struct {
 	configYaml     string
 	cortexClient   CortexCaller
 	httpClient     *httpclient.Client
+	rulerAPI       *RulerAPI
+	ruleValidator  RuleValidator
 }
 
 // NewPluginService returns cortex service provider plugin struct
@@ -63,67 +64,7 @@ func NewPluginService(logger log.Logger, appConfig AppConfig, opts ...ServiceOpt
 
 // TransformToAlerts is a function to transform alert body in hook API to []*alert.Alert
 func (s *PluginService) TransformToAlerts(ctx context.Context, providerID uint64, namespaceID uint64, body map[string]interface{}) ([]alert.Alert, int, error) {
-	var groupAlert = &GroupAlert{}
-	if err := mapstructure.Decode(body, groupAlert); err != nil {
-		return nil, 0, err
-	}
-
-	var (
-		alerts        = make([]alert.Alert, 0)
-		badAlertCount = 0
-		firingLen     = 0
-	)
-
-	for _, item := range groupAlert.Alerts {
-
-		if err := item.Validate(); err != nil {
-			s.logger.Error(fmt.Sprintf("invalid alerts: %s", err.Error()), "group key", groupAlert.GroupKey, "alert detail", item)
-			badAlertCount++
-			continue
-		}
-
-		if item.Status == "firing" {
-			firingLen++
-		}
-
-		severity := item.Labels["severity"]
-		if item.Status == "resolved" {
-			severity = item.Status
-		}
-
-		startsAt, err := time.Parse(time.RFC3339Nano, item.StartsAt)
-		if err != nil {
-			badAlertCount++
-			break
-		}
-
-		alrt := alert.Alert{
-			ProviderID:   providerID,
-			NamespaceID:  namespaceID,
-			ResourceName: item.Annotations["resource"],
-			MetricName:   item.Annotations["metric_name"],
-			MetricValue:  item.Annotations["metric_value"],
-			Severity:     severity,
-			Rule:         item.Annotations["template"],
-			TriggeredAt:  startsAt,
-
-			GroupKey:     groupAlert.GroupKey,
-			Status:       item.Status,
-			Annotations:  item.Annotations,
-			Labels:       item.Labels,
-			GeneratorURL: item.GeneratorURL,
-			Fingerprint:  item.Fingerprint,
-		}
-
-		alerts = append(alerts, alrt)
-	}
-
-	if badAlertCount > 0 {
-		s.logger.Error("parameters are missing for alert", "group key", groupAlert.GroupKey, "alert count", badAlertCount)
-		return alerts, firingLen, nil
-	}
-
-	return alerts, firingLen, nil
+	return TransformGroupAlert(s.logger, providerID, namespaceID, body)
 }
 
 // SyncRuntimeConfig synchronizes runtime configuration of provider
@@ -163,12 +104,17 @@ func (s *PluginService) SyncRuntimeConfig(ctx context.Context, namespaceID uint6
 // UpsertRule manages upsert logic to cortex ruler. Cortex client API granularity is on the rule-group.
 // This function has a logic to work with rule-level granurality and adapt it to cortex logic.
 func (s *PluginService) UpsertRule(ctx context.Context, namespaceURN string, prov provider.Provider, rl *rule.Rule, templateToUpdate *template.Template) error {
-	inputValues := make(map[string]string)
-	for _, v := range rl.Variables {
-		inputValues[v.Name] = v.Value
-	}
+	return s.syncRule(ctx, namespaceURN, prov, rl, templateToUpdate, rl.Enabled)
+}
+
+// DeleteRule removes rule nodes of the rule from its cortex rule-group,
+// the rule-group is deleted if there is no rule node left.
+func (s *PluginService) DeleteRule(ctx context.Context, namespaceURN string, prov provider.Provider, rl *rule.Rule, templateToDelete *template.Template) error {
+	return s.syncRule(ctx, namespaceURN, prov, rl, templateToDelete, false)
+}
 
-	renderedRule, err := template.RenderWithEnrichedDefault(templateToUpdate.Body, templateToUpdate.Variables, inputValues)
+func (s *PluginService) syncRule(ctx context.Context, namespaceURN string, prov provider.Provider, rl *rule.Rule, templateToUpdate *template.Template, enabled bool) error {
+	upsertedRuleNodes, err := RenderRuleNodes(rl, templateToUpdate)
 	if err != nil {
 		return err
 	}
@@ -178,11 +124,6 @@ func (s *PluginService) UpsertRule(ctx context.Context, namespaceURN string, pro
 		return err
 	}
 
-	var upsertedRuleNodes []rulefmt.RuleNode
-	if err := yaml.Unmarshal([]byte(renderedRule), &upsertedRuleNodes); err != nil {
-		return errors.ErrInvalid.WithMsgf("cannot parse upserted rule").WithCausef(err.Error())
-	}
-
 	cortexRuleGroup, err := cortexClient.GetRuleGroup(ctx, rl.Namespace, rl.GroupName)
 	if err != nil {
 		if errors.Is(err, client.ErrResourceNotFound) {
@@ -192,7 +133,7 @@ func (s *PluginService) UpsertRule(ctx context.Context, namespaceURN string, pro
 		}
 	}
 
-	newRuleNodes, err := mergeRuleNodes(cortexRuleGroup.Rules, upsertedRuleNodes, rl.Enabled)
+	newRuleNodes, err := mergeRuleNodes(cortexRuleGroup.Rules, upsertedRuleNodes, enabled)
 	if err != nil {
 		return err
 	}
@@ -219,6 +160,156 @@ func (s *PluginService) UpsertRule(ctx context.Context, namespaceURN string, pro
 	return nil
 }
 
+// PreviewRule renders and validates the rule then returns the cortex rule-group
+// before and after the rule is applied without calling any mutating cortex api.
+func (s *PluginService) PreviewRule(ctx context.Context, namespaceURN string, prov provider.Provider, rl *rule.Rule, templateToUpdate *template.Template) (*rule.Preview, error) {
+	upsertedRuleNodes, err := RenderRuleNodes(rl, templateToUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateRuleNodes(rl.GroupName, upsertedRuleNodes); err != nil {
+		return nil, err
+	}
+
+	cortexClient, err := s.getCortexClient(prov.Host, namespaceURN)
+	if err != nil {
+		return nil, err
+	}
+
+	cortexRuleGroup, err := cortexClient.GetRuleGroup(ctx, rl.Namespace, rl.GroupName)
+	if err != nil {
+		if errors.Is(err, client.ErrResourceNotFound) {
+			cortexRuleGroup = &rwrulefmt.RuleGroup{}
+		} else {
+			return nil, errors.ErrInvalid.WithMsgf("cannot get rule group from cortex when previewing rules").WithCausef(err.Error())
+		}
+	}
+
+	// merging mutates the rule nodes, work on a copy to keep the current rule-group intact
+	currentRuleNodes := make([]rulefmt.RuleNode, len(cortexRuleGroup.Rules))
+	copy(currentRuleNodes, cortexRuleGroup.Rules)
+
+	newRuleNodes, err := mergeRuleNodes(currentRuleNodes, upsertedRuleNodes, rl.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := MarshalRuleGroup(rl.GroupName, cortexRuleGroup.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	proposed, err := MarshalRuleGroup(rl.GroupName, newRuleNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rule.Preview{
+		Current:  current,
+		Proposed: proposed,
+	}, nil
+}
+
+func (s *PluginService) validateRuleNodes(groupName string, ruleNodes []rulefmt.RuleNode) error {
+	if s.ruleValidator != nil {
+		return s.ruleValidator(groupName, ruleNodes)
+	}
+	return ValidateRuleNodes(groupName, ruleNodes)
+}
+
+// ListRuleGroups returns all rule groups of the tenant in cortex ruler
+func (s *PluginService) ListRuleGroups(ctx context.Context, namespaceURN string, prov provider.Provider) ([]rule.RuleGroup, error) {
+	cortexClient, err := s.getCortexClient(prov.Host, namespaceURN)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleSet, err := cortexClient.ListRules(ctx, "")
+	if err != nil {
+		if errors.Is(err, client.ErrResourceNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error calling cortex: %w", err)
+	}
+
+	var ruleGroups []rule.RuleGroup
+	for ruleNamespace, rgs := range ruleSet {
+		for _, rg := range rgs {
+			nodes, err := RuleNodes(rg.Rules)
+			if err != nil {
+				return nil, err
+			}
+			ruleGroups = append(ruleGroups, rule.RuleGroup{
+				Namespace: ruleNamespace,
+				Name:      rg.Name,
+				Rules:     nodes,
+			})
+		}
+	}
+
+	return ruleGroups, nil
+}
+
+// RenderRuleNodes renders the rule template with the rule variables and parses the result into rule nodes
+func RenderRuleNodes(rl *rule.Rule, tmpl *template.Template) ([]rulefmt.RuleNode, error) {
+	inputValues := make(map[string]string)
+	for _, v := range rl.Variables {
+		inputValues[v.Name] = v.Value
+	}
+
+	renderedRule, err := template.RenderWithEnrichedDefault(tmpl.Body, tmpl.Variables, inputValues)
+	if err != nil {
+		return nil, err
+	}
+
+	var ruleNodes []rulefmt.RuleNode
+	if err := yaml.Unmarshal([]byte(renderedRule), &ruleNodes); err != nil {
+		return nil, errors.ErrInvalid.WithMsgf("cannot parse upserted rule").WithCausef(err.Error())
+	}
+	return ruleNodes, nil
+}
+
+// ValidateRuleNodes validates rule nodes with prometheus rule format, including the PromQL expressions
+func ValidateRuleNodes(groupName string, ruleNodes []rulefmt.RuleNode) error {
+	out, err := yaml.Marshal(rulefmt.RuleGroups{
+		Groups: []rulefmt.RuleGroup{{Name: groupName, Rules: ruleNodes}},
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, errs := rulefmt.Parse(out); len(errs) > 0 {
+		msgs := make([]string, 0, len(errs))
+		for _, e := range errs {
+			msgs = append(msgs, e.Error())
+		}
+		return errors.ErrInvalid.WithMsgf("invalid rule").WithCausef(strings.Join(msgs, "; "))
+	}
+	return nil
+}
+
+// MarshalRuleGroup returns the rule-group in yaml, empty string is returned if there is no rule node
+func MarshalRuleGroup(groupName string, ruleNodes []rulefmt.RuleNode) (string, error) {
+	if len(ruleNodes) == 0 {
+		return "", nil
+	}
+	out, err := yaml.Marshal(rulefmt.RuleGroup{Name: groupName, Rules: ruleNodes})
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// RuleNodes converts prometheus rule nodes to rule nodes that could be compared with rules rendered by siren
+func RuleNodes(ruleNodes []rulefmt.RuleNode) ([]rule.RuleNode, error) {
+	out, err := yaml.Marshal(ruleNodes)
+	if err != nil {
+		return nil, err
+	}
+	return rule.ParseRuleNodes(string(out))
+}
+
 func mergeRuleNodes(ruleNodes []rulefmt.RuleNode, newRuleNodes []rulefmt.RuleNode, enabled bool) ([]rulefmt.RuleNode, error) {
 	for _, nrn := range newRuleNodes {
 		var action string = "insert"
@@ -278,6 +369,9 @@ func (s *PluginService) getCortexClient(address string, tenant string) (CortexCa
 	if s.cortexClient != nil {
 		return s.cortexClient, nil
 	}
+	if s.rulerAPI != nil {
+		return NewRulerClient(address, tenant, *s.rulerAPI, s.httpClient), nil
+	}
 	cortexClient, err := client.New(client.Config{
 		Address: address,
 		ID:      tenant,
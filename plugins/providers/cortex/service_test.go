@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/google/go-cmp/cmp"
+	"github.com/grafana/cortex-tools/pkg/client"
 	"github.com/grafana/cortex-tools/pkg/rules/rwrulefmt"
 	"github.com/odpf/salt/log"
 	"github.com/odpf/siren/core/provider"
@@ -13,7 +15,9 @@ import (
 	"github.com/odpf/siren/pkg/errors"
 	"github.com/odpf/siren/plugins/providers/cortex"
 	"github.com/odpf/siren/plugins/providers/cortex/mocks"
+	"github.com/prometheus/prometheus/pkg/rulefmt"
 	"github.com/stretchr/testify/mock"
+	"gopkg.in/yaml.v3"
 )
 
 func TestService_UpsertRule(t *testing.T) {
@@ -209,3 +213,315 @@ func TestService_UpsertRule(t *testing.T) {
 		})
 	}
 }
+
+func TestService_DeleteRule(t *testing.T) {
+	var (
+		sampleTemplate = &template.Template{
+			Name: "cpu-usage",
+			Body: heredoc.Doc(`
+- alert: cpu high warning
+  expr: cpu_usage_user > [[.warning]]
+  labels:
+    severity: WARNING
+- alert: cpu high critical
+  expr: cpu_usage_user > [[.critical]]
+  labels:
+    severity: CRITICAL`),
+			Variables: []template.Variable{
+				{Name: "warning", Type: "int", Default: "85"},
+				{Name: "critical", Type: "int", Default: "90"},
+			},
+		}
+		sampleRule = &rule.Rule{
+			Namespace: "system",
+			GroupName: "cpu-usage",
+			Template:  "cpu-usage",
+			Enabled:   true,
+		}
+		ruleNodes = func(t *testing.T, body string) []rulefmt.RuleNode {
+			var nodes []rulefmt.RuleNode
+			if err := yaml.Unmarshal([]byte(body), &nodes); err != nil {
+				t.Fatal(err)
+			}
+			return nodes
+		}
+	)
+
+	tests := []struct {
+		name  string
+		setup func(*testing.T, *mocks.CortexCaller)
+		err   error
+	}{
+		{
+			name: "should return error if getting rule group from cortex return error",
+			setup: func(t *testing.T, cc *mocks.CortexCaller) {
+				cc.EXPECT().GetRuleGroup(mock.AnythingOfType("*context.emptyCtx"), "system", "cpu-usage").Return(nil, errors.New("some error"))
+			},
+			err: errors.New("cannot get rule group from cortex when upserting rules"),
+		},
+		{
+			name: "should return nil if rule group does not exist",
+			setup: func(t *testing.T, cc *mocks.CortexCaller) {
+				cc.EXPECT().GetRuleGroup(mock.AnythingOfType("*context.emptyCtx"), "system", "cpu-usage").Return(nil, client.ErrResourceNotFound)
+				cc.EXPECT().DeleteRuleGroup(mock.AnythingOfType("*context.emptyCtx"), "system", "cpu-usage").Return(client.ErrResourceNotFound)
+			},
+		},
+		{
+			name: "should delete rule group if no rule node left",
+			setup: func(t *testing.T, cc *mocks.CortexCaller) {
+				cc.EXPECT().GetRuleGroup(mock.AnythingOfType("*context.emptyCtx"), "system", "cpu-usage").Return(&rwrulefmt.RuleGroup{
+					RuleGroup: rulefmt.RuleGroup{
+						Name: "cpu-usage",
+						Rules: ruleNodes(t, heredoc.Doc(`
+- alert: cpu high warning
+  expr: cpu_usage_user > 85
+- alert: cpu high critical
+  expr: cpu_usage_user > 90`)),
+					},
+				}, nil)
+				cc.EXPECT().DeleteRuleGroup(mock.AnythingOfType("*context.emptyCtx"), "system", "cpu-usage").Return(nil)
+			},
+		},
+		{
+			name: "should keep other rule nodes in the rule group",
+			setup: func(t *testing.T, cc *mocks.CortexCaller) {
+				cc.EXPECT().GetRuleGroup(mock.AnythingOfType("*context.emptyCtx"), "system", "cpu-usage").Return(&rwrulefmt.RuleGroup{
+					RuleGroup: rulefmt.RuleGroup{
+						Name: "cpu-usage",
+						Rules: ruleNodes(t, heredoc.Doc(`
+- alert: cpu high warning
+  expr: cpu_usage_user > 85
+- alert: memory high
+  expr: mem_usage > 90
+- alert: cpu high critical
+  expr: cpu_usage_user > 90`)),
+					},
+				}, nil)
+				cc.EXPECT().CreateRuleGroup(mock.AnythingOfType("*context.emptyCtx"), "system", mock.MatchedBy(func(rg rwrulefmt.RuleGroup) bool {
+					return rg.Name == "cpu-usage" && len(rg.Rules) == 1 && rg.Rules[0].Alert.Value == "memory high"
+				})).Return(nil)
+			},
+		},
+		{
+			name: "should return error if delete rule group return error",
+			setup: func(t *testing.T, cc *mocks.CortexCaller) {
+				cc.EXPECT().GetRuleGroup(mock.AnythingOfType("*context.emptyCtx"), "system", "cpu-usage").Return(&rwrulefmt.RuleGroup{
+					RuleGroup: rulefmt.RuleGroup{
+						Name: "cpu-usage",
+						Rules: ruleNodes(t, heredoc.Doc(`
+- alert: cpu high warning
+  expr: cpu_usage_user > 85`)),
+					},
+				}, nil)
+				cc.EXPECT().DeleteRuleGroup(mock.AnythingOfType("*context.emptyCtx"), "system", "cpu-usage").Return(errors.New("some error"))
+			},
+			err: errors.New("error calling cortex: some error"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCortexClient := new(mocks.CortexCaller)
+			tc.setup(t, mockCortexClient)
+			s := cortex.NewPluginService(log.NewNoop(), cortex.AppConfig{}, cortex.WithCortexClient(mockCortexClient))
+			err := s.DeleteRule(context.Background(), "odpf", provider.Provider{}, sampleRule, sampleTemplate)
+			if (err != nil) != (tc.err != nil) {
+				t.Fatalf("got error %v, expected was %v", err, tc.err)
+			}
+			if err != nil && tc.err.Error() != err.Error() {
+				t.Fatalf("got error %s, expected was %s", err.Error(), tc.err)
+			}
+			mockCortexClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_PreviewRule(t *testing.T) {
+	var (
+		sampleTemplate = &template.Template{
+			Name: "cpu-usage",
+			Body: heredoc.Doc(`
+- alert: cpu high warning
+  expr: cpu_usage_user > [[.warning]]
+  labels:
+    severity: WARNING`),
+			Variables: []template.Variable{
+				{Name: "warning", Type: "int", Default: "85"},
+			},
+		}
+		sampleRule = &rule.Rule{
+			Namespace: "system",
+			GroupName: "cpu-usage",
+			Template:  "cpu-usage",
+			Enabled:   true,
+		}
+		ruleNodes = func(t *testing.T, body string) []rulefmt.RuleNode {
+			var nodes []rulefmt.RuleNode
+			if err := yaml.Unmarshal([]byte(body), &nodes); err != nil {
+				t.Fatal(err)
+			}
+			return nodes
+		}
+	)
+
+	tests := []struct {
+		name     string
+		tmpl     *template.Template
+		setup    func(*testing.T, *mocks.CortexCaller)
+		expected *rule.Preview
+		err      error
+	}{
+		{
+			name: "should return error if rendered rule has invalid expression",
+			tmpl: &template.Template{
+				Name: "cpu-usage",
+				Body: heredoc.Doc(`
+- alert: cpu high warning
+  expr: cpu_usage_user >`),
+			},
+			setup: func(t *testing.T, cc *mocks.CortexCaller) {},
+			err:   errors.New("invalid rule"),
+		},
+		{
+			name: "should return error if getting rule group from cortex return error",
+			tmpl: sampleTemplate,
+			setup: func(t *testing.T, cc *mocks.CortexCaller) {
+				cc.EXPECT().GetRuleGroup(mock.AnythingOfType("*context.emptyCtx"), "system", "cpu-usage").Return(nil, errors.New("some error"))
+			},
+			err: errors.New("cannot get rule group from cortex when previewing rules"),
+		},
+		{
+			name: "should return empty current if rule group does not exist",
+			tmpl: sampleTemplate,
+			setup: func(t *testing.T, cc *mocks.CortexCaller) {
+				cc.EXPECT().GetRuleGroup(mock.AnythingOfType("*context.emptyCtx"), "system", "cpu-usage").Return(nil, client.ErrResourceNotFound)
+			},
+			expected: &rule.Preview{
+				Proposed: heredoc.Doc(`
+name: cpu-usage
+rules:
+    - alert: cpu high warning
+      expr: cpu_usage_user > 85
+      labels:
+        severity: WARNING
+`),
+			},
+		},
+		{
+			name: "should return current and proposed rule group without changing cortex",
+			tmpl: sampleTemplate,
+			setup: func(t *testing.T, cc *mocks.CortexCaller) {
+				cc.EXPECT().GetRuleGroup(mock.AnythingOfType("*context.emptyCtx"), "system", "cpu-usage").Return(&rwrulefmt.RuleGroup{
+					RuleGroup: rulefmt.RuleGroup{
+						Name: "cpu-usage",
+						Rules: ruleNodes(t, heredoc.Doc(`
+- alert: cpu high warning
+  expr: cpu_usage_user > 80
+- alert: memory high
+  expr: mem_usage > 90`)),
+					},
+				}, nil)
+			},
+			expected: &rule.Preview{
+				Current: heredoc.Doc(`
+name: cpu-usage
+rules:
+    - alert: cpu high warning
+      expr: cpu_usage_user > 80
+    - alert: memory high
+      expr: mem_usage > 90
+`),
+				Proposed: heredoc.Doc(`
+name: cpu-usage
+rules:
+    - alert: cpu high warning
+      expr: cpu_usage_user > 85
+      labels:
+        severity: WARNING
+    - alert: memory high
+      expr: mem_usage > 90
+`),
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCortexClient := new(mocks.CortexCaller)
+			tc.setup(t, mockCortexClient)
+			s := cortex.NewPluginService(log.NewNoop(), cortex.AppConfig{}, cortex.WithCortexClient(mockCortexClient))
+			got, err := s.PreviewRule(context.Background(), "odpf", provider.Provider{}, sampleRule, tc.tmpl)
+			if (err != nil) != (tc.err != nil) {
+				t.Fatalf("got error %v, expected was %v", err, tc.err)
+			}
+			if err != nil && tc.err.Error() != err.Error() {
+				t.Fatalf("got error %s, expected was %s", err.Error(), tc.err)
+			}
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Fatalf("got diff %v", diff)
+			}
+			mockCortexClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_ListRuleGroups(t *testing.T) {
+	t.Run("should return empty if tenant has no rules", func(t *testing.T) {
+		mockCortexClient := new(mocks.CortexCaller)
+		mockCortexClient.EXPECT().ListRules(mock.AnythingOfType("*context.emptyCtx"), "").Return(nil, client.ErrResourceNotFound)
+		s := cortex.NewPluginService(log.NewNoop(), cortex.AppConfig{}, cortex.WithCortexClient(mockCortexClient))
+
+		ruleGroups, err := s.ListRuleGroups(context.Background(), "odpf", provider.Provider{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ruleGroups) != 0 {
+			t.Fatalf("expected empty rule groups but got %v", ruleGroups)
+		}
+	})
+
+	t.Run("should return error if list rules return error", func(t *testing.T) {
+		mockCortexClient := new(mocks.CortexCaller)
+		mockCortexClient.EXPECT().ListRules(mock.AnythingOfType("*context.emptyCtx"), "").Return(nil, errors.New("some error"))
+		s := cortex.NewPluginService(log.NewNoop(), cortex.AppConfig{}, cortex.WithCortexClient(mockCortexClient))
+
+		_, err := s.ListRuleGroups(context.Background(), "odpf", provider.Provider{})
+		if err == nil || err.Error() != "error calling cortex: some error" {
+			t.Fatalf("got error %v", err)
+		}
+	})
+
+	t.Run("should convert rule groups of all namespaces", func(t *testing.T) {
+		var nodes []rulefmt.RuleNode
+		if err := yaml.Unmarshal([]byte(heredoc.Doc(`
+- alert: cpu high
+  expr: cpu > 90
+  for: 5m
+  labels:
+    severity: CRITICAL`)), &nodes); err != nil {
+			t.Fatal(err)
+		}
+
+		mockCortexClient := new(mocks.CortexCaller)
+		mockCortexClient.EXPECT().ListRules(mock.AnythingOfType("*context.emptyCtx"), "").Return(map[string][]rwrulefmt.RuleGroup{
+			"system": {{RuleGroup: rulefmt.RuleGroup{Name: "cpu", Rules: nodes}}},
+		}, nil)
+		s := cortex.NewPluginService(log.NewNoop(), cortex.AppConfig{}, cortex.WithCortexClient(mockCortexClient))
+
+		ruleGroups, err := s.ListRuleGroups(context.Background(), "odpf", provider.Provider{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := []rule.RuleGroup{
+			{
+				Namespace: "system",
+				Name:      "cpu",
+				Rules: []rule.RuleNode{
+					{Name: "cpu high", Body: "alert: cpu high\nexpr: cpu > 90\nfor: 5m\nlabels:\n    severity: CRITICAL\n"},
+				},
+			},
+		}
+		if diff := cmp.Diff(expected, ruleGroups); diff != "" {
+			t.Errorf("ListRuleGroups() diff = %v", diff)
+		}
+	})
+}
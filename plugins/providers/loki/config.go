@@ -0,0 +1,7 @@
+package loki
+
+import "github.com/odpf/siren/pkg/httpclient"
+
+type AppConfig struct {
+	HTTPClient httpclient.Config `mapstructure:"http_client" yaml:"http_client"`
+}
@@ -0,0 +1,53 @@
+package loki
+
+import (
+	"context"
+
+	"github.com/odpf/salt/log"
+	"github.com/odpf/siren/core/provider"
+	"github.com/odpf/siren/pkg/errors"
+	"github.com/odpf/siren/plugins/providers/cortex"
+	"github.com/prometheus/prometheus/pkg/rulefmt"
+)
+
+// RulerAPI is the api paths of loki ruler, loki does not serve alertmanager config api
+// https://grafana.com/docs/loki/latest/api/#ruler
+var RulerAPI = cortex.RulerAPI{
+	RulesPath: "/loki/api/v1/rules",
+}
+
+// PluginService is a service layer of grafana loki provider plugin
+// loki serves the cortex ruler api under different paths, rules are uploaded as-is so LogQL expressions are kept
+type PluginService struct {
+	*cortex.PluginService
+}
+
+// NewPluginService returns loki service provider plugin struct
+func NewPluginService(logger log.Logger, appConfig AppConfig, opts ...cortex.ServiceOption) *PluginService {
+	opts = append([]cortex.ServiceOption{cortex.WithRulerAPI(RulerAPI), cortex.WithRuleValidator(ValidateRuleNodes)}, opts...)
+	return &PluginService{
+		PluginService: cortex.NewPluginService(logger, cortex.AppConfig{HTTPClient: appConfig.HTTPClient}, opts...),
+	}
+}
+
+// ValidateRuleNodes checks the required fields of rule nodes, expressions are LogQL thus not parsed as PromQL
+func ValidateRuleNodes(groupName string, ruleNodes []rulefmt.RuleNode) error {
+	for _, rn := range ruleNodes {
+		if rn.Alert.Value == "" && rn.Record.Value == "" {
+			return errors.ErrInvalid.WithMsgf("invalid rule in group %q", groupName).WithCausef("one of 'record' or 'alert' must be set")
+		}
+		if rn.Alert.Value != "" && rn.Record.Value != "" {
+			return errors.ErrInvalid.WithMsgf("invalid rule in group %q", groupName).WithCausef("only one of 'record' and 'alert' must be set")
+		}
+		if rn.Expr.Value == "" {
+			return errors.ErrInvalid.WithMsgf("invalid rule in group %q", groupName).WithCausef("field 'expr' must be set in rule")
+		}
+	}
+	return nil
+}
+
+// SyncRuntimeConfig does nothing since loki ruler sends alerts to an alertmanager configured in loki,
+// e.g. the alertmanager of a mimir provider that is synchronized by siren
+func (s *PluginService) SyncRuntimeConfig(ctx context.Context, namespaceID uint64, namespaceURN string, prov provider.Provider) error {
+	return nil
+}
@@ -0,0 +1,162 @@
+package loki_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/odpf/salt/log"
+	"github.com/odpf/siren/core/provider"
+	"github.com/odpf/siren/core/rule"
+	"github.com/odpf/siren/core/template"
+	"github.com/odpf/siren/plugins/providers/loki"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// fakeRuler stores rule groups per tenant in memory
+type fakeRuler struct {
+	mu       sync.Mutex
+	objects  map[string]string
+	requests int
+}
+
+func newFakeRuler() (*fakeRuler, *httptest.Server) {
+	fr := &fakeRuler{objects: map[string]string{}}
+	return fr, httptest.NewServer(fr)
+}
+
+func (fr *fakeRuler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+
+	fr.requests++
+	if !strings.HasPrefix(r.URL.Path, loki.RulerAPI.RulesPath) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	key := r.Header.Get("X-Scope-OrgID") + r.URL.Path
+	switch r.Method {
+	case http.MethodGet:
+		obj, ok := fr.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(obj))
+	case http.MethodPost:
+		body, _ := io.ReadAll(r.Body)
+		var rg struct {
+			Name string `yaml:"name"`
+		}
+		_ = yaml.Unmarshal(body, &rg)
+		fr.objects[key+"/"+rg.Name] = string(body)
+		w.WriteHeader(http.StatusAccepted)
+	case http.MethodDelete:
+		delete(fr.objects, key)
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+func TestService_UpsertRule(t *testing.T) {
+	t.Run("should upload logql rule as-is to loki ruler api of the tenant", func(t *testing.T) {
+		fr, testServer := newFakeRuler()
+		defer testServer.Close()
+
+		tmpl := &template.Template{
+			Name: "error-logs",
+			Body: heredoc.Doc(`
+- alert: high error logs
+  expr: sum by (app) (rate({namespace="[[.namespace]]"} |= "error" | json | line_format "{{.msg}}" [5m])) > [[.threshold]]
+  for: 1m
+  labels:
+    severity: WARNING
+  annotations:
+    summary: '{{ $labels.app }} logs too many errors'`),
+			Variables: []template.Variable{
+				{Name: "namespace", Type: "string", Default: "default"},
+				{Name: "threshold", Type: "int", Default: "10"},
+			},
+		}
+
+		s := loki.NewPluginService(log.NewNoop(), loki.AppConfig{})
+		err := s.UpsertRule(context.Background(), "tenant-1", provider.Provider{Host: testServer.URL}, &rule.Rule{
+			Enabled:   true,
+			GroupName: "errors",
+			Namespace: "app",
+			Variables: []rule.RuleVariable{{Name: "namespace", Value: "payments"}},
+		}, tmpl)
+		require.NoError(t, err)
+
+		rg, ok := fr.objects["tenant-1/loki/api/v1/rules/app/errors"]
+		require.True(t, ok, "rule group is not uploaded to loki ruler api of the tenant")
+
+		var uploaded struct {
+			Rules []struct {
+				Expr        string            `yaml:"expr"`
+				Annotations map[string]string `yaml:"annotations"`
+			} `yaml:"rules"`
+		}
+		require.NoError(t, yaml.Unmarshal([]byte(rg), &uploaded))
+		require.Len(t, uploaded.Rules, 1)
+		assert.Equal(t, `sum by (app) (rate({namespace="payments"} |= "error" | json | line_format "{{.msg}}" [5m])) > 10`, uploaded.Rules[0].Expr)
+		assert.Equal(t, "{{ $labels.app }} logs too many errors", uploaded.Rules[0].Annotations["summary"])
+	})
+}
+
+func TestService_PreviewRule(t *testing.T) {
+	t.Run("should preview logql rule without parsing it as promql nor uploading it", func(t *testing.T) {
+		fr, testServer := newFakeRuler()
+		defer testServer.Close()
+
+		tmpl := &template.Template{
+			Name: "error-logs",
+			Body: heredoc.Doc(`
+- alert: high error logs
+  expr: sum by (app) (rate({namespace="payments"} |= "error" [5m])) > 10`),
+		}
+
+		s := loki.NewPluginService(log.NewNoop(), loki.AppConfig{})
+		preview, err := s.PreviewRule(context.Background(), "tenant-1", provider.Provider{Host: testServer.URL}, &rule.Rule{
+			Enabled:   true,
+			GroupName: "errors",
+			Namespace: "app",
+		}, tmpl)
+		require.NoError(t, err)
+
+		assert.Empty(t, preview.Current)
+		assert.Contains(t, preview.Proposed, `|= "error"`)
+		assert.Empty(t, fr.objects)
+	})
+
+	t.Run("should return error if rule has no expression", func(t *testing.T) {
+		s := loki.NewPluginService(log.NewNoop(), loki.AppConfig{})
+		_, err := s.PreviewRule(context.Background(), "tenant-1", provider.Provider{}, &rule.Rule{
+			Enabled:   true,
+			GroupName: "errors",
+			Namespace: "app",
+		}, &template.Template{Body: "- alert: high error logs"})
+
+		assert.EqualError(t, err, `invalid rule in group "errors"`)
+	})
+}
+
+func TestService_SyncRuntimeConfig(t *testing.T) {
+	t.Run("should not call loki since it has no alertmanager config api", func(t *testing.T) {
+		fr, testServer := newFakeRuler()
+		defer testServer.Close()
+
+		s := loki.NewPluginService(log.NewNoop(), loki.AppConfig{})
+		err := s.SyncRuntimeConfig(context.Background(), 2, "tenant-1", provider.Provider{ID: 1, Host: testServer.URL})
+
+		assert.NoError(t, err)
+		assert.Zero(t, fr.requests)
+	})
+}
@@ -0,0 +1,25 @@
+package mimir
+
+import (
+	"github.com/odpf/siren/pkg/httpclient"
+	"github.com/odpf/siren/plugins/providers/cortex"
+)
+
+type AppConfig struct {
+	// https://prometheus.io/docs/alerting/latest/configuration/#route
+	GroupWaitDuration      string            `mapstructure:"group_wait" yaml:"group_wait" default:"30s"`
+	GroupIntervalDuration  string            `mapstructure:"group_interval" yaml:"group_interval" default:"5m"`
+	RepeatIntervalDuration string            `mapstructure:"repeat_interval" yaml:"repeat_interval" default:"4h"`
+	WebhookBaseAPI         string            `mapstructure:"webhook_base_api" yaml:"webhook_base_api" default:"http://localhost:8080/v1beta1/alerts/mimir"`
+	HTTPClient             httpclient.Config `mapstructure:"http_client" yaml:"http_client"`
+}
+
+func (c AppConfig) cortexAppConfig() cortex.AppConfig {
+	return cortex.AppConfig{
+		GroupWaitDuration:      c.GroupWaitDuration,
+		GroupIntervalDuration:  c.GroupIntervalDuration,
+		RepeatIntervalDuration: c.RepeatIntervalDuration,
+		WebhookBaseAPI:         c.WebhookBaseAPI,
+		HTTPClient:             c.HTTPClient,
+	}
+}
@@ -0,0 +1,27 @@
+package mimir
+
+import (
+	"github.com/odpf/salt/log"
+	"github.com/odpf/siren/plugins/providers/cortex"
+)
+
+// RulerAPI is the api paths of mimir ruler and alertmanager
+// https://grafana.com/docs/mimir/latest/references/http-api/#ruler
+var RulerAPI = cortex.RulerAPI{
+	RulesPath:        "/prometheus/config/v1/rules",
+	AlertmanagerPath: "/api/v1/alerts",
+}
+
+// PluginService is a service layer of grafana mimir provider plugin
+// mimir serves the cortex ruler and alertmanager api under different paths, so it works the same way as cortex
+type PluginService struct {
+	*cortex.PluginService
+}
+
+// NewPluginService returns mimir service provider plugin struct
+func NewPluginService(logger log.Logger, appConfig AppConfig, opts ...cortex.ServiceOption) *PluginService {
+	opts = append([]cortex.ServiceOption{cortex.WithRulerAPI(RulerAPI)}, opts...)
+	return &PluginService{
+		PluginService: cortex.NewPluginService(logger, appConfig.cortexAppConfig(), opts...),
+	}
+}
@@ -0,0 +1,119 @@
+package mimir_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/odpf/salt/log"
+	"github.com/odpf/siren/core/provider"
+	"github.com/odpf/siren/core/rule"
+	"github.com/odpf/siren/core/template"
+	"github.com/odpf/siren/plugins/providers/mimir"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// fakeRuler stores rule groups and alertmanager configs per tenant in memory
+type fakeRuler struct {
+	mu      sync.Mutex
+	objects map[string]string
+}
+
+func newFakeRuler() (*fakeRuler, *httptest.Server) {
+	fr := &fakeRuler{objects: map[string]string{}}
+	return fr, httptest.NewServer(fr)
+}
+
+func (fr *fakeRuler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+
+	key := r.Header.Get("X-Scope-OrgID") + r.URL.Path
+	switch r.Method {
+	case http.MethodGet:
+		obj, ok := fr.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(obj))
+	case http.MethodPost:
+		body, _ := io.ReadAll(r.Body)
+		if strings.HasPrefix(r.URL.Path, mimir.RulerAPI.RulesPath) {
+			var rg struct {
+				Name string `yaml:"name"`
+			}
+			_ = yaml.Unmarshal(body, &rg)
+			key = key + "/" + rg.Name
+		}
+		fr.objects[key] = string(body)
+		w.WriteHeader(http.StatusAccepted)
+	case http.MethodDelete:
+		delete(fr.objects, key)
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+var sampleTemplate = template.Template{
+	Name: "cpu-usage",
+	Body: heredoc.Doc(`
+- alert: cpu high
+  expr: avg by (host) (cpu_usage_user{cpu="cpu-total"}) > [[.warning]]
+  labels:
+    severity: WARNING`),
+	Variables: []template.Variable{
+		{Name: "warning", Type: "int", Default: "85"},
+	},
+}
+
+func TestService_UpsertRule(t *testing.T) {
+	fr, testServer := newFakeRuler()
+	defer testServer.Close()
+
+	s := mimir.NewPluginService(log.NewNoop(), mimir.AppConfig{})
+	prov := provider.Provider{Host: testServer.URL}
+	rl := &rule.Rule{
+		Enabled:   true,
+		GroupName: "cpu",
+		Namespace: "system",
+	}
+
+	require.NoError(t, s.UpsertRule(context.Background(), "tenant-1", prov, rl, &sampleTemplate))
+
+	rg, ok := fr.objects["tenant-1/prometheus/config/v1/rules/system/cpu"]
+	require.True(t, ok, "rule group is not uploaded to mimir ruler api of the tenant")
+	assert.Contains(t, rg, `expr: avg by (host) (cpu_usage_user{cpu="cpu-total"}) > 85`)
+
+	rl.Enabled = false
+	require.NoError(t, s.UpsertRule(context.Background(), "tenant-1", prov, rl, &sampleTemplate))
+
+	_, ok = fr.objects["tenant-1/prometheus/config/v1/rules/system/cpu"]
+	assert.False(t, ok, "empty rule group is not deleted")
+}
+
+func TestService_SyncRuntimeConfig(t *testing.T) {
+	fr, testServer := newFakeRuler()
+	defer testServer.Close()
+
+	s := mimir.NewPluginService(log.NewNoop(), mimir.AppConfig{
+		GroupWaitDuration:      "30s",
+		GroupIntervalDuration:  "5m",
+		RepeatIntervalDuration: "4h",
+		WebhookBaseAPI:         "http://siren/v1beta1/alerts/mimir",
+	})
+
+	err := s.SyncRuntimeConfig(context.Background(), 2, "tenant-1", provider.Provider{ID: 1, Host: testServer.URL})
+	require.NoError(t, err)
+
+	cfg, ok := fr.objects["tenant-1/api/v1/alerts"]
+	require.True(t, ok, "alertmanager config is not uploaded to mimir alertmanager api of the tenant")
+	assert.Contains(t, cfg, "http://siren/v1beta1/alerts/mimir/1/2")
+	assert.Contains(t, cfg, "helper.tmpl")
+}
@@ -0,0 +1,19 @@
+package prometheus
+
+import "github.com/odpf/siren/pkg/httpclient"
+
+type AppConfig struct {
+	// RulesDir is the root of rule files, each namespace has its own directory with one file per rule namespace
+	RulesDir string `mapstructure:"rules_dir" yaml:"rules_dir" default:"./prometheus/rules"`
+	// AlertmanagerConfigDir is the root of alertmanager configs, each namespace has its own directory
+	AlertmanagerConfigDir string `mapstructure:"alertmanager_config_dir" yaml:"alertmanager_config_dir" default:"./prometheus/alertmanager"`
+	// ReloadEnabled triggers the lifecycle reload api of the provider host after rule files are changed
+	ReloadEnabled bool `mapstructure:"reload_enabled" yaml:"reload_enabled" default:"false"`
+
+	// https://prometheus.io/docs/alerting/latest/configuration/#route
+	GroupWaitDuration      string            `mapstructure:"group_wait" yaml:"group_wait" default:"30s"`
+	GroupIntervalDuration  string            `mapstructure:"group_interval" yaml:"group_interval" default:"5m"`
+	RepeatIntervalDuration string            `mapstructure:"repeat_interval" yaml:"repeat_interval" default:"4h"`
+	WebhookBaseAPI         string            `mapstructure:"webhook_base_api" yaml:"webhook_base_api" default:"http://localhost:8080/v1beta1/alerts/prometheus"`
+	HTTPClient             httpclient.Config `mapstructure:"http_client" yaml:"http_client"`
+}
@@ -0,0 +1,12 @@
+package prometheus
+
+import "github.com/odpf/siren/pkg/httpclient"
+
+type ServiceOption func(*PluginService)
+
+// WithHTTPClient assigns custom client when creating a http client
+func WithHTTPClient(cli *httpclient.Client) ServiceOption {
+	return func(so *PluginService) {
+		so.httpClient = cli
+	}
+}
@@ -0,0 +1,134 @@
+package prometheus
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/odpf/siren/pkg/errors"
+	"github.com/prometheus/prometheus/pkg/rulefmt"
+	"gopkg.in/yaml.v3"
+)
+
+const ruleFileExtension = ".yaml"
+
+// ruleFilePath returns the rule file of a rule namespace of a siren namespace
+// rules_dir/<namespace urn>/<rule namespace>.yaml
+func ruleFilePath(rulesDir, namespaceURN, ruleNamespace string) (string, error) {
+	if err := validatePathElement(namespaceURN); err != nil {
+		return "", errors.ErrInvalid.WithMsgf("invalid namespace urn: %s", err)
+	}
+	if err := validatePathElement(ruleNamespace); err != nil {
+		return "", errors.ErrInvalid.WithMsgf("invalid rule namespace: %s", err)
+	}
+	return filepath.Join(rulesDir, namespaceURN, ruleNamespace+ruleFileExtension), nil
+}
+
+// validatePathElement makes sure a name could be used as a single file or directory name
+func validatePathElement(name string) error {
+	if name == "" {
+		return errors.New("name cannot be empty")
+	}
+	if name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return fmt.Errorf("%q cannot be used as a file name", name)
+	}
+	return nil
+}
+
+// readRuleGroups reads rule groups of a rule file, a missing file has no rule groups
+func readRuleGroups(path string) (*rulefmt.RuleGroups, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &rulefmt.RuleGroups{}, nil
+		}
+		return nil, fmt.Errorf("cannot read rule file %s: %w", path, err)
+	}
+
+	ruleGroups, errs := rulefmt.Parse(content)
+	if len(errs) != 0 {
+		return nil, fmt.Errorf("cannot parse rule file %s: %w", path, errs[0])
+	}
+
+	return ruleGroups, nil
+}
+
+// writeRuleGroups writes rule groups to a rule file, the file is removed if there is no rule group
+// the content is validated with the same parser prometheus uses before being written
+func writeRuleGroups(path string, ruleGroups *rulefmt.RuleGroups) error {
+	if len(ruleGroups.Groups) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("cannot remove rule file %s: %w", path, err)
+		}
+		return nil
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(ruleGroups); err != nil {
+		return fmt.Errorf("cannot marshal rule groups: %w", err)
+	}
+	content := buf.Bytes()
+
+	if _, errs := rulefmt.Parse(content); len(errs) != 0 {
+		return errors.ErrInvalid.WithMsgf("invalid rule groups").WithCausef(errs[0].Error())
+	}
+
+	return writeFile(path, content)
+}
+
+// writeFile writes the file atomically so the provider never loads a partially written file
+func writeFile(path string, content []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("cannot create directory %s: %w", dir, err)
+	}
+
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("cannot create temporary file in %s: %w", dir, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return fmt.Errorf("cannot write temporary file %s: %w", tmp.Name(), err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("cannot close temporary file %s: %w", tmp.Name(), err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return fmt.Errorf("cannot change mode of %s: %w", tmp.Name(), err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("cannot write file %s: %w", path, err)
+	}
+	return nil
+}
+
+func mergeRuleNodes(ruleNodes []rulefmt.RuleNode, newRuleNodes []rulefmt.RuleNode, enabled bool) []rulefmt.RuleNode {
+	for _, nrn := range newRuleNodes {
+		idx := -1
+		for i, ruleNode := range ruleNodes {
+			if ruleNode.Alert.Value == nrn.Alert.Value {
+				idx = i
+				break
+			}
+		}
+
+		switch {
+		case idx >= 0 && !enabled:
+			ruleNodes = append(ruleNodes[:idx], ruleNodes[idx+1:]...)
+		case idx >= 0:
+			ruleNodes[idx] = nrn
+		case enabled:
+			ruleNodes = append(ruleNodes, nrn)
+		}
+	}
+
+	return ruleNodes
+}
@@ -0,0 +1,304 @@
+package prometheus
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"sync"
+	texttemplate "text/template"
+
+	"github.com/odpf/salt/log"
+	"github.com/odpf/siren/core/alert"
+	"github.com/odpf/siren/core/provider"
+	"github.com/odpf/siren/core/rule"
+	"github.com/odpf/siren/core/template"
+	"github.com/odpf/siren/pkg/errors"
+	"github.com/odpf/siren/pkg/httpclient"
+	"github.com/odpf/siren/plugins/providers/cortex"
+	promconfig "github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/prometheus/pkg/rulefmt"
+)
+
+const (
+	alertmanagerConfigFileName = "alertmanager.yaml"
+	alertmanagerHelperFileName = "helper.tmpl"
+	reloadPath                 = "/-/reload"
+)
+
+// PluginService is a service layer of prometheus provider plugin
+// rules and alertmanager configs are rendered as files to be loaded by prometheus, thanos ruler, and alertmanager
+type PluginService struct {
+	logger         log.Logger
+	appConfig      AppConfig
+	helperTemplate string
+	configYaml     string
+	httpClient     *httpclient.Client
+
+	// mu guards read-modify-write of rule files
+	mu sync.Mutex
+}
+
+// NewPluginService returns prometheus service provider plugin struct
+func NewPluginService(logger log.Logger, appConfig AppConfig, opts ...ServiceOption) *PluginService {
+	s := &PluginService{
+		logger:         logger,
+		appConfig:      appConfig,
+		helperTemplate: cortex.HelperTemplateString,
+		configYaml:     cortex.ConfigYamlString,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if s.httpClient == nil {
+		s.httpClient = httpclient.New(appConfig.HTTPClient)
+	}
+
+	return s
+}
+
+// TransformToAlerts is a function to transform alert body in hook API to []*alert.Alert
+// prometheus alertmanager sends the same webhook payload as cortex
+func (s *PluginService) TransformToAlerts(ctx context.Context, providerID uint64, namespaceID uint64, body map[string]interface{}) ([]alert.Alert, int, error) {
+	return cortex.TransformGroupAlert(s.logger, providerID, namespaceID, body)
+}
+
+// SyncRuntimeConfig writes the alertmanager config of a namespace that sends all alerts to siren webhook
+// alertmanager_config_dir/<namespace urn>/alertmanager.yaml
+func (s *PluginService) SyncRuntimeConfig(ctx context.Context, namespaceID uint64, namespaceURN string, prov provider.Provider) error {
+	if s.appConfig.WebhookBaseAPI == "" {
+		return errors.New("Prometheus webhook base api string in config cannot be empty")
+	}
+
+	if err := validatePathElement(namespaceURN); err != nil {
+		return errors.ErrInvalid.WithMsgf("invalid namespace urn: %s", err)
+	}
+
+	webhookURL := fmt.Sprintf("%s/%d/%d", s.appConfig.WebhookBaseAPI, prov.ID, namespaceID)
+
+	cfg, err := s.generateAlertmanagerConfig(cortex.TemplateConfig{
+		GroupWaitDuration:      s.appConfig.GroupWaitDuration,
+		GroupIntervalDuration:  s.appConfig.GroupIntervalDuration,
+		RepeatIntervalDuration: s.appConfig.RepeatIntervalDuration,
+		WebhookURL:             webhookURL,
+	})
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Join(s.appConfig.AlertmanagerConfigDir, namespaceURN)
+
+	// the helper template is written first since the config refers to it
+	if err := writeFile(filepath.Join(dir, alertmanagerHelperFileName), []byte(s.helperTemplate)); err != nil {
+		return err
+	}
+
+	if err := writeFile(filepath.Join(dir, alertmanagerConfigFileName), []byte(cfg)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpsertRule renders the rule and merges it to its rule group in the rule file of the rule namespace.
+// Rule group is removed if it has no rule and rule file is removed if it has no rule group.
+func (s *PluginService) UpsertRule(ctx context.Context, namespaceURN string, prov provider.Provider, rl *rule.Rule, templateToUpdate *template.Template) error {
+	return s.syncRule(ctx, namespaceURN, prov, rl, templateToUpdate, rl.Enabled)
+}
+
+// DeleteRule removes rule nodes of the rule from its rule group in the rule file of the rule namespace.
+func (s *PluginService) DeleteRule(ctx context.Context, namespaceURN string, prov provider.Provider, rl *rule.Rule, templateToDelete *template.Template) error {
+	return s.syncRule(ctx, namespaceURN, prov, rl, templateToDelete, false)
+}
+
+// PreviewRule renders and validates the rule then returns the rule group in the rule file
+// before and after the rule is applied without writing the rule file.
+func (s *PluginService) PreviewRule(ctx context.Context, namespaceURN string, prov provider.Provider, rl *rule.Rule, templateToUpdate *template.Template) (*rule.Preview, error) {
+	upsertedRuleNodes, err := cortex.RenderRuleNodes(rl, templateToUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cortex.ValidateRuleNodes(rl.GroupName, upsertedRuleNodes); err != nil {
+		return nil, err
+	}
+
+	path, err := ruleFilePath(s.appConfig.RulesDir, namespaceURN, rl.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ruleGroups, err := readRuleGroups(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var currentRuleNodes []rulefmt.RuleNode
+	for _, rg := range ruleGroups.Groups {
+		if rg.Name == rl.GroupName {
+			currentRuleNodes = rg.Rules
+			break
+		}
+	}
+
+	// merging mutates the rule nodes, work on a copy to keep the current rule group intact
+	newRuleNodes := make([]rulefmt.RuleNode, len(currentRuleNodes))
+	copy(newRuleNodes, currentRuleNodes)
+	newRuleNodes = mergeRuleNodes(newRuleNodes, upsertedRuleNodes, rl.Enabled)
+
+	current, err := cortex.MarshalRuleGroup(rl.GroupName, currentRuleNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	proposed, err := cortex.MarshalRuleGroup(rl.GroupName, newRuleNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rule.Preview{
+		Current:  current,
+		Proposed: proposed,
+	}, nil
+}
+
+// ListRuleGroups returns rule groups of all rule files of the namespace
+func (s *PluginService) ListRuleGroups(ctx context.Context, namespaceURN string, prov provider.Provider) ([]rule.RuleGroup, error) {
+	if err := validatePathElement(namespaceURN); err != nil {
+		return nil, errors.ErrInvalid.WithMsgf("invalid namespace urn: %s", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	paths, err := filepath.Glob(filepath.Join(s.appConfig.RulesDir, namespaceURN, "*"+ruleFileExtension))
+	if err != nil {
+		return nil, err
+	}
+
+	var ruleGroups []rule.RuleGroup
+	for _, path := range paths {
+		rgs, err := readRuleGroups(path)
+		if err != nil {
+			return nil, err
+		}
+
+		ruleNamespace := strings.TrimSuffix(filepath.Base(path), ruleFileExtension)
+		for _, rg := range rgs.Groups {
+			nodes, err := cortex.RuleNodes(rg.Rules)
+			if err != nil {
+				return nil, err
+			}
+			ruleGroups = append(ruleGroups, rule.RuleGroup{
+				Namespace: ruleNamespace,
+				Name:      rg.Name,
+				Rules:     nodes,
+			})
+		}
+	}
+
+	return ruleGroups, nil
+}
+
+func (s *PluginService) syncRule(ctx context.Context, namespaceURN string, prov provider.Provider, rl *rule.Rule, templateToUpdate *template.Template, enabled bool) error {
+	upsertedRuleNodes, err := cortex.RenderRuleNodes(rl, templateToUpdate)
+	if err != nil {
+		return err
+	}
+
+	path, err := ruleFilePath(s.appConfig.RulesDir, namespaceURN, rl.Namespace)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ruleGroups, err := readRuleGroups(path)
+	if err != nil {
+		return err
+	}
+
+	var groups []rulefmt.RuleGroup
+	found := false
+	for _, rg := range ruleGroups.Groups {
+		if rg.Name == rl.GroupName {
+			found = true
+			rg.Rules = mergeRuleNodes(rg.Rules, upsertedRuleNodes, enabled)
+			if len(rg.Rules) == 0 {
+				continue
+			}
+		}
+		groups = append(groups, rg)
+	}
+
+	if !found {
+		newRuleNodes := mergeRuleNodes(nil, upsertedRuleNodes, enabled)
+		if len(newRuleNodes) == 0 {
+			return nil
+		}
+		groups = append(groups, rulefmt.RuleGroup{
+			Name:  rl.GroupName,
+			Rules: newRuleNodes,
+		})
+	}
+
+	ruleGroups.Groups = groups
+	if err := writeRuleGroups(path, ruleGroups); err != nil {
+		return err
+	}
+
+	return s.reload(ctx, prov.Host)
+}
+
+// reload asks the provider to reload its rule files if enabled
+// prometheus needs to be started with --web.enable-lifecycle
+func (s *PluginService) reload(ctx context.Context, host string) error {
+	if !s.appConfig.ReloadEnabled {
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(host, "/")+reloadPath, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create reload request: %w", err)
+	}
+
+	resp, err := s.httpClient.HTTP().Do(req)
+	if err != nil {
+		return fmt.Errorf("failure in reloading prometheus: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failure in reloading prometheus with status code %s and body %s", http.StatusText(resp.StatusCode), string(bodyBytes))
+	}
+
+	return nil
+}
+
+func (s *PluginService) generateAlertmanagerConfig(tmplConfig cortex.TemplateConfig) (string, error) {
+	delims := texttemplate.New("alertmanagerConfigTemplate").Delims("[[", "]]")
+	parse, err := delims.Parse(s.configYaml)
+	if err != nil {
+		return "", err
+	}
+	var tpl bytes.Buffer
+	if err := parse.Execute(&tpl, tmplConfig); err != nil {
+		// it is unlikely that the code returns error here
+		return "", err
+	}
+	configStr := tpl.String()
+	if _, err := promconfig.Load(configStr); err != nil {
+		return "", err
+	}
+	return configStr, nil
+}
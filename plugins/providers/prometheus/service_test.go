@@ -0,0 +1,405 @@
+package prometheus_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/odpf/salt/log"
+	"github.com/odpf/siren/core/provider"
+	"github.com/odpf/siren/core/rule"
+	"github.com/odpf/siren/core/template"
+	"github.com/odpf/siren/plugins/providers/prometheus"
+	"github.com/prometheus/prometheus/pkg/rulefmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var sampleTemplate = template.Template{
+	Name: "cpu-usage",
+	Body: heredoc.Doc(`
+- alert: cpu high warning
+  expr: avg by (host) (cpu_usage_user{cpu="cpu-total"}) > [[.warning]]
+  for: '[[.for]]'
+  labels:
+    severity: WARNING
+  annotations:
+    metric_name: cpu_usage_user
+    metric_value: '{{ printf "%0.2f" $value }}'
+    resource: '{{ $labels.host }}'
+    template: cpu-usage
+- alert: cpu high critical
+  expr: avg by (host) (cpu_usage_user{cpu="cpu-total"}) > [[.critical]]
+  for: '[[.for]]'
+  labels:
+    severity: CRITICAL
+  annotations:
+    metric_name: cpu_usage_user
+    metric_value: '{{ printf "%0.2f" $value }}'
+    resource: '{{ $labels.host }}'
+    template: cpu-usage`),
+	Variables: []template.Variable{
+		{Name: "for", Type: "string", Default: "5m"},
+		{Name: "warning", Type: "int", Default: "85"},
+		{Name: "critical", Type: "int", Default: "90"},
+	},
+}
+
+func readRuleGroups(t *testing.T, path string) []rulefmt.RuleGroup {
+	t.Helper()
+	rgs, errs := rulefmt.ParseFile(path)
+	require.Empty(t, errs)
+	return rgs.Groups
+}
+
+func TestService_UpsertRule(t *testing.T) {
+	t.Run("should write rule group to the rule file of the rule namespace", func(t *testing.T) {
+		rulesDir := t.TempDir()
+		s := prometheus.NewPluginService(log.NewNoop(), prometheus.AppConfig{RulesDir: rulesDir})
+
+		err := s.UpsertRule(context.Background(), "odpf", provider.Provider{}, &rule.Rule{
+			Enabled:   true,
+			GroupName: "cpu-usage",
+			Namespace: "system",
+			Variables: []rule.RuleVariable{{Name: "warning", Value: "80"}},
+		}, &sampleTemplate)
+		require.NoError(t, err)
+
+		groups := readRuleGroups(t, filepath.Join(rulesDir, "odpf", "system.yaml"))
+		require.Len(t, groups, 1)
+		assert.Equal(t, "cpu-usage", groups[0].Name)
+		require.Len(t, groups[0].Rules, 2)
+		assert.Equal(t, `avg by (host) (cpu_usage_user{cpu="cpu-total"}) > 80`, groups[0].Rules[0].Expr.Value)
+		assert.Equal(t, `avg by (host) (cpu_usage_user{cpu="cpu-total"}) > 90`, groups[0].Rules[1].Expr.Value)
+	})
+
+	t.Run("should update existing rules and keep other groups of the file", func(t *testing.T) {
+		rulesDir := t.TempDir()
+		s := prometheus.NewPluginService(log.NewNoop(), prometheus.AppConfig{RulesDir: rulesDir})
+
+		for _, groupName := range []string{"cpu-usage", "other"} {
+			require.NoError(t, s.UpsertRule(context.Background(), "odpf", provider.Provider{}, &rule.Rule{
+				Enabled:   true,
+				GroupName: groupName,
+				Namespace: "system",
+			}, &sampleTemplate))
+		}
+
+		require.NoError(t, s.UpsertRule(context.Background(), "odpf", provider.Provider{}, &rule.Rule{
+			Enabled:   true,
+			GroupName: "cpu-usage",
+			Namespace: "system",
+			Variables: []rule.RuleVariable{{Name: "critical", Value: "95"}},
+		}, &sampleTemplate))
+
+		groups := readRuleGroups(t, filepath.Join(rulesDir, "odpf", "system.yaml"))
+		require.Len(t, groups, 2)
+		assert.Equal(t, "cpu-usage", groups[0].Name)
+		require.Len(t, groups[0].Rules, 2)
+		assert.Equal(t, `avg by (host) (cpu_usage_user{cpu="cpu-total"}) > 95`, groups[0].Rules[1].Expr.Value)
+		assert.Equal(t, "other", groups[1].Name)
+		assert.Equal(t, `avg by (host) (cpu_usage_user{cpu="cpu-total"}) > 90`, groups[1].Rules[1].Expr.Value)
+	})
+
+	t.Run("should remove rule group and rule file if all rules are disabled", func(t *testing.T) {
+		rulesDir := t.TempDir()
+		s := prometheus.NewPluginService(log.NewNoop(), prometheus.AppConfig{RulesDir: rulesDir})
+
+		rl := &rule.Rule{
+			Enabled:   true,
+			GroupName: "cpu-usage",
+			Namespace: "system",
+		}
+		require.NoError(t, s.UpsertRule(context.Background(), "odpf", provider.Provider{}, rl, &sampleTemplate))
+
+		rl.Enabled = false
+		require.NoError(t, s.UpsertRule(context.Background(), "odpf", provider.Provider{}, rl, &sampleTemplate))
+
+		_, err := os.Stat(filepath.Join(rulesDir, "odpf", "system.yaml"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("should do nothing if disabled rule does not exist", func(t *testing.T) {
+		rulesDir := t.TempDir()
+		s := prometheus.NewPluginService(log.NewNoop(), prometheus.AppConfig{RulesDir: rulesDir})
+
+		require.NoError(t, s.UpsertRule(context.Background(), "odpf", provider.Provider{}, &rule.Rule{
+			GroupName: "cpu-usage",
+			Namespace: "system",
+		}, &sampleTemplate))
+
+		_, err := os.Stat(filepath.Join(rulesDir, "odpf", "system.yaml"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("should return error if rule namespace cannot be a file name", func(t *testing.T) {
+		s := prometheus.NewPluginService(log.NewNoop(), prometheus.AppConfig{RulesDir: t.TempDir()})
+
+		err := s.UpsertRule(context.Background(), "odpf", provider.Provider{}, &rule.Rule{
+			Enabled:   true,
+			GroupName: "cpu-usage",
+			Namespace: "../system",
+		}, &sampleTemplate)
+
+		assert.ErrorContains(t, err, "invalid rule namespace")
+	})
+
+	t.Run("should return error and not write file if rendered rule is invalid", func(t *testing.T) {
+		rulesDir := t.TempDir()
+		s := prometheus.NewPluginService(log.NewNoop(), prometheus.AppConfig{RulesDir: rulesDir})
+
+		err := s.UpsertRule(context.Background(), "odpf", provider.Provider{}, &rule.Rule{
+			Enabled:   true,
+			GroupName: "cpu-usage",
+			Namespace: "system",
+		}, &template.Template{Body: "- alert: broken\n  expr: 'sum('"})
+
+		assert.ErrorContains(t, err, "invalid rule groups")
+		_, err = os.Stat(filepath.Join(rulesDir, "odpf", "system.yaml"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("should reload provider after writing rules if enabled", func(t *testing.T) {
+		var reloaded bool
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/-/reload", r.URL.Path)
+			reloaded = true
+		}))
+		defer testServer.Close()
+
+		s := prometheus.NewPluginService(log.NewNoop(), prometheus.AppConfig{RulesDir: t.TempDir(), ReloadEnabled: true})
+		err := s.UpsertRule(context.Background(), "odpf", provider.Provider{Host: testServer.URL}, &rule.Rule{
+			Enabled:   true,
+			GroupName: "cpu-usage",
+			Namespace: "system",
+		}, &sampleTemplate)
+
+		assert.NoError(t, err)
+		assert.True(t, reloaded)
+	})
+
+	t.Run("should return error if reload failed", func(t *testing.T) {
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte("Lifecycle API is not enabled."))
+		}))
+		defer testServer.Close()
+
+		s := prometheus.NewPluginService(log.NewNoop(), prometheus.AppConfig{RulesDir: t.TempDir(), ReloadEnabled: true})
+		err := s.UpsertRule(context.Background(), "odpf", provider.Provider{Host: testServer.URL}, &rule.Rule{
+			Enabled:   true,
+			GroupName: "cpu-usage",
+			Namespace: "system",
+		}, &sampleTemplate)
+
+		assert.EqualError(t, err, "failure in reloading prometheus with status code Forbidden and body Lifecycle API is not enabled.")
+	})
+}
+
+func TestService_DeleteRule(t *testing.T) {
+	t.Run("should remove rule group of the rule and keep other groups of the file", func(t *testing.T) {
+		rulesDir := t.TempDir()
+		s := prometheus.NewPluginService(log.NewNoop(), prometheus.AppConfig{RulesDir: rulesDir})
+
+		for _, groupName := range []string{"cpu-usage", "other"} {
+			require.NoError(t, s.UpsertRule(context.Background(), "odpf", provider.Provider{}, &rule.Rule{
+				Enabled:   true,
+				GroupName: groupName,
+				Namespace: "system",
+			}, &sampleTemplate))
+		}
+
+		require.NoError(t, s.DeleteRule(context.Background(), "odpf", provider.Provider{}, &rule.Rule{
+			Enabled:   true,
+			GroupName: "cpu-usage",
+			Namespace: "system",
+		}, &sampleTemplate))
+
+		groups := readRuleGroups(t, filepath.Join(rulesDir, "odpf", "system.yaml"))
+		require.Len(t, groups, 1)
+		assert.Equal(t, "other", groups[0].Name)
+	})
+
+	t.Run("should remove rule file if no rule group left", func(t *testing.T) {
+		rulesDir := t.TempDir()
+		s := prometheus.NewPluginService(log.NewNoop(), prometheus.AppConfig{RulesDir: rulesDir})
+
+		rl := &rule.Rule{
+			Enabled:   true,
+			GroupName: "cpu-usage",
+			Namespace: "system",
+		}
+		require.NoError(t, s.UpsertRule(context.Background(), "odpf", provider.Provider{}, rl, &sampleTemplate))
+		require.NoError(t, s.DeleteRule(context.Background(), "odpf", provider.Provider{}, rl, &sampleTemplate))
+
+		_, err := os.Stat(filepath.Join(rulesDir, "odpf", "system.yaml"))
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestService_PreviewRule(t *testing.T) {
+	t.Run("should return error if rendered rule has invalid expression", func(t *testing.T) {
+		s := prometheus.NewPluginService(log.NewNoop(), prometheus.AppConfig{RulesDir: t.TempDir()})
+
+		_, err := s.PreviewRule(context.Background(), "odpf", provider.Provider{}, &rule.Rule{
+			Enabled:   true,
+			GroupName: "cpu-usage",
+			Namespace: "system",
+		}, &template.Template{
+			Body: heredoc.Doc(`
+- alert: cpu high warning
+  expr: sum(cpu_usage_user`),
+		})
+		assert.EqualError(t, err, "invalid rule")
+	})
+
+	t.Run("should return proposed rule group without writing rule file", func(t *testing.T) {
+		rulesDir := t.TempDir()
+		s := prometheus.NewPluginService(log.NewNoop(), prometheus.AppConfig{RulesDir: rulesDir})
+
+		rl := &rule.Rule{
+			Enabled:   true,
+			GroupName: "cpu-usage",
+			Namespace: "system",
+			Variables: []rule.RuleVariable{{Name: "warning", Value: "80"}},
+		}
+		preview, err := s.PreviewRule(context.Background(), "odpf", provider.Provider{}, rl, &sampleTemplate)
+		require.NoError(t, err)
+		assert.Empty(t, preview.Current)
+		assert.Contains(t, preview.Proposed, "cpu_usage_user{cpu=\"cpu-total\"}) > 80")
+
+		_, err = os.Stat(filepath.Join(rulesDir, "odpf", "system.yaml"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("should return current and proposed rule group of existing rule file", func(t *testing.T) {
+		rulesDir := t.TempDir()
+		s := prometheus.NewPluginService(log.NewNoop(), prometheus.AppConfig{RulesDir: rulesDir})
+
+		rl := &rule.Rule{
+			Enabled:   true,
+			GroupName: "cpu-usage",
+			Namespace: "system",
+		}
+		require.NoError(t, s.UpsertRule(context.Background(), "odpf", provider.Provider{}, rl, &sampleTemplate))
+
+		rl.Variables = []rule.RuleVariable{{Name: "warning", Value: "80"}}
+		preview, err := s.PreviewRule(context.Background(), "odpf", provider.Provider{}, rl, &sampleTemplate)
+		require.NoError(t, err)
+		assert.Contains(t, preview.Current, "cpu_usage_user{cpu=\"cpu-total\"}) > 85")
+		assert.Contains(t, preview.Proposed, "cpu_usage_user{cpu=\"cpu-total\"}) > 80")
+
+		groups := readRuleGroups(t, filepath.Join(rulesDir, "odpf", "system.yaml"))
+		require.Len(t, groups, 1)
+		assert.Equal(t, `avg by (host) (cpu_usage_user{cpu="cpu-total"}) > 85`, groups[0].Rules[0].Expr.Value)
+	})
+}
+
+func TestService_ListRuleGroups(t *testing.T) {
+	t.Run("should return rule groups of all rule files of the namespace", func(t *testing.T) {
+		rulesDir := t.TempDir()
+		s := prometheus.NewPluginService(log.NewNoop(), prometheus.AppConfig{RulesDir: rulesDir})
+
+		for _, ruleNamespace := range []string{"system", "kafka"} {
+			require.NoError(t, s.UpsertRule(context.Background(), "odpf", provider.Provider{}, &rule.Rule{
+				Enabled:   true,
+				GroupName: "cpu-usage",
+				Namespace: ruleNamespace,
+			}, &sampleTemplate))
+		}
+		require.NoError(t, s.UpsertRule(context.Background(), "other-tenant", provider.Provider{}, &rule.Rule{
+			Enabled:   true,
+			GroupName: "cpu-usage",
+			Namespace: "system",
+		}, &sampleTemplate))
+
+		ruleGroups, err := s.ListRuleGroups(context.Background(), "odpf", provider.Provider{})
+		require.NoError(t, err)
+		require.Len(t, ruleGroups, 2)
+		assert.Equal(t, "kafka", ruleGroups[0].Namespace)
+		assert.Equal(t, "system", ruleGroups[1].Namespace)
+
+		rendered, err := template.RenderWithEnrichedDefault(sampleTemplate.Body, sampleTemplate.Variables, nil)
+		require.NoError(t, err)
+		expectedNodes, err := rule.ParseRuleNodes(rendered)
+		require.NoError(t, err)
+		assert.Equal(t, expectedNodes, ruleGroups[1].Rules)
+	})
+
+	t.Run("should return empty if namespace has no rule file", func(t *testing.T) {
+		s := prometheus.NewPluginService(log.NewNoop(), prometheus.AppConfig{RulesDir: t.TempDir()})
+
+		ruleGroups, err := s.ListRuleGroups(context.Background(), "odpf", provider.Provider{})
+		require.NoError(t, err)
+		assert.Empty(t, ruleGroups)
+	})
+}
+
+func TestService_SyncRuntimeConfig(t *testing.T) {
+	t.Run("should return error if webhook base api is empty", func(t *testing.T) {
+		s := prometheus.NewPluginService(log.NewNoop(), prometheus.AppConfig{AlertmanagerConfigDir: t.TempDir()})
+
+		err := s.SyncRuntimeConfig(context.Background(), 2, "odpf", provider.Provider{ID: 1})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should write alertmanager config pointing to siren webhook with helper template", func(t *testing.T) {
+		configDir := t.TempDir()
+		s := prometheus.NewPluginService(log.NewNoop(), prometheus.AppConfig{
+			AlertmanagerConfigDir:  configDir,
+			GroupWaitDuration:      "30s",
+			GroupIntervalDuration:  "5m",
+			RepeatIntervalDuration: "4h",
+			WebhookBaseAPI:         "http://siren/v1beta1/alerts/prometheus",
+		})
+
+		err := s.SyncRuntimeConfig(context.Background(), 2, "odpf", provider.Provider{ID: 1})
+		require.NoError(t, err)
+
+		cfg, err := os.ReadFile(filepath.Join(configDir, "odpf", "alertmanager.yaml"))
+		require.NoError(t, err)
+		assert.True(t, strings.Contains(string(cfg), "url: 'http://siren/v1beta1/alerts/prometheus/1/2'"))
+
+		_, err = os.Stat(filepath.Join(configDir, "odpf", "helper.tmpl"))
+		assert.NoError(t, err)
+	})
+}
+
+func TestService_TransformToAlerts(t *testing.T) {
+	s := prometheus.NewPluginService(log.NewNoop(), prometheus.AppConfig{})
+
+	alerts, firingLen, err := s.TransformToAlerts(context.Background(), 1, 2, map[string]interface{}{
+		"groupKey": "group",
+		"alerts": []map[string]interface{}{
+			{
+				"status": "firing",
+				"labels": map[string]interface{}{
+					"severity": "CRITICAL",
+				},
+				"annotations": map[string]interface{}{
+					"resource":     "host-1",
+					"template":     "cpu-usage",
+					"metric_name":  "cpu_usage_user",
+					"metric_value": "95",
+				},
+				"startsAt":    "2022-10-06T03:39:33.817Z",
+				"fingerprint": "abc",
+			},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, firingLen)
+	require.Len(t, alerts, 1)
+	assert.Equal(t, uint64(1), alerts[0].ProviderID)
+	assert.Equal(t, uint64(2), alerts[0].NamespaceID)
+	assert.Equal(t, "host-1", alerts[0].ResourceName)
+	assert.Equal(t, "CRITICAL", alerts[0].Severity)
+}
@@ -0,0 +1,256 @@
+package webhook
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/antonmedv/expr"
+	"github.com/antonmedv/expr/vm"
+	"github.com/mitchellh/mapstructure"
+)
+
+const (
+	// configMappingKey is the key of provider config where the mapping is declared
+	configMappingKey = "mapping"
+
+	statusFiring   = "firing"
+	statusResolved = "resolved"
+)
+
+// Mapping declares how an incoming webhook body is transformed into alerts.
+// Each field is an expression (https://github.com/antonmedv/expr) evaluated against
+// `body` (the whole payload) and `alert` (the current item of `alerts` or the payload itself),
+// `upper` and `lower` functions are also available to normalize values.
+type Mapping struct {
+	// Alerts is an optional expression that returns the list of alerts in the payload,
+	// if empty, the whole payload is considered as a single alert
+	Alerts       string            `mapstructure:"alerts"`
+	ResourceName string            `mapstructure:"resource"`
+	MetricName   string            `mapstructure:"metric_name"`
+	MetricValue  string            `mapstructure:"metric_value"`
+	Severity     string            `mapstructure:"severity"`
+	Rule         string            `mapstructure:"rule"`
+	Status       string            `mapstructure:"status"`
+	Fingerprint  string            `mapstructure:"fingerprint"`
+	GroupKey     string            `mapstructure:"group_key"`
+	GeneratorURL string            `mapstructure:"generator_url"`
+	TriggeredAt  string            `mapstructure:"triggered_at"`
+	Labels       map[string]string `mapstructure:"labels"`
+	Annotations  map[string]string `mapstructure:"annotations"`
+}
+
+// ValidateConfig checks the mapping declared in provider config could be decoded and compiled
+// so an invalid mapping is rejected when the provider is created or updated
+func ValidateConfig(config map[string]interface{}) error {
+	m, err := MappingFromConfig(config)
+	if err != nil {
+		return err
+	}
+	_, err = m.compile()
+	return err
+}
+
+// MappingFromConfig decodes the mapping declared under `mapping` key of provider config
+func MappingFromConfig(config map[string]interface{}) (*Mapping, error) {
+	raw, ok := config[configMappingKey]
+	if !ok {
+		return nil, fmt.Errorf("webhook provider config has no %q", configMappingKey)
+	}
+
+	m := &Mapping{}
+	if err := mapstructure.Decode(raw, m); err != nil {
+		return nil, fmt.Errorf("cannot decode webhook provider mapping: %w", err)
+	}
+
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (m Mapping) Validate() error {
+	if m.ResourceName == "" {
+		return fmt.Errorf("invalid webhook provider mapping, resource is required")
+	}
+	if m.MetricName == "" {
+		return fmt.Errorf("invalid webhook provider mapping, metric_name is required")
+	}
+	if m.Severity == "" {
+		return fmt.Errorf("invalid webhook provider mapping, severity is required")
+	}
+	return nil
+}
+
+// compiledMapping holds compiled programs of a mapping, empty expressions are not compiled
+type compiledMapping struct {
+	alerts       *vm.Program
+	resourceName *vm.Program
+	metricName   *vm.Program
+	metricValue  *vm.Program
+	severity     *vm.Program
+	rule         *vm.Program
+	status       *vm.Program
+	fingerprint  *vm.Program
+	groupKey     *vm.Program
+	generatorURL *vm.Program
+	triggeredAt  *vm.Program
+	labels       map[string]*vm.Program
+	annotations  map[string]*vm.Program
+}
+
+func (m Mapping) compile() (*compiledMapping, error) {
+	var (
+		cm  = &compiledMapping{}
+		err error
+	)
+
+	for _, f := range []struct {
+		name   string
+		input  string
+		target **vm.Program
+	}{
+		{"alerts", m.Alerts, &cm.alerts},
+		{"resource", m.ResourceName, &cm.resourceName},
+		{"metric_name", m.MetricName, &cm.metricName},
+		{"metric_value", m.MetricValue, &cm.metricValue},
+		{"severity", m.Severity, &cm.severity},
+		{"rule", m.Rule, &cm.rule},
+		{"status", m.Status, &cm.status},
+		{"fingerprint", m.Fingerprint, &cm.fingerprint},
+		{"group_key", m.GroupKey, &cm.groupKey},
+		{"generator_url", m.GeneratorURL, &cm.generatorURL},
+		{"triggered_at", m.TriggeredAt, &cm.triggeredAt},
+	} {
+		if f.input == "" {
+			continue
+		}
+		if *f.target, err = expr.Compile(f.input); err != nil {
+			return nil, fmt.Errorf("invalid %s expression: %w", f.name, err)
+		}
+	}
+
+	if cm.labels, err = compileMap("labels", m.Labels); err != nil {
+		return nil, err
+	}
+	if cm.annotations, err = compileMap("annotations", m.Annotations); err != nil {
+		return nil, err
+	}
+
+	return cm, nil
+}
+
+func compileMap(name string, inputs map[string]string) (map[string]*vm.Program, error) {
+	programs := make(map[string]*vm.Program, len(inputs))
+	for k, input := range inputs {
+		p, err := expr.Compile(input)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s.%s expression: %w", name, k, err)
+		}
+		programs[k] = p
+	}
+	return programs, nil
+}
+
+// items returns the list of alert items of the body
+func (cm *compiledMapping) items(body map[string]interface{}) ([]interface{}, error) {
+	if cm.alerts == nil {
+		return []interface{}{body}, nil
+	}
+
+	res, err := expr.Run(cm.alerts, newEnv(body, body))
+	if err != nil {
+		return nil, fmt.Errorf("cannot evaluate alerts expression: %w", err)
+	}
+
+	items, ok := res.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("alerts expression result is not a list: %T", res)
+	}
+	return items, nil
+}
+
+func newEnv(body map[string]interface{}, item interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"body":  body,
+		"alert": item,
+		"upper": func(v interface{}) string { return strings.ToUpper(toString(v)) },
+		"lower": func(v interface{}) string { return strings.ToLower(toString(v)) },
+	}
+}
+
+func evalString(p *vm.Program, env map[string]interface{}) (string, error) {
+	if p == nil {
+		return "", nil
+	}
+
+	res, err := expr.Run(p, env)
+	if err != nil {
+		return "", err
+	}
+
+	return toString(res), nil
+}
+
+func evalMap(name string, programs map[string]*vm.Program, env map[string]interface{}) (map[string]string, error) {
+	m := make(map[string]string, len(programs))
+	for k, p := range programs {
+		v, err := evalString(p, env)
+		if err != nil {
+			return nil, fmt.Errorf("cannot evaluate %s.%s: %w", name, k, err)
+		}
+		if v != "" {
+			m[k] = v
+		}
+	}
+	return m, nil
+}
+
+func toString(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+// parseTime accepts RFC3339 string or unix epoch seconds
+func parseTime(v string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+		return t, nil
+	}
+
+	epoch, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("cannot parse triggered_at %q as RFC3339 or unix epoch", v)
+	}
+	sec := int64(epoch)
+	return time.Unix(sec, int64((epoch-float64(sec))*float64(time.Second))).UTC(), nil
+}
+
+// generateFingerprint builds a stable fingerprint of an alert from its identity
+// if the payload does not carry one
+func generateFingerprint(resourceName, metricName, rule string, labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s\x00%s", resourceName, metricName, rule)
+	for _, k := range keys {
+		fmt.Fprintf(h, "\x00%s=%s", k, labels[k])
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
@@ -0,0 +1,204 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/antonmedv/expr/vm"
+	"github.com/odpf/salt/log"
+	"github.com/odpf/siren/core/alert"
+	"github.com/odpf/siren/core/provider"
+	"github.com/odpf/siren/pkg/errors"
+)
+
+// PluginService is a service layer of generic webhook provider plugin
+// alerts are pushed by any external system and transformed with the mapping declared in the provider config
+type PluginService struct {
+	logger          log.Logger
+	providerService ProviderService
+	nowFn           func() time.Time
+
+	mu       sync.Mutex
+	mappings map[uint64]cachedMapping
+}
+
+// cachedMapping is a compiled mapping of a provider as it was at updatedAt
+type cachedMapping struct {
+	updatedAt time.Time
+	mapping   *compiledMapping
+}
+
+// NewPluginService returns webhook service provider plugin struct
+func NewPluginService(logger log.Logger, providerService ProviderService) *PluginService {
+	return &PluginService{
+		logger:          logger,
+		providerService: providerService,
+		nowFn:           time.Now,
+		mappings:        make(map[uint64]cachedMapping),
+	}
+}
+
+// SyncRuntimeConfig does nothing since alerts are pushed by external systems that are not managed by siren
+func (s *PluginService) SyncRuntimeConfig(ctx context.Context, namespaceID uint64, namespaceURN string, prov provider.Provider) error {
+	return nil
+}
+
+// TransformToAlerts is a function to transform arbitrary json body into []alert.Alert
+// based on the mapping of the provider
+func (s *PluginService) TransformToAlerts(ctx context.Context, providerID uint64, namespaceID uint64, body map[string]interface{}) ([]alert.Alert, int, error) {
+	prov, err := s.providerService.Get(ctx, providerID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if prov.Type != provider.TypeWebhook {
+		return nil, 0, errors.ErrInvalid.WithMsgf("provider %d is not a %s provider", providerID, provider.TypeWebhook)
+	}
+
+	cm, err := s.getMapping(prov)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	items, err := cm.items(body)
+	if err != nil {
+		return nil, 0, errors.ErrInvalid.WithMsgf(err.Error())
+	}
+
+	var (
+		alerts        = make([]alert.Alert, 0, len(items))
+		badAlertCount = 0
+		firingLen     = 0
+	)
+
+	for _, item := range items {
+		alrt, err := s.transform(cm, body, item)
+		if err != nil {
+			s.logger.Error(fmt.Sprintf("invalid alerts: %s", err.Error()), "provider id", providerID, "alert detail", item)
+			badAlertCount++
+			continue
+		}
+
+		alrt.ProviderID = providerID
+		alrt.NamespaceID = namespaceID
+
+		if alrt.Status == statusFiring {
+			firingLen++
+		}
+
+		alerts = append(alerts, *alrt)
+	}
+
+	if badAlertCount > 0 {
+		s.logger.Error("parameters are missing for alert", "provider id", providerID, "alert count", badAlertCount)
+	}
+
+	return alerts, firingLen, nil
+}
+
+func (s *PluginService) transform(cm *compiledMapping, body map[string]interface{}, item interface{}) (*alert.Alert, error) {
+	var (
+		env  = newEnv(body, item)
+		alrt = &alert.Alert{}
+		err  error
+	)
+
+	for _, f := range []struct {
+		name    string
+		program *vm.Program
+		target  *string
+	}{
+		{"resource", cm.resourceName, &alrt.ResourceName},
+		{"metric_name", cm.metricName, &alrt.MetricName},
+		{"metric_value", cm.metricValue, &alrt.MetricValue},
+		{"severity", cm.severity, &alrt.Severity},
+		{"rule", cm.rule, &alrt.Rule},
+		{"status", cm.status, &alrt.Status},
+		{"fingerprint", cm.fingerprint, &alrt.Fingerprint},
+		{"group_key", cm.groupKey, &alrt.GroupKey},
+		{"generator_url", cm.generatorURL, &alrt.GeneratorURL},
+	} {
+		if *f.target, err = evalString(f.program, env); err != nil {
+			return nil, fmt.Errorf("cannot evaluate %s: %w", f.name, err)
+		}
+	}
+
+	if alrt.ResourceName == "" {
+		return nil, fmt.Errorf("'resource' is missing")
+	}
+	if alrt.MetricName == "" {
+		return nil, fmt.Errorf("'metric_name' is missing")
+	}
+	if alrt.Severity == "" {
+		return nil, fmt.Errorf("'severity' is missing")
+	}
+
+	switch strings.ToLower(alrt.Status) {
+	case "":
+		alrt.Status = statusFiring
+	case statusFiring, statusResolved:
+		alrt.Status = strings.ToLower(alrt.Status)
+	default:
+		return nil, fmt.Errorf("status should be %q or %q but got %q", statusFiring, statusResolved, alrt.Status)
+	}
+
+	if alrt.Labels, err = evalMap("labels", cm.labels, env); err != nil {
+		return nil, err
+	}
+	if alrt.Annotations, err = evalMap("annotations", cm.annotations, env); err != nil {
+		return nil, err
+	}
+
+	// severity label is needed by subscriptions to route notifications
+	// the same way alerts coming from cortex are routed
+	if _, ok := alrt.Labels["severity"]; !ok {
+		alrt.Labels["severity"] = alrt.Severity
+	}
+	if alrt.Status == statusResolved {
+		alrt.Severity = alrt.Status
+	}
+
+	alrt.TriggeredAt = s.nowFn()
+	triggeredAt, err := evalString(cm.triggeredAt, env)
+	if err != nil {
+		return nil, fmt.Errorf("cannot evaluate triggered_at: %w", err)
+	}
+	if triggeredAt != "" {
+		if alrt.TriggeredAt, err = parseTime(triggeredAt); err != nil {
+			return nil, err
+		}
+	}
+
+	if alrt.Fingerprint == "" {
+		alrt.Fingerprint = generateFingerprint(alrt.ResourceName, alrt.MetricName, alrt.Rule, alrt.Labels)
+	}
+
+	return alrt, nil
+}
+
+// getMapping returns the compiled mapping of the provider, the mapping is
+// compiled again only if the provider has been updated since it was cached
+func (s *PluginService) getMapping(prov *provider.Provider) (*compiledMapping, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if cached, ok := s.mappings[prov.ID]; ok && cached.updatedAt.Equal(prov.UpdatedAt) {
+		return cached.mapping, nil
+	}
+
+	mapping, err := MappingFromConfig(prov.Config)
+	if err != nil {
+		return nil, errors.ErrInvalid.WithMsgf(err.Error())
+	}
+
+	cm, err := mapping.compile()
+	if err != nil {
+		return nil, errors.ErrInvalid.WithMsgf("invalid webhook provider mapping").WithCausef(err.Error())
+	}
+
+	s.mappings[prov.ID] = cachedMapping{updatedAt: prov.UpdatedAt, mapping: cm}
+	return cm, nil
+}
@@ -0,0 +1,354 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/odpf/salt/log"
+	"github.com/odpf/siren/core/alert"
+	"github.com/odpf/siren/core/provider"
+	"github.com/odpf/siren/plugins/providers/webhook"
+	"github.com/odpf/siren/plugins/providers/webhook/mocks"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_TransformToAlerts(t *testing.T) {
+	var (
+		providerID  uint64 = 3
+		namespaceID uint64 = 5
+	)
+
+	grafanaMapping := map[string]interface{}{
+		"alerts":       "body.alerts",
+		"resource":     "alert.labels.instance",
+		"metric_name":  "alert.labels.alertname",
+		"metric_value": "alert.values.B",
+		"severity":     "alert.labels.severity != nil ? upper(alert.labels.severity) : 'WARNING'",
+		"rule":         "alert.labels.rule",
+		"status":       "alert.status",
+		"fingerprint":  "alert.fingerprint",
+		"triggered_at": "alert.startsAt",
+		"labels": map[string]interface{}{
+			"team": "body.commonLabels.team",
+		},
+		"annotations": map[string]interface{}{
+			"summary": "alert.annotations != nil ? alert.annotations.summary : ''",
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		setup         func(*mocks.ProviderService)
+		body          map[string]interface{}
+		wantAlerts    []alert.Alert
+		wantFiringLen int
+		wantErr       bool
+	}{
+		{
+			name: "should return error if get provider return error",
+			setup: func(ps *mocks.ProviderService) {
+				ps.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), providerID).Return(nil, errors.New("some error"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return error if provider is not webhook",
+			setup: func(ps *mocks.ProviderService) {
+				ps.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), providerID).Return(&provider.Provider{
+					Type: provider.TypeCortex,
+				}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return error if mapping is missing",
+			setup: func(ps *mocks.ProviderService) {
+				ps.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), providerID).Return(&provider.Provider{
+					Type: provider.TypeWebhook,
+				}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return error if mapping has invalid expression",
+			setup: func(ps *mocks.ProviderService) {
+				ps.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), providerID).Return(&provider.Provider{
+					Type: provider.TypeWebhook,
+					Config: map[string]interface{}{
+						"mapping": map[string]interface{}{
+							"resource":    "body.host +",
+							"metric_name": "body.metric",
+							"severity":    "body.severity",
+						},
+					},
+				}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return error if alerts expression does not return a list",
+			setup: func(ps *mocks.ProviderService) {
+				ps.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), providerID).Return(&provider.Provider{
+					Type: provider.TypeWebhook,
+					Config: map[string]interface{}{
+						"mapping": grafanaMapping,
+					},
+				}, nil)
+			},
+			body: map[string]interface{}{
+				"alerts": "not a list",
+			},
+			wantErr: true,
+		},
+		{
+			name: "should transform the whole body as an alert if alerts expression is empty",
+			setup: func(ps *mocks.ProviderService) {
+				ps.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), providerID).Return(&provider.Provider{
+					Type: provider.TypeWebhook,
+					Config: map[string]interface{}{
+						"mapping": map[string]interface{}{
+							"resource":     "body.host",
+							"metric_name":  "body.check",
+							"metric_value": "body.value",
+							"severity":     "upper(body.level)",
+							"status":       "body.ok ? 'resolved' : 'firing'",
+							"triggered_at": "body.timestamp",
+						},
+					},
+				}, nil)
+			},
+			body: map[string]interface{}{
+				"host":      "db-1",
+				"check":     "disk_usage",
+				"value":     float64(91.5),
+				"level":     "critical",
+				"ok":        false,
+				"timestamp": float64(1665000000),
+			},
+			wantAlerts: []alert.Alert{
+				{
+					ProviderID:   providerID,
+					NamespaceID:  namespaceID,
+					ResourceName: "db-1",
+					MetricName:   "disk_usage",
+					MetricValue:  "91.5",
+					Severity:     "CRITICAL",
+					Status:       "firing",
+					Fingerprint:  "2382f6e6d42ebe01",
+					TriggeredAt:  time.Unix(1665000000, 0).UTC(),
+					Labels:       map[string]string{"severity": "CRITICAL"},
+					Annotations:  map[string]string{},
+				},
+			},
+			wantFiringLen: 1,
+		},
+		{
+			name: "should transform list of alerts and skip invalid ones",
+			setup: func(ps *mocks.ProviderService) {
+				ps.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), providerID).Return(&provider.Provider{
+					Type: provider.TypeWebhook,
+					Config: map[string]interface{}{
+						"mapping": grafanaMapping,
+					},
+				}, nil)
+			},
+			body: map[string]interface{}{
+				"commonLabels": map[string]interface{}{
+					"team": "odpf",
+				},
+				"alerts": []interface{}{
+					map[string]interface{}{
+						"status":      "firing",
+						"fingerprint": "abc",
+						"startsAt":    "2022-10-06T10:00:00Z",
+						"labels": map[string]interface{}{
+							"instance":  "host-1",
+							"alertname": "cpu_usage",
+							"severity":  "CRITICAL",
+							"rule":      "cpu-high",
+						},
+						"values": map[string]interface{}{
+							"B": float64(97),
+						},
+						"annotations": map[string]interface{}{
+							"summary": "cpu is high",
+						},
+					},
+					map[string]interface{}{
+						"status":      "resolved",
+						"fingerprint": "def",
+						"startsAt":    "2022-10-06T11:00:00Z",
+						"labels": map[string]interface{}{
+							"instance":  "host-2",
+							"alertname": "cpu_usage",
+						},
+						"values": map[string]interface{}{
+							"B": float64(12),
+						},
+					},
+					map[string]interface{}{
+						"status": "firing",
+						"labels": map[string]interface{}{
+							"alertname": "no_resource",
+						},
+					},
+					map[string]interface{}{
+						"status": "pending",
+						"labels": map[string]interface{}{
+							"instance":  "host-3",
+							"alertname": "cpu_usage",
+						},
+					},
+				},
+			},
+			wantAlerts: []alert.Alert{
+				{
+					ProviderID:   providerID,
+					NamespaceID:  namespaceID,
+					ResourceName: "host-1",
+					MetricName:   "cpu_usage",
+					MetricValue:  "97",
+					Severity:     "CRITICAL",
+					Rule:         "cpu-high",
+					Status:       "firing",
+					Fingerprint:  "abc",
+					TriggeredAt:  time.Date(2022, 10, 6, 10, 0, 0, 0, time.UTC),
+					Labels:       map[string]string{"team": "odpf", "severity": "CRITICAL"},
+					Annotations:  map[string]string{"summary": "cpu is high"},
+				},
+				{
+					ProviderID:   providerID,
+					NamespaceID:  namespaceID,
+					ResourceName: "host-2",
+					MetricName:   "cpu_usage",
+					MetricValue:  "12",
+					Severity:     "resolved",
+					Status:       "resolved",
+					Fingerprint:  "def",
+					TriggeredAt:  time.Date(2022, 10, 6, 11, 0, 0, 0, time.UTC),
+					Labels:       map[string]string{"team": "odpf", "severity": "WARNING"},
+					Annotations:  map[string]string{},
+				},
+			},
+			wantFiringLen: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockProviderService := new(mocks.ProviderService)
+			if tc.setup != nil {
+				tc.setup(mockProviderService)
+			}
+
+			s := webhook.NewPluginService(log.NewNoop(), mockProviderService)
+			gotAlerts, gotFiringLen, err := s.TransformToAlerts(context.Background(), providerID, namespaceID, tc.body)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("TransformToAlerts() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.wantAlerts, gotAlerts, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("TransformToAlerts() diff = %v", diff)
+			}
+			if gotFiringLen != tc.wantFiringLen {
+				t.Errorf("TransformToAlerts() firingLen = %v, want %v", gotFiringLen, tc.wantFiringLen)
+			}
+
+			mockProviderService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_TransformToAlerts_GeneratesFingerprint(t *testing.T) {
+	mockProviderService := new(mocks.ProviderService)
+	mockProviderService.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), uint64(1)).Return(&provider.Provider{
+		Type: provider.TypeWebhook,
+		Config: map[string]interface{}{
+			"mapping": map[string]interface{}{
+				"resource":    "body.host",
+				"metric_name": "body.check",
+				"severity":    "'CRITICAL'",
+			},
+		},
+	}, nil)
+
+	s := webhook.NewPluginService(log.NewNoop(), mockProviderService)
+	body := map[string]interface{}{"host": "db-1", "check": "disk"}
+
+	first, _, err := s.TransformToAlerts(context.Background(), 1, 1, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, _, err := s.TransformToAlerts(context.Background(), 1, 1, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if first[0].Fingerprint == "" || first[0].Fingerprint != second[0].Fingerprint {
+		t.Errorf("fingerprint should be generated and stable, got %q and %q", first[0].Fingerprint, second[0].Fingerprint)
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	testCases := []struct {
+		name    string
+		config  map[string]interface{}
+		wantErr string
+	}{
+		{
+			name:    "should return error if mapping is missing",
+			config:  map[string]interface{}{},
+			wantErr: "webhook provider config has no \"mapping\"",
+		},
+		{
+			name: "should return error if required field is missing",
+			config: map[string]interface{}{
+				"mapping": map[string]interface{}{
+					"resource": "body.host",
+				},
+			},
+			wantErr: "invalid webhook provider mapping, metric_name is required",
+		},
+		{
+			name: "should return error if expression cannot be compiled",
+			config: map[string]interface{}{
+				"mapping": map[string]interface{}{
+					"resource":    "body.host",
+					"metric_name": "body.check",
+					"severity":    "body.(",
+				},
+			},
+			wantErr: "invalid severity expression",
+		},
+		{
+			name: "should return nil if mapping is valid",
+			config: map[string]interface{}{
+				"mapping": map[string]interface{}{
+					"resource":    "body.host",
+					"metric_name": "body.check",
+					"severity":    "upper(body.level)",
+					"labels":      map[string]interface{}{"team": "body.team"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := webhook.ValidateConfig(tc.config)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ValidateConfig() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.HasPrefix(err.Error(), tc.wantErr) {
+				t.Fatalf("ValidateConfig() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
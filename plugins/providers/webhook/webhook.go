@@ -0,0 +1,12 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/odpf/siren/core/provider"
+)
+
+//go:generate mockery --name=ProviderService -r --case underscore --with-expecter --structname ProviderService --filename provider_service.go --output=./mocks
+type ProviderService interface {
+	Get(ctx context.Context, id uint64) (*provider.Provider, error)
+}
@@ -0,0 +1,44 @@
+package queues
+
+import (
+	"math/rand"
+	"time"
+)
+
+// BackoffConfig configures the delay before a retryable failed message is dequeued again,
+// the delay doubles on every try starting from base and is capped at max
+type BackoffConfig struct {
+	Base time.Duration `mapstructure:"base" yaml:"base" default:"5s"`
+	Max  time.Duration `mapstructure:"max" yaml:"max" default:"10m"`
+	// Jitter is the fraction of the delay randomly taken off, between 0 and 1,
+	// to spread retries of messages failed at the same time
+	Jitter float64 `mapstructure:"jitter" yaml:"jitter" default:"0.2"`
+}
+
+// Delay returns the delay before the next attempt of a message that has been tried tryCount times
+func (c BackoffConfig) Delay(tryCount int) time.Duration {
+	if c.Base <= 0 || tryCount <= 0 {
+		return 0
+	}
+
+	delay := c.Base
+	for i := 1; i < tryCount; i++ {
+		if c.Max > 0 && delay >= c.Max {
+			break
+		}
+		delay *= 2
+	}
+	if c.Max > 0 && delay > c.Max {
+		delay = c.Max
+	}
+
+	if c.Jitter > 0 {
+		jitter := c.Jitter
+		if jitter > 1 {
+			jitter = 1
+		}
+		delay -= time.Duration(rand.Float64() * jitter * float64(delay))
+	}
+
+	return delay
+}
@@ -0,0 +1,63 @@
+package queues_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/odpf/siren/plugins/queues"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoffConfig_Delay(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cfg      queues.BackoffConfig
+		tryCount int
+		want     time.Duration
+	}{
+		{
+			name:     "should return zero if base is not set",
+			cfg:      queues.BackoffConfig{Max: time.Minute},
+			tryCount: 3,
+			want:     0,
+		},
+		{
+			name:     "should return zero if message has not been tried",
+			cfg:      queues.BackoffConfig{Base: time.Second, Max: time.Minute},
+			tryCount: 0,
+			want:     0,
+		},
+		{
+			name:     "should return base on the first try",
+			cfg:      queues.BackoffConfig{Base: time.Second, Max: time.Minute},
+			tryCount: 1,
+			want:     time.Second,
+		},
+		{
+			name:     "should double the delay on every try",
+			cfg:      queues.BackoffConfig{Base: time.Second, Max: time.Minute},
+			tryCount: 4,
+			want:     8 * time.Second,
+		},
+		{
+			name:     "should cap the delay at max",
+			cfg:      queues.BackoffConfig{Base: time.Second, Max: time.Minute},
+			tryCount: 100,
+			want:     time.Minute,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.cfg.Delay(tc.tryCount))
+		})
+	}
+
+	t.Run("should take off at most jitter fraction of the delay", func(t *testing.T) {
+		cfg := queues.BackoffConfig{Base: 10 * time.Second, Max: time.Minute, Jitter: 0.5}
+		for i := 0; i < 100; i++ {
+			got := cfg.Delay(2)
+			assert.LessOrEqual(t, got, 20*time.Second)
+			assert.GreaterOrEqual(t, got, 10*time.Second)
+		}
+	})
+}
@@ -1,5 +1,7 @@
 package queues
 
+import "time"
+
 type Kind string
 
 const (
@@ -12,10 +14,24 @@ func (k Kind) String() string {
 }
 
 type Config struct {
-	Kind Kind `mapstructure:"kind" yaml:"kind" default:"inmemory"`
+	Kind    Kind          `mapstructure:"kind" yaml:"kind" default:"inmemory"`
+	Backoff BackoffConfig `mapstructure:"backoff" yaml:"backoff"`
+	// VisibilityTimeout is how long a dequeued message could stay pending before it is put back to the queue
+	VisibilityTimeout time.Duration `mapstructure:"visibility_timeout" yaml:"visibility_timeout" default:"5m"`
 }
 
 type FilterCleanup struct {
 	MessagePendingTimeThreshold   string
 	MessagePublishedTimeThreshold string
 }
+
+// FilterMessage selects messages in the queue to be inspected, start and end time are unix seconds of creation
+type FilterMessage struct {
+	IDs          []string
+	Status       string
+	ReceiverType string
+	StartTime    int64
+	EndTime      int64
+	Error        string
+	Limit        uint64
+}
@@ -85,10 +85,34 @@ func (q *Queue) ErrorCallback(ctx context.Context, ms notification.Message) erro
 	return nil
 }
 
+// DelayCallback is a callback that will be called once the message is throttled by handlerFn
+// inmemory queue does not support delay, the message is enqueued back right away
+func (q *Queue) DelayCallback(ctx context.Context, ms notification.Message) error {
+	q.logger.Debug("delaying message", "scope", "queues.inmemory.delay_callback", "type", ms.ReceiverType, "next_attempt_at", ms.NextAttemptAt)
+	return q.Enqueue(ctx, ms)
+}
+
 func (q *Queue) Cleanup(ctx context.Context, filter queues.FilterCleanup) error {
 	return plugins.ErrNotImplemented
 }
 
+// ListMessages is not supported, messages in inmemory queue could not be inspected
+func (q *Queue) ListMessages(ctx context.Context, filter queues.FilterMessage) ([]notification.Message, error) {
+	return nil, plugins.ErrNotImplemented
+}
+
+func (q *Queue) GetMessage(ctx context.Context, id string) (notification.Message, error) {
+	return notification.Message{}, plugins.ErrNotImplemented
+}
+
+func (q *Queue) RequeueMessages(ctx context.Context, ids ...string) (int64, error) {
+	return 0, plugins.ErrNotImplemented
+}
+
+func (q *Queue) DiscardMessages(ctx context.Context, ids ...string) (int64, error) {
+	return 0, plugins.ErrNotImplemented
+}
+
 func (q *Queue) Type() string {
 	return "inmemory"
 }
@@ -0,0 +1,118 @@
+package postgresq
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"github.com/odpf/siren/core/notification"
+	"github.com/odpf/siren/pkg/errors"
+	"github.com/odpf/siren/pkg/pgc"
+	"github.com/odpf/siren/plugins/queues"
+)
+
+const defaultListMessagesLimit = 100
+
+var (
+	requeueMessagesQuery = fmt.Sprintf(`
+UPDATE %s
+SET status = '%s', try_count = 0, last_error = NULL, retryable = false, next_attempt_at = NULL, updated_at = now()
+WHERE id = any($1) AND status = '%s'
+`, MessageQueueTableFullName, notification.MessageStatusEnqueued, notification.MessageStatusFailed)
+
+	discardMessagesQuery = fmt.Sprintf(`
+DELETE FROM %s
+WHERE id = any($1) AND status <> '%s'
+`, MessageQueueTableFullName, notification.MessageStatusPending)
+)
+
+// ListMessages returns messages in the queue matching the filter, latest created first
+func (q *Queue) ListMessages(ctx context.Context, filter queues.FilterMessage) ([]notification.Message, error) {
+	queryBuilder := sq.Select("*").From(MessageQueueTableFullName)
+
+	if len(filter.IDs) > 0 {
+		queryBuilder = queryBuilder.Where("id = any(?)", pq.Array(filter.IDs))
+	}
+	if filter.Status != "" {
+		queryBuilder = queryBuilder.Where("status = ?", filter.Status)
+	}
+	if filter.ReceiverType != "" {
+		queryBuilder = queryBuilder.Where("receiver_type = ?", filter.ReceiverType)
+	}
+	if filter.StartTime != 0 {
+		queryBuilder = queryBuilder.Where("created_at >= ?", time.Unix(filter.StartTime, 0))
+	}
+	if filter.EndTime != 0 {
+		queryBuilder = queryBuilder.Where("created_at <= ?", time.Unix(filter.EndTime, 0))
+	}
+	if filter.Error != "" {
+		queryBuilder = queryBuilder.Where("last_error ILIKE '%' || ? || '%'", filter.Error)
+	}
+
+	limit := filter.Limit
+	if limit == 0 {
+		limit = defaultListMessagesLimit
+	}
+
+	query, args, err := queryBuilder.OrderBy("created_at DESC").Limit(limit).PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.pgClient.QueryxContext(ctx, pgc.OpSelectAll, MessageQueueTableFullName, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := []notification.Message{}
+	for rows.Next() {
+		msg := NotificationMessage{}
+		if err := rows.StructScan(&msg); err != nil {
+			return nil, err
+		}
+		messages = append(messages, msg.ToDomain())
+	}
+
+	return messages, rows.Err()
+}
+
+// GetMessage returns a message in the queue with its try history
+func (q *Queue) GetMessage(ctx context.Context, id string) (notification.Message, error) {
+	query, args, err := sq.Select("*").From(MessageQueueTableFullName).Where("id = ?", id).PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return notification.Message{}, err
+	}
+
+	var msg NotificationMessage
+	if err := q.pgClient.GetContext(ctx, pgc.OpSelect, MessageQueueTableFullName, &msg, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return notification.Message{}, errors.ErrNotFound.WithMsgf("message with id %q not found", id)
+		}
+		return notification.Message{}, err
+	}
+
+	return msg.ToDomain(), nil
+}
+
+// RequeueMessages puts failed messages back to the queue with a fresh try count
+// the try history is kept, messages in other status are left untouched
+func (q *Queue) RequeueMessages(ctx context.Context, ids ...string) (int64, error) {
+	res, err := q.pgClient.ExecContext(ctx, "UPDATE_REQUEUE", MessageQueueTableFullName, requeueMessagesQuery, pq.Array(ids))
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// DiscardMessages removes messages from the queue, pending messages being handled are left untouched
+func (q *Queue) DiscardMessages(ctx context.Context, ids ...string) (int64, error) {
+	res, err := q.pgClient.ExecContext(ctx, pgc.OpDelete, MessageQueueTableFullName, discardMessagesQuery, pq.Array(ids))
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
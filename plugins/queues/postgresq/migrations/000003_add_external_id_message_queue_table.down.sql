@@ -0,0 +1 @@
+ALTER TABLE message_queue DROP COLUMN IF EXISTS external_id;
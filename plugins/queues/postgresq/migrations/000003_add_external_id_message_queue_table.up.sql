@@ -0,0 +1 @@
+ALTER TABLE message_queue ADD COLUMN IF NOT EXISTS external_id text;
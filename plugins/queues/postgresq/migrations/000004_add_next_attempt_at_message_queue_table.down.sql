@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS message_queue_next_attempt_at_idx;
+ALTER TABLE message_queue DROP COLUMN IF EXISTS next_attempt_at;
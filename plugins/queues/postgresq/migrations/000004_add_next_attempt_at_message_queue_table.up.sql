@@ -0,0 +1,2 @@
+ALTER TABLE message_queue ADD COLUMN IF NOT EXISTS next_attempt_at timestamptz;
+CREATE INDEX IF NOT EXISTS message_queue_next_attempt_at_idx ON message_queue (status, retryable, next_attempt_at);
@@ -0,0 +1,2 @@
+ALTER TABLE message_queue ADD COLUMN IF NOT EXISTS attempts jsonb NOT NULL DEFAULT '[]'::jsonb;
+CREATE INDEX IF NOT EXISTS message_queue_created_at_idx ON message_queue (created_at);
@@ -2,6 +2,8 @@ package postgresq
 
 import (
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/odpf/siren/core/notification"
@@ -17,14 +19,18 @@ type NotificationMessage struct {
 	Details      pgc.StringInterfaceMap `db:"details"`
 	Metadata     pgc.StringInterfaceMap `db:"metadata"`
 	LastError    sql.NullString         `db:"last_error"`
+	ExternalID   sql.NullString         `db:"external_id"`
 
 	MaxTries  int  `db:"max_tries"`
 	TryCount  int  `db:"try_count"`
 	Retryable bool `db:"retryable"`
 
-	ExpiredAt sql.NullTime `db:"expired_at"`
-	CreatedAt time.Time    `db:"created_at"`
-	UpdatedAt time.Time    `db:"updated_at"`
+	Attempts MessageAttempts `db:"attempts"`
+
+	ExpiredAt     sql.NullTime `db:"expired_at"`
+	NextAttemptAt sql.NullTime `db:"next_attempt_at"`
+	CreatedAt     time.Time    `db:"created_at"`
+	UpdatedAt     time.Time    `db:"updated_at"`
 }
 
 func (nm *NotificationMessage) FromDomain(domainMessage notification.Message) {
@@ -41,6 +47,7 @@ func (nm *NotificationMessage) FromDomain(domainMessage notification.Message) {
 			return true
 		}
 	}()}
+	nm.ExternalID = sql.NullString{String: domainMessage.ExternalID, Valid: domainMessage.ExternalID != ""}
 	nm.MaxTries = domainMessage.MaxTries
 	nm.TryCount = domainMessage.TryCount
 	nm.Retryable = domainMessage.Retryable
@@ -51,6 +58,7 @@ func (nm *NotificationMessage) FromDomain(domainMessage notification.Message) {
 			return true
 		}
 	}()}
+	nm.NextAttemptAt = sql.NullTime{Time: domainMessage.NextAttemptAt, Valid: !domainMessage.NextAttemptAt.IsZero()}
 	nm.CreatedAt = domainMessage.CreatedAt
 	nm.UpdatedAt = domainMessage.UpdatedAt
 }
@@ -64,13 +72,31 @@ func (nm *NotificationMessage) ToDomain() notification.Message {
 		Configs:      nm.Configs,
 		Details:      nm.Details,
 		LastError:    nm.LastError.String,
+		ExternalID:   nm.ExternalID.String,
 
 		MaxTries:  nm.MaxTries,
 		TryCount:  nm.TryCount,
 		Retryable: nm.Retryable,
 
-		ExpiredAt: nm.ExpiredAt.Time,
-		CreatedAt: nm.CreatedAt,
-		UpdatedAt: nm.UpdatedAt,
+		Attempts: nm.Attempts,
+
+		ExpiredAt:     nm.ExpiredAt.Time,
+		NextAttemptAt: nm.NextAttemptAt.Time,
+		CreatedAt:     nm.CreatedAt,
+		UpdatedAt:     nm.UpdatedAt,
+	}
+}
+
+// MessageAttempts is the try history of a message stored as jsonb array
+type MessageAttempts []notification.MessageAttempt
+
+func (a *MessageAttempts) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("failed type assertion to []byte")
 	}
+	return json.Unmarshal(b, a)
 }
@@ -1,5 +1,11 @@
 package postgresq
 
+import (
+	"time"
+
+	"github.com/odpf/siren/plugins/queues"
+)
+
 type QueueOption func(*Queue)
 
 func WithStrategy(s Strategy) QueueOption {
@@ -7,3 +13,18 @@ func WithStrategy(s Strategy) QueueOption {
 		q.strategy = s
 	}
 }
+
+// WithBackoff sets the delay of the next attempt of retryable failed messages
+func WithBackoff(cfg queues.BackoffConfig) QueueOption {
+	return func(q *Queue) {
+		q.backoff = cfg
+	}
+}
+
+// WithVisibilityTimeout sets how long a dequeued message could stay pending
+// before it is put back to the queue, zero disables reclaiming
+func WithVisibilityTimeout(d time.Duration) QueueOption {
+	return func(q *Queue) {
+		q.visibilityTimeout = d
+	}
+}
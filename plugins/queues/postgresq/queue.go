@@ -2,16 +2,20 @@ package postgresq
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/odpf/salt/db"
 	"github.com/odpf/salt/log"
 	"github.com/odpf/siren/core/notification"
 	"github.com/odpf/siren/pkg/pgc"
 	"github.com/odpf/siren/pkg/telemetry"
+	"github.com/odpf/siren/plugins/queues"
 	"github.com/odpf/siren/plugins/queues/postgresq/migrations"
+	"go.opencensus.io/tag"
 )
 
 const (
@@ -27,30 +31,45 @@ const (
 	StrategyDLQ     Strategy = "dlq"
 )
 
+// reclaimError is the error of a try lost because the message was not called back within the visibility timeout
+const reclaimError = "visibility timeout exceeded"
+
 type Queue struct {
-	logger         log.Logger
-	pgClient       *pgc.Client
-	strategy       Strategy
-	postgresTracer *telemetry.PostgresTracer
+	logger   log.Logger
+	pgClient *pgc.Client
+	strategy Strategy
+	backoff  queues.BackoffConfig
+	// visibilityTimeout is the lease of a dequeued message to be called back before it is reclaimed
+	visibilityTimeout time.Duration
+	postgresTracer    *telemetry.PostgresTracer
 }
 
 var (
 	successCallbackQuery = fmt.Sprintf(`
 UPDATE %s
-SET updated_at = $1, status = $2, try_count = $3
-WHERE id = $4
+SET updated_at = $1, status = $2, try_count = $3, external_id = $4,
+    attempts = attempts || jsonb_build_array(jsonb_build_object('try_count', $3::int, 'status', $2::text, 'at', $1::timestamptz))
+WHERE id = $5
 `, MessageQueueTableFullName)
 
 	errorCallbackQuery = fmt.Sprintf(`
 UPDATE %s
-SET updated_at = $1, status = $2, try_count = $3, last_error = $4, retryable = $5
-WHERE id = $6
+SET updated_at = $1, status = $2, try_count = $3, last_error = $4, retryable = $5, next_attempt_at = $6,
+    attempts = attempts || jsonb_build_array(jsonb_build_object('try_count', $3::int, 'status', $2::text, 'error', $4::text, 'at', $1::timestamptz))
+WHERE id = $7
+`, MessageQueueTableFullName)
+
+	delayCallbackQuery = fmt.Sprintf(`
+UPDATE %s
+SET updated_at = $1, status = $2, next_attempt_at = $3
+WHERE id = $4
 `, MessageQueueTableFullName)
 
 	queueEnqueueNamedQuery = fmt.Sprintf(`
 INSERT INTO %s
 	(id, status, receiver_type, configs, details, last_error, max_tries, try_count, retryable, expired_at, created_at, updated_at)
     VALUES (:id,:status,:receiver_type,:configs,:details,:last_error,:max_tries,:try_count,:retryable,:expired_at,:created_at,:updated_at)
+ON CONFLICT (id) DO NOTHING
 `, MessageQueueTableFullName)
 )
 
@@ -61,13 +80,13 @@ SET status = '%s', updated_at = now()
 WHERE id IN (
     SELECT id
     FROM %s
-    WHERE (status = '%s' OR status = '%s') AND retryable IS FALSE %s AND (expired_at < now() OR expired_at IS NULL) AND try_count < max_tries AND last_error IS NULL
-    ORDER BY expired_at
+    WHERE status = '%s' AND retryable IS FALSE %s AND (expired_at < now() OR expired_at IS NULL) AND try_count < max_tries AND last_error IS NULL AND (next_attempt_at IS NULL OR next_attempt_at <= now())
+    ORDER BY COALESCE(next_attempt_at, created_at)
     FOR UPDATE SKIP LOCKED
     LIMIT %d
 )
 RETURNING *
-`, MessageQueueTableFullName, notification.MessageStatusPending, MessageQueueTableFullName, notification.MessageStatusEnqueued, notification.MessageStatusPending, receiverTypesList, batchSize)
+`, MessageQueueTableFullName, notification.MessageStatusPending, MessageQueueTableFullName, notification.MessageStatusEnqueued, receiverTypesList, batchSize)
 }
 
 func getDLQDequeueQuery(batchSize int, receiverTypesList string) string {
@@ -77,13 +96,39 @@ SET status = '%s', updated_at = now()
 WHERE id IN (
     SELECT id
     FROM %s
-    WHERE (status = '%s' OR status = '%s') AND retryable IS TRUE  %s AND (expired_at < now() OR expired_at IS NULL) AND try_count < max_tries AND last_error IS NOT NULL
-    ORDER BY expired_at
+    WHERE status = '%s' AND retryable IS TRUE %s AND (expired_at < now() OR expired_at IS NULL) AND try_count < max_tries AND last_error IS NOT NULL AND (next_attempt_at IS NULL OR next_attempt_at <= now())
+    ORDER BY COALESCE(next_attempt_at, created_at)
     FOR UPDATE SKIP LOCKED
     LIMIT %d
 )
 RETURNING *
-`, MessageQueueTableFullName, notification.MessageStatusPending, MessageQueueTableFullName, notification.MessageStatusFailed, notification.MessageStatusPending, receiverTypesList, batchSize)
+`, MessageQueueTableFullName, notification.MessageStatusPending, MessageQueueTableFullName, notification.MessageStatusFailed, receiverTypesList, batchSize)
+}
+
+// getReclaimQuery returns pending messages of the strategy not called back within the visibility timeout
+// to the state they were dequeued from, the lost try is counted
+// a message which lost its last try is marked as non-retryable failed so it does not stay in the queue unnoticed
+func getReclaimQuery(strategy Strategy) string {
+	var (
+		status    = notification.MessageStatusEnqueued
+		lastError = "last_error IS NULL"
+	)
+	if strategy == StrategyDLQ {
+		status = notification.MessageStatusFailed
+		lastError = "last_error IS NOT NULL"
+	}
+	return fmt.Sprintf(`
+UPDATE %[1]s
+SET status = CASE WHEN try_count + 1 >= max_tries THEN '%[4]s' ELSE '%[2]s' END,
+    retryable = CASE WHEN try_count + 1 >= max_tries THEN false ELSE retryable END,
+    last_error = CASE WHEN try_count + 1 >= max_tries THEN '%[6]s' ELSE last_error END,
+    try_count = try_count + 1, updated_at = now(),
+    attempts = attempts || jsonb_build_array(jsonb_build_object('try_count', try_count + 1,
+        'status', CASE WHEN try_count + 1 >= max_tries THEN '%[4]s' ELSE '%[3]s' END,
+        'error', '%[6]s', 'at', now()))
+WHERE status = '%[3]s' AND %[5]s AND updated_at < now() - $1 * interval '1 second'
+RETURNING receiver_type
+`, MessageQueueTableFullName, status, notification.MessageStatusPending, notification.MessageStatusFailed, lastError, reclaimError)
 }
 
 // New creates a new queue instance
@@ -134,10 +179,17 @@ func New(logger log.Logger, dbConfig db.Config, opts ...QueueOption) (*Queue, er
 }
 
 // Dequeue pop the queue based on specific filters (receiver types or batch size) and process the messages with handlerFn
-// message left in pending state that has expired or been updated long time ago means there was a failure when transforming row into a struct
+// message left in pending state longer than visibility timeout means the handler crashed before calling back
+// or there was a failure when transforming row into a struct, it is reclaimed before dequeueing
 func (q *Queue) Dequeue(ctx context.Context, receiverTypes []string, batchSize int, handlerFn func(context.Context, []notification.Message) error) error {
 	messages := []notification.Message{}
 
+	if q.visibilityTimeout > 0 {
+		if err := q.reclaim(ctx); err != nil {
+			q.logger.Error("failed to reclaim pending messages", "strategy", q.strategy, "error", err)
+		}
+	}
+
 	receiverTypesQuery := getFilterReceiverTypes(receiverTypes)
 
 	var dequeueQuery string
@@ -174,7 +226,35 @@ func (q *Queue) Dequeue(ctx context.Context, receiverTypes []string, batchSize i
 	return nil
 }
 
+// reclaim puts pending messages not called back within the visibility timeout back to the queue
+func (q *Queue) reclaim(ctx context.Context) error {
+	rows, err := q.pgClient.QueryxContext(ctx, "UPDATE_RECLAIM", MessageQueueTableFullName, getReclaimQuery(q.strategy), int(q.visibilityTimeout.Seconds()))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var reclaimed int
+	for rows.Next() {
+		var receiverType string
+		if err := rows.Scan(&receiverType); err != nil {
+			return err
+		}
+		reclaimed++
+
+		telemetry.IncrementInt64Counter(ctx, telemetry.MetricNotificationMessageReclaimed,
+			tag.Upsert(telemetry.TagReceiverType, receiverType))
+	}
+
+	if reclaimed > 0 {
+		q.logger.Warn(fmt.Sprintf("reclaimed %d pending messages exceeding visibility timeout %s", reclaimed, q.visibilityTimeout), "strategy", q.strategy)
+	}
+
+	return rows.Err()
+}
+
 // Enqueue pushes messages to the queue
+// a message with an id already in the queue is ignored, so enqueueing the same message again is a no-op
 func (q *Queue) Enqueue(ctx context.Context, ms ...notification.Message) error {
 	messages := []NotificationMessage{}
 	for _, m := range ms {
@@ -192,8 +272,8 @@ func (q *Queue) Enqueue(ctx context.Context, ms ...notification.Message) error {
 	if err != nil {
 		return err
 	}
-	if rowsAffected == 0 {
-		return errors.New("no rows affected when enqueueing messages")
+	if rowsAffected < int64(len(messages)) {
+		q.logger.Debug(fmt.Sprintf("ignored %d messages already in the queue", int64(len(messages))-rowsAffected), "strategy", q.strategy)
 	}
 	return nil
 }
@@ -201,7 +281,7 @@ func (q *Queue) Enqueue(ctx context.Context, ms ...notification.Message) error {
 // SuccessCallback is a callback that will be called once the message is succesfully handled by handlerFn
 func (q *Queue) SuccessCallback(ctx context.Context, ms notification.Message) error {
 	q.logger.Debug("marking a message as published", "strategy", q.strategy, "id", ms.ID)
-	res, err := q.pgClient.ExecContext(ctx, "UPDATE_SUCCESS", MessageQueueTableFullName, successCallbackQuery, ms.UpdatedAt, ms.Status, ms.TryCount, ms.ID)
+	res, err := q.pgClient.ExecContext(ctx, "UPDATE_SUCCESS", MessageQueueTableFullName, successCallbackQuery, ms.UpdatedAt, ms.Status, ms.TryCount, sql.NullString{String: ms.ExternalID, Valid: ms.ExternalID != ""}, ms.ID)
 	if err != nil {
 		return err
 	}
@@ -217,9 +297,14 @@ func (q *Queue) SuccessCallback(ctx context.Context, ms notification.Message) er
 }
 
 // ErrorCallback is a callback that will be called once the message is failed to be handled by handlerFn
+// retryable message is not dequeued again until its next attempt time, backed off by its try count
 func (q *Queue) ErrorCallback(ctx context.Context, ms notification.Message) error {
 	q.logger.Debug("marking a message as failed with", "strategy", q.strategy, "id", ms.ID)
-	res, err := q.pgClient.ExecContext(ctx, "UPDATE_ERROR", MessageQueueTableFullName, errorCallbackQuery, ms.UpdatedAt, ms.Status, ms.TryCount, ms.LastError, ms.Retryable, ms.ID)
+	var nextAttemptAt sql.NullTime
+	if ms.Retryable {
+		nextAttemptAt = sql.NullTime{Time: time.Now().Add(q.backoff.Delay(ms.TryCount)), Valid: true}
+	}
+	res, err := q.pgClient.ExecContext(ctx, "UPDATE_ERROR", MessageQueueTableFullName, errorCallbackQuery, ms.UpdatedAt, ms.Status, ms.TryCount, ms.LastError, ms.Retryable, nextAttemptAt, ms.ID)
 	if err != nil {
 		return err
 	}
@@ -234,6 +319,25 @@ func (q *Queue) ErrorCallback(ctx context.Context, ms notification.Message) erro
 	return nil
 }
 
+// DelayCallback is a callback that will be called once the message is throttled by handlerFn
+// the message is put back to the queue and is not dequeued again until its next attempt time
+func (q *Queue) DelayCallback(ctx context.Context, ms notification.Message) error {
+	q.logger.Debug("delaying a message", "strategy", q.strategy, "id", ms.ID, "next_attempt_at", ms.NextAttemptAt)
+	res, err := q.pgClient.ExecContext(ctx, "UPDATE_DELAY", MessageQueueTableFullName, delayCallbackQuery, ms.UpdatedAt, ms.Status, ms.NextAttemptAt, ms.ID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.New("no rows affected when delaying row")
+	}
+	q.logger.Debug("delayed a message", "strategy", q.strategy, "id", ms.ID)
+	return nil
+}
+
 func (q *Queue) Type() string {
 	return "postgresql"
 }
@@ -13,6 +13,8 @@ import (
 	"github.com/odpf/salt/log"
 	"github.com/odpf/siren/core/notification"
 	"github.com/odpf/siren/core/receiver"
+	sirenerrors "github.com/odpf/siren/pkg/errors"
+	"github.com/odpf/siren/plugins/queues"
 	"github.com/odpf/siren/plugins/queues/postgresq"
 	"github.com/odpf/siren/plugins/queues/postgresq/migrations"
 	"github.com/ory/dockertest/v3"
@@ -21,13 +23,15 @@ import (
 
 type QueueTestSuite struct {
 	suite.Suite
-	logger   log.Logger
-	ctx      context.Context
-	dbc      *db.Client
-	pool     *dockertest.Pool
-	resource *dockertest.Resource
-	q        *postgresq.Queue
-	dlq      *postgresq.Queue
+	logger     log.Logger
+	ctx        context.Context
+	dbc        *db.Client
+	pool       *dockertest.Pool
+	resource   *dockertest.Resource
+	q          *postgresq.Queue
+	dlq        *postgresq.Queue
+	backoffDLQ *postgresq.Queue
+	leasedQ    *postgresq.Queue
 }
 
 func (s *QueueTestSuite) SetupSuite() {
@@ -76,6 +80,19 @@ func (s *QueueTestSuite) SetupSuite() {
 	if err != nil {
 		s.T().Fatal(err)
 	}
+
+	s.leasedQ, err = postgresq.New(s.logger, dbConfig, postgresq.WithVisibilityTimeout(time.Minute))
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.backoffDLQ, err = postgresq.New(s.logger, dbConfig, postgresq.WithStrategy(postgresq.StrategyDLQ), postgresq.WithBackoff(queues.BackoffConfig{
+		Base: time.Hour,
+		Max:  4 * time.Hour,
+	}))
+	if err != nil {
+		s.T().Fatal(err)
+	}
 }
 
 func (s *QueueTestSuite) TearDownSuite() {
@@ -233,7 +250,7 @@ func (s *QueueTestSuite) TestEnqueueDequeueWithCallback() {
 		s.Require().NoError(err)
 
 		for _, m := range messages {
-			m.MarkPublished(time.Now())
+			m.MarkPublished(time.Now(), "external-id")
 			err = s.q.SuccessCallback(s.ctx, m)
 			s.Assert().NoError(err)
 		}
@@ -243,6 +260,7 @@ func (s *QueueTestSuite) TestEnqueueDequeueWithCallback() {
 		s.Require().NoError(err)
 
 		s.Assert().Equal(string(notification.MessageStatusPublished), tempMessage.Status)
+		s.Assert().Equal("external-id", tempMessage.ExternalID.String)
 		s.Assert().Equal(1, tempMessage.TryCount)
 
 		err = s.cleanup()
@@ -292,6 +310,275 @@ func (s *QueueTestSuite) TestEnqueueDequeueDLQ() {
 	})
 }
 
+func (s *QueueTestSuite) TestDLQBackoff() {
+	messages := make([]notification.Message, 2)
+
+	for i := 0; i < len(messages); i++ {
+		messages[i].ID = fmt.Sprintf("%d", i+1)
+		messages[i].ReceiverType = receiver.TypeSlack
+		messages[i].Status = notification.MessageStatusEnqueued
+		messages[i].MaxTries = 5
+		messages[i].CreatedAt = time.Now()
+		messages[i].UpdatedAt = time.Now()
+	}
+
+	s.Run("retryable failed messages should not be dequeued before their next attempt time", func() {
+		var anError = errors.New("some error")
+
+		s.Require().NoError(s.backoffDLQ.Enqueue(s.ctx, messages...))
+
+		for _, m := range messages {
+			m.MarkFailed(time.Now(), true, anError)
+			m.MarkFailed(time.Now(), true, anError)
+			s.Assert().NoError(s.backoffDLQ.ErrorCallback(s.ctx, m))
+		}
+
+		tempMessage := &postgresq.NotificationMessage{}
+		s.Require().NoError(s.dbc.Get(tempMessage, fmt.Sprintf("SELECT * FROM %s WHERE id = '1'", postgresq.MessageQueueTableFullName)))
+		s.Assert().True(tempMessage.NextAttemptAt.Valid)
+		s.Assert().WithinDuration(time.Now().Add(2*time.Hour), tempMessage.NextAttemptAt.Time, time.Minute)
+
+		s.Assert().EqualError(
+			s.backoffDLQ.Dequeue(s.ctx, nil, 5, func(ctx context.Context, m []notification.Message) error { s.Assert().Empty(m); return nil }),
+			notification.ErrNoMessage.Error(),
+		)
+
+		_, err := s.dbc.Exec(fmt.Sprintf("UPDATE %s SET next_attempt_at = now() - interval '1 second' WHERE id = '2'", postgresq.MessageQueueTableFullName))
+		s.Require().NoError(err)
+
+		s.Assert().NoError(s.backoffDLQ.Dequeue(s.ctx, nil, 5, func(ctx context.Context, m []notification.Message) error {
+			s.Assert().Len(m, 1)
+			s.Assert().Equal("2", m[0].ID)
+			return nil
+		}))
+
+		s.Require().NoError(s.cleanup())
+	})
+
+	s.Run("non retryable failed messages should not have next attempt time", func() {
+		s.Require().NoError(s.backoffDLQ.Enqueue(s.ctx, messages[0]))
+
+		m := messages[0]
+		m.MarkFailed(time.Now(), false, errors.New("some error"))
+		s.Assert().NoError(s.backoffDLQ.ErrorCallback(s.ctx, m))
+
+		tempMessage := &postgresq.NotificationMessage{}
+		s.Require().NoError(s.dbc.Get(tempMessage, fmt.Sprintf("SELECT * FROM %s WHERE id = '1'", postgresq.MessageQueueTableFullName)))
+		s.Assert().False(tempMessage.NextAttemptAt.Valid)
+
+		s.Require().NoError(s.cleanup())
+	})
+}
+
+func (s *QueueTestSuite) TestDelayCallback() {
+	message := notification.Message{
+		ID:           "1",
+		ReceiverType: receiver.TypeSlack,
+		Status:       notification.MessageStatusEnqueued,
+		MaxTries:     3,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	s.Run("throttled message should be put back to the queue and not dequeued before its next attempt time", func() {
+		s.Require().NoError(s.q.Enqueue(s.ctx, message))
+
+		s.Assert().NoError(s.q.Dequeue(s.ctx, nil, 1, func(ctx context.Context, ms []notification.Message) error {
+			s.Require().Len(ms, 1)
+			m := ms[0]
+			m.MarkThrottled(time.Now(), time.Now().Add(time.Hour))
+			return s.q.DelayCallback(ctx, m)
+		}))
+
+		tempMessage := &postgresq.NotificationMessage{}
+		s.Require().NoError(s.dbc.Get(tempMessage, fmt.Sprintf("SELECT * FROM %s WHERE id = '1'", postgresq.MessageQueueTableFullName)))
+		s.Assert().Equal(string(notification.MessageStatusEnqueued), tempMessage.Status)
+		s.Assert().Equal(0, tempMessage.TryCount)
+
+		s.Assert().EqualError(
+			s.q.Dequeue(s.ctx, nil, 1, func(ctx context.Context, m []notification.Message) error { s.Assert().Empty(m); return nil }),
+			notification.ErrNoMessage.Error(),
+		)
+
+		s.Require().NoError(s.cleanup())
+	})
+}
+
+func (s *QueueTestSuite) TestReclaimPendingMessages() {
+	message := notification.Message{
+		ID:           "1",
+		ReceiverType: receiver.TypeSlack,
+		Status:       notification.MessageStatusEnqueued,
+		MaxTries:     3,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	s.Run("message left pending by a crashed handler should be redelivered after visibility timeout", func() {
+		s.Require().NoError(s.leasedQ.Enqueue(s.ctx, message))
+
+		// the handler crashes before calling back, the message is left pending
+		s.Assert().NoError(s.leasedQ.Dequeue(s.ctx, nil, 1, func(ctx context.Context, ms []notification.Message) error {
+			s.Assert().Len(ms, 1)
+			return nil
+		}))
+
+		tempMessage := &postgresq.NotificationMessage{}
+		s.Require().NoError(s.dbc.Get(tempMessage, fmt.Sprintf("SELECT * FROM %s WHERE id = '1'", postgresq.MessageQueueTableFullName)))
+		s.Assert().Equal(string(notification.MessageStatusPending), tempMessage.Status)
+
+		// the message is still leased to the crashed handler
+		s.Assert().EqualError(
+			s.leasedQ.Dequeue(s.ctx, nil, 1, func(ctx context.Context, m []notification.Message) error { s.Assert().Empty(m); return nil }),
+			notification.ErrNoMessage.Error(),
+		)
+
+		_, err := s.dbc.Exec(fmt.Sprintf("UPDATE %s SET updated_at = now() - interval '2 minutes' WHERE id = '1'", postgresq.MessageQueueTableFullName))
+		s.Require().NoError(err)
+
+		s.Assert().NoError(s.leasedQ.Dequeue(s.ctx, nil, 1, func(ctx context.Context, ms []notification.Message) error {
+			s.Require().Len(ms, 1)
+			s.Assert().Equal("1", ms[0].ID)
+			s.Assert().Equal(1, ms[0].TryCount)
+			return nil
+		}))
+
+		s.Require().NoError(s.cleanup())
+	})
+
+	s.Run("message which lost its last try should be marked as non-retryable failed", func() {
+		lastTryMessage := message
+		lastTryMessage.MaxTries = 1
+		s.Require().NoError(s.leasedQ.Enqueue(s.ctx, lastTryMessage))
+
+		s.Assert().NoError(s.leasedQ.Dequeue(s.ctx, nil, 1, func(ctx context.Context, ms []notification.Message) error { return nil }))
+
+		_, err := s.dbc.Exec(fmt.Sprintf("UPDATE %s SET updated_at = now() - interval '2 minutes' WHERE id = '1'", postgresq.MessageQueueTableFullName))
+		s.Require().NoError(err)
+
+		s.Assert().EqualError(
+			s.leasedQ.Dequeue(s.ctx, nil, 1, func(ctx context.Context, m []notification.Message) error { s.Assert().Empty(m); return nil }),
+			notification.ErrNoMessage.Error(),
+		)
+
+		tempMessage := &postgresq.NotificationMessage{}
+		s.Require().NoError(s.dbc.Get(tempMessage, fmt.Sprintf("SELECT * FROM %s WHERE id = '1'", postgresq.MessageQueueTableFullName)))
+		s.Assert().Equal(string(notification.MessageStatusFailed), tempMessage.Status)
+		s.Assert().False(tempMessage.Retryable)
+		s.Assert().Equal("visibility timeout exceeded", tempMessage.LastError.String)
+		s.Assert().Equal(1, tempMessage.TryCount)
+
+		s.Require().NoError(s.cleanup())
+	})
+
+	s.Run("message left pending should not be reclaimed if visibility timeout is disabled", func() {
+		s.Require().NoError(s.q.Enqueue(s.ctx, message))
+
+		s.Assert().NoError(s.q.Dequeue(s.ctx, nil, 1, func(ctx context.Context, ms []notification.Message) error { return nil }))
+
+		_, err := s.dbc.Exec(fmt.Sprintf("UPDATE %s SET updated_at = now() - interval '2 minutes' WHERE id = '1'", postgresq.MessageQueueTableFullName))
+		s.Require().NoError(err)
+
+		s.Assert().EqualError(
+			s.q.Dequeue(s.ctx, nil, 1, func(ctx context.Context, m []notification.Message) error { s.Assert().Empty(m); return nil }),
+			notification.ErrNoMessage.Error(),
+		)
+
+		s.Require().NoError(s.cleanup())
+	})
+}
+
+func (s *QueueTestSuite) TestEnqueueSameMessageTwice() {
+	message := notification.Message{
+		ID:           "1",
+		ReceiverType: receiver.TypeSlack,
+		Status:       notification.MessageStatusEnqueued,
+		MaxTries:     3,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	s.Run("message with an id already in the queue should be ignored", func() {
+		s.Require().NoError(s.q.Enqueue(s.ctx, message))
+		s.Require().NoError(s.q.Enqueue(s.ctx, message))
+
+		var count int
+		s.Require().NoError(s.dbc.Get(&count, fmt.Sprintf("SELECT count(*) FROM %s WHERE id = '1'", postgresq.MessageQueueTableFullName)))
+		s.Assert().Equal(1, count)
+
+		s.Require().NoError(s.cleanup())
+	})
+}
+
+func (s *QueueTestSuite) TestInspectMessages() {
+	messages := []notification.Message{
+		{
+			ID:           "1",
+			ReceiverType: receiver.TypeSlack,
+			Status:       notification.MessageStatusEnqueued,
+			MaxTries:     3,
+			CreatedAt:    time.Now().Add(-2 * time.Hour),
+			UpdatedAt:    time.Now(),
+		},
+		{
+			ID:           "2",
+			ReceiverType: receiver.TypeHTTP,
+			Status:       notification.MessageStatusEnqueued,
+			MaxTries:     3,
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		},
+	}
+
+	s.Run("failed message should be inspected with its try history, requeued and discarded", func() {
+		s.Require().NoError(s.q.Enqueue(s.ctx, messages...))
+
+		s.Assert().NoError(s.q.Dequeue(s.ctx, []string{receiver.TypeSlack}, 1, func(ctx context.Context, ms []notification.Message) error {
+			s.Require().Len(ms, 1)
+			ms[0].MarkFailed(time.Now(), false, errors.New("Connection Timeout"))
+			return s.q.ErrorCallback(ctx, ms[0])
+		}))
+
+		got, err := s.q.ListMessages(s.ctx, queues.FilterMessage{Status: string(notification.MessageStatusFailed), Error: "timeout"})
+		s.Require().NoError(err)
+		s.Require().Len(got, 1)
+		s.Assert().Equal("1", got[0].ID)
+
+		got, err = s.q.ListMessages(s.ctx, queues.FilterMessage{StartTime: time.Now().Add(-time.Hour).Unix()})
+		s.Require().NoError(err)
+		s.Require().Len(got, 1)
+		s.Assert().Equal("2", got[0].ID)
+
+		msg, err := s.q.GetMessage(s.ctx, "1")
+		s.Require().NoError(err)
+		s.Assert().Equal("Connection Timeout", msg.LastError)
+		s.Require().Len(msg.Attempts, 1)
+		s.Assert().Equal(1, msg.Attempts[0].TryCount)
+		s.Assert().Equal(notification.MessageStatusFailed, msg.Attempts[0].Status)
+		s.Assert().Equal("Connection Timeout", msg.Attempts[0].Error)
+
+		count, err := s.q.RequeueMessages(s.ctx, "1", "2")
+		s.Require().NoError(err)
+		s.Assert().EqualValues(1, count)
+
+		msg, err = s.q.GetMessage(s.ctx, "1")
+		s.Require().NoError(err)
+		s.Assert().Equal(notification.MessageStatusEnqueued, msg.Status)
+		s.Assert().Equal(0, msg.TryCount)
+		s.Assert().Empty(msg.LastError)
+		s.Assert().Len(msg.Attempts, 1)
+
+		count, err = s.q.DiscardMessages(s.ctx, "1", "2")
+		s.Require().NoError(err)
+		s.Assert().EqualValues(2, count)
+
+		_, err = s.q.GetMessage(s.ctx, "1")
+		s.Assert().ErrorIs(err, sirenerrors.ErrNotFound)
+
+		s.Require().NoError(s.cleanup())
+	})
+}
+
 func TestQueue(t *testing.T) {
 	suite.Run(t, new(QueueTestSuite))
 }
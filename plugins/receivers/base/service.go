@@ -30,10 +30,10 @@ func (s *UnimplementedService) PostHookQueueTransformConfigs(ctx context.Context
 	return notificationConfigMap, nil
 }
 
-func (s *UnimplementedService) GetSystemDefaultTemplate() string {
+func (s *UnimplementedService) GetSystemDefaultTemplate(notificationConfigMap map[string]interface{}) string {
 	return ""
 }
 
-func (s *UnimplementedService) Send(ctx context.Context, notificationMessage notification.Message) (bool, error) {
-	return false, plugins.ErrNotImplemented
+func (s *UnimplementedService) Send(ctx context.Context, notificationMessage notification.Message) (string, bool, error) {
+	return "", false, plugins.ErrNotImplemented
 }
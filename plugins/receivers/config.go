@@ -1,13 +1,23 @@
 package receivers
 
 import (
+	"github.com/odpf/siren/plugins/receivers/discord"
+	"github.com/odpf/siren/plugins/receivers/email"
 	"github.com/odpf/siren/plugins/receivers/httpreceiver"
+	"github.com/odpf/siren/plugins/receivers/msteams"
+	"github.com/odpf/siren/plugins/receivers/opsgenie"
 	"github.com/odpf/siren/plugins/receivers/pagerduty"
 	"github.com/odpf/siren/plugins/receivers/slack"
+	"github.com/odpf/siren/plugins/receivers/telegram"
 )
 
 type Config struct {
 	Slack        slack.AppConfig        `mapstructure:"slack"`
 	Pagerduty    pagerduty.AppConfig    `mapstructure:"pagerduty"`
 	HTTPReceiver httpreceiver.AppConfig `mapstructure:"http"`
+	MSTeams      msteams.AppConfig      `mapstructure:"msteams"`
+	Email        email.AppConfig        `mapstructure:"email"`
+	Opsgenie     opsgenie.AppConfig     `mapstructure:"opsgenie"`
+	Telegram     telegram.AppConfig     `mapstructure:"telegram"`
+	Discord      discord.AppConfig      `mapstructure:"discord"`
 }
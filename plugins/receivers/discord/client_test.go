@@ -0,0 +1,150 @@
+package discord_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/odpf/siren/pkg/retry"
+	"github.com/odpf/siren/pkg/secret"
+	"github.com/odpf/siren/plugins/receivers/discord"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Notify(t *testing.T) {
+	t.Run("return error when message is invalid", func(t *testing.T) {
+		c := discord.NewClient(discord.AppConfig{})
+		err := c.Notify(context.Background(), "http://webhook", discord.Message{})
+
+		assert.EqualError(t, err, "discord message has no content or embeds")
+	})
+
+	t.Run("return retryable error with retry after from body when webhook returns 429", func(t *testing.T) {
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "5")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"message":"You are being rate limited.","retry_after":1.5,"global":false}`))
+		}))
+		defer testServer.Close()
+
+		c := discord.NewClient(discord.AppConfig{})
+		err := c.Notify(context.Background(), secret.MaskableString(testServer.URL), discord.Message{Content: "hello"})
+
+		var rtErr retry.RetryableError
+		assert.True(t, errors.As(err, &rtErr))
+		assert.Equal(t, 1500*time.Millisecond, rtErr.RetryAfter)
+	})
+
+	t.Run("return retryable error with retry after from header when 429 body has none", func(t *testing.T) {
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "5")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer testServer.Close()
+
+		c := discord.NewClient(discord.AppConfig{})
+		err := c.Notify(context.Background(), secret.MaskableString(testServer.URL), discord.Message{Content: "hello"})
+
+		var rtErr retry.RetryableError
+		assert.True(t, errors.As(err, &rtErr))
+		assert.Equal(t, 5*time.Second, rtErr.RetryAfter)
+	})
+
+	t.Run("return retryable error when webhook returns 5xx", func(t *testing.T) {
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer testServer.Close()
+
+		c := discord.NewClient(discord.AppConfig{})
+		err := c.Notify(context.Background(), secret.MaskableString(testServer.URL), discord.Message{Content: "hello"})
+
+		assert.True(t, errors.As(err, new(retry.RetryableError)))
+	})
+
+	t.Run("return non retryable error when webhook returns 4xx", func(t *testing.T) {
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"code":50006,"message":"Cannot send an empty message"}`))
+		}))
+		defer testServer.Close()
+
+		c := discord.NewClient(discord.AppConfig{})
+		err := c.Notify(context.Background(), secret.MaskableString(testServer.URL), discord.Message{Content: "hello"})
+
+		assert.EqualError(t, err, `error with status code Bad Request and body {"code":50006,"message":"Cannot send an empty message"}`)
+		assert.False(t, errors.As(err, new(retry.RetryableError)))
+	})
+
+	t.Run("return error without webhook url when http call failed", func(t *testing.T) {
+		c := discord.NewClient(discord.AppConfig{})
+		err := c.Notify(context.Background(), "http://localhost:0/api/webhooks/1/token", discord.Message{Content: "hello"})
+
+		assert.True(t, errors.As(err, new(retry.RetryableError)))
+		assert.NotContains(t, err.Error(), "token")
+	})
+
+	t.Run("return nil error and post embeds when notify succeed", func(t *testing.T) {
+		var payload map[string]interface{}
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer testServer.Close()
+
+		c := discord.NewClient(discord.AppConfig{})
+		err := c.Notify(context.Background(), secret.MaskableString(testServer.URL), discord.Message{
+			Embeds: []discord.Embed{
+				{
+					Title:  "cpu-high",
+					Color:  15158332,
+					Fields: []discord.EmbedField{{Name: "Runbook", Value: "http://runbook"}},
+				},
+			},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"embeds": []interface{}{
+				map[string]interface{}{
+					"title": "cpu-high",
+					"color": float64(15158332),
+					"fields": []interface{}{
+						map[string]interface{}{"name": "Runbook", "value": "http://runbook"},
+					},
+				},
+			},
+		}, payload)
+	})
+
+	t.Run("retry the call after retry after when webhook returns 429", func(t *testing.T) {
+		var (
+			counter int
+			calls   []time.Time
+		)
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			counter++
+			calls = append(calls, time.Now())
+			if counter < 2 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				w.Write([]byte(`{"retry_after":0.2}`))
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer testServer.Close()
+
+		c := discord.NewClient(discord.AppConfig{}, discord.ClientWithRetrier(retry.New(retry.Config{Enable: true})))
+		err := c.Notify(context.Background(), secret.MaskableString(testServer.URL), discord.Message{Content: "hello"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, counter)
+		assert.GreaterOrEqual(t, calls[1].Sub(calls[0]), 200*time.Millisecond)
+	})
+}
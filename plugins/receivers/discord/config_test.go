@@ -0,0 +1,53 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/odpf/siren/pkg/secret"
+)
+
+func TestReceiverConfig(t *testing.T) {
+	t.Run("validate", func(t *testing.T) {
+		testCases := []struct {
+			name    string
+			c       ReceiverConfig
+			wantErr bool
+		}{
+			{
+				name:    "return error if one of required field is missing",
+				wantErr: true,
+			},
+			{
+				name: "return nil if all required fields are present",
+				c: ReceiverConfig{
+					WebhookURL: "http://webhook",
+				},
+				wantErr: false,
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				if err := tc.c.Validate(); (err != nil) != tc.wantErr {
+					t.Errorf("ReceiverConfig.Validate() error = %v, wantErr %v", err, tc.wantErr)
+				}
+			})
+		}
+	})
+}
+
+func TestNotificationConfig(t *testing.T) {
+	t.Run("AsMap", func(t *testing.T) {
+		nc := NotificationConfig{
+			ReceiverConfig: ReceiverConfig{
+				WebhookURL: "http://webhook",
+			},
+		}
+
+		if diff := cmp.Diff(map[string]interface{}{
+			"webhook_url": secret.MaskableString("http://webhook"),
+		}, nc.AsMap()); diff != "" {
+			t.Errorf("result not match\n%v", diff)
+		}
+	})
+}
@@ -0,0 +1,18 @@
+package discord
+
+import (
+	"context"
+
+	"github.com/odpf/siren/pkg/secret"
+)
+
+//go:generate mockery --name=Encryptor -r --case underscore --with-expecter --structname Encryptor --filename encryptor.go --output=./mocks
+type Encryptor interface {
+	Encrypt(str secret.MaskableString) (secret.MaskableString, error)
+	Decrypt(str secret.MaskableString) (secret.MaskableString, error)
+}
+
+//go:generate mockery --name=DiscordCaller -r --case underscore --with-expecter --structname DiscordCaller --filename discord_caller.go --output=./mocks
+type DiscordCaller interface {
+	Notify(ctx context.Context, webhookURL secret.MaskableString, message Message) error
+}
@@ -0,0 +1,63 @@
+package discord
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+const (
+	maxContentLength = 2000
+	maxEmbeds        = 10
+)
+
+// Message is the content of a discord webhook message
+// content is shown as plain text above the embeds
+type Message struct {
+	Content   string  `yaml:"content,omitempty" json:"content,omitempty" mapstructure:"content"`
+	Username  string  `yaml:"username,omitempty" json:"username,omitempty" mapstructure:"username"`
+	AvatarURL string  `yaml:"avatar_url,omitempty" json:"avatar_url,omitempty" mapstructure:"avatar_url"`
+	Embeds    []Embed `yaml:"embeds,omitempty" json:"embeds,omitempty" mapstructure:"embeds"`
+}
+
+type Embed struct {
+	Title       string       `yaml:"title,omitempty" json:"title,omitempty" mapstructure:"title"`
+	Description string       `yaml:"description,omitempty" json:"description,omitempty" mapstructure:"description"`
+	URL         string       `yaml:"url,omitempty" json:"url,omitempty" mapstructure:"url"`
+	Color       int          `yaml:"color,omitempty" json:"color,omitempty" mapstructure:"color"`
+	Timestamp   string       `yaml:"timestamp,omitempty" json:"timestamp,omitempty" mapstructure:"timestamp"`
+	Fields      []EmbedField `yaml:"fields,omitempty" json:"fields,omitempty" mapstructure:"fields"`
+	Footer      *EmbedFooter `yaml:"footer,omitempty" json:"footer,omitempty" mapstructure:"footer"`
+}
+
+type EmbedField struct {
+	Name   string `yaml:"name" json:"name" mapstructure:"name"`
+	Value  string `yaml:"value" json:"value" mapstructure:"value"`
+	Inline bool   `yaml:"inline,omitempty" json:"inline,omitempty" mapstructure:"inline"`
+}
+
+type EmbedFooter struct {
+	Text    string `yaml:"text" json:"text" mapstructure:"text"`
+	IconURL string `yaml:"icon_url,omitempty" json:"icon_url,omitempty" mapstructure:"icon_url"`
+}
+
+func (m Message) Validate() error {
+	if m.Content == "" && len(m.Embeds) == 0 {
+		return errors.New("discord message has no content or embeds")
+	}
+	if utf8.RuneCountInString(m.Content) > maxContentLength {
+		return fmt.Errorf("discord message content exceeds %d characters", maxContentLength)
+	}
+	if len(m.Embeds) > maxEmbeds {
+		return fmt.Errorf("discord message has more than %d embeds", maxEmbeds)
+	}
+	return nil
+}
+
+// RateLimitResponse is the response body of discord when the request is rate limited
+type RateLimitResponse struct {
+	Message string `json:"message"`
+	// RetryAfter is the number of seconds to wait before the request can be repeated
+	RetryAfter float64 `json:"retry_after"`
+	Global     bool    `json:"global"`
+}
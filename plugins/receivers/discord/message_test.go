@@ -0,0 +1,54 @@
+package discord_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/odpf/siren/plugins/receivers/discord"
+)
+
+func TestMessage_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		m       discord.Message
+		wantErr bool
+	}{
+		{
+			name:    "should return error if message has no content and embeds",
+			wantErr: true,
+		},
+		{
+			name: "should return error if content is too long",
+			m: discord.Message{
+				Content: strings.Repeat("a", 2001),
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return error if there are too many embeds",
+			m: discord.Message{
+				Embeds: make([]discord.Embed, 11),
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return nil if message only has content",
+			m: discord.Message{
+				Content: "hello",
+			},
+		},
+		{
+			name: "should return nil if message only has embeds",
+			m: discord.Message{
+				Embeds: []discord.Embed{{Title: "hello"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.m.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Message.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
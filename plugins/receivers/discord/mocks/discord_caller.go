@@ -0,0 +1,78 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	secret "github.com/odpf/siren/pkg/secret"
+	discord "github.com/odpf/siren/plugins/receivers/discord"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DiscordCaller is an autogenerated mock type for the DiscordCaller type
+type DiscordCaller struct {
+	mock.Mock
+}
+
+type DiscordCaller_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DiscordCaller) EXPECT() *DiscordCaller_Expecter {
+	return &DiscordCaller_Expecter{mock: &_m.Mock}
+}
+
+// Notify provides a mock function with given fields: ctx, webhookURL, message
+func (_m *DiscordCaller) Notify(ctx context.Context, webhookURL secret.MaskableString, message discord.Message) error {
+	ret := _m.Called(ctx, webhookURL, message)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, secret.MaskableString, discord.Message) error); ok {
+		r0 = rf(ctx, webhookURL, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DiscordCaller_Notify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Notify'
+type DiscordCaller_Notify_Call struct {
+	*mock.Call
+}
+
+// Notify is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookURL secret.MaskableString
+//   - message discord.Message
+func (_e *DiscordCaller_Expecter) Notify(ctx interface{}, webhookURL interface{}, message interface{}) *DiscordCaller_Notify_Call {
+	return &DiscordCaller_Notify_Call{Call: _e.mock.On("Notify", ctx, webhookURL, message)}
+}
+
+func (_c *DiscordCaller_Notify_Call) Run(run func(ctx context.Context, webhookURL secret.MaskableString, message discord.Message)) *DiscordCaller_Notify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secret.MaskableString), args[2].(discord.Message))
+	})
+	return _c
+}
+
+func (_c *DiscordCaller_Notify_Call) Return(_a0 error) *DiscordCaller_Notify_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+type mockConstructorTestingTNewDiscordCaller interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewDiscordCaller creates a new instance of DiscordCaller. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewDiscordCaller(t mockConstructorTestingTNewDiscordCaller) *DiscordCaller {
+	mock := &DiscordCaller{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,28 @@
+package discord
+
+import (
+	"github.com/odpf/siren/pkg/httpclient"
+	"github.com/odpf/siren/pkg/retry"
+)
+
+type ServiceOption func(*PluginService)
+
+// WithHTTPClient assigns custom http client when creating a discord service
+func WithHTTPClient(httpClient *httpclient.Client) ServiceOption {
+	return func(s *PluginService) {
+		s.httpClient = httpClient
+	}
+}
+
+// WithRetrier wraps client call with retrier
+func WithRetrier(runner retry.Runner) ServiceOption {
+	return func(s *PluginService) {
+		s.retrier = runner
+	}
+}
+
+func WithDiscordClient(client DiscordCaller) ServiceOption {
+	return func(s *PluginService) {
+		s.client = client
+	}
+}
@@ -0,0 +1,154 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/odpf/siren/core/notification"
+	"github.com/odpf/siren/pkg/errors"
+	"github.com/odpf/siren/pkg/httpclient"
+	"github.com/odpf/siren/pkg/retry"
+	"github.com/odpf/siren/plugins/receivers/base"
+)
+
+// PluginService is a plugin service layer for discord
+type PluginService struct {
+	base.UnimplementedService
+	client       DiscordCaller
+	cryptoClient Encryptor
+	httpClient   *httpclient.Client
+	retrier      retry.Runner
+}
+
+// NewPluginService returns discord plugin service struct. This service implement [receiver.Resolver] and [notification.Notifier] interface.
+func NewPluginService(cfg AppConfig, cryptoClient Encryptor, opts ...ServiceOption) *PluginService {
+	s := &PluginService{}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.cryptoClient = cryptoClient
+
+	if s.httpClient == nil {
+		s.httpClient = httpclient.New(cfg.HTTPClient)
+	}
+
+	if s.retrier == nil {
+		s.retrier = retry.New(cfg.Retry)
+	}
+
+	if s.client == nil {
+		s.client = NewClient(cfg, ClientWithHTTPClient(s.httpClient), ClientWithRetrier(s.retrier))
+	}
+
+	return s
+}
+
+func (s *PluginService) PreHookDBTransformConfigs(ctx context.Context, configurations map[string]interface{}) (map[string]interface{}, error) {
+	receiverConfig := &ReceiverConfig{}
+	if err := mapstructure.Decode(configurations, receiverConfig); err != nil {
+		return nil, fmt.Errorf("failed to transform configurations to receiver config: %w", err)
+	}
+
+	if err := receiverConfig.Validate(); err != nil {
+		return nil, errors.ErrInvalid.WithMsgf(err.Error())
+	}
+
+	cipherText, err := s.cryptoClient.Encrypt(receiverConfig.WebhookURL)
+	if err != nil {
+		return nil, fmt.Errorf("discord webhook url encryption failed: %w", err)
+	}
+
+	receiverConfig.WebhookURL = cipherText
+
+	return receiverConfig.AsMap(), nil
+}
+
+// PostHookDBTransformConfigs do transformation in post-hook service lifecycle
+func (s *PluginService) PostHookDBTransformConfigs(ctx context.Context, configurations map[string]interface{}) (map[string]interface{}, error) {
+	receiverConfig := &ReceiverConfig{}
+	if err := mapstructure.Decode(configurations, receiverConfig); err != nil {
+		return nil, fmt.Errorf("failed to transform configurations to receiver config: %w", err)
+	}
+
+	if err := receiverConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	webhookURL, err := s.cryptoClient.Decrypt(receiverConfig.WebhookURL)
+	if err != nil {
+		return nil, fmt.Errorf("discord webhook url decryption failed: %w", err)
+	}
+
+	receiverConfig.WebhookURL = webhookURL
+
+	return receiverConfig.AsMap(), nil
+}
+
+func (s *PluginService) PreHookQueueTransformConfigs(ctx context.Context, notificationConfigMap map[string]interface{}) (map[string]interface{}, error) {
+	notificationConfig := &NotificationConfig{}
+	if err := mapstructure.Decode(notificationConfigMap, notificationConfig); err != nil {
+		return nil, fmt.Errorf("failed to transform configurations to discord notification config: %w", err)
+	}
+
+	if err := notificationConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	cipher, err := s.cryptoClient.Encrypt(notificationConfig.WebhookURL)
+	if err != nil {
+		return nil, fmt.Errorf("discord webhook url encryption failed: %w", err)
+	}
+
+	notificationConfig.WebhookURL = cipher
+
+	return notificationConfig.AsMap(), nil
+}
+
+func (s *PluginService) PostHookQueueTransformConfigs(ctx context.Context, notificationConfigMap map[string]interface{}) (map[string]interface{}, error) {
+	notificationConfig := &NotificationConfig{}
+	if err := mapstructure.Decode(notificationConfigMap, notificationConfig); err != nil {
+		return nil, fmt.Errorf("failed to transform configurations to notification config: %w", err)
+	}
+
+	if err := notificationConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	webhookURL, err := s.cryptoClient.Decrypt(notificationConfig.WebhookURL)
+	if err != nil {
+		return nil, fmt.Errorf("discord webhook url decryption failed: %w", err)
+	}
+
+	notificationConfig.WebhookURL = webhookURL
+
+	return notificationConfig.AsMap(), nil
+}
+
+func (s *PluginService) Send(ctx context.Context, notificationMessage notification.Message) (string, bool, error) {
+	notificationConfig := &NotificationConfig{}
+	if err := mapstructure.Decode(notificationMessage.Configs, notificationConfig); err != nil {
+		return "", false, err
+	}
+
+	discordMessage := &Message{}
+	if err := mapstructure.Decode(notificationMessage.Details, discordMessage); err != nil {
+		return "", false, err
+	}
+
+	if err := s.client.Notify(ctx, notificationConfig.WebhookURL, *discordMessage); err != nil {
+		if errors.As(err, new(retry.RetryableError)) {
+			return "", true, err
+		} else {
+			return "", false, err
+		}
+	}
+
+	return "", false, nil
+}
+
+func (s *PluginService) GetSystemDefaultTemplate(notificationConfigMap map[string]interface{}) string {
+	return defaultAlertTemplateBody
+}
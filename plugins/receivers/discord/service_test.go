@@ -0,0 +1,211 @@
+package discord_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/odpf/siren/core/notification"
+	"github.com/odpf/siren/core/template"
+	"github.com/odpf/siren/pkg/errors"
+	"github.com/odpf/siren/pkg/retry"
+	"github.com/odpf/siren/pkg/secret"
+	"github.com/odpf/siren/plugins/receivers/discord"
+	"github.com/odpf/siren/plugins/receivers/discord/mocks"
+	"github.com/odpf/siren/plugins/receivers/receivertest"
+	"github.com/stretchr/testify/mock"
+	"gopkg.in/yaml.v3"
+)
+
+func TestService_TransformConfigs(t *testing.T) {
+	receivertest.TestSecretConfigHooks(t, func(e receivertest.Encryptor) receivertest.ConfigTransformer {
+		return discord.NewPluginService(discord.AppConfig{}, e)
+	}, "webhook_url", map[string]interface{}{
+		"webhook_url": "http://webhook",
+	})
+}
+
+func TestService_Send(t *testing.T) {
+	tests := []struct {
+		name                string
+		setup               func(*mocks.DiscordCaller)
+		notificationMessage notification.Message
+		wantRetryable       bool
+		wantErr             bool
+	}{
+		{
+			name: "should return error if failed to decode notification config",
+			notificationMessage: notification.Message{
+				Configs: map[string]interface{}{
+					"webhook_url": true,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return error if failed to decode notification detail",
+			notificationMessage: notification.Message{
+				Details: map[string]interface{}{
+					"content": make(chan bool),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return error and not retryable if notify return error",
+			setup: func(mc *mocks.DiscordCaller) {
+				mc.EXPECT().Notify(mock.AnythingOfType("*context.emptyCtx"), secret.MaskableString("http://webhook"), discord.Message{Content: "hello"}).Return(errors.New("some error"))
+			},
+			notificationMessage: notification.Message{
+				Configs: map[string]interface{}{
+					"webhook_url": "http://webhook",
+				},
+				Details: map[string]interface{}{
+					"content": "hello",
+				},
+			},
+			wantRetryable: false,
+			wantErr:       true,
+		},
+		{
+			name: "should return error and retryable if notify return retryable error",
+			setup: func(mc *mocks.DiscordCaller) {
+				mc.EXPECT().Notify(mock.AnythingOfType("*context.emptyCtx"), secret.MaskableString("http://webhook"), discord.Message{Content: "hello"}).Return(retry.RetryableError{Err: errors.New("some error")})
+			},
+			notificationMessage: notification.Message{
+				Configs: map[string]interface{}{
+					"webhook_url": "http://webhook",
+				},
+				Details: map[string]interface{}{
+					"content": "hello",
+				},
+			},
+			wantRetryable: true,
+			wantErr:       true,
+		},
+		{
+			name: "should return no error if notify succeed",
+			setup: func(mc *mocks.DiscordCaller) {
+				mc.EXPECT().Notify(mock.AnythingOfType("*context.emptyCtx"), secret.MaskableString("http://webhook"), discord.Message{Content: "hello"}).Return(nil)
+			},
+			notificationMessage: notification.Message{
+				Configs: map[string]interface{}{
+					"webhook_url": "http://webhook",
+				},
+				Details: map[string]interface{}{
+					"content": "hello",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mockDiscordClient = new(mocks.DiscordCaller)
+			)
+
+			if tt.setup != nil {
+				tt.setup(mockDiscordClient)
+			}
+
+			s := discord.NewPluginService(discord.AppConfig{}, nil, discord.WithDiscordClient(mockDiscordClient))
+
+			_, got, err := s.Send(context.Background(), tt.notificationMessage)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.Send() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.wantRetryable {
+				t.Errorf("Service.Send() = %v, want %v", got, tt.wantRetryable)
+			}
+			mockDiscordClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_GetSystemDefaultTemplate(t *testing.T) {
+	tests := []struct {
+		name       string
+		n          notification.Notification
+		wantTitle  string
+		wantColor  int
+		wantURL    string
+		wantFields int
+	}{
+		{
+			name: "should render embed with severity colour, summary, dashboard, and runbook",
+			n: notification.Notification{
+				Data: map[string]interface{}{
+					"status":            "firing",
+					"num_alerts_firing": 1,
+					"summary":           "cpu usage is high",
+					"dashboard":         "http://dashboard",
+					"playbook":          "http://runbook",
+				},
+				Labels: map[string]string{
+					"severity":  "CRITICAL",
+					"alertname": "cpu-high",
+				},
+			},
+			wantTitle:  "(FIRING:1) (CRITICAL) cpu-high",
+			wantColor:  15158332,
+			wantURL:    "http://dashboard",
+			wantFields: 1,
+		},
+		{
+			name: "should only render title if there is no summary, dashboard, and runbook",
+			n: notification.Notification{
+				Data: map[string]interface{}{
+					"status": "resolved",
+				},
+				Labels: map[string]string{
+					"severity":  "WARNING",
+					"alertname": "cpu-high",
+				},
+			},
+			wantTitle: "(RESOLVED) (WARNING) cpu-high",
+			wantColor: 3066993,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := discord.NewPluginService(discord.AppConfig{}, nil)
+
+			rendered, err := template.RenderBody(s.GetSystemDefaultTemplate(nil), tt.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var details map[string]interface{}
+			if err := yaml.Unmarshal([]byte(rendered), &details); err != nil {
+				t.Fatalf("failed to unmarshal rendered template: %v\n%s", err, rendered)
+			}
+
+			msg := discord.Message{}
+			if err := mapstructure.Decode(details, &msg); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := msg.Validate(); err != nil {
+				t.Fatal(err)
+			}
+
+			if len(msg.Embeds) != 1 {
+				t.Fatalf("got %d embeds, want 1", len(msg.Embeds))
+			}
+			embed := msg.Embeds[0]
+			if embed.Title != tt.wantTitle {
+				t.Errorf("got title %q, want %q", embed.Title, tt.wantTitle)
+			}
+			if embed.Color != tt.wantColor {
+				t.Errorf("got color %d, want %d", embed.Color, tt.wantColor)
+			}
+			if embed.URL != tt.wantURL {
+				t.Errorf("got url %q, want %q", embed.URL, tt.wantURL)
+			}
+			if len(embed.Fields) != tt.wantFields {
+				t.Errorf("got %d fields, want %d", len(embed.Fields), tt.wantFields)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package email
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"time"
+
+	"github.com/odpf/siren/pkg/retry"
+)
+
+const defaultDialTimeout = 10 * time.Second
+
+type ClientOption func(*Client)
+
+// ClientWithRetrier wraps client call with retrier
+func ClientWithRetrier(runner retry.Runner) ClientOption {
+	return func(c *Client) {
+		c.retrier = runner
+	}
+}
+
+type Client struct {
+	cfg     AppConfig
+	retrier retry.Runner
+	timeNow func() time.Time
+}
+
+func NewClient(cfg AppConfig, opts ...ClientOption) *Client {
+	c := &Client{
+		cfg:     cfg,
+		timeNow: time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.cfg.DialTimeout == 0 {
+		c.cfg.DialTimeout = defaultDialTimeout
+	}
+
+	return c
+}
+
+// Notify sends the message to all recipients through the smtp server
+// smtp 4xx replies are transient failures and returned as retryable errors
+func (c *Client) Notify(ctx context.Context, conf NotificationConfig, message Message) error {
+	if c.retrier != nil {
+		return c.retrier.Run(ctx, func(ctx context.Context) error {
+			return c.notify(ctx, conf, message)
+		})
+	}
+	return c.notify(ctx, conf, message)
+}
+
+func (c *Client) notify(ctx context.Context, conf NotificationConfig, message Message) error {
+	body, err := message.Build(conf, c.timeNow())
+	if err != nil {
+		return err
+	}
+
+	sc, err := c.dial(ctx, conf)
+	if err != nil {
+		return err
+	}
+	defer sc.Close()
+
+	if err := c.send(sc, conf, body); err != nil {
+		return classifyError(err)
+	}
+
+	return nil
+}
+
+func (c *Client) dial(ctx context.Context, conf NotificationConfig) (*smtp.Client, error) {
+	tlsConfig := &tls.Config{
+		ServerName:         conf.Host,
+		InsecureSkipVerify: conf.InsecureSkipVerify,
+	}
+
+	dialer := &net.Dialer{Timeout: c.cfg.DialTimeout}
+
+	var (
+		conn net.Conn
+		err  error
+	)
+	if conf.TLS == TLSModeTLS {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: tlsConfig}).DialContext(ctx, "tcp", conf.Address())
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", conf.Address())
+	}
+	if err != nil {
+		return nil, retry.RetryableError{Err: fmt.Errorf("failed to connect to smtp server %s: %w", conf.Address(), err)}
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
+	sc, err := smtp.NewClient(conn, conf.Host)
+	if err != nil {
+		conn.Close()
+		return nil, classifyError(err)
+	}
+
+	if conf.TLS != TLSModeTLS && conf.TLS != TLSModeNone {
+		if ok, _ := sc.Extension("STARTTLS"); ok {
+			if err := sc.StartTLS(tlsConfig); err != nil {
+				sc.Close()
+				return nil, classifyError(err)
+			}
+		} else if conf.TLS == TLSModeStartTLS {
+			sc.Close()
+			return nil, fmt.Errorf("smtp server %s does not support STARTTLS", conf.Address())
+		}
+	}
+
+	return sc, nil
+}
+
+func (c *Client) send(sc *smtp.Client, conf NotificationConfig, body []byte) error {
+	if conf.Username != "" {
+		if err := sc.Auth(smtp.PlainAuth("", conf.Username, conf.Password.UnmaskedString(), conf.Host)); err != nil {
+			return fmt.Errorf("failed to authenticate to smtp server: %w", err)
+		}
+	}
+
+	if err := sc.Mail(conf.From); err != nil {
+		return fmt.Errorf("failed to set sender %s: %w", conf.From, err)
+	}
+
+	for _, rcpt := range conf.Recipients() {
+		if err := sc.Rcpt(rcpt); err != nil {
+			return fmt.Errorf("failed to add recipient %s: %w", rcpt, err)
+		}
+	}
+
+	wc, err := sc.Data()
+	if err != nil {
+		return fmt.Errorf("failed to start sending message: %w", err)
+	}
+
+	if _, err := wc.Write(body); err != nil {
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+
+	if err := wc.Close(); err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+
+	return sc.Quit()
+}
+
+// classifyError marks transient smtp replies (4xx) and connection failures as retryable
+// permanent smtp replies (5xx) are not retried
+func classifyError(err error) error {
+	var smtpErr *textproto.Error
+	if errors.As(err, &smtpErr) {
+		if smtpErr.Code >= 400 && smtpErr.Code < 500 {
+			return retry.RetryableError{Err: err}
+		}
+		return err
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return retry.RetryableError{Err: err}
+	}
+
+	return err
+}
@@ -0,0 +1,248 @@
+package email_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/odpf/siren/pkg/retry"
+	"github.com/odpf/siren/plugins/receivers/email"
+	"github.com/stretchr/testify/assert"
+)
+
+// smtpServer is an in-process smtp server stand-in that records delivered mails
+// replies map a command (e.g. RCPT) to a custom reply
+type smtpServer struct {
+	listener net.Listener
+	username string
+	password string
+	replies  map[string]string
+
+	mu         sync.Mutex
+	from       string
+	recipients []string
+	data       string
+	calls      int
+}
+
+func newSMTPServer(t *testing.T, replies map[string]string) *smtpServer {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &smtpServer{
+		listener: l,
+		username: "user",
+		password: "pass",
+		replies:  replies,
+	}
+	go s.serve()
+	t.Cleanup(func() { l.Close() })
+
+	return s
+}
+
+func (s *smtpServer) config() email.NotificationConfig {
+	host, portStr, _ := net.SplitHostPort(s.listener.Addr().String())
+	port, _ := strconv.Atoi(portStr)
+	return email.NotificationConfig{
+		ReceiverConfig: email.ReceiverConfig{
+			Host:     host,
+			Port:     port,
+			Username: s.username,
+			Password: "pass",
+			From:     "siren@odpf.io",
+			To:       []string{"oncall@odpf.io"},
+			CC:       []string{"lead@odpf.io"},
+			BCC:      []string{"audit@odpf.io"},
+		},
+	}
+}
+
+func (s *smtpServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *smtpServer) handle(conn net.Conn) {
+	defer conn.Close()
+
+	s.mu.Lock()
+	s.calls++
+	s.mu.Unlock()
+
+	tc := textproto.NewConn(conn)
+	reply := func(cmd string, def string) {
+		if r, ok := s.replies[cmd]; ok {
+			def = r
+		}
+		_ = tc.PrintfLine("%s", def)
+	}
+
+	_ = tc.PrintfLine("220 localhost ESMTP")
+	for {
+		line, err := tc.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch cmd {
+		case "EHLO", "HELO":
+			_ = tc.PrintfLine("250-localhost")
+			_ = tc.PrintfLine("250 AUTH PLAIN")
+		case "AUTH":
+			creds, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(line, "AUTH PLAIN "))
+			if string(creds) != fmt.Sprintf("\x00%s\x00%s", s.username, s.password) {
+				_ = tc.PrintfLine("535 authentication failed")
+				continue
+			}
+			reply(cmd, "235 authenticated")
+		case "MAIL":
+			s.mu.Lock()
+			s.from = line
+			s.mu.Unlock()
+			reply(cmd, "250 ok")
+		case "RCPT":
+			s.mu.Lock()
+			s.recipients = append(s.recipients, line)
+			s.mu.Unlock()
+			reply(cmd, "250 ok")
+		case "DATA":
+			_ = tc.PrintfLine("354 send data")
+			data, err := tc.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.data = string(data)
+			s.mu.Unlock()
+			reply(cmd, "250 queued")
+		case "QUIT":
+			_ = tc.PrintfLine("221 bye")
+			return
+		default:
+			_ = tc.PrintfLine("250 ok")
+		}
+	}
+}
+
+func TestClient_Notify(t *testing.T) {
+	message := email.Message{
+		Subject: "[FIRING:1] cpu-high",
+		Text:    "cpu usage is high",
+		HTML:    "<p>cpu usage is high</p>",
+	}
+
+	t.Run("return error when message has no body", func(t *testing.T) {
+		c := email.NewClient(email.AppConfig{})
+		err := c.Notify(context.Background(), email.NotificationConfig{}, email.Message{Subject: "subject"})
+
+		assert.EqualError(t, err, "email message has no text or html body")
+	})
+
+	t.Run("return retryable error when failed to connect to smtp server", func(t *testing.T) {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		port := l.Addr().(*net.TCPAddr).Port
+		l.Close()
+
+		c := email.NewClient(email.AppConfig{})
+		err = c.Notify(context.Background(), email.NotificationConfig{
+			ReceiverConfig: email.ReceiverConfig{Host: "127.0.0.1", Port: port, From: "siren@odpf.io", To: []string{"oncall@odpf.io"}},
+		}, message)
+
+		assert.True(t, errors.As(err, new(retry.RetryableError)))
+	})
+
+	t.Run("return error when starttls is required but not supported by the server", func(t *testing.T) {
+		srv := newSMTPServer(t, nil)
+		conf := srv.config()
+		conf.TLS = email.TLSModeStartTLS
+
+		c := email.NewClient(email.AppConfig{})
+		err := c.Notify(context.Background(), conf, message)
+
+		assert.ErrorContains(t, err, "does not support STARTTLS")
+	})
+
+	t.Run("return non retryable error when authentication failed", func(t *testing.T) {
+		srv := newSMTPServer(t, nil)
+		conf := srv.config()
+		conf.Password = "wrong"
+
+		c := email.NewClient(email.AppConfig{})
+		err := c.Notify(context.Background(), conf, message)
+
+		assert.ErrorContains(t, err, "535")
+		assert.False(t, errors.As(err, new(retry.RetryableError)))
+	})
+
+	t.Run("return retryable error when smtp server replies 4xx", func(t *testing.T) {
+		srv := newSMTPServer(t, map[string]string{"RCPT": "451 try again later"})
+
+		c := email.NewClient(email.AppConfig{})
+		err := c.Notify(context.Background(), srv.config(), message)
+
+		assert.ErrorContains(t, err, "451")
+		assert.True(t, errors.As(err, new(retry.RetryableError)))
+	})
+
+	t.Run("return non retryable error when smtp server replies 5xx", func(t *testing.T) {
+		srv := newSMTPServer(t, map[string]string{"DATA": "554 message rejected"})
+
+		c := email.NewClient(email.AppConfig{})
+		err := c.Notify(context.Background(), srv.config(), message)
+
+		assert.ErrorContains(t, err, "554")
+		assert.False(t, errors.As(err, new(retry.RetryableError)))
+	})
+
+	t.Run("retry sending when smtp server replies 4xx", func(t *testing.T) {
+		srv := newSMTPServer(t, map[string]string{"MAIL": "421 service not available"})
+
+		c := email.NewClient(email.AppConfig{}, email.ClientWithRetrier(retry.New(retry.Config{Enable: true, MaxTries: 2})))
+		err := c.Notify(context.Background(), srv.config(), message)
+
+		assert.True(t, errors.As(err, new(retry.RetryableError)))
+		assert.Equal(t, 3, srv.calls)
+	})
+
+	t.Run("return nil error and deliver message to all recipients when notify succeed", func(t *testing.T) {
+		srv := newSMTPServer(t, nil)
+
+		c := email.NewClient(email.AppConfig{})
+		err := c.Notify(context.Background(), srv.config(), message)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "MAIL FROM:<siren@odpf.io>", srv.from)
+		assert.Equal(t, []string{"RCPT TO:<oncall@odpf.io>", "RCPT TO:<lead@odpf.io>", "RCPT TO:<audit@odpf.io>"}, srv.recipients)
+
+		data, err := textproto.NewReader(bufio.NewReader(strings.NewReader(srv.data))).ReadMIMEHeader()
+		assert.NoError(t, err)
+		assert.Equal(t, "oncall@odpf.io", data.Get("To"))
+		assert.Equal(t, "lead@odpf.io", data.Get("Cc"))
+		assert.Empty(t, data.Get("Bcc"))
+		assert.Equal(t, "[FIRING:1] cpu-high", data.Get("Subject"))
+		assert.Contains(t, data.Get("Content-Type"), "multipart/alternative")
+		assert.Contains(t, srv.data, "cpu usage is high")
+		assert.Contains(t, srv.data, "text/html; charset=UTF-8")
+	})
+}
@@ -0,0 +1,102 @@
+package email
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/odpf/siren/pkg/retry"
+	"github.com/odpf/siren/pkg/secret"
+)
+
+const (
+	// TLSModeStartTLS upgrades the connection with STARTTLS and fails if the server does not support it
+	TLSModeStartTLS = "starttls"
+	// TLSModeTLS connects to the server with implicit TLS (usually port 465)
+	TLSModeTLS = "tls"
+	// TLSModeNone never upgrades the connection
+	TLSModeNone = "none"
+)
+
+// AppConfig is a config loaded when siren is started
+type AppConfig struct {
+	Retry       retry.Config  `mapstructure:"retry" yaml:"retry"`
+	DialTimeout time.Duration `mapstructure:"dial_timeout" yaml:"dial_timeout" default:"10s"`
+}
+
+// ReceiverConfig is a stored config for an email receiver
+// if tls is empty, the connection is upgraded with STARTTLS only if the server supports it
+type ReceiverConfig struct {
+	Host               string                `mapstructure:"host"`
+	Port               int                   `mapstructure:"port"`
+	Username           string                `mapstructure:"username"`
+	Password           secret.MaskableString `mapstructure:"password"`
+	TLS                string                `mapstructure:"tls"`
+	InsecureSkipVerify bool                  `mapstructure:"insecure_skip_verify"`
+	From               string                `mapstructure:"from"`
+	To                 []string              `mapstructure:"to"`
+	CC                 []string              `mapstructure:"cc"`
+	BCC                []string              `mapstructure:"bcc"`
+}
+
+func (c *ReceiverConfig) Validate() error {
+	if c.Host == "" || c.Port == 0 || c.From == "" || len(c.To) == 0 {
+		return fmt.Errorf("invalid email receiver config, host: %s, port: %d, from: %s, to: %v", c.Host, c.Port, c.From, c.To)
+	}
+	switch c.TLS {
+	case "", TLSModeStartTLS, TLSModeTLS, TLSModeNone:
+	default:
+		return fmt.Errorf("invalid email receiver config, unsupported tls: %s", c.TLS)
+	}
+	return nil
+}
+
+func (c *ReceiverConfig) AsMap() map[string]interface{} {
+	m := map[string]interface{}{
+		"host": c.Host,
+		"port": c.Port,
+		"from": c.From,
+		"to":   c.To,
+	}
+	if c.Username != "" {
+		m["username"] = c.Username
+	}
+	if c.Password != "" {
+		m["password"] = c.Password
+	}
+	if c.TLS != "" {
+		m["tls"] = c.TLS
+	}
+	if c.InsecureSkipVerify {
+		m["insecure_skip_verify"] = c.InsecureSkipVerify
+	}
+	if len(c.CC) != 0 {
+		m["cc"] = c.CC
+	}
+	if len(c.BCC) != 0 {
+		m["bcc"] = c.BCC
+	}
+	return m
+}
+
+// Address returns host:port of the smtp server
+func (c *ReceiverConfig) Address() string {
+	return fmt.Sprintf("%s:%d", c.Host, c.Port)
+}
+
+// Recipients returns all addresses the email is delivered to
+func (c *ReceiverConfig) Recipients() []string {
+	var rcpts []string
+	rcpts = append(rcpts, c.To...)
+	rcpts = append(rcpts, c.CC...)
+	rcpts = append(rcpts, c.BCC...)
+	return rcpts
+}
+
+// NotificationConfig has all configs needed to send notification
+type NotificationConfig struct {
+	ReceiverConfig `mapstructure:",squash"`
+}
+
+func (c *NotificationConfig) AsMap() map[string]interface{} {
+	return c.ReceiverConfig.AsMap()
+}
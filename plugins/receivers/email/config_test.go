@@ -0,0 +1,77 @@
+package email
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/odpf/siren/pkg/secret"
+)
+
+func TestReceiverConfig(t *testing.T) {
+	t.Run("validate", func(t *testing.T) {
+		testCases := []struct {
+			name    string
+			c       ReceiverConfig
+			wantErr bool
+		}{
+			{
+				name:    "return error if one of required field is missing",
+				wantErr: true,
+			},
+			{
+				name: "return error if tls is not supported",
+				c: ReceiverConfig{
+					Host: "smtp.odpf.io",
+					Port: 587,
+					From: "siren@odpf.io",
+					To:   []string{"oncall@odpf.io"},
+					TLS:  "ssl",
+				},
+				wantErr: true,
+			},
+			{
+				name: "return nil if all required fields are present",
+				c: ReceiverConfig{
+					Host: "smtp.odpf.io",
+					Port: 587,
+					From: "siren@odpf.io",
+					To:   []string{"oncall@odpf.io"},
+				},
+				wantErr: false,
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				if err := tc.c.Validate(); (err != nil) != tc.wantErr {
+					t.Errorf("ReceiverConfig.Validate() error = %v, wantErr %v", err, tc.wantErr)
+				}
+			})
+		}
+	})
+
+	t.Run("AsMap", func(t *testing.T) {
+		c := ReceiverConfig{
+			Host:     "smtp.odpf.io",
+			Port:     587,
+			Username: "user",
+			Password: "pass",
+			TLS:      TLSModeStartTLS,
+			From:     "siren@odpf.io",
+			To:       []string{"oncall@odpf.io"},
+			CC:       []string{"lead@odpf.io"},
+		}
+
+		if diff := cmp.Diff(map[string]interface{}{
+			"host":     "smtp.odpf.io",
+			"port":     587,
+			"username": "user",
+			"password": secret.MaskableString("pass"),
+			"tls":      "starttls",
+			"from":     "siren@odpf.io",
+			"to":       []string{"oncall@odpf.io"},
+			"cc":       []string{"lead@odpf.io"},
+		}, c.AsMap()); diff != "" {
+			t.Errorf("result not match\n%v", diff)
+		}
+	})
+}
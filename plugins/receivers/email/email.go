@@ -0,0 +1,18 @@
+package email
+
+import (
+	"context"
+
+	"github.com/odpf/siren/pkg/secret"
+)
+
+//go:generate mockery --name=Encryptor -r --case underscore --with-expecter --structname Encryptor --filename encryptor.go --output=./mocks
+type Encryptor interface {
+	Encrypt(str secret.MaskableString) (secret.MaskableString, error)
+	Decrypt(str secret.MaskableString) (secret.MaskableString, error)
+}
+
+//go:generate mockery --name=SMTPCaller -r --case underscore --with-expecter --structname SMTPCaller --filename smtp_caller.go --output=./mocks
+type SMTPCaller interface {
+	Notify(ctx context.Context, conf NotificationConfig, message Message) error
+}
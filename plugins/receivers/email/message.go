@@ -0,0 +1,91 @@
+package email
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// Message is the content of an email
+// text and html are sent as alternatives of the same content
+type Message struct {
+	Subject string `yaml:"subject,omitempty" json:"subject,omitempty" mapstructure:"subject"`
+	Text    string `yaml:"text,omitempty" json:"text,omitempty" mapstructure:"text"`
+	HTML    string `yaml:"html,omitempty" json:"html,omitempty" mapstructure:"html"`
+}
+
+func (m Message) Validate() error {
+	if m.Subject == "" {
+		return errors.New("email message has no subject")
+	}
+	if m.Text == "" && m.HTML == "" {
+		return errors.New("email message has no text or html body")
+	}
+	return nil
+}
+
+// Build builds the MIME message sent to the smtp server
+func (m Message) Build(conf NotificationConfig, date time.Time) ([]byte, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+
+	header := []string{
+		fmt.Sprintf("From: %s", conf.From),
+		fmt.Sprintf("To: %s", strings.Join(conf.To, ", ")),
+	}
+	if len(conf.CC) != 0 {
+		header = append(header, fmt.Sprintf("Cc: %s", strings.Join(conf.CC, ", ")))
+	}
+	header = append(header,
+		fmt.Sprintf("Subject: %s", mime.QEncoding.Encode("utf-8", m.Subject)),
+		fmt.Sprintf("Date: %s", date.Format(time.RFC1123Z)),
+		"MIME-Version: 1.0",
+		fmt.Sprintf("Content-Type: multipart/alternative; boundary=%s", mw.Boundary()),
+	)
+	buf.WriteString(strings.Join(header, "\r\n"))
+	buf.WriteString("\r\n\r\n")
+
+	// the last part is the preferred alternative
+	if m.Text != "" {
+		if err := writePart(mw, "text/plain; charset=UTF-8", m.Text); err != nil {
+			return nil, err
+		}
+	}
+	if m.HTML != "" {
+		if err := writePart(mw, "text/html; charset=UTF-8", m.HTML); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writePart(mw *multipart.Writer, contentType string, body string) error {
+	pw, err := mw.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {contentType},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return err
+	}
+
+	qw := quotedprintable.NewWriter(pw)
+	if _, err := qw.Write([]byte(body)); err != nil {
+		return err
+	}
+	return qw.Close()
+}
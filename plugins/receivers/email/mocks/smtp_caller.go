@@ -0,0 +1,77 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	email "github.com/odpf/siren/plugins/receivers/email"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SMTPCaller is an autogenerated mock type for the SMTPCaller type
+type SMTPCaller struct {
+	mock.Mock
+}
+
+type SMTPCaller_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SMTPCaller) EXPECT() *SMTPCaller_Expecter {
+	return &SMTPCaller_Expecter{mock: &_m.Mock}
+}
+
+// Notify provides a mock function with given fields: ctx, conf, message
+func (_m *SMTPCaller) Notify(ctx context.Context, conf email.NotificationConfig, message email.Message) error {
+	ret := _m.Called(ctx, conf, message)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, email.NotificationConfig, email.Message) error); ok {
+		r0 = rf(ctx, conf, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SMTPCaller_Notify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Notify'
+type SMTPCaller_Notify_Call struct {
+	*mock.Call
+}
+
+// Notify is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conf email.NotificationConfig
+//   - message email.Message
+func (_e *SMTPCaller_Expecter) Notify(ctx interface{}, conf interface{}, message interface{}) *SMTPCaller_Notify_Call {
+	return &SMTPCaller_Notify_Call{Call: _e.mock.On("Notify", ctx, conf, message)}
+}
+
+func (_c *SMTPCaller_Notify_Call) Run(run func(ctx context.Context, conf email.NotificationConfig, message email.Message)) *SMTPCaller_Notify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(email.NotificationConfig), args[2].(email.Message))
+	})
+	return _c
+}
+
+func (_c *SMTPCaller_Notify_Call) Return(_a0 error) *SMTPCaller_Notify_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+type mockConstructorTestingTNewSMTPCaller interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewSMTPCaller creates a new instance of SMTPCaller. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewSMTPCaller(t mockConstructorTestingTNewSMTPCaller) *SMTPCaller {
+	mock := &SMTPCaller{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
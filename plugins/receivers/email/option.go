@@ -0,0 +1,20 @@
+package email
+
+import (
+	"github.com/odpf/siren/pkg/retry"
+)
+
+type ServiceOption func(*PluginService)
+
+// WithRetrier wraps client call with retrier
+func WithRetrier(runner retry.Runner) ServiceOption {
+	return func(s *PluginService) {
+		s.retrier = runner
+	}
+}
+
+func WithSMTPClient(client SMTPCaller) ServiceOption {
+	return func(s *PluginService) {
+		s.client = client
+	}
+}
@@ -0,0 +1,168 @@
+package email
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/odpf/siren/core/notification"
+	"github.com/odpf/siren/pkg/errors"
+	"github.com/odpf/siren/pkg/retry"
+	"github.com/odpf/siren/pkg/secret"
+	"github.com/odpf/siren/plugins/receivers/base"
+)
+
+// PluginService is a plugin service layer for email
+type PluginService struct {
+	base.UnimplementedService
+	client       SMTPCaller
+	cryptoClient Encryptor
+	retrier      retry.Runner
+}
+
+// NewPluginService returns email plugin service struct. This service implement [receiver.Resolver] and [notification.Notifier] interface.
+func NewPluginService(cfg AppConfig, cryptoClient Encryptor, opts ...ServiceOption) *PluginService {
+	s := &PluginService{}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.cryptoClient = cryptoClient
+
+	if s.retrier == nil {
+		s.retrier = retry.New(cfg.Retry)
+	}
+
+	if s.client == nil {
+		s.client = NewClient(cfg, ClientWithRetrier(s.retrier))
+	}
+
+	return s
+}
+
+func (s *PluginService) PreHookDBTransformConfigs(ctx context.Context, configurations map[string]interface{}) (map[string]interface{}, error) {
+	receiverConfig := &ReceiverConfig{}
+	if err := mapstructure.Decode(configurations, receiverConfig); err != nil {
+		return nil, fmt.Errorf("failed to transform configurations to receiver config: %w", err)
+	}
+
+	if err := receiverConfig.Validate(); err != nil {
+		return nil, errors.ErrInvalid.WithMsgf(err.Error())
+	}
+
+	password, err := s.encryptPassword(receiverConfig.Password)
+	if err != nil {
+		return nil, err
+	}
+	receiverConfig.Password = password
+
+	return receiverConfig.AsMap(), nil
+}
+
+// PostHookDBTransformConfigs do transformation in post-hook service lifecycle
+func (s *PluginService) PostHookDBTransformConfigs(ctx context.Context, configurations map[string]interface{}) (map[string]interface{}, error) {
+	receiverConfig := &ReceiverConfig{}
+	if err := mapstructure.Decode(configurations, receiverConfig); err != nil {
+		return nil, fmt.Errorf("failed to transform configurations to receiver config: %w", err)
+	}
+
+	if err := receiverConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	password, err := s.decryptPassword(receiverConfig.Password)
+	if err != nil {
+		return nil, err
+	}
+	receiverConfig.Password = password
+
+	return receiverConfig.AsMap(), nil
+}
+
+func (s *PluginService) PreHookQueueTransformConfigs(ctx context.Context, notificationConfigMap map[string]interface{}) (map[string]interface{}, error) {
+	notificationConfig := &NotificationConfig{}
+	if err := mapstructure.Decode(notificationConfigMap, notificationConfig); err != nil {
+		return nil, fmt.Errorf("failed to transform configurations to email notification config: %w", err)
+	}
+
+	if err := notificationConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	password, err := s.encryptPassword(notificationConfig.Password)
+	if err != nil {
+		return nil, err
+	}
+	notificationConfig.Password = password
+
+	return notificationConfig.AsMap(), nil
+}
+
+func (s *PluginService) PostHookQueueTransformConfigs(ctx context.Context, notificationConfigMap map[string]interface{}) (map[string]interface{}, error) {
+	notificationConfig := &NotificationConfig{}
+	if err := mapstructure.Decode(notificationConfigMap, notificationConfig); err != nil {
+		return nil, fmt.Errorf("failed to transform configurations to notification config: %w", err)
+	}
+
+	if err := notificationConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	password, err := s.decryptPassword(notificationConfig.Password)
+	if err != nil {
+		return nil, err
+	}
+	notificationConfig.Password = password
+
+	return notificationConfig.AsMap(), nil
+}
+
+func (s *PluginService) Send(ctx context.Context, notificationMessage notification.Message) (string, bool, error) {
+	notificationConfig := &NotificationConfig{}
+	if err := mapstructure.Decode(notificationMessage.Configs, notificationConfig); err != nil {
+		return "", false, err
+	}
+
+	emailMessage := &Message{}
+	if err := mapstructure.Decode(notificationMessage.Details, emailMessage); err != nil {
+		return "", false, err
+	}
+
+	if err := s.client.Notify(ctx, *notificationConfig, *emailMessage); err != nil {
+		if errors.As(err, new(retry.RetryableError)) {
+			return "", true, err
+		} else {
+			return "", false, err
+		}
+	}
+
+	return "", false, nil
+}
+
+func (s *PluginService) GetSystemDefaultTemplate(notificationConfigMap map[string]interface{}) string {
+	return defaultAlertTemplateBody
+}
+
+// password is optional, smtp servers could accept email without authentication
+func (s *PluginService) encryptPassword(password secret.MaskableString) (secret.MaskableString, error) {
+	if password == "" {
+		return "", nil
+	}
+	cipher, err := s.cryptoClient.Encrypt(password)
+	if err != nil {
+		return "", fmt.Errorf("email password encryption failed: %w", err)
+	}
+	return cipher, nil
+}
+
+func (s *PluginService) decryptPassword(password secret.MaskableString) (secret.MaskableString, error) {
+	if password == "" {
+		return "", nil
+	}
+	plain, err := s.cryptoClient.Decrypt(password)
+	if err != nil {
+		return "", fmt.Errorf("email password decryption failed: %w", err)
+	}
+	return plain, nil
+}
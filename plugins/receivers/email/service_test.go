@@ -0,0 +1,273 @@
+package email_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/odpf/siren/core/notification"
+	"github.com/odpf/siren/core/template"
+	"github.com/odpf/siren/pkg/errors"
+	"github.com/odpf/siren/pkg/retry"
+	"github.com/odpf/siren/pkg/secret"
+	"github.com/odpf/siren/plugins/receivers/email"
+	"github.com/odpf/siren/plugins/receivers/email/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gopkg.in/yaml.v3"
+)
+
+var testReceiverConfigMap = map[string]interface{}{
+	"host":     "smtp.odpf.io",
+	"port":     587,
+	"username": "user",
+	"from":     "siren@odpf.io",
+	"to":       []string{"oncall@odpf.io"},
+}
+
+func withPassword(password secret.MaskableString) map[string]interface{} {
+	m := map[string]interface{}{}
+	for k, v := range testReceiverConfigMap {
+		m[k] = v
+	}
+	m["password"] = password
+	return m
+}
+
+func TestService_PreHookDBTransformConfigs(t *testing.T) {
+	tests := []struct {
+		name           string
+		setup          func(*mocks.Encryptor)
+		configurations map[string]interface{}
+		want           map[string]interface{}
+		wantErr        bool
+	}{
+		{
+			name:           "should return error if required fields are missing",
+			configurations: map[string]interface{}{},
+			wantErr:        true,
+		},
+		{
+			name:           "should not encrypt if there is no password",
+			configurations: testReceiverConfigMap,
+			want:           testReceiverConfigMap,
+		},
+		{
+			name:           "should return error if password encryption failed",
+			configurations: withPassword("pass"),
+			setup: func(e *mocks.Encryptor) {
+				e.EXPECT().Encrypt(secret.MaskableString("pass")).Return("", errors.New("some error"))
+			},
+			wantErr: true,
+		},
+		{
+			name:           "should return encrypted password if succeed",
+			configurations: withPassword("pass"),
+			setup: func(e *mocks.Encryptor) {
+				e.EXPECT().Encrypt(secret.MaskableString("pass")).Return(secret.MaskableString("encrypted-pass"), nil)
+			},
+			want: withPassword("encrypted-pass"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mockEncryptor = new(mocks.Encryptor)
+			)
+
+			if tt.setup != nil {
+				tt.setup(mockEncryptor)
+			}
+
+			s := email.NewPluginService(email.AppConfig{}, mockEncryptor)
+			got, err := s.PreHookDBTransformConfigs(context.TODO(), tt.configurations)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.PreHookDBTransformConfigs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.PreHookDBTransformConfigs() = %v, want %v", got, tt.want)
+			}
+			mockEncryptor.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_PostHookQueueTransformConfigs(t *testing.T) {
+	tests := []struct {
+		name                  string
+		setup                 func(*mocks.Encryptor)
+		notificationConfigMap map[string]interface{}
+		want                  map[string]interface{}
+		wantErr               bool
+	}{
+		{
+			name:                  "should return error if failed to parse configmap to notification config",
+			notificationConfigMap: nil,
+			wantErr:               true,
+		},
+		{
+			name:                  "should return error if password decryption failed",
+			notificationConfigMap: withPassword("encrypted-pass"),
+			setup: func(e *mocks.Encryptor) {
+				e.EXPECT().Decrypt(mock.AnythingOfType("secret.MaskableString")).Return("", errors.New("some error"))
+			},
+			wantErr: true,
+		},
+		{
+			name:                  "should return decrypted password if succeed",
+			notificationConfigMap: withPassword("encrypted-pass"),
+			setup: func(e *mocks.Encryptor) {
+				e.EXPECT().Decrypt(secret.MaskableString("encrypted-pass")).Return(secret.MaskableString("pass"), nil)
+			},
+			want: withPassword("pass"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mockEncryptor = new(mocks.Encryptor)
+			)
+
+			if tt.setup != nil {
+				tt.setup(mockEncryptor)
+			}
+
+			s := email.NewPluginService(email.AppConfig{}, mockEncryptor)
+			got, err := s.PostHookQueueTransformConfigs(context.TODO(), tt.notificationConfigMap)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.PostHookQueueTransformConfigs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.PostHookQueueTransformConfigs() = %v, want %v", got, tt.want)
+			}
+			mockEncryptor.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Send(t *testing.T) {
+	tests := []struct {
+		name                string
+		setup               func(*mocks.SMTPCaller)
+		notificationMessage notification.Message
+		wantRetryable       bool
+		wantErr             bool
+	}{
+		{
+			name: "should return error if failed to decode notification config",
+			notificationMessage: notification.Message{
+				Configs: map[string]interface{}{
+					"port": "not-a-port",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return error and not retryable if notify return error",
+			setup: func(sc *mocks.SMTPCaller) {
+				sc.EXPECT().Notify(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("email.NotificationConfig"), email.Message{Subject: "subject", Text: "hello"}).Return(errors.New("some error"))
+			},
+			notificationMessage: notification.Message{
+				Configs: testReceiverConfigMap,
+				Details: map[string]interface{}{
+					"subject": "subject",
+					"text":    "hello",
+				},
+			},
+			wantRetryable: false,
+			wantErr:       true,
+		},
+		{
+			name: "should return error and retryable if notify return retryable error",
+			setup: func(sc *mocks.SMTPCaller) {
+				sc.EXPECT().Notify(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("email.NotificationConfig"), email.Message{Subject: "subject", Text: "hello"}).Return(retry.RetryableError{Err: errors.New("some error")})
+			},
+			notificationMessage: notification.Message{
+				Configs: testReceiverConfigMap,
+				Details: map[string]interface{}{
+					"subject": "subject",
+					"text":    "hello",
+				},
+			},
+			wantRetryable: true,
+			wantErr:       true,
+		},
+		{
+			name: "should return no error if notify succeed",
+			setup: func(sc *mocks.SMTPCaller) {
+				sc.EXPECT().Notify(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("email.NotificationConfig"), email.Message{Subject: "subject", Text: "hello"}).Return(nil)
+			},
+			notificationMessage: notification.Message{
+				Configs: testReceiverConfigMap,
+				Details: map[string]interface{}{
+					"subject": "subject",
+					"text":    "hello",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mockSMTPClient = new(mocks.SMTPCaller)
+			)
+
+			if tt.setup != nil {
+				tt.setup(mockSMTPClient)
+			}
+
+			s := email.NewPluginService(email.AppConfig{}, nil, email.WithSMTPClient(mockSMTPClient))
+
+			_, got, err := s.Send(context.Background(), tt.notificationMessage)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.Send() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.wantRetryable {
+				t.Errorf("Service.Send() = %v, want %v", got, tt.wantRetryable)
+			}
+			mockSMTPClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_GetSystemDefaultTemplate(t *testing.T) {
+	s := email.NewPluginService(email.AppConfig{}, nil)
+
+	rendered, err := template.RenderBody(s.GetSystemDefaultTemplate(nil), notification.Notification{
+		Data: map[string]interface{}{
+			"status":            "firing",
+			"num_alerts_firing": 1,
+			"summary":           "cpu usage is <b>high</b>\non host-1",
+			"dashboard":         "http://dashboard",
+			"playbook":          "http://runbook",
+		},
+		Labels: map[string]string{
+			"severity":  "CRITICAL",
+			"alertname": "cpu-high",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var details map[string]interface{}
+	if err := yaml.Unmarshal([]byte(rendered), &details); err != nil {
+		t.Fatalf("failed to unmarshal rendered template: %v\n%s", err, rendered)
+	}
+
+	msg := email.Message{}
+	if err := mapstructure.Decode(details, &msg); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.NoError(t, msg.Validate())
+	assert.Equal(t, "[FIRING:1] (CRITICAL) cpu-high", msg.Subject)
+	assert.Equal(t, "[FIRING:1] (CRITICAL) cpu-high\n\ncpu usage is <b>high</b>\non host-1\n\nDashboard: http://dashboard\nRunbook: http://runbook\n", msg.Text)
+	assert.Contains(t, msg.HTML, "cpu usage is &lt;b&gt;high&lt;/b&gt;\non host-1")
+	assert.Contains(t, msg.HTML, `<a href="http://dashboard">Dashboard</a>`)
+	assert.Contains(t, msg.HTML, `<a href="http://runbook">Runbook</a>`)
+}
@@ -48,33 +48,33 @@ func (s *PluginService) PreHookQueueTransformConfigs(ctx context.Context, notifi
 	return notificationConfig.AsMap(), nil
 }
 
-func (s *PluginService) Send(ctx context.Context, notificationMessage notification.Message) (bool, error) {
+func (s *PluginService) Send(ctx context.Context, notificationMessage notification.Message) (string, bool, error) {
 	notificationConfig := &NotificationConfig{}
 	if err := mapstructure.Decode(notificationMessage.Configs, notificationConfig); err != nil {
-		return false, err
+		return "", false, err
 	}
 
 	bodyBytes, err := json.Marshal(notificationMessage.Details)
 	if err != nil {
-		return false, err
+		return "", false, err
 	}
 	if err := s.validateFilePath(notificationConfig.URL); err != nil {
-		return false, err
+		return "", false, err
 	}
 
 	fileInstance, err := os.OpenFile(notificationConfig.URL, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0777)
 	if err != nil {
-		return false, err
+		return "", false, err
 	}
 
 	byteNewLine := []byte("\n")
 	bodyBytes = append(bodyBytes, byteNewLine...)
 	_, err = fileInstance.Write(bodyBytes)
 	if err != nil {
-		return false, err
+		return "", false, err
 	}
 
-	return false, nil
+	return "", false, nil
 }
 
 func (s *PluginService) validateFilePath(path string) error {
@@ -52,7 +52,7 @@ func TestService_Send(t *testing.T) {
 
 			fr := file.NewPluginService()
 
-			got, err := fr.Send(context.Background(), tt.notificationMessage)
+			_, got, err := fr.Send(context.Background(), tt.notificationMessage)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NotificationService.Publish() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -2,31 +2,150 @@ package httpreceiver
 
 import (
 	"fmt"
+	"net/http"
+	"strings"
 
 	"github.com/odpf/siren/pkg/httpclient"
 	"github.com/odpf/siren/pkg/retry"
+	"github.com/odpf/siren/pkg/secret"
 )
 
+const (
+	AuthTypeBasic  = "basic"
+	AuthTypeBearer = "bearer"
+
+	defaultMethod          = http.MethodPost
+	defaultSignatureHeader = "X-Siren-Signature"
+)
+
+var allowedMethods = []string{http.MethodPost, http.MethodPut, http.MethodPatch}
+
 // AppConfig is a config loaded when siren is started
 type AppConfig struct {
 	Retry      retry.Config      `mapstructure:"retry" yaml:"retry"`
 	HTTPClient httpclient.Config `mapstructure:"http_client" yaml:"http_client"`
 }
+
+// AuthConfig is an authentication attached to every request
+type AuthConfig struct {
+	Type     string                `mapstructure:"type"`
+	Username string                `mapstructure:"username"`
+	Password secret.MaskableString `mapstructure:"password"`
+	Token    secret.MaskableString `mapstructure:"token"`
+}
+
+func (c *AuthConfig) Validate() error {
+	switch c.Type {
+	case AuthTypeBasic:
+		if c.Username == "" {
+			return fmt.Errorf("invalid http receiver config, basic auth requires username")
+		}
+	case AuthTypeBearer:
+		if c.Token == "" {
+			return fmt.Errorf("invalid http receiver config, bearer auth requires token")
+		}
+	default:
+		return fmt.Errorf("invalid http receiver config, auth type: %s", c.Type)
+	}
+	return nil
+}
+
+func (c *AuthConfig) AsMap() map[string]interface{} {
+	m := map[string]interface{}{
+		"type": c.Type,
+	}
+	if c.Username != "" {
+		m["username"] = c.Username
+	}
+	if c.Password != "" {
+		m["password"] = c.Password
+	}
+	if c.Token != "" {
+		m["token"] = c.Token
+	}
+	return m
+}
+
 type ReceiverConfig struct {
-	URL string `mapstructure:"url"`
+	URL             string                `mapstructure:"url"`
+	Method          string                `mapstructure:"method"`
+	Headers         map[string]string     `mapstructure:"headers"`
+	Auth            *AuthConfig           `mapstructure:"auth"`
+	SignatureSecret secret.MaskableString `mapstructure:"signature_secret"`
+	SignatureHeader string                `mapstructure:"signature_header"`
+	BodyTemplate    string                `mapstructure:"body_template"`
 }
 
 func (c *ReceiverConfig) Validate() error {
 	if c.URL == "" {
 		return fmt.Errorf("invalid http receiver config, url: %s", c.URL)
 	}
+
+	if c.Method != "" {
+		valid := false
+		for _, m := range allowedMethods {
+			if strings.ToUpper(c.Method) == m {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return fmt.Errorf("invalid http receiver config, method: %s", c.Method)
+		}
+	}
+
+	if c.Auth != nil {
+		if err := c.Auth.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// RequestMethod returns the configured method or POST if not set
+func (c *ReceiverConfig) RequestMethod() string {
+	if c.Method == "" {
+		return defaultMethod
+	}
+	return strings.ToUpper(c.Method)
+}
+
+// RequestSignatureHeader returns the configured signature header or the default one if not set
+func (c *ReceiverConfig) RequestSignatureHeader() string {
+	if c.SignatureHeader == "" {
+		return defaultSignatureHeader
+	}
+	return c.SignatureHeader
+}
+
 func (c *ReceiverConfig) AsMap() map[string]interface{} {
-	return map[string]interface{}{
+	m := map[string]interface{}{
 		"url": c.URL,
 	}
+	if c.Method != "" {
+		m["method"] = c.Method
+	}
+	if len(c.Headers) != 0 {
+		headers := map[string]interface{}{}
+		for k, v := range c.Headers {
+			headers[k] = v
+		}
+		m["headers"] = headers
+	}
+	if c.Auth != nil {
+		m["auth"] = c.Auth.AsMap()
+	}
+	if c.SignatureSecret != "" {
+		m["signature_secret"] = c.SignatureSecret
+	}
+	if c.SignatureHeader != "" {
+		m["signature_header"] = c.SignatureHeader
+	}
+	if c.BodyTemplate != "" {
+		m["body_template"] = c.BodyTemplate
+	}
+	return m
 }
 
 type NotificationConfig struct {
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/odpf/siren/pkg/secret"
 )
 
 func TestReceiverConfig(t *testing.T) {
@@ -24,6 +25,54 @@ func TestReceiverConfig(t *testing.T) {
 				},
 				wantErr: false,
 			},
+			{
+				name: "return error if method is not allowed",
+				c: ReceiverConfig{
+					URL:    "url",
+					Method: "GET",
+				},
+				wantErr: true,
+			},
+			{
+				name: "return nil if method is allowed regardless the case",
+				c: ReceiverConfig{
+					URL:    "url",
+					Method: "put",
+				},
+				wantErr: false,
+			},
+			{
+				name: "return error if auth type is unknown",
+				c: ReceiverConfig{
+					URL:  "url",
+					Auth: &AuthConfig{Type: "digest"},
+				},
+				wantErr: true,
+			},
+			{
+				name: "return error if basic auth has no username",
+				c: ReceiverConfig{
+					URL:  "url",
+					Auth: &AuthConfig{Type: AuthTypeBasic, Password: "pass"},
+				},
+				wantErr: true,
+			},
+			{
+				name: "return error if bearer auth has no token",
+				c: ReceiverConfig{
+					URL:  "url",
+					Auth: &AuthConfig{Type: AuthTypeBearer},
+				},
+				wantErr: true,
+			},
+			{
+				name: "return nil if bearer auth has token",
+				c: ReceiverConfig{
+					URL:  "url",
+					Auth: &AuthConfig{Type: AuthTypeBearer, Token: "token"},
+				},
+				wantErr: false,
+			},
 		}
 		for _, tc := range testCases {
 			t.Run(tc.name, func(t *testing.T) {
@@ -33,6 +82,58 @@ func TestReceiverConfig(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("request defaults", func(t *testing.T) {
+		c := ReceiverConfig{URL: "url"}
+		if c.RequestMethod() != "POST" {
+			t.Errorf("RequestMethod() = %v, want POST", c.RequestMethod())
+		}
+		if c.RequestSignatureHeader() != "X-Siren-Signature" {
+			t.Errorf("RequestSignatureHeader() = %v, want X-Siren-Signature", c.RequestSignatureHeader())
+		}
+
+		c = ReceiverConfig{URL: "url", Method: "patch", SignatureHeader: "X-Signature"}
+		if c.RequestMethod() != "PATCH" {
+			t.Errorf("RequestMethod() = %v, want PATCH", c.RequestMethod())
+		}
+		if c.RequestSignatureHeader() != "X-Signature" {
+			t.Errorf("RequestSignatureHeader() = %v, want X-Signature", c.RequestSignatureHeader())
+		}
+	})
+
+	t.Run("AsMap", func(t *testing.T) {
+		c := ReceiverConfig{
+			URL:     "url",
+			Method:  "PUT",
+			Headers: map[string]string{"X-Key": "value"},
+			Auth: &AuthConfig{
+				Type:     AuthTypeBasic,
+				Username: "user",
+				Password: "pass",
+			},
+			SignatureSecret: "secret",
+			SignatureHeader: "X-Signature",
+			BodyTemplate:    "[[ .Details.summary ]]",
+		}
+
+		if diff := cmp.Diff(map[string]interface{}{
+			"url":    "url",
+			"method": "PUT",
+			"headers": map[string]interface{}{
+				"X-Key": "value",
+			},
+			"auth": map[string]interface{}{
+				"type":     "basic",
+				"username": "user",
+				"password": secret.MaskableString("pass"),
+			},
+			"signature_secret": secret.MaskableString("secret"),
+			"signature_header": "X-Signature",
+			"body_template":    "[[ .Details.summary ]]",
+		}, c.AsMap()); diff != "" {
+			t.Errorf("result not match\n%v", diff)
+		}
+	})
 }
 
 func TestNotificationConfig(t *testing.T) {
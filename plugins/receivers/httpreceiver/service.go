@@ -3,6 +3,9 @@ package httpreceiver
 import (
 	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -11,20 +14,23 @@ import (
 	"github.com/mitchellh/mapstructure"
 	"github.com/odpf/salt/log"
 	"github.com/odpf/siren/core/notification"
+	"github.com/odpf/siren/core/template"
 	"github.com/odpf/siren/pkg/errors"
 	"github.com/odpf/siren/pkg/httpclient"
 	"github.com/odpf/siren/pkg/retry"
+	"github.com/odpf/siren/pkg/secret"
 	"github.com/odpf/siren/plugins/receivers/base"
 )
 
 type PluginService struct {
 	base.UnimplementedService
-	httpClient *httpclient.Client
-	retrier    retry.Runner
-	logger     log.Logger
+	cryptoClient Encryptor
+	httpClient   *httpclient.Client
+	retrier      retry.Runner
+	logger       log.Logger
 }
 
-func NewPluginService(logger log.Logger, cfg AppConfig, opts ...ServiceOption) *PluginService {
+func NewPluginService(logger log.Logger, cfg AppConfig, cryptoClient Encryptor, opts ...ServiceOption) *PluginService {
 	s := &PluginService{}
 
 	for _, opt := range opts {
@@ -32,6 +38,7 @@ func NewPluginService(logger log.Logger, cfg AppConfig, opts ...ServiceOption) *
 	}
 
 	s.logger = logger
+	s.cryptoClient = cryptoClient
 
 	if s.httpClient == nil {
 		s.httpClient = httpclient.New(cfg.HTTPClient)
@@ -50,61 +57,130 @@ func (s *PluginService) PreHookDBTransformConfigs(ctx context.Context, receiverC
 		return nil, errors.ErrInvalid.WithMsgf(err.Error())
 	}
 
+	if err := transformSecrets(receiverConfig, s.cryptoClient.Encrypt); err != nil {
+		return nil, fmt.Errorf("http receiver secret encryption failed: %w", err)
+	}
+
+	return receiverConfig.AsMap(), nil
+}
+
+// PostHookDBTransformConfigs do transformation in post-hook service lifecycle
+func (s *PluginService) PostHookDBTransformConfigs(ctx context.Context, receiverConfigMap map[string]interface{}) (map[string]interface{}, error) {
+	receiverConfig := &ReceiverConfig{}
+	if err := mapstructure.Decode(receiverConfigMap, receiverConfig); err != nil {
+		return nil, fmt.Errorf("failed to transform configurations to receiver config: %w", err)
+	}
+
+	if err := receiverConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := transformSecrets(receiverConfig, s.cryptoClient.Decrypt); err != nil {
+		return nil, fmt.Errorf("http receiver secret decryption failed: %w", err)
+	}
+
 	return receiverConfig.AsMap(), nil
 }
 
 func (s *PluginService) PreHookQueueTransformConfigs(ctx context.Context, notificationConfigMap map[string]interface{}) (map[string]interface{}, error) {
 	notificationConfig := &NotificationConfig{}
 	if err := mapstructure.Decode(notificationConfigMap, notificationConfig); err != nil {
-		return nil, fmt.Errorf("failed to transform configurations to slack notification config: %w", err)
+		return nil, fmt.Errorf("failed to transform configurations to http notification config: %w", err)
 	}
 
 	if err := notificationConfig.Validate(); err != nil {
 		return nil, err
 	}
 
+	if err := transformSecrets(&notificationConfig.ReceiverConfig, s.cryptoClient.Encrypt); err != nil {
+		return nil, fmt.Errorf("http receiver secret encryption failed: %w", err)
+	}
+
 	return notificationConfig.AsMap(), nil
 }
 
-func (s *PluginService) Send(ctx context.Context, notificationMessage notification.Message) (bool, error) {
+func (s *PluginService) PostHookQueueTransformConfigs(ctx context.Context, notificationConfigMap map[string]interface{}) (map[string]interface{}, error) {
+	notificationConfig := &NotificationConfig{}
+	if err := mapstructure.Decode(notificationConfigMap, notificationConfig); err != nil {
+		return nil, fmt.Errorf("failed to transform configurations to http notification config: %w", err)
+	}
+
+	if err := notificationConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := transformSecrets(&notificationConfig.ReceiverConfig, s.cryptoClient.Decrypt); err != nil {
+		return nil, fmt.Errorf("http receiver secret decryption failed: %w", err)
+	}
+
+	return notificationConfig.AsMap(), nil
+}
+
+func (s *PluginService) Send(ctx context.Context, notificationMessage notification.Message) (string, bool, error) {
 	notificationConfig := &NotificationConfig{}
 	if err := mapstructure.Decode(notificationMessage.Configs, notificationConfig); err != nil {
-		return false, err
+		return "", false, err
 	}
 
-	bodyBytes, err := json.Marshal(notificationMessage.Details)
-	if err != nil {
-		return false, err
+	var bodyBytes []byte
+	if notificationConfig.BodyTemplate != "" {
+		body, err := template.RenderBody(notificationConfig.BodyTemplate, notificationMessage)
+		if err != nil {
+			return "", false, fmt.Errorf("failed to render body template: %w", err)
+		}
+		bodyBytes = []byte(body)
+	} else {
+		var err error
+		bodyBytes, err = json.Marshal(notificationMessage.Details)
+		if err != nil {
+			return "", false, err
+		}
 	}
 
-	if err := s.Notify(ctx, notificationConfig.URL, bodyBytes); err != nil {
+	if err := s.Notify(ctx, notificationConfig.ReceiverConfig, bodyBytes); err != nil {
 		if errors.As(err, new(retry.RetryableError)) {
-			return true, err
+			return "", true, err
 		} else {
-			return false, err
+			return "", false, err
 		}
 	}
 
-	return false, nil
+	return "", false, nil
 }
 
-func (s *PluginService) Notify(ctx context.Context, apiURL string, body []byte) error {
+func (s *PluginService) Notify(ctx context.Context, conf ReceiverConfig, body []byte) error {
 	if s.retrier != nil {
-		if err := s.retrier.Run(ctx, func(ctx context.Context) error {
-			return s.notify(ctx, apiURL, body)
-		}); err != nil {
-			return err
-		}
+		return s.retrier.Run(ctx, func(ctx context.Context) error {
+			return s.notify(ctx, conf, body)
+		})
 	}
-	return s.notify(ctx, apiURL, body)
+	return s.notify(ctx, conf, body)
 }
 
-func (s *PluginService) notify(ctx context.Context, apiURL string, body []byte) error {
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, apiURL, bytes.NewReader(body))
+func (s *PluginService) notify(ctx context.Context, conf ReceiverConfig, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, conf.RequestMethod(), conf.URL, bytes.NewReader(body))
 	if err != nil {
 		return fmt.Errorf("failed to create request body: %w", err)
 	}
 
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range conf.Headers {
+		req.Header.Set(k, v)
+	}
+
+	if conf.Auth != nil {
+		switch conf.Auth.Type {
+		case AuthTypeBasic:
+			req.SetBasicAuth(conf.Auth.Username, conf.Auth.Password.UnmaskedString())
+		case AuthTypeBearer:
+			req.Header.Set("Authorization", "Bearer "+conf.Auth.Token.UnmaskedString())
+		}
+	}
+
+	if conf.SignatureSecret != "" {
+		req.Header.Set(conf.RequestSignatureHeader(), Sign(conf.SignatureSecret.UnmaskedString(), body))
+	}
+
 	resp, err := s.httpClient.HTTP().Do(req)
 	if err != nil {
 		return retry.RetryableError{Err: fmt.Errorf("failure in http call: %w", err)}
@@ -123,7 +199,36 @@ func (s *PluginService) notify(ctx context.Context, apiURL string, body []byte)
 		if err != nil {
 			return fmt.Errorf("failed to read response body: %w", err)
 		}
-		s.logger.Info("httpreceiver call success", "url", apiURL, "response", string(bodyBytes))
+		s.logger.Info("httpreceiver call success", "url", conf.URL, "response", string(bodyBytes))
+	}
+
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the body prefixed with the algorithm
+// receivers verify a request by computing the same value with the shared secret
+func Sign(secretKey string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secretKey))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// transformSecrets applies fn to every credential in the config that is set
+func transformSecrets(c *ReceiverConfig, fn func(secret.MaskableString) (secret.MaskableString, error)) error {
+	secrets := []*secret.MaskableString{&c.SignatureSecret}
+	if c.Auth != nil {
+		secrets = append(secrets, &c.Auth.Password, &c.Auth.Token)
+	}
+
+	for _, sec := range secrets {
+		if *sec == "" {
+			continue
+		}
+		transformed, err := fn(*sec)
+		if err != nil {
+			return err
+		}
+		*sec = transformed
 	}
 
 	return nil
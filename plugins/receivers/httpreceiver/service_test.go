@@ -2,16 +2,247 @@ package httpreceiver_test
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
 
 	"github.com/odpf/salt/log"
+	"github.com/odpf/siren/core/notification"
+	"github.com/odpf/siren/pkg/errors"
 	"github.com/odpf/siren/pkg/retry"
+	"github.com/odpf/siren/pkg/secret"
 	"github.com/odpf/siren/plugins/receivers/httpreceiver"
+	"github.com/odpf/siren/plugins/receivers/httpreceiver/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
+func TestService_PreHookDBTransformConfigs(t *testing.T) {
+	tests := []struct {
+		name           string
+		setup          func(*mocks.Encryptor)
+		configurations map[string]interface{}
+		want           map[string]interface{}
+		wantErr        bool
+	}{
+		{
+			name:           "should return error if url is missing",
+			configurations: map[string]interface{}{},
+			wantErr:        true,
+		},
+		{
+			name: "should return as-is if there is no secret",
+			configurations: map[string]interface{}{
+				"url": "http://webhook",
+			},
+			want: map[string]interface{}{
+				"url": "http://webhook",
+			},
+		},
+		{
+			name: "should return error if secret encryption failed",
+			configurations: map[string]interface{}{
+				"url":              "http://webhook",
+				"signature_secret": "secret",
+			},
+			setup: func(e *mocks.Encryptor) {
+				e.EXPECT().Encrypt(mock.AnythingOfType("secret.MaskableString")).Return("", errors.New("some error"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return encrypted secrets if succeed",
+			configurations: map[string]interface{}{
+				"url":              "http://webhook",
+				"signature_secret": "secret",
+				"auth": map[string]interface{}{
+					"type":  "bearer",
+					"token": "token",
+				},
+			},
+			setup: func(e *mocks.Encryptor) {
+				e.EXPECT().Encrypt(secret.MaskableString("secret")).Return(secret.MaskableString("encrypted-secret"), nil)
+				e.EXPECT().Encrypt(secret.MaskableString("token")).Return(secret.MaskableString("encrypted-token"), nil)
+			},
+			want: map[string]interface{}{
+				"url":              "http://webhook",
+				"signature_secret": secret.MaskableString("encrypted-secret"),
+				"auth": map[string]interface{}{
+					"type":  "bearer",
+					"token": secret.MaskableString("encrypted-token"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mockEncryptor = new(mocks.Encryptor)
+			)
+
+			if tt.setup != nil {
+				tt.setup(mockEncryptor)
+			}
+
+			s := httpreceiver.NewPluginService(log.NewNoop(), httpreceiver.AppConfig{}, mockEncryptor)
+			got, err := s.PreHookDBTransformConfigs(context.TODO(), tt.configurations)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.PreHookDBTransformConfigs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.PreHookDBTransformConfigs() = %v, want %v", got, tt.want)
+			}
+			mockEncryptor.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_PostHookQueueTransformConfigs(t *testing.T) {
+	t.Run("should return decrypted secrets", func(t *testing.T) {
+		mockEncryptor := new(mocks.Encryptor)
+		mockEncryptor.EXPECT().Decrypt(secret.MaskableString("encrypted-pass")).Return(secret.MaskableString("pass"), nil)
+
+		s := httpreceiver.NewPluginService(log.NewNoop(), httpreceiver.AppConfig{}, mockEncryptor)
+		got, err := s.PostHookQueueTransformConfigs(context.TODO(), map[string]interface{}{
+			"url": "http://webhook",
+			"auth": map[string]interface{}{
+				"type":     "basic",
+				"username": "user",
+				"password": "encrypted-pass",
+			},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"url": "http://webhook",
+			"auth": map[string]interface{}{
+				"type":     "basic",
+				"username": "user",
+				"password": secret.MaskableString("pass"),
+			},
+		}, got)
+		mockEncryptor.AssertExpectations(t)
+	})
+}
+
+func TestService_Send(t *testing.T) {
+	testCases := []struct {
+		name     string
+		configs  map[string]interface{}
+		details  map[string]interface{}
+		wantBody string
+		wantErr  bool
+	}{
+		{
+			name: "should send details as json if there is no body template",
+			configs: map[string]interface{}{
+				"url": "",
+			},
+			details:  map[string]interface{}{"summary": "cpu high"},
+			wantBody: `{"summary":"cpu high"}`,
+		},
+		{
+			name: "should send rendered body template",
+			configs: map[string]interface{}{
+				"url":           "",
+				"body_template": `{"text":"[[ .Details.summary | toUpper ]] from [[ .ReceiverType ]]"}`,
+			},
+			details:  map[string]interface{}{"summary": "cpu high"},
+			wantBody: `{"text":"CPU HIGH from http"}`,
+		},
+		{
+			name: "should return error if body template is invalid",
+			configs: map[string]interface{}{
+				"url":           "",
+				"body_template": `[[ .Details.summary `,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotBody string
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, _ := io.ReadAll(r.Body)
+				gotBody = string(b)
+			}))
+			defer testServer.Close()
+
+			tc.configs["url"] = testServer.URL
+
+			s := httpreceiver.NewPluginService(log.NewNoop(), httpreceiver.AppConfig{}, nil)
+			_, retryable, err := s.Send(context.Background(), notification.Message{
+				ReceiverType: "http",
+				Configs:      tc.configs,
+				Details:      tc.details,
+			})
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Service.Send() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			assert.False(t, retryable)
+			if !tc.wantErr {
+				assert.Equal(t, tc.wantBody, gotBody)
+			}
+		})
+	}
+}
+
+func TestService_Notify_Request(t *testing.T) {
+	t.Run("should send request with configured method, headers, bearer auth and signature", func(t *testing.T) {
+		body := []byte(`{"summary":"cpu high"}`)
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.Equal(t, "value", r.Header.Get("X-Key"))
+			assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+			assert.Equal(t, httpreceiver.Sign("secret", body), r.Header.Get("X-Signature"))
+		}))
+		defer testServer.Close()
+
+		c := httpreceiver.NewPluginService(log.NewNoop(), httpreceiver.AppConfig{}, nil)
+		err := c.Notify(context.Background(), httpreceiver.ReceiverConfig{
+			URL:             testServer.URL,
+			Method:          "put",
+			Headers:         map[string]string{"X-Key": "value"},
+			Auth:            &httpreceiver.AuthConfig{Type: httpreceiver.AuthTypeBearer, Token: "token"},
+			SignatureSecret: "secret",
+			SignatureHeader: "X-Signature",
+		}, body)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should send request with basic auth and overridden content type", func(t *testing.T) {
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "text/plain", r.Header.Get("Content-Type"))
+			assert.Empty(t, r.Header.Get("X-Siren-Signature"))
+			username, password, ok := r.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, "user", username)
+			assert.Equal(t, "pass", password)
+		}))
+		defer testServer.Close()
+
+		c := httpreceiver.NewPluginService(log.NewNoop(), httpreceiver.AppConfig{}, nil)
+		err := c.Notify(context.Background(), httpreceiver.ReceiverConfig{
+			URL:     testServer.URL,
+			Headers: map[string]string{"Content-Type": "text/plain"},
+			Auth:    &httpreceiver.AuthConfig{Type: httpreceiver.AuthTypeBasic, Username: "user", Password: "pass"},
+		}, []byte("cpu high"))
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestSign(t *testing.T) {
+	// generated with: printf 'hello' | openssl dgst -sha256 -hmac 'secret'
+	assert.Equal(t, "sha256=88aab3ede8d3adf94d26ab90d3bafd4a2083070c3bcce9c014ee04a443847c0b", httpreceiver.Sign("secret", []byte("hello")))
+}
+
 func TestService_Notify_WithoutRetrier(t *testing.T) {
 	testCases := []struct {
 		name    string
@@ -40,8 +271,8 @@ func TestService_Notify_WithoutRetrier(t *testing.T) {
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			c := httpreceiver.NewPluginService(log.NewNoop(), tc.cfg)
-			if err := c.Notify(tc.ctx, tc.apiURL, tc.message); (err != nil) != tc.wantErr {
+			c := httpreceiver.NewPluginService(log.NewNoop(), tc.cfg, nil)
+			if err := c.Notify(tc.ctx, httpreceiver.ReceiverConfig{URL: tc.apiURL}, tc.message); (err != nil) != tc.wantErr {
 				t.Errorf("Client.Notify() error = %v, wantErr %v", err, tc.wantErr)
 			}
 		})
@@ -54,8 +285,8 @@ func TestService_Notify_HTTPCall(t *testing.T) {
 			w.WriteHeader(http.StatusTooManyRequests)
 		}))
 
-		c := httpreceiver.NewPluginService(log.NewNoop(), httpreceiver.AppConfig{})
-		err := c.Notify(context.Background(), testServer.URL, nil)
+		c := httpreceiver.NewPluginService(log.NewNoop(), httpreceiver.AppConfig{}, nil)
+		err := c.Notify(context.Background(), httpreceiver.ReceiverConfig{URL: testServer.URL}, nil)
 
 		assert.EqualError(t, err, "Too Many Requests")
 
@@ -67,8 +298,8 @@ func TestService_Notify_HTTPCall(t *testing.T) {
 			w.WriteHeader(http.StatusBadRequest)
 		}))
 
-		c := httpreceiver.NewPluginService(log.NewNoop(), httpreceiver.AppConfig{})
-		err := c.Notify(context.Background(), testServer.URL, nil)
+		c := httpreceiver.NewPluginService(log.NewNoop(), httpreceiver.AppConfig{}, nil)
+		err := c.Notify(context.Background(), httpreceiver.ReceiverConfig{URL: testServer.URL}, nil)
 
 		assert.EqualError(t, err, "Bad Request")
 
@@ -80,8 +311,8 @@ func TestService_Notify_HTTPCall(t *testing.T) {
 			w.Header().Set("Content-Length", "1")
 		}))
 
-		c := httpreceiver.NewPluginService(log.NewNoop(), httpreceiver.AppConfig{})
-		err := c.Notify(context.Background(), testServer.URL, nil)
+		c := httpreceiver.NewPluginService(log.NewNoop(), httpreceiver.AppConfig{}, nil)
+		err := c.Notify(context.Background(), httpreceiver.ReceiverConfig{URL: testServer.URL}, nil)
 
 		assert.EqualError(t, err, "failed to read response body: unexpected EOF")
 
@@ -93,8 +324,8 @@ func TestService_Notify_HTTPCall(t *testing.T) {
 			w.Header().Set("Content-Length", "1")
 		}))
 
-		c := httpreceiver.NewPluginService(log.NewNoop(), httpreceiver.AppConfig{})
-		err := c.Notify(context.Background(), testServer.URL, nil)
+		c := httpreceiver.NewPluginService(log.NewNoop(), httpreceiver.AppConfig{}, nil)
+		err := c.Notify(context.Background(), httpreceiver.ReceiverConfig{URL: testServer.URL}, nil)
 
 		assert.EqualError(t, err, "failed to read response body: unexpected EOF")
 
@@ -113,9 +344,9 @@ func TestService_Notify_WithRetrier(t *testing.T) {
 			w.WriteHeader(http.StatusTooManyRequests)
 		}))
 
-		c := httpreceiver.NewPluginService(log.NewNoop(), httpreceiver.AppConfig{},
+		c := httpreceiver.NewPluginService(log.NewNoop(), httpreceiver.AppConfig{}, nil,
 			httpreceiver.WithRetrier(retry.New(retry.Config{Enable: true})))
-		_ = c.Notify(context.Background(), testServer.URL, nil)
+		_ = c.Notify(context.Background(), httpreceiver.ReceiverConfig{URL: testServer.URL}, nil)
 
 		assert.Equal(t, expectedCounter, counter)
 
@@ -130,9 +361,9 @@ func TestService_Notify_WithRetrier(t *testing.T) {
 			w.Write([]byte(`{"ok":false}`))
 		}))
 
-		c := httpreceiver.NewPluginService(log.NewNoop(), httpreceiver.AppConfig{},
+		c := httpreceiver.NewPluginService(log.NewNoop(), httpreceiver.AppConfig{}, nil,
 			httpreceiver.WithRetrier(retry.New(retry.Config{Enable: true})))
-		_ = c.Notify(context.Background(), testServer.URL, nil)
+		_ = c.Notify(context.Background(), httpreceiver.ReceiverConfig{URL: testServer.URL}, nil)
 
 		assert.Equal(t, expectedCounter, counter)
 
@@ -0,0 +1,101 @@
+package msteams
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/odpf/siren/pkg/httpclient"
+	"github.com/odpf/siren/pkg/retry"
+	"github.com/odpf/siren/pkg/secret"
+)
+
+type ClientOption func(*Client)
+
+// ClientWithHTTPClient assigns custom client when creating a msteams client
+func ClientWithHTTPClient(cli *httpclient.Client) ClientOption {
+	return func(c *Client) {
+		c.httpClient = cli
+	}
+}
+
+// ClientWithRetrier wraps client call with retrier
+func ClientWithRetrier(runner retry.Runner) ClientOption {
+	return func(c *Client) {
+		c.retrier = runner
+	}
+}
+
+type Client struct {
+	cfg        AppConfig
+	httpClient *httpclient.Client
+	retrier    retry.Runner
+}
+
+func NewClient(cfg AppConfig, opts ...ClientOption) *Client {
+	c := &Client{
+		cfg: cfg,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.httpClient == nil {
+		c.httpClient = httpclient.New(cfg.HTTPClient)
+	}
+
+	return c
+}
+
+// Notify posts the message as an adaptive card to msteams incoming webhook
+func (c *Client) Notify(ctx context.Context, webhookURL secret.MaskableString, message Message) error {
+	if c.retrier != nil {
+		return c.retrier.Run(ctx, func(ctx context.Context) error {
+			return c.notify(ctx, webhookURL, message)
+		})
+	}
+	return c.notify(ctx, webhookURL, message)
+}
+
+func (c *Client) notify(ctx context.Context, webhookURL secret.MaskableString, message Message) error {
+	payload, err := message.BuildPayload()
+	if err != nil {
+		return err
+	}
+
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal msteams payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL.UnmaskedString(), bytes.NewReader(payloadJSON))
+	if err != nil {
+		return fmt.Errorf("failed to create request body: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.HTTP().Do(req)
+	if err != nil {
+		return retry.RetryableError{Err: fmt.Errorf("failure in http call: %w", err)}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 429 || resp.StatusCode >= 500 {
+		return retry.RetryableError{Err: errors.New(http.StatusText(resp.StatusCode))}
+	}
+
+	if resp.StatusCode >= 300 {
+		bodyBytes, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return fmt.Errorf("error with status code %s without response body", http.StatusText(resp.StatusCode))
+		}
+		return fmt.Errorf("error with status code %s and body %s", http.StatusText(resp.StatusCode), string(bodyBytes))
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package msteams_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/odpf/siren/pkg/retry"
+	"github.com/odpf/siren/pkg/secret"
+	"github.com/odpf/siren/plugins/receivers/msteams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Notify(t *testing.T) {
+	t.Run("return error when message has no content", func(t *testing.T) {
+		c := msteams.NewClient(msteams.AppConfig{})
+		err := c.Notify(context.Background(), "http://webhook", msteams.Message{})
+
+		assert.EqualError(t, err, "msteams message has no text or body")
+	})
+
+	t.Run("return retryable error when webhook returns 429 or 5xx", func(t *testing.T) {
+		for _, statusCode := range []int{http.StatusTooManyRequests, http.StatusBadGateway} {
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(statusCode)
+			}))
+
+			c := msteams.NewClient(msteams.AppConfig{})
+			err := c.Notify(context.Background(), secret.MaskableString(testServer.URL), msteams.Message{Text: "hello"})
+
+			assert.True(t, errors.As(err, new(retry.RetryableError)))
+
+			testServer.Close()
+		}
+	})
+
+	t.Run("return non retryable error when webhook returns 4xx", func(t *testing.T) {
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte("invalid card"))
+		}))
+
+		c := msteams.NewClient(msteams.AppConfig{})
+		err := c.Notify(context.Background(), secret.MaskableString(testServer.URL), msteams.Message{Text: "hello"})
+
+		assert.EqualError(t, err, "error with status code Bad Request and body invalid card")
+		assert.False(t, errors.As(err, new(retry.RetryableError)))
+
+		testServer.Close()
+	})
+
+	t.Run("return nil error and post adaptive card when notify succeed", func(t *testing.T) {
+		var payload msteams.Payload
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			w.Write([]byte("1"))
+		}))
+
+		c := msteams.NewClient(msteams.AppConfig{})
+		err := c.Notify(context.Background(), secret.MaskableString(testServer.URL), msteams.Message{Text: "hello"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "message", payload.Type)
+		assert.Len(t, payload.Attachments, 1)
+		assert.Equal(t, "AdaptiveCard", payload.Attachments[0].Content.Type)
+
+		testServer.Close()
+	})
+
+	t.Run("retry the call when webhook returns retryable error", func(t *testing.T) {
+		var counter int
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			counter++
+			if counter < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte("1"))
+		}))
+
+		c := msteams.NewClient(msteams.AppConfig{}, msteams.ClientWithRetrier(retry.New(retry.Config{Enable: true})))
+		err := c.Notify(context.Background(), secret.MaskableString(testServer.URL), msteams.Message{Text: "hello"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, counter)
+
+		testServer.Close()
+	})
+}
@@ -0,0 +1,42 @@
+package msteams
+
+import (
+	"fmt"
+
+	"github.com/odpf/siren/pkg/httpclient"
+	"github.com/odpf/siren/pkg/retry"
+	"github.com/odpf/siren/pkg/secret"
+)
+
+// AppConfig is a config loaded when siren is started
+type AppConfig struct {
+	Retry      retry.Config      `mapstructure:"retry" yaml:"retry"`
+	HTTPClient httpclient.Config `mapstructure:"http_client" yaml:"http_client"`
+}
+
+// ReceiverConfig is a stored config for a msteams receiver
+type ReceiverConfig struct {
+	WebhookURL secret.MaskableString `mapstructure:"webhook_url"`
+}
+
+func (c *ReceiverConfig) Validate() error {
+	if c.WebhookURL == "" {
+		return fmt.Errorf("invalid msteams receiver config, webhook_url: %s", c.WebhookURL)
+	}
+	return nil
+}
+
+func (c *ReceiverConfig) AsMap() map[string]interface{} {
+	return map[string]interface{}{
+		"webhook_url": c.WebhookURL,
+	}
+}
+
+// NotificationConfig has all configs needed to send notification
+type NotificationConfig struct {
+	ReceiverConfig `mapstructure:",squash"`
+}
+
+func (c *NotificationConfig) AsMap() map[string]interface{} {
+	return c.ReceiverConfig.AsMap()
+}
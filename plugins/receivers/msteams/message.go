@@ -0,0 +1,82 @@
+package msteams
+
+import "errors"
+
+const (
+	payloadTypeMessage          = "message"
+	contentTypeAdaptiveCard     = "application/vnd.microsoft.card.adaptive"
+	adaptiveCardSchema          = "http://adaptivecards.io/schemas/adaptive-card.json"
+	adaptiveCardType            = "AdaptiveCard"
+	adaptiveCardVersion         = "1.4"
+	adaptiveCardTextBlockType   = "TextBlock"
+	adaptiveCardFullWidthLayout = "Full"
+)
+
+// Message is the content of an adaptive card posted to msteams
+// body and actions are adaptive card elements, text is shown as a text block if there is no body
+type Message struct {
+	Text    string                   `yaml:"text,omitempty" json:"text,omitempty" mapstructure:"text"`
+	Body    []map[string]interface{} `yaml:"body,omitempty" json:"body,omitempty" mapstructure:"body"`
+	Actions []map[string]interface{} `yaml:"actions,omitempty" json:"actions,omitempty" mapstructure:"actions"`
+}
+
+// Payload is the request body of msteams incoming webhook
+type Payload struct {
+	Type        string       `json:"type"`
+	Attachments []Attachment `json:"attachments"`
+}
+
+type Attachment struct {
+	ContentType string       `json:"contentType"`
+	ContentURL  *string      `json:"contentUrl"`
+	Content     AdaptiveCard `json:"content"`
+}
+
+type AdaptiveCard struct {
+	Schema  string                   `json:"$schema"`
+	Type    string                   `json:"type"`
+	Version string                   `json:"version"`
+	Body    []map[string]interface{} `json:"body"`
+	Actions []map[string]interface{} `json:"actions,omitempty"`
+	MSTeams AdaptiveCardMSTeams      `json:"msteams"`
+}
+
+type AdaptiveCardMSTeams struct {
+	Width string `json:"width"`
+}
+
+// BuildPayload wraps the message as an adaptive card attachment of a msteams message
+func (m Message) BuildPayload() (Payload, error) {
+	body := m.Body
+	if len(body) == 0 {
+		if m.Text == "" {
+			return Payload{}, errors.New("msteams message has no text or body")
+		}
+		body = []map[string]interface{}{
+			{
+				"type": adaptiveCardTextBlockType,
+				"text": m.Text,
+				"wrap": true,
+			},
+		}
+	}
+
+	return Payload{
+		Type: payloadTypeMessage,
+		Attachments: []Attachment{
+			{
+				ContentType: contentTypeAdaptiveCard,
+				Content: AdaptiveCard{
+					Schema:  adaptiveCardSchema,
+					Type:    adaptiveCardType,
+					Version: adaptiveCardVersion,
+					Body:    body,
+					Actions: m.Actions,
+					MSTeams: AdaptiveCardMSTeams{
+						Width: adaptiveCardFullWidthLayout,
+					},
+				},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,66 @@
+package msteams_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/odpf/siren/plugins/receivers/msteams"
+)
+
+func TestMessage_BuildPayload(t *testing.T) {
+	tests := []struct {
+		name    string
+		m       msteams.Message
+		want    []map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name:    "should return error if message has no text and body",
+			wantErr: true,
+		},
+		{
+			name: "should render text as a text block if message has no body",
+			m: msteams.Message{
+				Text: "hello",
+			},
+			want: []map[string]interface{}{
+				{"type": "TextBlock", "text": "hello", "wrap": true},
+			},
+		},
+		{
+			name: "should use body of the message",
+			m: msteams.Message{
+				Text: "hello",
+				Body: []map[string]interface{}{
+					{"type": "TextBlock", "text": "world"},
+				},
+			},
+			want: []map[string]interface{}{
+				{"type": "TextBlock", "text": "world"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.BuildPayload()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Message.BuildPayload() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Type != "message" || len(got.Attachments) != 1 {
+				t.Fatalf("Message.BuildPayload() got invalid payload %v", got)
+			}
+			if got.Attachments[0].ContentType != "application/vnd.microsoft.card.adaptive" {
+				t.Errorf("Message.BuildPayload() got content type %q", got.Attachments[0].ContentType)
+			}
+			if got.Attachments[0].Content.Type != "AdaptiveCard" {
+				t.Errorf("Message.BuildPayload() got card type %q", got.Attachments[0].Content.Type)
+			}
+			if diff := cmp.Diff(tt.want, got.Attachments[0].Content.Body); diff != "" {
+				t.Errorf("Message.BuildPayload() diff = %v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	secret "github.com/odpf/siren/pkg/secret"
+	msteams "github.com/odpf/siren/plugins/receivers/msteams"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MSTeamsCaller is an autogenerated mock type for the MSTeamsCaller type
+type MSTeamsCaller struct {
+	mock.Mock
+}
+
+type MSTeamsCaller_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MSTeamsCaller) EXPECT() *MSTeamsCaller_Expecter {
+	return &MSTeamsCaller_Expecter{mock: &_m.Mock}
+}
+
+// Notify provides a mock function with given fields: ctx, webhookURL, message
+func (_m *MSTeamsCaller) Notify(ctx context.Context, webhookURL secret.MaskableString, message msteams.Message) error {
+	ret := _m.Called(ctx, webhookURL, message)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, secret.MaskableString, msteams.Message) error); ok {
+		r0 = rf(ctx, webhookURL, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MSTeamsCaller_Notify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Notify'
+type MSTeamsCaller_Notify_Call struct {
+	*mock.Call
+}
+
+// Notify is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookURL secret.MaskableString
+//   - message msteams.Message
+func (_e *MSTeamsCaller_Expecter) Notify(ctx interface{}, webhookURL interface{}, message interface{}) *MSTeamsCaller_Notify_Call {
+	return &MSTeamsCaller_Notify_Call{Call: _e.mock.On("Notify", ctx, webhookURL, message)}
+}
+
+func (_c *MSTeamsCaller_Notify_Call) Run(run func(ctx context.Context, webhookURL secret.MaskableString, message msteams.Message)) *MSTeamsCaller_Notify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secret.MaskableString), args[2].(msteams.Message))
+	})
+	return _c
+}
+
+func (_c *MSTeamsCaller_Notify_Call) Return(_a0 error) *MSTeamsCaller_Notify_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+type mockConstructorTestingTNewMSTeamsCaller interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMSTeamsCaller creates a new instance of MSTeamsCaller. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMSTeamsCaller(t mockConstructorTestingTNewMSTeamsCaller) *MSTeamsCaller {
+	mock := &MSTeamsCaller{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,18 @@
+package msteams
+
+import (
+	"context"
+
+	"github.com/odpf/siren/pkg/secret"
+)
+
+//go:generate mockery --name=Encryptor -r --case underscore --with-expecter --structname Encryptor --filename encryptor.go --output=./mocks
+type Encryptor interface {
+	Encrypt(str secret.MaskableString) (secret.MaskableString, error)
+	Decrypt(str secret.MaskableString) (secret.MaskableString, error)
+}
+
+//go:generate mockery --name=MSTeamsCaller -r --case underscore --with-expecter --structname MSTeamsCaller --filename msteams_caller.go --output=./mocks
+type MSTeamsCaller interface {
+	Notify(ctx context.Context, webhookURL secret.MaskableString, message Message) error
+}
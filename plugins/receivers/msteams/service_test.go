@@ -0,0 +1,211 @@
+package msteams_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/odpf/siren/core/notification"
+	"github.com/odpf/siren/core/template"
+	"github.com/odpf/siren/pkg/errors"
+	"github.com/odpf/siren/pkg/retry"
+	"github.com/odpf/siren/pkg/secret"
+	"github.com/odpf/siren/plugins/receivers/msteams"
+	"github.com/odpf/siren/plugins/receivers/msteams/mocks"
+	"github.com/odpf/siren/plugins/receivers/receivertest"
+	"github.com/stretchr/testify/mock"
+	"gopkg.in/yaml.v3"
+)
+
+func TestService_TransformConfigs(t *testing.T) {
+	receivertest.TestSecretConfigHooks(t, func(e receivertest.Encryptor) receivertest.ConfigTransformer {
+		return msteams.NewPluginService(msteams.AppConfig{}, e)
+	}, "webhook_url", map[string]interface{}{
+		"webhook_url": "http://webhook",
+	})
+}
+
+func TestService_Send(t *testing.T) {
+	tests := []struct {
+		name                string
+		setup               func(*mocks.MSTeamsCaller)
+		notificationMessage notification.Message
+		wantRetryable       bool
+		wantErr             bool
+	}{
+		{
+			name: "should return error if failed to decode notification config",
+			notificationMessage: notification.Message{
+				Configs: map[string]interface{}{
+					"webhook_url": true,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return error if failed to decode notification detail",
+			notificationMessage: notification.Message{
+				Details: map[string]interface{}{
+					"text": make(chan bool),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return error and not retryable if notify return error",
+			setup: func(mc *mocks.MSTeamsCaller) {
+				mc.EXPECT().Notify(mock.AnythingOfType("*context.emptyCtx"), secret.MaskableString("http://webhook"), msteams.Message{Text: "hello"}).Return(errors.New("some error"))
+			},
+			notificationMessage: notification.Message{
+				Configs: map[string]interface{}{
+					"webhook_url": "http://webhook",
+				},
+				Details: map[string]interface{}{
+					"text": "hello",
+				},
+			},
+			wantRetryable: false,
+			wantErr:       true,
+		},
+		{
+			name: "should return error and retryable if notify return retryable error",
+			setup: func(mc *mocks.MSTeamsCaller) {
+				mc.EXPECT().Notify(mock.AnythingOfType("*context.emptyCtx"), secret.MaskableString("http://webhook"), msteams.Message{Text: "hello"}).Return(retry.RetryableError{Err: errors.New("some error")})
+			},
+			notificationMessage: notification.Message{
+				Configs: map[string]interface{}{
+					"webhook_url": "http://webhook",
+				},
+				Details: map[string]interface{}{
+					"text": "hello",
+				},
+			},
+			wantRetryable: true,
+			wantErr:       true,
+		},
+		{
+			name: "should return no error if notify succeed",
+			setup: func(mc *mocks.MSTeamsCaller) {
+				mc.EXPECT().Notify(mock.AnythingOfType("*context.emptyCtx"), secret.MaskableString("http://webhook"), msteams.Message{Text: "hello"}).Return(nil)
+			},
+			notificationMessage: notification.Message{
+				Configs: map[string]interface{}{
+					"webhook_url": "http://webhook",
+				},
+				Details: map[string]interface{}{
+					"text": "hello",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mockMSTeamsClient = new(mocks.MSTeamsCaller)
+			)
+
+			if tt.setup != nil {
+				tt.setup(mockMSTeamsClient)
+			}
+
+			s := msteams.NewPluginService(msteams.AppConfig{}, nil, msteams.WithMSTeamsClient(mockMSTeamsClient))
+
+			_, got, err := s.Send(context.Background(), tt.notificationMessage)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.Send() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.wantRetryable {
+				t.Errorf("Service.Send() = %v, want %v", got, tt.wantRetryable)
+			}
+			mockMSTeamsClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_GetSystemDefaultTemplate(t *testing.T) {
+	tests := []struct {
+		name        string
+		n           notification.Notification
+		wantTitle   string
+		wantColor   string
+		wantBody    int
+		wantActions int
+	}{
+		{
+			name: "should render adaptive card with severity colour, summary, dashboard, and runbook",
+			n: notification.Notification{
+				Data: map[string]interface{}{
+					"status":            "firing",
+					"num_alerts_firing": 1,
+					"summary":           "cpu usage is high",
+					"dashboard":         "http://dashboard",
+					"playbook":          "http://runbook",
+				},
+				Labels: map[string]string{
+					"severity":  "CRITICAL",
+					"alertname": "cpu-high",
+				},
+			},
+			wantTitle:   "(FIRING:1) (CRITICAL) cpu-high",
+			wantColor:   "Attention",
+			wantBody:    2,
+			wantActions: 2,
+		},
+		{
+			name: "should only render title if there is no summary, dashboard, and runbook",
+			n: notification.Notification{
+				Data: map[string]interface{}{
+					"status": "resolved",
+				},
+				Labels: map[string]string{
+					"severity":  "WARNING",
+					"alertname": "cpu-high",
+				},
+			},
+			wantTitle: "(RESOLVED) (WARNING) cpu-high",
+			wantColor: "Good",
+			wantBody:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := msteams.NewPluginService(msteams.AppConfig{}, nil)
+
+			rendered, err := template.RenderBody(s.GetSystemDefaultTemplate(nil), tt.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var details map[string]interface{}
+			if err := yaml.Unmarshal([]byte(rendered), &details); err != nil {
+				t.Fatalf("failed to unmarshal rendered template: %v\n%s", err, rendered)
+			}
+
+			msg := msteams.Message{}
+			if err := mapstructure.Decode(details, &msg); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := msg.BuildPayload(); err != nil {
+				t.Fatal(err)
+			}
+
+			if msg.Text != tt.wantTitle {
+				t.Errorf("got text %q, want %q", msg.Text, tt.wantTitle)
+			}
+			if len(msg.Body) != tt.wantBody {
+				t.Fatalf("got %d body elements, want %d", len(msg.Body), tt.wantBody)
+			}
+			if msg.Body[0]["text"] != tt.wantTitle {
+				t.Errorf("got title %q, want %q", msg.Body[0]["text"], tt.wantTitle)
+			}
+			if msg.Body[0]["color"] != tt.wantColor {
+				t.Errorf("got color %q, want %q", msg.Body[0]["color"], tt.wantColor)
+			}
+			if len(msg.Actions) != tt.wantActions {
+				t.Errorf("got %d actions, want %d", len(msg.Actions), tt.wantActions)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package opsgenie
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/odpf/siren/pkg/httpclient"
+	"github.com/odpf/siren/pkg/retry"
+	"github.com/odpf/siren/pkg/secret"
+)
+
+const (
+	defaultOpsgenieHost = "https://api.opsgenie.com"
+)
+
+type alertHTTPResponse struct {
+	Result    string  `json:"result"`
+	Took      float64 `json:"took"`
+	RequestID string  `json:"requestId"`
+	Message   string  `json:"message"`
+}
+
+type ClientOption func(*Client)
+
+// ClientWithHTTPClient assigns custom client when creating a opsgenie client
+func ClientWithHTTPClient(cli *httpclient.Client) ClientOption {
+	return func(c *Client) {
+		c.httpClient = cli
+	}
+}
+
+// ClientWithRetrier wraps client call with retrier
+func ClientWithRetrier(runner retry.Runner) ClientOption {
+	return func(c *Client) {
+		c.retrier = runner
+	}
+}
+
+type Client struct {
+	cfg        AppConfig
+	httpClient *httpclient.Client
+	retrier    retry.Runner
+}
+
+func NewClient(cfg AppConfig, opts ...ClientOption) *Client {
+	c := &Client{
+		cfg: cfg,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if cfg.APIHost == "" {
+		c.cfg.APIHost = defaultOpsgenieHost
+	}
+
+	if c.httpClient == nil {
+		c.httpClient = httpclient.New(cfg.HTTPClient)
+	}
+
+	return c
+}
+
+// CreateAlert creates an alert in opsgenie and returns the request id of the alert creation
+// opsgenie deduplicates open alerts with the same alias
+func (c *Client) CreateAlert(ctx context.Context, apiKey secret.MaskableString, message Message) (string, error) {
+	return c.call(ctx, apiKey, "/v2/alerts", message)
+}
+
+// CloseAlert closes the open alert with the alias and returns the request id of the alert closing
+func (c *Client) CloseAlert(ctx context.Context, apiKey secret.MaskableString, alias string, message CloseMessage) (string, error) {
+	return c.call(ctx, apiKey, fmt.Sprintf("/v2/alerts/%s/close?identifierType=alias", url.PathEscape(alias)), message)
+}
+
+func (c *Client) call(ctx context.Context, apiKey secret.MaskableString, path string, body interface{}) (string, error) {
+	if c.retrier != nil {
+		var requestID string
+		if err := c.retrier.Run(ctx, func(ctx context.Context) error {
+			var err error
+			requestID, err = c.post(ctx, apiKey, path, body)
+			return err
+		}); err != nil {
+			return "", err
+		}
+		return requestID, nil
+	}
+	return c.post(ctx, apiKey, path, body)
+}
+
+func (c *Client) post(ctx context.Context, apiKey secret.MaskableString, path string, body interface{}) (string, error) {
+	bodyJSON, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.APIHost+path, bytes.NewReader(bodyJSON))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request body: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "GenieKey "+apiKey.UnmaskedString())
+
+	resp, err := c.httpClient.HTTP().Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failure in http call: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 429 || resp.StatusCode >= 500 {
+		return "", retry.RetryableError{Err: errors.New(http.StatusText(resp.StatusCode))}
+	}
+
+	if resp.StatusCode >= 300 {
+		bodyBytes, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return "", fmt.Errorf("error with status code %s without response body", http.StatusText(resp.StatusCode))
+		}
+		return "", fmt.Errorf("error with status code %s and body %s", http.StatusText(resp.StatusCode), string(bodyBytes))
+	}
+
+	// Status code 2xx only
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	apiResponse := alertHTTPResponse{}
+	if err = json.Unmarshal(bodyBytes, &apiResponse); err != nil {
+		return "", fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	return apiResponse.RequestID, nil
+}
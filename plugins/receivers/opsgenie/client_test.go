@@ -0,0 +1,126 @@
+package opsgenie_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/odpf/siren/pkg/retry"
+	"github.com/odpf/siren/plugins/receivers/opsgenie"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_CreateAlert(t *testing.T) {
+	t.Run("return retryable error when opsgenie returns 429 or 5xx", func(t *testing.T) {
+		for _, statusCode := range []int{http.StatusTooManyRequests, http.StatusServiceUnavailable} {
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(statusCode)
+			}))
+
+			c := opsgenie.NewClient(opsgenie.AppConfig{APIHost: testServer.URL})
+			_, err := c.CreateAlert(context.Background(), "api-key", opsgenie.Message{Message: "cpu-high"})
+
+			assert.True(t, errors.As(err, new(retry.RetryableError)))
+
+			testServer.Close()
+		}
+	})
+
+	t.Run("return non retryable error when opsgenie returns 4xx", func(t *testing.T) {
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"message":"Request body is not processable"}`))
+		}))
+
+		c := opsgenie.NewClient(opsgenie.AppConfig{APIHost: testServer.URL})
+		_, err := c.CreateAlert(context.Background(), "api-key", opsgenie.Message{Message: "cpu-high"})
+
+		assert.EqualError(t, err, `error with status code Unprocessable Entity and body {"message":"Request body is not processable"}`)
+		assert.False(t, errors.As(err, new(retry.RetryableError)))
+
+		testServer.Close()
+	})
+
+	t.Run("return request id when alert is created", func(t *testing.T) {
+		var (
+			gotMessage opsgenie.Message
+			gotAuth    string
+			gotPath    string
+		)
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotAuth = r.Header.Get("Authorization")
+			gotPath = r.URL.Path
+			_ = json.NewDecoder(r.Body).Decode(&gotMessage)
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"result":"Request will be processed","took":0.302,"requestId":"43a29c5c-3dbf-4fa4-9c26-f4f71023e120"}`))
+		}))
+
+		c := opsgenie.NewClient(opsgenie.AppConfig{APIHost: testServer.URL})
+		requestID, err := c.CreateAlert(context.Background(), "api-key", opsgenie.Message{
+			Message:  "cpu-high",
+			Alias:    "some-fingerprint",
+			Priority: "P1",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "43a29c5c-3dbf-4fa4-9c26-f4f71023e120", requestID)
+		assert.Equal(t, "GenieKey api-key", gotAuth)
+		assert.Equal(t, "/v2/alerts", gotPath)
+		assert.Equal(t, opsgenie.Message{Message: "cpu-high", Alias: "some-fingerprint", Priority: "P1"}, gotMessage)
+
+		testServer.Close()
+	})
+
+	t.Run("retry the call when opsgenie returns retryable error", func(t *testing.T) {
+		var counter int
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			counter++
+			if counter < 2 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"result":"Request will be processed","requestId":"request-id"}`))
+		}))
+
+		c := opsgenie.NewClient(opsgenie.AppConfig{APIHost: testServer.URL}, opsgenie.ClientWithRetrier(retry.New(retry.Config{Enable: true})))
+		requestID, err := c.CreateAlert(context.Background(), "api-key", opsgenie.Message{Message: "cpu-high"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "request-id", requestID)
+		assert.Equal(t, 2, counter)
+
+		testServer.Close()
+	})
+}
+
+func TestClient_CloseAlert(t *testing.T) {
+	t.Run("close the alert by alias", func(t *testing.T) {
+		var (
+			gotPath  string
+			gotQuery string
+			gotBody  opsgenie.CloseMessage
+		)
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotPath = r.URL.Path
+			gotQuery = r.URL.RawQuery
+			_ = json.NewDecoder(r.Body).Decode(&gotBody)
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"result":"Request will be processed","requestId":"request-id"}`))
+		}))
+
+		c := opsgenie.NewClient(opsgenie.AppConfig{APIHost: testServer.URL})
+		requestID, err := c.CloseAlert(context.Background(), "api-key", "some-fingerprint", opsgenie.CloseMessage{Source: "Siren"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "request-id", requestID)
+		assert.Equal(t, "/v2/alerts/some-fingerprint/close", gotPath)
+		assert.Equal(t, "identifierType=alias", gotQuery)
+		assert.Equal(t, opsgenie.CloseMessage{Source: "Siren"}, gotBody)
+
+		testServer.Close()
+	})
+}
@@ -0,0 +1,65 @@
+package opsgenie
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/odpf/siren/pkg/httpclient"
+	"github.com/odpf/siren/pkg/retry"
+	"github.com/odpf/siren/pkg/secret"
+)
+
+// AppConfig is a config loaded when siren is started
+type AppConfig struct {
+	APIHost    string            `mapstructure:"api_host" yaml:"api_host"`
+	Retry      retry.Config      `mapstructure:"retry" yaml:"retry"`
+	HTTPClient httpclient.Config `mapstructure:"http_client" yaml:"http_client"`
+}
+
+func (c AppConfig) Validate() error {
+	if c.APIHost == "" {
+		return errors.New("invalid opsgenie app config")
+	}
+	return nil
+}
+
+// ReceiverConfig is a stored config for an opsgenie receiver
+// responders are added to every alert created by the receiver
+type ReceiverConfig struct {
+	APIKey     secret.MaskableString `mapstructure:"api_key"`
+	Responders []Responder           `mapstructure:"responders"`
+}
+
+func (c *ReceiverConfig) Validate() error {
+	if c.APIKey == "" {
+		return fmt.Errorf("invalid opsgenie receiver config, api_key: %s", c.APIKey)
+	}
+	for _, r := range c.Responders {
+		if err := r.Validate(); err != nil {
+			return fmt.Errorf("invalid opsgenie receiver config: %w", err)
+		}
+	}
+	return nil
+}
+
+func (c *ReceiverConfig) AsMap() map[string]interface{} {
+	m := map[string]interface{}{
+		"api_key": c.APIKey,
+	}
+	if len(c.Responders) != 0 {
+		responders := []map[string]interface{}{}
+		for _, r := range c.Responders {
+			responders = append(responders, r.AsMap())
+		}
+		m["responders"] = responders
+	}
+	return m
+}
+
+type NotificationConfig struct {
+	ReceiverConfig `mapstructure:",squash"`
+}
+
+func (c *NotificationConfig) AsMap() map[string]interface{} {
+	return c.ReceiverConfig.AsMap()
+}
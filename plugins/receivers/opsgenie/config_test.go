@@ -0,0 +1,75 @@
+package opsgenie
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/odpf/siren/pkg/secret"
+)
+
+func TestReceiverConfig(t *testing.T) {
+	t.Run("validate", func(t *testing.T) {
+		testCases := []struct {
+			name    string
+			c       ReceiverConfig
+			wantErr bool
+		}{
+			{
+				name:    "return error if one of required field is missing",
+				wantErr: true,
+			},
+			{
+				name: "return error if responder type is not supported",
+				c: ReceiverConfig{
+					APIKey:     "api-key",
+					Responders: []Responder{{Type: "group", Name: "odpf"}},
+				},
+				wantErr: true,
+			},
+			{
+				name: "return error if responder has no identifier",
+				c: ReceiverConfig{
+					APIKey:     "api-key",
+					Responders: []Responder{{Type: ResponderTypeUser, Name: "odpf"}},
+				},
+				wantErr: true,
+			},
+			{
+				name: "return nil if all required fields are present",
+				c: ReceiverConfig{
+					APIKey: "api-key",
+					Responders: []Responder{
+						{Type: ResponderTypeTeam, Name: "odpf"},
+						{Type: ResponderTypeUser, Username: "user@odpf.io"},
+					},
+				},
+				wantErr: false,
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				if err := tc.c.Validate(); (err != nil) != tc.wantErr {
+					t.Errorf("ReceiverConfig.Validate() error = %v, wantErr %v", err, tc.wantErr)
+				}
+			})
+		}
+	})
+
+	t.Run("AsMap", func(t *testing.T) {
+		c := ReceiverConfig{
+			APIKey: "api-key",
+			Responders: []Responder{
+				{Type: ResponderTypeTeam, Name: "odpf"},
+			},
+		}
+
+		if diff := cmp.Diff(map[string]interface{}{
+			"api_key": secret.MaskableString("api-key"),
+			"responders": []map[string]interface{}{
+				{"type": "team", "name": "odpf"},
+			},
+		}, c.AsMap()); diff != "" {
+			t.Errorf("result not match\n%v", diff)
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package opsgenie
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	ResponderTypeTeam       = "team"
+	ResponderTypeUser       = "user"
+	ResponderTypeEscalation = "escalation"
+	ResponderTypeSchedule   = "schedule"
+)
+
+// https://docs.opsgenie.com/docs/alert-api#create-alert
+type Message struct {
+	Message     string            `mapstructure:"message" yaml:"message,omitempty" json:"message"`
+	Alias       string            `mapstructure:"alias" yaml:"alias,omitempty" json:"alias,omitempty"`
+	Description string            `mapstructure:"description" yaml:"description,omitempty" json:"description,omitempty"`
+	Responders  []Responder       `mapstructure:"responders" yaml:"responders,omitempty" json:"responders,omitempty"`
+	Actions     []string          `mapstructure:"actions" yaml:"actions,omitempty" json:"actions,omitempty"`
+	Tags        []string          `mapstructure:"tags" yaml:"tags,omitempty" json:"tags,omitempty"`
+	Details     map[string]string `mapstructure:"details" yaml:"details,omitempty" json:"details,omitempty"`
+	Entity      string            `mapstructure:"entity" yaml:"entity,omitempty" json:"entity,omitempty"`
+	Source      string            `mapstructure:"source" yaml:"source,omitempty" json:"source,omitempty"`
+	Priority    string            `mapstructure:"priority" yaml:"priority,omitempty" json:"priority,omitempty"`
+	Note        string            `mapstructure:"note" yaml:"note,omitempty" json:"note,omitempty"`
+}
+
+func (m Message) Validate() error {
+	if m.Message == "" {
+		return errors.New("opsgenie message has no message")
+	}
+	return nil
+}
+
+// CloseMessage is the request body to close an alert
+// https://docs.opsgenie.com/docs/alert-api#close-alert
+type CloseMessage struct {
+	Source string `json:"source,omitempty"`
+	Note   string `json:"note,omitempty"`
+}
+
+// Responder is a team, user, escalation, or schedule that is notified of an alert
+// a responder is identified by either its id or its name (username for user)
+type Responder struct {
+	Type     string `mapstructure:"type" yaml:"type,omitempty" json:"type"`
+	ID       string `mapstructure:"id" yaml:"id,omitempty" json:"id,omitempty"`
+	Name     string `mapstructure:"name" yaml:"name,omitempty" json:"name,omitempty"`
+	Username string `mapstructure:"username" yaml:"username,omitempty" json:"username,omitempty"`
+}
+
+func (r Responder) Validate() error {
+	switch r.Type {
+	case ResponderTypeTeam, ResponderTypeEscalation, ResponderTypeSchedule:
+		if r.ID == "" && r.Name == "" {
+			return fmt.Errorf("responder %s needs id or name", r.Type)
+		}
+	case ResponderTypeUser:
+		if r.ID == "" && r.Username == "" {
+			return fmt.Errorf("responder %s needs id or username", r.Type)
+		}
+	default:
+		return fmt.Errorf("unsupported responder type: %s", r.Type)
+	}
+	return nil
+}
+
+func (r Responder) AsMap() map[string]interface{} {
+	m := map[string]interface{}{
+		"type": r.Type,
+	}
+	if r.ID != "" {
+		m["id"] = r.ID
+	}
+	if r.Name != "" {
+		m["name"] = r.Name
+	}
+	if r.Username != "" {
+		m["username"] = r.Username
+	}
+	return m
+}
@@ -0,0 +1,132 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	secret "github.com/odpf/siren/pkg/secret"
+	opsgenie "github.com/odpf/siren/plugins/receivers/opsgenie"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OpsgenieCaller is an autogenerated mock type for the OpsgenieCaller type
+type OpsgenieCaller struct {
+	mock.Mock
+}
+
+type OpsgenieCaller_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OpsgenieCaller) EXPECT() *OpsgenieCaller_Expecter {
+	return &OpsgenieCaller_Expecter{mock: &_m.Mock}
+}
+
+// CloseAlert provides a mock function with given fields: ctx, apiKey, alias, message
+func (_m *OpsgenieCaller) CloseAlert(ctx context.Context, apiKey secret.MaskableString, alias string, message opsgenie.CloseMessage) (string, error) {
+	ret := _m.Called(ctx, apiKey, alias, message)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, secret.MaskableString, string, opsgenie.CloseMessage) string); ok {
+		r0 = rf(ctx, apiKey, alias, message)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, secret.MaskableString, string, opsgenie.CloseMessage) error); ok {
+		r1 = rf(ctx, apiKey, alias, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OpsgenieCaller_CloseAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseAlert'
+type OpsgenieCaller_CloseAlert_Call struct {
+	*mock.Call
+}
+
+// CloseAlert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - apiKey secret.MaskableString
+//   - alias string
+//   - message opsgenie.CloseMessage
+func (_e *OpsgenieCaller_Expecter) CloseAlert(ctx interface{}, apiKey interface{}, alias interface{}, message interface{}) *OpsgenieCaller_CloseAlert_Call {
+	return &OpsgenieCaller_CloseAlert_Call{Call: _e.mock.On("CloseAlert", ctx, apiKey, alias, message)}
+}
+
+func (_c *OpsgenieCaller_CloseAlert_Call) Run(run func(ctx context.Context, apiKey secret.MaskableString, alias string, message opsgenie.CloseMessage)) *OpsgenieCaller_CloseAlert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secret.MaskableString), args[2].(string), args[3].(opsgenie.CloseMessage))
+	})
+	return _c
+}
+
+func (_c *OpsgenieCaller_CloseAlert_Call) Return(_a0 string, _a1 error) *OpsgenieCaller_CloseAlert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// CreateAlert provides a mock function with given fields: ctx, apiKey, message
+func (_m *OpsgenieCaller) CreateAlert(ctx context.Context, apiKey secret.MaskableString, message opsgenie.Message) (string, error) {
+	ret := _m.Called(ctx, apiKey, message)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, secret.MaskableString, opsgenie.Message) string); ok {
+		r0 = rf(ctx, apiKey, message)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, secret.MaskableString, opsgenie.Message) error); ok {
+		r1 = rf(ctx, apiKey, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OpsgenieCaller_CreateAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAlert'
+type OpsgenieCaller_CreateAlert_Call struct {
+	*mock.Call
+}
+
+// CreateAlert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - apiKey secret.MaskableString
+//   - message opsgenie.Message
+func (_e *OpsgenieCaller_Expecter) CreateAlert(ctx interface{}, apiKey interface{}, message interface{}) *OpsgenieCaller_CreateAlert_Call {
+	return &OpsgenieCaller_CreateAlert_Call{Call: _e.mock.On("CreateAlert", ctx, apiKey, message)}
+}
+
+func (_c *OpsgenieCaller_CreateAlert_Call) Run(run func(ctx context.Context, apiKey secret.MaskableString, message opsgenie.Message)) *OpsgenieCaller_CreateAlert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secret.MaskableString), args[2].(opsgenie.Message))
+	})
+	return _c
+}
+
+func (_c *OpsgenieCaller_CreateAlert_Call) Return(_a0 string, _a1 error) *OpsgenieCaller_CreateAlert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+type mockConstructorTestingTNewOpsgenieCaller interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewOpsgenieCaller creates a new instance of OpsgenieCaller. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewOpsgenieCaller(t mockConstructorTestingTNewOpsgenieCaller) *OpsgenieCaller {
+	mock := &OpsgenieCaller{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
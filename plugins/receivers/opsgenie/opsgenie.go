@@ -0,0 +1,13 @@
+package opsgenie
+
+import (
+	"context"
+
+	"github.com/odpf/siren/pkg/secret"
+)
+
+//go:generate mockery --name=OpsgenieCaller -r --case underscore --with-expecter --structname OpsgenieCaller --filename opsgenie_caller.go --output=./mocks
+type OpsgenieCaller interface {
+	CreateAlert(ctx context.Context, apiKey secret.MaskableString, message Message) (string, error)
+	CloseAlert(ctx context.Context, apiKey secret.MaskableString, alias string, message CloseMessage) (string, error)
+}
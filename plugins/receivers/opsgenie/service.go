@@ -0,0 +1,122 @@
+package opsgenie
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/odpf/siren/core/notification"
+	"github.com/odpf/siren/pkg/errors"
+	"github.com/odpf/siren/pkg/httpclient"
+	"github.com/odpf/siren/pkg/retry"
+	"github.com/odpf/siren/plugins/receivers/base"
+)
+
+const (
+	defaultSource   = "Siren"
+	defaultPriority = "P3"
+)
+
+type PluginService struct {
+	base.UnimplementedService
+	client     OpsgenieCaller
+	httpClient *httpclient.Client
+	retrier    retry.Runner
+}
+
+func NewPluginService(cfg AppConfig, opts ...ServiceOption) *PluginService {
+	s := &PluginService{}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if s.client == nil {
+		s.client = NewClient(cfg, ClientWithHTTPClient(s.httpClient), ClientWithRetrier(s.retrier))
+	}
+
+	return s
+}
+
+func (s *PluginService) PreHookDBTransformConfigs(ctx context.Context, configurations map[string]interface{}) (map[string]interface{}, error) {
+	receiverConfig := &ReceiverConfig{}
+	if err := mapstructure.Decode(configurations, receiverConfig); err != nil {
+		return nil, fmt.Errorf("failed to transform configurations to receiver config: %w", err)
+	}
+
+	if err := receiverConfig.Validate(); err != nil {
+		return nil, errors.ErrInvalid.WithMsgf(err.Error())
+	}
+
+	return receiverConfig.AsMap(), nil
+}
+
+func (s *PluginService) PreHookQueueTransformConfigs(ctx context.Context, notificationConfigMap map[string]interface{}) (map[string]interface{}, error) {
+	notificationConfig := &NotificationConfig{}
+	if err := mapstructure.Decode(notificationConfigMap, notificationConfig); err != nil {
+		return nil, fmt.Errorf("failed to transform configurations to opsgenie notification config: %w", err)
+	}
+
+	if err := notificationConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	return notificationConfig.AsMap(), nil
+}
+
+// Send creates an opsgenie alert of a firing alert and closes it once the alert is resolved
+// the alert fingerprint is used as the alias so the same opsgenie alert is closed
+func (s *PluginService) Send(ctx context.Context, notificationMessage notification.Message) (string, bool, error) {
+	notificationConfig := &NotificationConfig{}
+	if err := mapstructure.Decode(notificationMessage.Configs, notificationConfig); err != nil {
+		return "", false, err
+	}
+
+	ogMessage := &Message{}
+	if err := mapstructure.Decode(notificationMessage.Details, ogMessage); err != nil {
+		return "", false, err
+	}
+
+	if fingerprint := notificationMessage.AlertFingerprint(); fingerprint != "" {
+		ogMessage.Alias = fingerprint
+	}
+
+	var (
+		requestID string
+		err       error
+	)
+	if notificationMessage.IsAlertResolved() {
+		if ogMessage.Alias == "" {
+			return "", false, errors.New("failed to close opsgenie alert: alert has no alias")
+		}
+		requestID, err = s.client.CloseAlert(ctx, notificationConfig.APIKey, ogMessage.Alias, CloseMessage{
+			Source: defaultSource,
+			Note:   ogMessage.Note,
+		})
+	} else {
+		if err := ogMessage.Validate(); err != nil {
+			return "", false, err
+		}
+		if ogMessage.Source == "" {
+			ogMessage.Source = defaultSource
+		}
+		if ogMessage.Priority == "" {
+			ogMessage.Priority = defaultPriority
+		}
+		ogMessage.Responders = append(ogMessage.Responders, notificationConfig.Responders...)
+		requestID, err = s.client.CreateAlert(ctx, notificationConfig.APIKey, *ogMessage)
+	}
+	if err != nil {
+		if errors.As(err, new(retry.RetryableError)) {
+			return "", true, err
+		} else {
+			return "", false, err
+		}
+	}
+
+	return requestID, false, nil
+}
+
+func (s *PluginService) GetSystemDefaultTemplate(notificationConfigMap map[string]interface{}) string {
+	return defaultAlertTemplateBody
+}
@@ -0,0 +1,228 @@
+package opsgenie_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/odpf/siren/core/notification"
+	"github.com/odpf/siren/core/template"
+	"github.com/odpf/siren/pkg/errors"
+	"github.com/odpf/siren/pkg/retry"
+	"github.com/odpf/siren/pkg/secret"
+	"github.com/odpf/siren/plugins/receivers/opsgenie"
+	"github.com/odpf/siren/plugins/receivers/opsgenie/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gopkg.in/yaml.v3"
+)
+
+func TestService_Send(t *testing.T) {
+	var (
+		configs = map[string]interface{}{
+			"api_key": "api-key",
+			"responders": []map[string]interface{}{
+				{"type": "team", "name": "odpf"},
+			},
+		}
+		firingMessage = notification.Message{
+			Configs: configs,
+			Details: map[string]interface{}{
+				"message":                               "cpu-high",
+				"priority":                              "P1",
+				notification.DetailsKeyAlertFingerprint: "some-fingerprint",
+				notification.DetailsKeyAlertStatus:      "firing",
+			},
+		}
+		resolvedMessage = notification.Message{
+			Configs: configs,
+			Details: map[string]interface{}{
+				"message":                               "cpu-high",
+				notification.DetailsKeyAlertFingerprint: "some-fingerprint",
+				notification.DetailsKeyAlertStatus:      "resolved",
+			},
+		}
+	)
+	tests := []struct {
+		name                string
+		setup               func(*mocks.OpsgenieCaller)
+		notificationMessage notification.Message
+		wantRequestID       string
+		wantRetryable       bool
+		wantErr             bool
+	}{
+		{
+			name: "should return error if failed to decode notification config",
+			notificationMessage: notification.Message{
+				Configs: map[string]interface{}{
+					"api_key": true,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return error if message is invalid",
+			notificationMessage: notification.Message{
+				Configs: configs,
+			},
+			wantErr: true,
+		},
+		{
+			name: "should create alert with fingerprint alias and receiver responders",
+			setup: func(oc *mocks.OpsgenieCaller) {
+				oc.EXPECT().CreateAlert(mock.AnythingOfType("*context.emptyCtx"), secret.MaskableString("api-key"), opsgenie.Message{
+					Message:    "cpu-high",
+					Alias:      "some-fingerprint",
+					Priority:   "P1",
+					Source:     "Siren",
+					Responders: []opsgenie.Responder{{Type: "team", Name: "odpf"}},
+				}).Return("request-id", nil)
+			},
+			notificationMessage: firingMessage,
+			wantRequestID:       "request-id",
+		},
+		{
+			name: "should return error and retryable if create alert return retryable error",
+			setup: func(oc *mocks.OpsgenieCaller) {
+				oc.EXPECT().CreateAlert(mock.AnythingOfType("*context.emptyCtx"), secret.MaskableString("api-key"), mock.AnythingOfType("opsgenie.Message")).Return("", retry.RetryableError{Err: errors.New("some error")})
+			},
+			notificationMessage: firingMessage,
+			wantRetryable:       true,
+			wantErr:             true,
+		},
+		{
+			name: "should close alert with fingerprint alias if alert is resolved",
+			setup: func(oc *mocks.OpsgenieCaller) {
+				oc.EXPECT().CloseAlert(mock.AnythingOfType("*context.emptyCtx"), secret.MaskableString("api-key"), "some-fingerprint", opsgenie.CloseMessage{Source: "Siren"}).Return("request-id", nil)
+			},
+			notificationMessage: resolvedMessage,
+			wantRequestID:       "request-id",
+		},
+		{
+			name: "should return error and not retryable if close alert return error",
+			setup: func(oc *mocks.OpsgenieCaller) {
+				oc.EXPECT().CloseAlert(mock.AnythingOfType("*context.emptyCtx"), secret.MaskableString("api-key"), "some-fingerprint", mock.AnythingOfType("opsgenie.CloseMessage")).Return("", errors.New("some error"))
+			},
+			notificationMessage: resolvedMessage,
+			wantRetryable:       false,
+			wantErr:             true,
+		},
+		{
+			name: "should return error if resolved alert has no alias",
+			notificationMessage: notification.Message{
+				Configs: configs,
+				Details: map[string]interface{}{
+					"message":                          "cpu-high",
+					notification.DetailsKeyAlertStatus: "resolved",
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mockOpsgenieClient = new(mocks.OpsgenieCaller)
+			)
+
+			if tt.setup != nil {
+				tt.setup(mockOpsgenieClient)
+			}
+
+			s := opsgenie.NewPluginService(opsgenie.AppConfig{}, opsgenie.WithOpsgenieClient(mockOpsgenieClient))
+
+			requestID, got, err := s.Send(context.Background(), tt.notificationMessage)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.Send() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.wantRetryable {
+				t.Errorf("Service.Send() = %v, want %v", got, tt.wantRetryable)
+			}
+			if requestID != tt.wantRequestID {
+				t.Errorf("Service.Send() requestID = %v, want %v", requestID, tt.wantRequestID)
+			}
+			mockOpsgenieClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_GetSystemDefaultTemplate(t *testing.T) {
+	tests := []struct {
+		name         string
+		severity     string
+		wantPriority string
+	}{
+		{
+			name:         "should map critical severity to P1",
+			severity:     "CRITICAL",
+			wantPriority: "P1",
+		},
+		{
+			name:         "should map warning severity to P3",
+			severity:     "WARNING",
+			wantPriority: "P3",
+		},
+		{
+			name:         "should map lowercase critical severity to P1",
+			severity:     "critical",
+			wantPriority: "P1",
+		},
+		{
+			name:         "should map lowercase warning severity to P3",
+			severity:     "warning",
+			wantPriority: "P3",
+		},
+		{
+			name:         "should map other severity to P5",
+			severity:     "INFO",
+			wantPriority: "P5",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := opsgenie.NewPluginService(opsgenie.AppConfig{})
+
+			rendered, err := template.RenderBody(s.GetSystemDefaultTemplate(nil), notification.Notification{
+				Data: map[string]interface{}{
+					"status":    "firing",
+					"summary":   "cpu usage is high",
+					"resource":  "host-1",
+					"dashboard": "http://dashboard",
+				},
+				Labels: map[string]string{
+					"severity":  tt.severity,
+					"alertname": "cpu-high",
+					"team":      "odpf",
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var details map[string]interface{}
+			if err := yaml.Unmarshal([]byte(rendered), &details); err != nil {
+				t.Fatalf("failed to unmarshal rendered template: %v\n%s", err, rendered)
+			}
+
+			msg := opsgenie.Message{}
+			if err := mapstructure.Decode(details, &msg); err != nil {
+				t.Fatal(err)
+			}
+
+			assert.NoError(t, msg.Validate())
+			assert.Equal(t, tt.wantPriority, msg.Priority)
+			assert.Equal(t, strings.ToUpper(tt.severity)+" cpu-high", msg.Message)
+			assert.Equal(t, "cpu usage is high\n", msg.Description)
+			assert.Equal(t, "host-1", msg.Entity)
+			assert.Equal(t, []string{tt.severity, "odpf"}, msg.Tags)
+			assert.Equal(t, map[string]string{
+				"alertname": "cpu-high",
+				"severity":  tt.severity,
+				"team":      "odpf",
+				"dashboard": "http://dashboard",
+			}, msg.Details)
+		})
+	}
+}
@@ -0,0 +1,8 @@
+package opsgenie
+
+import _ "embed"
+
+var (
+	//go:embed config/default_alert_template_body.goyaml
+	defaultAlertTemplateBody string
+)
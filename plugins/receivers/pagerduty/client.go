@@ -23,6 +23,12 @@ type eventsV1HTTPResponse struct {
 	IncidentKey string `json:"incident_key"`
 }
 
+type eventsV2HTTPResponse struct {
+	Status   string `json:"status"`
+	Message  string `json:"message"`
+	DedupKey string `json:"dedup_key"`
+}
+
 type ClientOption func(*Client)
 
 // ClientWithHTTPClient assigns custom client when creating a pagerduty client
@@ -65,61 +71,130 @@ func NewClient(cfg AppConfig, opts ...ClientOption) *Client {
 	return c
 }
 
-func (c *Client) NotifyV1(ctx context.Context, msg MessageV1) error {
+// NotifyV1 sends an event to pagerduty events API v1 and returns the incident key of the event
+func (c *Client) NotifyV1(ctx context.Context, msg MessageV1) (string, error) {
 	if c.retrier != nil {
+		var incidentKey string
 		if err := c.retrier.Run(ctx, func(ctx context.Context) error {
-			return c.notifyV1(ctx, msg)
-		}); err != nil {
+			var err error
+			incidentKey, err = c.notifyV1(ctx, msg)
 			return err
+		}); err != nil {
+			return "", err
 		}
+		return incidentKey, nil
 	}
 	return c.notifyV1(ctx, msg)
 }
 
-func (c *Client) notifyV1(ctx context.Context, message MessageV1) error {
+func (c *Client) notifyV1(ctx context.Context, message MessageV1) (string, error) {
 	// TODO need to sanitize body first?
 	messageJSON, err := json.Marshal(message)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.APIHost+"/generic/2010-04-15/create_event.json", bytes.NewReader(messageJSON))
 	if err != nil {
-		return fmt.Errorf("failed to create request body: %w", err)
+		return "", fmt.Errorf("failed to create request body: %w", err)
 	}
 
 	resp, err := c.httpClient.HTTP().Do(req)
 	if err != nil {
-		return fmt.Errorf("failure in http call: %w", err)
+		return "", fmt.Errorf("failure in http call: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == 429 || resp.StatusCode >= 500 {
-		return retry.RetryableError{Err: errors.New(http.StatusText(resp.StatusCode))}
+		return "", retry.RetryableError{Err: errors.New(http.StatusText(resp.StatusCode))}
 	}
 
 	if resp.StatusCode >= 300 {
 		bodyBytes, err := io.ReadAll(resp.Body)
 		if err != nil {
-			return fmt.Errorf("error with status code %s without response body", http.StatusText(resp.StatusCode))
+			return "", fmt.Errorf("error with status code %s without response body", http.StatusText(resp.StatusCode))
 		}
-		return fmt.Errorf("error with status code %s and body %s", http.StatusText(resp.StatusCode), string(bodyBytes))
-	} else {
-		// Status code 2xx only
+		return "", fmt.Errorf("error with status code %s and body %s", http.StatusText(resp.StatusCode), string(bodyBytes))
+	}
+
+	// Status code 2xx only
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	apiResponse := eventsV1HTTPResponse{}
+	if err = json.Unmarshal(bodyBytes, &apiResponse); err != nil {
+		return "", fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	if apiResponse.Status != "success" {
+		return "", fmt.Errorf("something wrong when sending pagerduty event: %v", apiResponse)
+	}
+
+	return apiResponse.IncidentKey, nil
+}
+
+// NotifyV2 sends an event to pagerduty events API v2 and returns the dedup key of the event
+func (c *Client) NotifyV2(ctx context.Context, msg MessageV2) (string, error) {
+	if c.retrier != nil {
+		var dedupKey string
+		if err := c.retrier.Run(ctx, func(ctx context.Context) error {
+			var err error
+			dedupKey, err = c.notifyV2(ctx, msg)
+			return err
+		}); err != nil {
+			return "", err
+		}
+		return dedupKey, nil
+	}
+	return c.notifyV2(ctx, msg)
+}
+
+func (c *Client) notifyV2(ctx context.Context, message MessageV2) (string, error) {
+	messageJSON, err := json.Marshal(message)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.APIHost+"/v2/enqueue", bytes.NewReader(messageJSON))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request body: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.HTTP().Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failure in http call: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 429 || resp.StatusCode >= 500 {
+		return "", retry.RetryableError{Err: errors.New(http.StatusText(resp.StatusCode))}
+	}
+
+	if resp.StatusCode >= 300 {
 		bodyBytes, err := io.ReadAll(resp.Body)
 		if err != nil {
-			return fmt.Errorf("failed to read response body: %w", err)
+			return "", fmt.Errorf("error with status code %s without response body", http.StatusText(resp.StatusCode))
 		}
+		return "", fmt.Errorf("error with status code %s and body %s", http.StatusText(resp.StatusCode), string(bodyBytes))
+	}
 
-		apiResponse := eventsV1HTTPResponse{}
-		if err = json.Unmarshal(bodyBytes, &apiResponse); err != nil {
-			return fmt.Errorf("failed to unmarshal response body: %w", err)
-		}
+	// Status code 2xx only
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response body: %w", err)
+	}
 
-		if apiResponse.Status != "success" {
-			return fmt.Errorf("something wrong when sending pagerduty event: %v", apiResponse)
-		}
+	apiResponse := eventsV2HTTPResponse{}
+	if err = json.Unmarshal(bodyBytes, &apiResponse); err != nil {
+		return "", fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	if apiResponse.Status != "success" {
+		return "", fmt.Errorf("something wrong when sending pagerduty event: %v", apiResponse)
 	}
 
-	return nil
+	return apiResponse.DedupKey, nil
 }
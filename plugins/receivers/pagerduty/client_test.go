@@ -2,6 +2,7 @@ package pagerduty_test
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -39,7 +40,7 @@ func TestClient_NotifyV1_WithoutRetrier(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			c := pagerduty.NewClient(tc.cfg)
-			if err := c.NotifyV1(tc.ctx, tc.message); (err != nil) != tc.wantErr {
+			if _, err := c.NotifyV1(tc.ctx, tc.message); (err != nil) != tc.wantErr {
 				t.Errorf("Client.Notify() error = %v, wantErr %v", err, tc.wantErr)
 			}
 		})
@@ -53,7 +54,7 @@ func TestClient_NotifyV1_HTTPCall(t *testing.T) {
 		}))
 
 		c := pagerduty.NewClient(pagerduty.AppConfig{APIHost: testServer.URL})
-		err := c.NotifyV1(context.Background(), pagerduty.MessageV1{})
+		_, err := c.NotifyV1(context.Background(), pagerduty.MessageV1{})
 
 		assert.EqualError(t, err, "Too Many Requests")
 
@@ -66,7 +67,7 @@ func TestClient_NotifyV1_HTTPCall(t *testing.T) {
 		}))
 
 		c := pagerduty.NewClient(pagerduty.AppConfig{APIHost: testServer.URL})
-		err := c.NotifyV1(context.Background(), pagerduty.MessageV1{})
+		_, err := c.NotifyV1(context.Background(), pagerduty.MessageV1{})
 
 		assert.EqualError(t, err, "error with status code Bad Request and body ")
 
@@ -79,7 +80,7 @@ func TestClient_NotifyV1_HTTPCall(t *testing.T) {
 		}))
 
 		c := pagerduty.NewClient(pagerduty.AppConfig{APIHost: testServer.URL})
-		err := c.NotifyV1(context.Background(), pagerduty.MessageV1{})
+		_, err := c.NotifyV1(context.Background(), pagerduty.MessageV1{})
 
 		assert.EqualError(t, err, "failed to read response body: unexpected EOF")
 
@@ -92,7 +93,7 @@ func TestClient_NotifyV1_HTTPCall(t *testing.T) {
 		}))
 
 		c := pagerduty.NewClient(pagerduty.AppConfig{APIHost: testServer.URL})
-		err := c.NotifyV1(context.Background(), pagerduty.MessageV1{})
+		_, err := c.NotifyV1(context.Background(), pagerduty.MessageV1{})
 
 		assert.EqualError(t, err, "failed to read response body: unexpected EOF")
 
@@ -105,7 +106,7 @@ func TestClient_NotifyV1_HTTPCall(t *testing.T) {
 		}))
 
 		c := pagerduty.NewClient(pagerduty.AppConfig{APIHost: testServer.URL})
-		err := c.NotifyV1(context.Background(), pagerduty.MessageV1{})
+		_, err := c.NotifyV1(context.Background(), pagerduty.MessageV1{})
 
 		assert.EqualError(t, err, "failed to unmarshal response body: invalid character '/' looking for beginning of object key string")
 
@@ -118,22 +119,23 @@ func TestClient_NotifyV1_HTTPCall(t *testing.T) {
 		}))
 
 		c := pagerduty.NewClient(pagerduty.AppConfig{APIHost: testServer.URL})
-		err := c.NotifyV1(context.Background(), pagerduty.MessageV1{})
+		_, err := c.NotifyV1(context.Background(), pagerduty.MessageV1{})
 
 		assert.EqualError(t, err, "something wrong when sending pagerduty event: {failed  }")
 
 		testServer.Close()
 	})
 
-	t.Run("should return error if response status is not success", func(t *testing.T) {
+	t.Run("should return incident key if response status is success", func(t *testing.T) {
 		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.Write([]byte(`{"status":"success"}`))
+			w.Write([]byte(`{"status":"success","incident_key":"some-incident-key"}`))
 		}))
 
 		c := pagerduty.NewClient(pagerduty.AppConfig{APIHost: testServer.URL})
-		err := c.NotifyV1(context.Background(), pagerduty.MessageV1{})
+		incidentKey, err := c.NotifyV1(context.Background(), pagerduty.MessageV1{})
 
 		assert.NoError(t, err)
+		assert.Equal(t, "some-incident-key", incidentKey)
 
 		testServer.Close()
 	})
@@ -153,7 +155,7 @@ func TestClient_NotifyV1_WithRetrier(t *testing.T) {
 		c := pagerduty.NewClient(pagerduty.AppConfig{APIHost: testServer.URL},
 			pagerduty.ClientWithRetrier(retry.New(retry.Config{Enable: true})),
 		)
-		_ = c.NotifyV1(context.Background(), pagerduty.MessageV1{})
+		_, _ = c.NotifyV1(context.Background(), pagerduty.MessageV1{})
 
 		assert.Equal(t, expectedCounter, counter)
 
@@ -171,7 +173,7 @@ func TestClient_NotifyV1_WithRetrier(t *testing.T) {
 		c := pagerduty.NewClient(pagerduty.AppConfig{APIHost: testServer.URL},
 			pagerduty.ClientWithRetrier(retry.New(retry.Config{Enable: true})),
 		)
-		_ = c.NotifyV1(context.Background(), pagerduty.MessageV1{})
+		_, _ = c.NotifyV1(context.Background(), pagerduty.MessageV1{})
 
 		assert.Equal(t, expectedCounter, counter)
 
@@ -179,3 +181,96 @@ func TestClient_NotifyV1_WithRetrier(t *testing.T) {
 	})
 
 }
+
+func TestClient_NotifyV2_HTTPCall(t *testing.T) {
+	t.Run("should return error if error response is retryable", func(t *testing.T) {
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+
+		c := pagerduty.NewClient(pagerduty.AppConfig{APIHost: testServer.URL})
+		_, err := c.NotifyV2(context.Background(), pagerduty.MessageV2{})
+
+		assert.ErrorAs(t, err, new(retry.RetryableError))
+		assert.EqualError(t, err, "Too Many Requests")
+
+		testServer.Close()
+	})
+
+	t.Run("should return error if error response is non retryable", func(t *testing.T) {
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"status":"invalid event","message":"Event object is invalid"}`))
+		}))
+
+		c := pagerduty.NewClient(pagerduty.AppConfig{APIHost: testServer.URL})
+		_, err := c.NotifyV2(context.Background(), pagerduty.MessageV2{})
+
+		assert.EqualError(t, err, `error with status code Bad Request and body {"status":"invalid event","message":"Event object is invalid"}`)
+
+		testServer.Close()
+	})
+
+	t.Run("should return error if response can't be unmarshalled", func(t *testing.T) {
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{//x`))
+		}))
+
+		c := pagerduty.NewClient(pagerduty.AppConfig{APIHost: testServer.URL})
+		_, err := c.NotifyV2(context.Background(), pagerduty.MessageV2{})
+
+		assert.EqualError(t, err, "failed to unmarshal response body: invalid character '/' looking for beginning of object key string")
+
+		testServer.Close()
+	})
+
+	t.Run("should send event to enqueue endpoint and return dedup key", func(t *testing.T) {
+		var (
+			gotPath string
+			gotBody map[string]interface{}
+		)
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotPath = r.URL.Path
+			_ = json.NewDecoder(r.Body).Decode(&gotBody)
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"status":"success","message":"Event processed","dedup_key":"some-dedup-key"}`))
+		}))
+
+		c := pagerduty.NewClient(pagerduty.AppConfig{APIHost: testServer.URL})
+		dedupKey, err := c.NotifyV2(context.Background(), pagerduty.MessageV2{
+			RoutingKey:  "routing-key",
+			EventAction: "trigger",
+			Payload: &pagerduty.PayloadV2{
+				Summary:  "summary",
+				Source:   "source",
+				Severity: "critical",
+				CustomDetails: map[string]interface{}{
+					"key": "value",
+				},
+			},
+			Links: []pagerduty.LinkV2{{Href: "http://dashboard", Text: "Dashboard"}},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "some-dedup-key", dedupKey)
+		assert.Equal(t, "/v2/enqueue", gotPath)
+		assert.Equal(t, map[string]interface{}{
+			"routing_key":  "routing-key",
+			"event_action": "trigger",
+			"payload": map[string]interface{}{
+				"summary":  "summary",
+				"source":   "source",
+				"severity": "critical",
+				"custom_details": map[string]interface{}{
+					"key": "value",
+				},
+			},
+			"links": []interface{}{
+				map[string]interface{}{"href": "http://dashboard", "text": "Dashboard"},
+			},
+		}, gotBody)
+
+		testServer.Close()
+	})
+}
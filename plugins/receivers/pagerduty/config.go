@@ -23,22 +23,51 @@ func (c AppConfig) Validate() error {
 	return nil
 }
 
-// TODO need to support versioning later v1 and v2
+const (
+	APIVersionV1 = "v1"
+	APIVersionV2 = "v2"
+)
+
+// ReceiverConfig is a stored config for a pagerduty receiver
+// service_key is used to send events API v1 and routing_key is used to send events API v2
 type ReceiverConfig struct {
 	ServiceKey secret.MaskableString `mapstructure:"service_key"`
+	RoutingKey secret.MaskableString `mapstructure:"routing_key"`
+	Version    string                `mapstructure:"version"`
 }
 
 func (c *ReceiverConfig) Validate() error {
-	if c.ServiceKey == "" {
-		return fmt.Errorf("invalid pagerduty receiver config, service_key: %s", c.ServiceKey)
+	switch c.Version {
+	case "", APIVersionV1:
+		if c.ServiceKey == "" {
+			return fmt.Errorf("invalid pagerduty receiver config, service_key: %s", c.ServiceKey)
+		}
+	case APIVersionV2:
+		if c.RoutingKey == "" {
+			return fmt.Errorf("invalid pagerduty receiver config, routing_key: %s", c.RoutingKey)
+		}
+	default:
+		return fmt.Errorf("invalid pagerduty receiver config, unsupported version: %s", c.Version)
 	}
 	return nil
 }
 
+// IsV2 returns true if the receiver sends events with events API v2
+func (c *ReceiverConfig) IsV2() bool {
+	return c.Version == APIVersionV2
+}
+
 func (c *ReceiverConfig) AsMap() map[string]interface{} {
-	return map[string]interface{}{
+	m := map[string]interface{}{
 		"service_key": c.ServiceKey,
 	}
+	if c.RoutingKey != "" {
+		m["routing_key"] = c.RoutingKey
+	}
+	if c.Version != "" {
+		m["version"] = c.Version
+	}
+	return m
 }
 
 type NotificationConfig struct {
@@ -25,6 +25,30 @@ func TestReceiverConfig(t *testing.T) {
 				},
 				wantErr: false,
 			},
+			{
+				name: "return error if routing key is missing in version v2",
+				c: ReceiverConfig{
+					ServiceKey: "service_key",
+					Version:    APIVersionV2,
+				},
+				wantErr: true,
+			},
+			{
+				name: "return nil if routing key is present in version v2",
+				c: ReceiverConfig{
+					RoutingKey: "routing_key",
+					Version:    APIVersionV2,
+				},
+				wantErr: false,
+			},
+			{
+				name: "return error if version is not supported",
+				c: ReceiverConfig{
+					ServiceKey: "service_key",
+					Version:    "v3",
+				},
+				wantErr: true,
+			},
 		}
 		for _, tc := range testCases {
 			t.Run(tc.name, func(t *testing.T) {
@@ -79,4 +103,21 @@ func TestNotificationConfig(t *testing.T) {
 			t.Errorf("result not match\n%v", diff)
 		}
 	})
+
+	t.Run("AsMap with version v2", func(t *testing.T) {
+		nc := NotificationConfig{
+			ReceiverConfig: ReceiverConfig{
+				RoutingKey: secret.MaskableString("routing_key"),
+				Version:    APIVersionV2,
+			},
+		}
+
+		if diff := cmp.Diff(map[string]interface{}{
+			"service_key": secret.MaskableString(""),
+			"routing_key": secret.MaskableString("routing_key"),
+			"version":     APIVersionV2,
+		}, nc.AsMap()); diff != "" {
+			t.Errorf("result not match\n%v", diff)
+		}
+	})
 }
@@ -0,0 +1,37 @@
+package pagerduty
+
+import "github.com/odpf/siren/pkg/secret"
+
+// https://developer.pagerduty.com/docs/ZG9jOjExMDI5NTgx-send-an-alert-event
+type MessageV2 struct {
+	RoutingKey  secret.MaskableString `mapstructure:"routing_key" yaml:"routing_key,omitempty" json:"routing_key,omitempty"`
+	EventAction string                `mapstructure:"event_action" yaml:"event_action,omitempty" json:"event_action,omitempty"`
+	DedupKey    string                `mapstructure:"dedup_key" yaml:"dedup_key,omitempty" json:"dedup_key,omitempty"`
+	Payload     *PayloadV2            `mapstructure:"payload" yaml:"payload,omitempty" json:"payload,omitempty"`
+	Client      string                `mapstructure:"client" yaml:"client,omitempty" json:"client,omitempty"`
+	ClientURL   string                `mapstructure:"client_url" yaml:"client_url,omitempty" json:"client_url,omitempty"`
+	Links       []LinkV2              `mapstructure:"links" yaml:"links,omitempty" json:"links,omitempty"`
+	Images      []ImageV2             `mapstructure:"images" yaml:"images,omitempty" json:"images,omitempty"`
+}
+
+type PayloadV2 struct {
+	Summary       string                 `mapstructure:"summary" yaml:"summary,omitempty" json:"summary"`
+	Source        string                 `mapstructure:"source" yaml:"source,omitempty" json:"source"`
+	Severity      string                 `mapstructure:"severity" yaml:"severity,omitempty" json:"severity"`
+	Timestamp     string                 `mapstructure:"timestamp" yaml:"timestamp,omitempty" json:"timestamp,omitempty"`
+	Component     string                 `mapstructure:"component" yaml:"component,omitempty" json:"component,omitempty"`
+	Group         string                 `mapstructure:"group" yaml:"group,omitempty" json:"group,omitempty"`
+	Class         string                 `mapstructure:"class" yaml:"class,omitempty" json:"class,omitempty"`
+	CustomDetails map[string]interface{} `mapstructure:"custom_details" yaml:"custom_details,omitempty" json:"custom_details,omitempty"`
+}
+
+type LinkV2 struct {
+	Href string `mapstructure:"href" yaml:"href,omitempty" json:"href"`
+	Text string `mapstructure:"text" yaml:"text,omitempty" json:"text,omitempty"`
+}
+
+type ImageV2 struct {
+	Src  string `mapstructure:"src" yaml:"src,omitempty" json:"src"`
+	Href string `mapstructure:"href" yaml:"href,omitempty" json:"href,omitempty"`
+	Alt  string `mapstructure:"alt" yaml:"alt,omitempty" json:"alt,omitempty"`
+}
@@ -23,17 +23,24 @@ func (_m *PagerDutyCaller) EXPECT() *PagerDutyCaller_Expecter {
 }
 
 // NotifyV1 provides a mock function with given fields: ctx, message
-func (_m *PagerDutyCaller) NotifyV1(ctx context.Context, message pagerduty.MessageV1) error {
+func (_m *PagerDutyCaller) NotifyV1(ctx context.Context, message pagerduty.MessageV1) (string, error) {
 	ret := _m.Called(ctx, message)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, pagerduty.MessageV1) error); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, pagerduty.MessageV1) string); ok {
 		r0 = rf(ctx, message)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(string)
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, pagerduty.MessageV1) error); ok {
+		r1 = rf(ctx, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // PagerDutyCaller_NotifyV1_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyV1'
@@ -55,8 +62,53 @@ func (_c *PagerDutyCaller_NotifyV1_Call) Run(run func(ctx context.Context, messa
 	return _c
 }
 
-func (_c *PagerDutyCaller_NotifyV1_Call) Return(_a0 error) *PagerDutyCaller_NotifyV1_Call {
-	_c.Call.Return(_a0)
+func (_c *PagerDutyCaller_NotifyV1_Call) Return(_a0 string, _a1 error) *PagerDutyCaller_NotifyV1_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// NotifyV2 provides a mock function with given fields: ctx, message
+func (_m *PagerDutyCaller) NotifyV2(ctx context.Context, message pagerduty.MessageV2) (string, error) {
+	ret := _m.Called(ctx, message)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, pagerduty.MessageV2) string); ok {
+		r0 = rf(ctx, message)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, pagerduty.MessageV2) error); ok {
+		r1 = rf(ctx, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PagerDutyCaller_NotifyV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyV2'
+type PagerDutyCaller_NotifyV2_Call struct {
+	*mock.Call
+}
+
+// NotifyV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message pagerduty.MessageV2
+func (_e *PagerDutyCaller_Expecter) NotifyV2(ctx interface{}, message interface{}) *PagerDutyCaller_NotifyV2_Call {
+	return &PagerDutyCaller_NotifyV2_Call{Call: _e.mock.On("NotifyV2", ctx, message)}
+}
+
+func (_c *PagerDutyCaller_NotifyV2_Call) Run(run func(ctx context.Context, message pagerduty.MessageV2)) *PagerDutyCaller_NotifyV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pagerduty.MessageV2))
+	})
+	return _c
+}
+
+func (_c *PagerDutyCaller_NotifyV2_Call) Return(_a0 string, _a1 error) *PagerDutyCaller_NotifyV2_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
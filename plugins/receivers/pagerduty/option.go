@@ -1,6 +1,7 @@
 package pagerduty
 
 import (
+	"github.com/odpf/siren/core/notification"
 	"github.com/odpf/siren/pkg/httpclient"
 	"github.com/odpf/siren/pkg/retry"
 )
@@ -27,3 +28,10 @@ func WithPagerDutyClient(client PagerDutyCaller) ServiceOption {
 		s.client = client
 	}
 }
+
+// WithCorrelationRepository stores incident key of sent alerts so resolved alerts resolve the same incident
+func WithCorrelationRepository(repository notification.CorrelationRepository) ServiceOption {
+	return func(s *PluginService) {
+		s.correlationRepository = repository
+	}
+}
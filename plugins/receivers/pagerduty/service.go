@@ -2,25 +2,35 @@ package pagerduty
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 
 	"github.com/mitchellh/mapstructure"
+	"github.com/odpf/salt/log"
 	"github.com/odpf/siren/core/notification"
 	"github.com/odpf/siren/pkg/errors"
 	"github.com/odpf/siren/pkg/httpclient"
 	"github.com/odpf/siren/pkg/retry"
+	"github.com/odpf/siren/pkg/secret"
 	"github.com/odpf/siren/plugins/receivers/base"
 )
 
+const identifierIncidentKey = "incident_key"
+
 type PluginService struct {
 	base.UnimplementedService
-	client     PagerDutyCaller
-	httpClient *httpclient.Client
-	retrier    retry.Runner
+	logger                log.Logger
+	client                PagerDutyCaller
+	httpClient            *httpclient.Client
+	retrier               retry.Runner
+	correlationRepository notification.CorrelationRepository
 }
 
-func NewPluginService(cfg AppConfig, opts ...ServiceOption) *PluginService {
-	s := &PluginService{}
+func NewPluginService(logger log.Logger, cfg AppConfig, opts ...ServiceOption) *PluginService {
+	s := &PluginService{
+		logger: logger,
+	}
 
 	for _, opt := range opts {
 		opt(s)
@@ -60,29 +70,131 @@ func (s *PluginService) PreHookQueueTransformConfigs(ctx context.Context, notifi
 	return notificationConfig.AsMap(), nil
 }
 
-func (s *PluginService) Send(ctx context.Context, notificationMessage notification.Message) (bool, error) {
+func (s *PluginService) Send(ctx context.Context, notificationMessage notification.Message) (string, bool, error) {
 	notificationConfig := &NotificationConfig{}
 	if err := mapstructure.Decode(notificationMessage.Configs, notificationConfig); err != nil {
-		return false, err
+		return "", false, err
 	}
 
+	if notificationConfig.IsV2() {
+		return s.sendV2(ctx, *notificationConfig, notificationMessage)
+	}
+	return s.sendV1(ctx, *notificationConfig, notificationMessage)
+}
+
+func (s *PluginService) sendV1(ctx context.Context, notificationConfig NotificationConfig, notificationMessage notification.Message) (string, bool, error) {
 	pgMessageV1 := &MessageV1{}
-	if err := mapstructure.Decode(notificationMessage.Details, &pgMessageV1); err != nil {
-		return false, err
+	if err := mapstructure.Decode(notificationMessage.Details, pgMessageV1); err != nil {
+		return "", false, err
 	}
 	pgMessageV1.ServiceKey = notificationConfig.ServiceKey
 
-	if err := s.client.NotifyV1(ctx, *pgMessageV1); err != nil {
+	destination := hashIntegrationKey(notificationConfig.ServiceKey)
+
+	incidentKey, err := s.getCorrelatedKey(ctx, notificationMessage, destination)
+	if err != nil {
+		return "", true, err
+	}
+	if incidentKey != "" {
+		pgMessageV1.EventType = string(EvenActionResolve)
+		pgMessageV1.IncidentKey = incidentKey
+	}
+
+	incidentKey, err = s.client.NotifyV1(ctx, *pgMessageV1)
+	if err != nil {
+		if errors.As(err, new(retry.RetryableError)) {
+			return "", true, err
+		} else {
+			return "", false, err
+		}
+	}
+
+	s.storeCorrelatedKey(ctx, notificationMessage, destination, incidentKey)
+
+	return incidentKey, false, nil
+}
+
+func (s *PluginService) sendV2(ctx context.Context, notificationConfig NotificationConfig, notificationMessage notification.Message) (string, bool, error) {
+	pgMessageV2 := &MessageV2{}
+	if err := mapstructure.Decode(notificationMessage.Details, pgMessageV2); err != nil {
+		return "", false, err
+	}
+	pgMessageV2.RoutingKey = notificationConfig.RoutingKey
+
+	destination := hashIntegrationKey(notificationConfig.RoutingKey)
+
+	dedupKey, err := s.getCorrelatedKey(ctx, notificationMessage, destination)
+	if err != nil {
+		return "", true, err
+	}
+	if dedupKey != "" {
+		pgMessageV2.EventAction = string(EvenActionResolve)
+		pgMessageV2.DedupKey = dedupKey
+	}
+
+	dedupKey, err = s.client.NotifyV2(ctx, *pgMessageV2)
+	if err != nil {
 		if errors.As(err, new(retry.RetryableError)) {
-			return true, err
+			return "", true, err
 		} else {
-			return false, err
+			return "", false, err
+		}
+	}
+
+	s.storeCorrelatedKey(ctx, notificationMessage, destination, dedupKey)
+
+	return dedupKey, false, nil
+}
+
+// getCorrelatedKey returns the incident key of the firing alert if the message is a resolved alert
+func (s *PluginService) getCorrelatedKey(ctx context.Context, notificationMessage notification.Message, destination string) (string, error) {
+	fingerprint := notificationMessage.AlertFingerprint()
+	if s.correlationRepository == nil || fingerprint == "" || !notificationMessage.IsAlertResolved() {
+		return "", nil
+	}
+
+	correlation, err := s.correlationRepository.Get(ctx, fingerprint, notificationMessage.ReceiverType, destination)
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return "", nil
 		}
+		return "", fmt.Errorf("failed to get incident of alert %s: %w", fingerprint, err)
+	}
+
+	return correlation.Identifiers[identifierIncidentKey], nil
+}
+
+// storeCorrelatedKey stores the incident key returned by pagerduty if the message is a firing alert.
+// The event is already delivered at this point, a failure is only logged so the message is not marked failed or retried.
+func (s *PluginService) storeCorrelatedKey(ctx context.Context, notificationMessage notification.Message, destination string, incidentKey string) {
+	fingerprint := notificationMessage.AlertFingerprint()
+	if s.correlationRepository == nil || fingerprint == "" || notificationMessage.IsAlertResolved() || incidentKey == "" {
+		return
 	}
 
-	return false, nil
+	if err := s.correlationRepository.Upsert(ctx, notification.Correlation{
+		Fingerprint:  fingerprint,
+		ReceiverType: notificationMessage.ReceiverType,
+		Destination:  destination,
+		Identifiers: map[string]string{
+			identifierIncidentKey: incidentKey,
+		},
+	}); err != nil {
+		s.logger.Error("failed to store incident of alert", "fingerprint", fingerprint, "incident_key", incidentKey, "error", err)
+	}
+}
+
+// hashIntegrationKey scopes correlation per pagerduty service without storing the integration key
+func hashIntegrationKey(key secret.MaskableString) string {
+	sum := sha256.Sum256([]byte(key.UnmaskedString()))
+	return hex.EncodeToString(sum[:])
 }
 
-func (s *PluginService) GetSystemDefaultTemplate() string {
+// GetSystemDefaultTemplate returns the default template of the events API version of the receiver
+func (s *PluginService) GetSystemDefaultTemplate(notificationConfigMap map[string]interface{}) string {
+	notificationConfig := &NotificationConfig{}
+	if err := mapstructure.Decode(notificationConfigMap, notificationConfig); err == nil && notificationConfig.IsV2() {
+		return defaultAlertTemplateBodyV2
+	}
 	return defaultAlertTemplateBodyV1
 }
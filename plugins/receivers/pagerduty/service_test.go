@@ -5,17 +5,49 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/mapstructure"
+	"github.com/odpf/salt/log"
 	"github.com/odpf/siren/core/notification"
+	notificationmocks "github.com/odpf/siren/core/notification/mocks"
+	"github.com/odpf/siren/core/template"
+	sirenerrors "github.com/odpf/siren/pkg/errors"
 	"github.com/odpf/siren/pkg/retry"
 	"github.com/odpf/siren/plugins/receivers/pagerduty"
 	"github.com/odpf/siren/plugins/receivers/pagerduty/mocks"
 	"github.com/stretchr/testify/mock"
+	"gopkg.in/yaml.v3"
 )
 
 func TestService_Send_V1(t *testing.T) {
+	var (
+		firingMessage = notification.Message{
+			ReceiverType: "pagerduty",
+			Configs: map[string]interface{}{
+				"service_key": "123123",
+			},
+			Details: map[string]interface{}{
+				"description":                           "hello",
+				notification.DetailsKeyAlertFingerprint: "some-fingerprint",
+				notification.DetailsKeyAlertStatus:      "firing",
+			},
+		}
+		resolvedMessage = notification.Message{
+			ReceiverType: "pagerduty",
+			Configs: map[string]interface{}{
+				"service_key": "123123",
+			},
+			Details: map[string]interface{}{
+				"description":                           "hello",
+				notification.DetailsKeyAlertFingerprint: "some-fingerprint",
+				notification.DetailsKeyAlertStatus:      "resolved",
+			},
+		}
+	)
 	tests := []struct {
 		name                string
-		setup               func(*mocks.PagerDutyCaller)
+		setup               func(*mocks.PagerDutyCaller, *notificationmocks.CorrelationRepository)
 		notificationMessage notification.Message
 		wantRetryable       bool
 		wantErr             bool
@@ -40,8 +72,8 @@ func TestService_Send_V1(t *testing.T) {
 		},
 		{
 			name: "should return error and not retryable if notify return error",
-			setup: func(pd *mocks.PagerDutyCaller) {
-				pd.EXPECT().NotifyV1(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("pagerduty.MessageV1")).Return(errors.New("some error"))
+			setup: func(pd *mocks.PagerDutyCaller, _ *notificationmocks.CorrelationRepository) {
+				pd.EXPECT().NotifyV1(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("pagerduty.MessageV1")).Return("", errors.New("some error"))
 			},
 			notificationMessage: notification.Message{
 				Configs: map[string]interface{}{
@@ -56,8 +88,8 @@ func TestService_Send_V1(t *testing.T) {
 		},
 		{
 			name: "should return error and retryable if notify return retryable error",
-			setup: func(sc *mocks.PagerDutyCaller) {
-				sc.EXPECT().NotifyV1(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("pagerduty.MessageV1")).Return(retry.RetryableError{Err: errors.New("some error")})
+			setup: func(sc *mocks.PagerDutyCaller, _ *notificationmocks.CorrelationRepository) {
+				sc.EXPECT().NotifyV1(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("pagerduty.MessageV1")).Return("", retry.RetryableError{Err: errors.New("some error")})
 			},
 			notificationMessage: notification.Message{
 				Configs: map[string]interface{}{
@@ -70,18 +102,206 @@ func TestService_Send_V1(t *testing.T) {
 			wantRetryable: true,
 			wantErr:       true,
 		},
+		{
+			name: "should store incident key of firing alert",
+			setup: func(pd *mocks.PagerDutyCaller, cr *notificationmocks.CorrelationRepository) {
+				pd.EXPECT().NotifyV1(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("pagerduty.MessageV1")).Return("some-incident-key", nil)
+				cr.EXPECT().Upsert(mock.AnythingOfType("*context.emptyCtx"), mock.MatchedBy(func(c notification.Correlation) bool {
+					return c.Fingerprint == "some-fingerprint" && c.ReceiverType == "pagerduty" && c.Identifiers["incident_key"] == "some-incident-key"
+				})).Return(nil)
+			},
+			notificationMessage: firingMessage,
+		},
+		{
+			name: "should return success if failed to store incident key of sent alert",
+			setup: func(pd *mocks.PagerDutyCaller, cr *notificationmocks.CorrelationRepository) {
+				pd.EXPECT().NotifyV1(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("pagerduty.MessageV1")).Return("some-incident-key", nil)
+				cr.EXPECT().Upsert(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("notification.Correlation")).Return(errors.New("some error"))
+			},
+			notificationMessage: firingMessage,
+		},
+		{
+			name: "should resolve the stored incident of resolved alert",
+			setup: func(pd *mocks.PagerDutyCaller, cr *notificationmocks.CorrelationRepository) {
+				cr.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), "some-fingerprint", "pagerduty", mock.AnythingOfType("string")).Return(notification.Correlation{
+					Identifiers: map[string]string{"incident_key": "some-incident-key"},
+				}, nil)
+				pd.EXPECT().NotifyV1(mock.AnythingOfType("*context.emptyCtx"), pagerduty.MessageV1{
+					ServiceKey:  "123123",
+					EventType:   "resolve",
+					IncidentKey: "some-incident-key",
+					Description: "hello",
+				}).Return("some-incident-key", nil)
+			},
+			notificationMessage: resolvedMessage,
+		},
+		{
+			name: "should send resolved alert as is if there is no stored incident",
+			setup: func(pd *mocks.PagerDutyCaller, cr *notificationmocks.CorrelationRepository) {
+				cr.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), "some-fingerprint", "pagerduty", mock.AnythingOfType("string")).Return(notification.Correlation{}, sirenerrors.ErrNotFound)
+				pd.EXPECT().NotifyV1(mock.AnythingOfType("*context.emptyCtx"), pagerduty.MessageV1{
+					ServiceKey:  "123123",
+					Description: "hello",
+				}).Return("", nil)
+			},
+			notificationMessage: resolvedMessage,
+		},
+		{
+			name: "should return error and retryable if failed to get stored incident",
+			setup: func(pd *mocks.PagerDutyCaller, cr *notificationmocks.CorrelationRepository) {
+				cr.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), "some-fingerprint", "pagerduty", mock.AnythingOfType("string")).Return(notification.Correlation{}, errors.New("some error"))
+			},
+			notificationMessage: resolvedMessage,
+			wantRetryable:       true,
+			wantErr:             true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mockPDClient              = new(mocks.PagerDutyCaller)
+				mockCorrelationRepository = new(notificationmocks.CorrelationRepository)
+			)
+
+			if tt.setup != nil {
+				tt.setup(mockPDClient, mockCorrelationRepository)
+			}
+
+			pd := pagerduty.NewPluginService(log.NewNoop(), pagerduty.AppConfig{},
+				pagerduty.WithPagerDutyClient(mockPDClient),
+				pagerduty.WithCorrelationRepository(mockCorrelationRepository),
+			)
+
+			_, got, err := pd.Send(context.Background(), tt.notificationMessage)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NotificationService.Send() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.wantRetryable {
+				t.Errorf("NotificationService.Send() = %v, want %v", got, tt.wantRetryable)
+			}
+			mockPDClient.AssertExpectations(t)
+			mockCorrelationRepository.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Send_V2(t *testing.T) {
+	var (
+		firingMessage = notification.Message{
+			ReceiverType: "pagerduty",
+			Configs: map[string]interface{}{
+				"routing_key": "123123",
+				"version":     "v2",
+			},
+			Details: map[string]interface{}{
+				"event_action": "trigger",
+				"payload": map[string]interface{}{
+					"summary":  "hello",
+					"source":   "siren",
+					"severity": "critical",
+				},
+				notification.DetailsKeyAlertFingerprint: "some-fingerprint",
+				notification.DetailsKeyAlertStatus:      "firing",
+			},
+		}
+		resolvedMessage = notification.Message{
+			ReceiverType: "pagerduty",
+			Configs: map[string]interface{}{
+				"routing_key": "123123",
+				"version":     "v2",
+			},
+			Details: map[string]interface{}{
+				"event_action":                          "resolve",
+				notification.DetailsKeyAlertFingerprint: "some-fingerprint",
+				notification.DetailsKeyAlertStatus:      "resolved",
+			},
+		}
+	)
+	tests := []struct {
+		name                string
+		setup               func(*mocks.PagerDutyCaller, *notificationmocks.CorrelationRepository)
+		notificationMessage notification.Message
+		wantRetryable       bool
+		wantErr             bool
+	}{
+		{
+			name: "should return error if failed to decode notification detail",
+			notificationMessage: notification.Message{
+				Configs: map[string]interface{}{
+					"routing_key": "123123",
+					"version":     "v2",
+				},
+				Details: map[string]interface{}{
+					"payload": make(chan bool),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return error and retryable if notify return retryable error",
+			setup: func(pd *mocks.PagerDutyCaller, _ *notificationmocks.CorrelationRepository) {
+				pd.EXPECT().NotifyV2(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("pagerduty.MessageV2")).Return("", retry.RetryableError{Err: errors.New("some error")})
+			},
+			notificationMessage: notification.Message{
+				Configs: map[string]interface{}{
+					"routing_key": "123123",
+					"version":     "v2",
+				},
+			},
+			wantRetryable: true,
+			wantErr:       true,
+		},
+		{
+			name: "should send event with routing key and store dedup key of firing alert",
+			setup: func(pd *mocks.PagerDutyCaller, cr *notificationmocks.CorrelationRepository) {
+				pd.EXPECT().NotifyV2(mock.AnythingOfType("*context.emptyCtx"), pagerduty.MessageV2{
+					RoutingKey:  "123123",
+					EventAction: "trigger",
+					Payload: &pagerduty.PayloadV2{
+						Summary:  "hello",
+						Source:   "siren",
+						Severity: "critical",
+					},
+				}).Return("some-dedup-key", nil)
+				cr.EXPECT().Upsert(mock.AnythingOfType("*context.emptyCtx"), mock.MatchedBy(func(c notification.Correlation) bool {
+					return c.Fingerprint == "some-fingerprint" && c.Identifiers["incident_key"] == "some-dedup-key"
+				})).Return(nil)
+			},
+			notificationMessage: firingMessage,
+		},
+		{
+			name: "should resolve the stored dedup key of resolved alert",
+			setup: func(pd *mocks.PagerDutyCaller, cr *notificationmocks.CorrelationRepository) {
+				cr.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), "some-fingerprint", "pagerduty", mock.AnythingOfType("string")).Return(notification.Correlation{
+					Identifiers: map[string]string{"incident_key": "some-dedup-key"},
+				}, nil)
+				pd.EXPECT().NotifyV2(mock.AnythingOfType("*context.emptyCtx"), pagerduty.MessageV2{
+					RoutingKey:  "123123",
+					EventAction: "resolve",
+					DedupKey:    "some-dedup-key",
+				}).Return("some-dedup-key", nil)
+			},
+			notificationMessage: resolvedMessage,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mockPDClient := new(mocks.PagerDutyCaller)
+			var (
+				mockPDClient              = new(mocks.PagerDutyCaller)
+				mockCorrelationRepository = new(notificationmocks.CorrelationRepository)
+			)
 
 			if tt.setup != nil {
-				tt.setup(mockPDClient)
+				tt.setup(mockPDClient, mockCorrelationRepository)
 			}
 
-			pd := pagerduty.NewPluginService(pagerduty.AppConfig{}, pagerduty.WithPagerDutyClient(mockPDClient))
+			pd := pagerduty.NewPluginService(log.NewNoop(), pagerduty.AppConfig{},
+				pagerduty.WithPagerDutyClient(mockPDClient),
+				pagerduty.WithCorrelationRepository(mockCorrelationRepository),
+			)
 
-			got, err := pd.Send(context.Background(), tt.notificationMessage)
+			_, got, err := pd.Send(context.Background(), tt.notificationMessage)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NotificationService.Send() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -89,6 +309,126 @@ func TestService_Send_V1(t *testing.T) {
 			if got != tt.wantRetryable {
 				t.Errorf("NotificationService.Send() = %v, want %v", got, tt.wantRetryable)
 			}
+			mockPDClient.AssertExpectations(t)
+			mockCorrelationRepository.AssertExpectations(t)
 		})
 	}
 }
+
+func TestService_GetSystemDefaultTemplate(t *testing.T) {
+	n := notification.Notification{
+		Data: map[string]interface{}{
+			"id":                "some-id",
+			"status":            "firing",
+			"num_alerts_firing": 1,
+			"resource":          "some-resource",
+			"generator_url":     "http://generator",
+			"dashboard":         "http://dashboard",
+			"playbook":          "http://playbook",
+		},
+		Labels: map[string]string{
+			"severity":  "CRITICAL",
+			"alertname": "some-alert",
+		},
+		UniqueKey: "some-unique-key",
+	}
+
+	t.Run("should render v1 template if version is not set", func(t *testing.T) {
+		pd := pagerduty.NewPluginService(log.NewNoop(), pagerduty.AppConfig{})
+
+		rendered, err := template.RenderBody(pd.GetSystemDefaultTemplate(map[string]interface{}{
+			"service_key": "123123",
+		}), n)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var details map[string]interface{}
+		if err := yaml.Unmarshal([]byte(rendered), &details); err != nil {
+			t.Fatal(err)
+		}
+
+		if details["event_type"] != "trigger" || details["incident_key"] != "some-unique-key" {
+			t.Errorf("unexpected v1 message %v", details)
+		}
+	})
+
+	t.Run("should render v2 template if version is v2", func(t *testing.T) {
+		pd := pagerduty.NewPluginService(log.NewNoop(), pagerduty.AppConfig{})
+
+		rendered, err := template.RenderBody(pd.GetSystemDefaultTemplate(map[string]interface{}{
+			"routing_key": "123123",
+			"version":     "v2",
+		}), n)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var details map[string]interface{}
+		if err := yaml.Unmarshal([]byte(rendered), &details); err != nil {
+			t.Fatal(err)
+		}
+
+		got := pagerduty.MessageV2{}
+		if err := mapstructure.Decode(details, &got); err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(pagerduty.MessageV2{
+			EventAction: "trigger",
+			DedupKey:    "some-unique-key",
+			Payload: &pagerduty.PayloadV2{
+				Summary:   "(FIRING:1) CRITICAL some-alert",
+				Source:    "some-resource",
+				Severity:  "critical",
+				Component: "some-resource",
+			},
+			Client:    "Siren",
+			ClientURL: "http://generator",
+			Links: []pagerduty.LinkV2{
+				{Href: "http://dashboard", Text: "Dashboard"},
+				{Href: "http://playbook", Text: "Runbook"},
+			},
+		}, got, cmpopts.IgnoreFields(pagerduty.PayloadV2{}, "CustomDetails")); diff != "" {
+			t.Errorf("unexpected v2 message\n%v", diff)
+		}
+		if len(got.Payload.CustomDetails) == 0 {
+			t.Errorf("custom details should not be empty")
+		}
+	})
+
+	t.Run("should map lowercase severity label to pagerduty severity in v2 template", func(t *testing.T) {
+		pd := pagerduty.NewPluginService(log.NewNoop(), pagerduty.AppConfig{})
+
+		for label, want := range map[string]string{
+			"critical": "critical",
+			"warning":  "warning",
+			"info":     "info",
+		} {
+			rendered, err := template.RenderBody(pd.GetSystemDefaultTemplate(map[string]interface{}{
+				"routing_key": "123123",
+				"version":     "v2",
+			}), notification.Notification{
+				Data:   map[string]interface{}{"status": "firing"},
+				Labels: map[string]string{"severity": label},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var details map[string]interface{}
+			if err := yaml.Unmarshal([]byte(rendered), &details); err != nil {
+				t.Fatal(err)
+			}
+
+			got := pagerduty.MessageV2{}
+			if err := mapstructure.Decode(details, &got); err != nil {
+				t.Fatal(err)
+			}
+
+			if got.Payload.Severity != want {
+				t.Errorf("severity label %q rendered as %q, want %q", label, got.Payload.Severity, want)
+			}
+		}
+	})
+}
@@ -5,4 +5,7 @@ import _ "embed"
 var (
 	//go:embed config/default_alert_template_body_v1.goyaml
 	defaultAlertTemplateBodyV1 string
+
+	//go:embed config/default_alert_template_body_v2.goyaml
+	defaultAlertTemplateBodyV2 string
 )
@@ -0,0 +1,193 @@
+// Package receivertest provides test cases shared by receiver plugins that encrypt a single secret in their configs.
+package receivertest
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/odpf/siren/pkg/secret"
+)
+
+const encryptedPrefix = "encrypted-"
+
+// ConfigTransformer is the pre and post hook lifecycle of a receiver plugin service
+type ConfigTransformer interface {
+	PreHookDBTransformConfigs(ctx context.Context, configs map[string]interface{}) (map[string]interface{}, error)
+	PostHookDBTransformConfigs(ctx context.Context, configs map[string]interface{}) (map[string]interface{}, error)
+	PreHookQueueTransformConfigs(ctx context.Context, notificationConfigMap map[string]interface{}) (map[string]interface{}, error)
+	PostHookQueueTransformConfigs(ctx context.Context, notificationConfigMap map[string]interface{}) (map[string]interface{}, error)
+}
+
+type hookFunc func(ctx context.Context, configs map[string]interface{}) (map[string]interface{}, error)
+
+func preHookDB(s ConfigTransformer) hookFunc     { return s.PreHookDBTransformConfigs }
+func postHookDB(s ConfigTransformer) hookFunc    { return s.PostHookDBTransformConfigs }
+func preHookQueue(s ConfigTransformer) hookFunc  { return s.PreHookQueueTransformConfigs }
+func postHookQueue(s ConfigTransformer) hookFunc { return s.PostHookQueueTransformConfigs }
+
+// Encryptor is the crypto client the plugin service is created with
+type Encryptor interface {
+	Encrypt(str secret.MaskableString) (secret.MaskableString, error)
+	Decrypt(str secret.MaskableString) (secret.MaskableString, error)
+}
+
+// fakeEncryptor prefixes plain text on encryption and strips the prefix on decryption
+type fakeEncryptor struct {
+	err error
+}
+
+func (e fakeEncryptor) Encrypt(str secret.MaskableString) (secret.MaskableString, error) {
+	if e.err != nil {
+		return "", e.err
+	}
+	return encryptedPrefix + str, nil
+}
+
+func (e fakeEncryptor) Decrypt(str secret.MaskableString) (secret.MaskableString, error) {
+	if e.err != nil {
+		return "", e.err
+	}
+	return secret.MaskableString(strings.TrimPrefix(str.UnmaskedString(), encryptedPrefix)), nil
+}
+
+// TestSecretConfigHooks runs the pre and post hook cases of a plugin whose configs have a single secret under secretKey.
+// configs is a valid plain text config of the plugin, the same config is used for receiver and notification.
+func TestSecretConfigHooks(t *testing.T, newService func(Encryptor) ConfigTransformer, secretKey string, configs map[string]interface{}) {
+	plainText, ok := configs[secretKey].(string)
+	if !ok {
+		t.Fatalf("configs should have string %q", secretKey)
+	}
+
+	var (
+		plainConfigs     = withSecret(configs, secretKey, plainText)
+		encryptedConfigs = withSecret(configs, secretKey, encryptedPrefix+plainText)
+		invalidConfigs   = withSecret(configs, secretKey, 123)
+		someErr          = errors.New("some error")
+	)
+
+	tests := []struct {
+		name      string
+		hook      func(ConfigTransformer) hookFunc
+		cryptoErr error
+		configs   map[string]interface{}
+		want      map[string]interface{}
+		wantErr   bool
+	}{
+		{
+			name:    "PreHookDBTransformConfigs should return error if secret is missing",
+			hook:    preHookDB,
+			configs: map[string]interface{}{},
+			wantErr: true,
+		},
+		{
+			name:      "PreHookDBTransformConfigs should return error if encryption failed",
+			hook:      preHookDB,
+			cryptoErr: someErr,
+			configs:   configs,
+			wantErr:   true,
+		},
+		{
+			name:    "PreHookDBTransformConfigs should return encrypted secret if succeed",
+			hook:    preHookDB,
+			configs: configs,
+			want:    encryptedConfigs,
+		},
+		{
+			name:    "PostHookDBTransformConfigs should return error if secret is missing",
+			hook:    postHookDB,
+			configs: map[string]interface{}{},
+			wantErr: true,
+		},
+		{
+			name:      "PostHookDBTransformConfigs should return error if decryption failed",
+			hook:      postHookDB,
+			cryptoErr: someErr,
+			configs:   encryptedConfigs,
+			wantErr:   true,
+		},
+		{
+			name:    "PostHookDBTransformConfigs should return decrypted secret if succeed",
+			hook:    postHookDB,
+			configs: encryptedConfigs,
+			want:    plainConfigs,
+		},
+		{
+			name:    "PreHookQueueTransformConfigs should return error if failed to parse configmap to notification config",
+			hook:    preHookQueue,
+			wantErr: true,
+		},
+		{
+			name:    "PreHookQueueTransformConfigs should return error if validate notification config failed",
+			hook:    preHookQueue,
+			configs: invalidConfigs,
+			wantErr: true,
+		},
+		{
+			name:      "PreHookQueueTransformConfigs should return error if encryption failed",
+			hook:      preHookQueue,
+			cryptoErr: someErr,
+			configs:   plainConfigs,
+			wantErr:   true,
+		},
+		{
+			name:    "PreHookQueueTransformConfigs should return encrypted secret if succeed",
+			hook:    preHookQueue,
+			configs: plainConfigs,
+			want:    encryptedConfigs,
+		},
+		{
+			name:    "PostHookQueueTransformConfigs should return error if failed to parse configmap to notification config",
+			hook:    postHookQueue,
+			wantErr: true,
+		},
+		{
+			name:    "PostHookQueueTransformConfigs should return error if validate notification config failed",
+			hook:    postHookQueue,
+			configs: invalidConfigs,
+			wantErr: true,
+		},
+		{
+			name:      "PostHookQueueTransformConfigs should return error if decryption failed",
+			hook:      postHookQueue,
+			cryptoErr: someErr,
+			configs:   encryptedConfigs,
+			wantErr:   true,
+		},
+		{
+			name:    "PostHookQueueTransformConfigs should return decrypted secret if succeed",
+			hook:    postHookQueue,
+			configs: encryptedConfigs,
+			want:    plainConfigs,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newService(fakeEncryptor{err: tt.cryptoErr})
+
+			got, err := tt.hook(s)(context.TODO(), tt.configs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// withSecret returns a copy of configs with the secret replaced, strings are converted to the decoded secret type
+func withSecret(configs map[string]interface{}, secretKey string, value interface{}) map[string]interface{} {
+	m := map[string]interface{}{}
+	for k, v := range configs {
+		m[k] = v
+	}
+	if str, ok := value.(string); ok {
+		value = secret.MaskableString(str)
+	}
+	m[secretKey] = value
+	return m
+}
@@ -26,6 +26,7 @@ type GoSlackCaller interface {
 	GetConversationsForUserContext(ctx context.Context, params *goslack.GetConversationsForUserParameters) (channels []goslack.Channel, nextCursor string, err error)
 	GetUserByEmailContext(ctx context.Context, email string) (*goslack.User, error)
 	SendMessageContext(ctx context.Context, channel string, options ...goslack.MsgOption) (string, string, string, error)
+	UpdateMessageContext(ctx context.Context, channelID, timestamp string, options ...goslack.MsgOption) (string, string, string, error)
 }
 
 type codeExchangeHTTPResponse struct {
@@ -46,6 +47,12 @@ type Credential struct {
 	TeamName    string
 }
 
+// MessageReference identifies a message posted to a slack channel
+type MessageReference struct {
+	ChannelID string
+	Timestamp string
+}
+
 type ClientOption func(*Client)
 
 // ClientWithHTTPClient assigns custom http client when creating a slack client
@@ -154,79 +161,128 @@ func (c *Client) GetWorkspaceChannels(ctx context.Context, token secret.Maskable
 	return result, nil
 }
 
-func (c *Client) Notify(ctx context.Context, conf NotificationConfig, message Message) error {
+func (c *Client) Notify(ctx context.Context, conf NotificationConfig, message Message) (MessageReference, error) {
 	if c.retrier != nil {
+		var ref MessageReference
 		if err := c.retrier.Run(ctx, func(ctx context.Context) error {
-			return c.notify(ctx, conf, message)
-		}); err != nil {
+			var err error
+			ref, err = c.notify(ctx, conf, message)
 			return err
+		}); err != nil {
+			return MessageReference{}, err
 		}
+		return ref, nil
 	}
 	return c.notify(ctx, conf, message)
 }
 
-// Notify sends message to a specific slack channel
-func (c *Client) notify(ctx context.Context, conf NotificationConfig, message Message) error {
+// notify sends message to a specific slack channel
+func (c *Client) notify(ctx context.Context, conf NotificationConfig, message Message) (MessageReference, error) {
+
+	gsc := goslack.New(
+		conf.ReceiverConfig.Token.UnmaskedString(),
+		goslack.OptionAPIURL(c.cfg.APIHost),
+		goslack.OptionHTTPClient(c.httpClient.HTTP()),
+	)
+
+	channelID, err := c.resolveChannelID(ctx, gsc, conf, message)
+	if err != nil {
+		return MessageReference{}, err
+	}
+
+	msgOptions, err := message.BuildGoSlackMessageOptions()
+	if err != nil {
+		return MessageReference{}, err
+	}
+
+	ref, err := c.sendMessageContext(ctx, gsc, channelID, message.Channel, msgOptions...)
+	if err != nil {
+		if err := c.checkSlackErrorRetryable(err); errors.As(err, new(retry.RetryableError)) {
+			return MessageReference{}, err
+		}
+		return MessageReference{}, fmt.Errorf("failed to send message to %q: %w", message.Channel, err)
+	}
+
+	return ref, nil
+}
+
+// UpdateMessage replaces the content of a message previously posted to slack
+// the channel id of the reference is the one returned when posting, e.g. DM channel id for user
+func (c *Client) UpdateMessage(ctx context.Context, conf NotificationConfig, ref MessageReference, message Message) error {
+	if c.retrier != nil {
+		return c.retrier.Run(ctx, func(ctx context.Context) error {
+			return c.updateMessage(ctx, conf, ref, message)
+		})
+	}
+	return c.updateMessage(ctx, conf, ref, message)
+}
 
+func (c *Client) updateMessage(ctx context.Context, conf NotificationConfig, ref MessageReference, message Message) error {
 	gsc := goslack.New(
 		conf.ReceiverConfig.Token.UnmaskedString(),
 		goslack.OptionAPIURL(c.cfg.APIHost),
 		goslack.OptionHTTPClient(c.httpClient.HTTP()),
 	)
 
-	var channelID string
+	msgOptions, err := message.BuildGoSlackMessageOptions()
+	if err != nil {
+		return err
+	}
+
+	if _, _, _, err := gsc.UpdateMessageContext(ctx, ref.ChannelID, ref.Timestamp, msgOptions...); err != nil {
+		if err := c.checkSlackErrorRetryable(err); errors.As(err, new(retry.RetryableError)) {
+			return err
+		}
+		return fmt.Errorf("failed to update message %s in %q: %w", ref.Timestamp, message.Channel, err)
+	}
+
+	return nil
+}
+
+// resolveChannelID returns the id of the channel or user the message is sent to
+func (c *Client) resolveChannelID(ctx context.Context, gsc GoSlackCaller, conf NotificationConfig, message Message) (string, error) {
 	switch conf.ChannelType {
 	case TypeChannelChannel:
 		joinedChannelList, err := c.getJoinedChannelsList(ctx, gsc)
 		if err != nil {
 			if err := c.checkSlackErrorRetryable(err); errors.As(err, new(retry.RetryableError)) {
-				return err
+				return "", err
 			}
-			return fmt.Errorf("failed to fetch joined channel list: %w", err)
+			return "", fmt.Errorf("failed to fetch joined channel list: %w", err)
 		}
-		channelID = searchChannelId(joinedChannelList, message.Channel)
+		channelID := searchChannelId(joinedChannelList, message.Channel)
 		if channelID == "" {
-			return fmt.Errorf("app is not part of the channel %q", message.Channel)
+			return "", fmt.Errorf("app is not part of the channel %q", message.Channel)
 		}
+		return channelID, nil
 	case TypeChannelUser:
 		// https://api.slack.com/methods/users.lookupByEmail
 		user, err := gsc.GetUserByEmailContext(ctx, message.Channel)
 		if err != nil {
 			if err.Error() == "users_not_found" {
-				return fmt.Errorf("failed to get id for %q", message.Channel)
+				return "", fmt.Errorf("failed to get id for %q", message.Channel)
 			}
-			return c.checkSlackErrorRetryable(err)
+			return "", c.checkSlackErrorRetryable(err)
 		}
-		channelID = user.ID
+		return user.ID, nil
 	default:
-		return fmt.Errorf("unknown receiver type %q", conf.ChannelType)
+		return "", fmt.Errorf("unknown receiver type %q", conf.ChannelType)
 	}
-
-	msgOptions, err := message.BuildGoSlackMessageOptions()
-	if err != nil {
-		return err
-	}
-
-	if err := c.sendMessageContext(ctx, gsc, channelID, message.Channel, msgOptions...); err != nil {
-		if err := c.checkSlackErrorRetryable(err); errors.As(err, new(retry.RetryableError)) {
-			return err
-		}
-		return fmt.Errorf("failed to send message to %q: %w", message.Channel, err)
-	}
-
-	return nil
 }
 
-func (c *Client) sendMessageContext(ctx context.Context, gsc GoSlackCaller, channelID string, channelName string, msgOpts ...goslack.MsgOption) error {
-	_, _, _, err := gsc.SendMessageContext(
+func (c *Client) sendMessageContext(ctx context.Context, gsc GoSlackCaller, channelID string, channelName string, msgOpts ...goslack.MsgOption) (MessageReference, error) {
+	respChannelID, timestamp, _, err := gsc.SendMessageContext(
 		ctx,
 		channelID,
 		msgOpts...,
 	)
 	if err != nil {
-		return c.checkSlackErrorRetryable(err)
+		return MessageReference{}, c.checkSlackErrorRetryable(err)
 	}
-	return nil
+	return MessageReference{
+		ChannelID: respChannelID,
+		Timestamp: timestamp,
+	}, nil
 }
 
 func (c *Client) checkSlackErrorRetryable(err error) error {
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/odpf/siren/pkg/retry"
@@ -72,7 +73,7 @@ func TestClient_NotifyChannel(t *testing.T) {
 
 	t.Run("return error when message receiver type is wrong", func(t *testing.T) {
 		c := slack.NewClient(slack.AppConfig{})
-		err := c.Notify(context.Background(),
+		_, err := c.Notify(context.Background(),
 			slack.NotificationConfig{
 				ReceiverConfig: slack.ReceiverConfig{
 					Token: token,
@@ -89,7 +90,7 @@ func TestClient_NotifyChannel(t *testing.T) {
 		}))
 
 		c := slack.NewClient(slack.AppConfig{APIHost: testServer.URL})
-		err := c.Notify(
+		_, err := c.Notify(
 			context.Background(),
 			slack.NotificationConfig{
 				ReceiverConfig: slack.ReceiverConfig{
@@ -133,7 +134,7 @@ func TestClient_NotifyChannel(t *testing.T) {
 		}))
 
 		c := slack.NewClient(slack.AppConfig{APIHost: testServer.URL})
-		err := c.Notify(
+		_, err := c.Notify(
 			context.Background(),
 			slack.NotificationConfig{
 				ReceiverConfig: slack.ReceiverConfig{
@@ -177,7 +178,7 @@ func TestClient_NotifyChannel(t *testing.T) {
 		}))
 
 		c := slack.NewClient(slack.AppConfig{APIHost: testServer.URL})
-		err := c.Notify(
+		_, err := c.Notify(
 			context.Background(),
 			slack.NotificationConfig{
 				ReceiverConfig: slack.ReceiverConfig{
@@ -217,12 +218,12 @@ func TestClient_NotifyChannel(t *testing.T) {
 				w.Write(respByte)
 				return
 			} else {
-				w.Write([]byte(`{"ok":true}`))
+				w.Write([]byte(`{"ok":true,"channel":"123","ts":"1503435956.000247"}`))
 			}
 		}))
 
 		c := slack.NewClient(slack.AppConfig{APIHost: testServer.URL})
-		err := c.Notify(
+		ref, err := c.Notify(
 			context.Background(),
 			slack.NotificationConfig{
 				ReceiverConfig: slack.ReceiverConfig{
@@ -237,6 +238,7 @@ func TestClient_NotifyChannel(t *testing.T) {
 			})
 
 		assert.NoError(t, err)
+		assert.Equal(t, slack.MessageReference{ChannelID: "123", Timestamp: "1503435956.000247"}, ref)
 
 		testServer.Close()
 	})
@@ -251,7 +253,7 @@ func TestClient_NotifyUser(t *testing.T) {
 		}))
 
 		c := slack.NewClient(slack.AppConfig{APIHost: testServer.URL})
-		err := c.Notify(
+		_, err := c.Notify(
 			context.Background(),
 			slack.NotificationConfig{
 				ReceiverConfig: slack.ReceiverConfig{
@@ -274,7 +276,7 @@ func TestClient_NotifyUser(t *testing.T) {
 		}))
 
 		c := slack.NewClient(slack.AppConfig{APIHost: testServer.URL})
-		err := c.Notify(
+		_, err := c.Notify(
 			context.Background(),
 			slack.NotificationConfig{
 				ReceiverConfig: slack.ReceiverConfig{
@@ -301,7 +303,7 @@ func TestClient_NotifyUser(t *testing.T) {
 		}))
 
 		c := slack.NewClient(slack.AppConfig{APIHost: testServer.URL})
-		err := c.Notify(
+		_, err := c.Notify(
 			context.Background(),
 			slack.NotificationConfig{
 				ReceiverConfig: slack.ReceiverConfig{
@@ -320,6 +322,82 @@ func TestClient_NotifyUser(t *testing.T) {
 	})
 }
 
+func TestClient_UpdateMessage(t *testing.T) {
+	var (
+		token              = secret.MaskableString("test-token")
+		notificationConfig = slack.NotificationConfig{
+			ReceiverConfig: slack.ReceiverConfig{
+				Token: token,
+			},
+			SubscriptionConfig: slack.SubscriptionConfig{
+				ChannelType: slack.TypeChannelChannel,
+			},
+		}
+	)
+
+	t.Run("return error when failed to update message", func(t *testing.T) {
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"ok":false,"error":"message_not_found"}`))
+		}))
+
+		c := slack.NewClient(slack.AppConfig{APIHost: testServer.URL})
+		err := c.UpdateMessage(context.Background(), notificationConfig, slack.MessageReference{ChannelID: "123", Timestamp: "1503435956.000247"}, slack.Message{Channel: "test"})
+
+		assert.EqualError(t, err, "failed to update message 1503435956.000247 in \"test\": message_not_found")
+
+		testServer.Close()
+	})
+
+	t.Run("return nil error when message is updated in the channel it was posted to", func(t *testing.T) {
+		var form url.Values
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/chat.update" {
+				t.Errorf("unexpected call to %s, the channel id of the reference should be used", r.URL.Path)
+			}
+			_ = r.ParseForm()
+			form = r.PostForm
+			w.Write([]byte(`{"ok":true,"channel":"123","ts":"1503435956.000247"}`))
+		}))
+
+		c := slack.NewClient(slack.AppConfig{APIHost: testServer.URL})
+		err := c.UpdateMessage(context.Background(), notificationConfig, slack.MessageReference{ChannelID: "123", Timestamp: "1503435956.000247"}, slack.Message{Channel: "test"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "123", form.Get("channel"))
+		assert.Equal(t, "1503435956.000247", form.Get("ts"))
+
+		testServer.Close()
+	})
+
+	t.Run("update message to user in the DM channel it was posted to", func(t *testing.T) {
+		var form url.Values
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/chat.update" {
+				t.Errorf("unexpected call to %s, the DM channel id should be used", r.URL.Path)
+			}
+			_ = r.ParseForm()
+			form = r.PostForm
+			w.Write([]byte(`{"ok":true,"channel":"D123","ts":"1503435956.000247"}`))
+		}))
+
+		c := slack.NewClient(slack.AppConfig{APIHost: testServer.URL})
+		err := c.UpdateMessage(context.Background(), slack.NotificationConfig{
+			ReceiverConfig: slack.ReceiverConfig{
+				Token: token,
+			},
+			SubscriptionConfig: slack.SubscriptionConfig{
+				ChannelType: slack.TypeChannelUser,
+			},
+		}, slack.MessageReference{ChannelID: "D123", Timestamp: "1503435956.000247"}, slack.Message{Channel: "email@email.com"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "D123", form.Get("channel"))
+		assert.Equal(t, "1503435956.000247", form.Get("ts"))
+
+		testServer.Close()
+	})
+}
+
 func TestClient_NotifyWithRetrier(t *testing.T) {
 	var (
 		expectedCounter = 4
@@ -341,7 +419,7 @@ func TestClient_NotifyWithRetrier(t *testing.T) {
 		}))
 
 		c := slack.NewClient(slack.AppConfig{APIHost: testServer.URL}, slack.ClientWithRetrier(retry.New(retry.Config{Enable: true})))
-		_ = c.Notify(
+		_, _ = c.Notify(
 			context.Background(),
 			slack.NotificationConfig{
 				ReceiverConfig: slack.ReceiverConfig{
@@ -372,7 +450,7 @@ func TestClient_NotifyWithRetrier(t *testing.T) {
 		}))
 
 		c := slack.NewClient(slack.AppConfig{APIHost: testServer.URL}, slack.ClientWithRetrier(retry.New(retry.Config{Enable: true})))
-		_ = c.Notify(
+		_, _ = c.Notify(
 			context.Background(),
 			slack.NotificationConfig{
 				ReceiverConfig: slack.ReceiverConfig{
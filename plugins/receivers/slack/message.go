@@ -7,7 +7,6 @@ import (
 	goslack "github.com/slack-go/slack"
 )
 
-// TODO support block-kit messages
 type Message struct {
 	Channel     string              `yaml:"channel,omitempty" json:"channel,omitempty"  mapstructure:"channel"`
 	Text        string              `yaml:"text,omitempty" json:"text,omitempty"  mapstructure:"text"`
@@ -16,6 +15,8 @@ type Message struct {
 	IconURL     string              `yaml:"icon_url,omitempty" json:"icon_url,omitempty"  mapstructure:"icon_url"`
 	LinkNames   bool                `yaml:"link_names,omitempty" json:"link_names,omitempty"  mapstructure:"link_names"`
 	Attachments []MessageAttachment `yaml:"attachments,omitempty" json:"attachments,omitempty" mapstructure:"attachments"`
+	Blocks      MessageBlocks       `yaml:"blocks,omitempty" json:"blocks,omitempty" mapstructure:"blocks"`
+	ThreadTS    string              `yaml:"thread_ts,omitempty" json:"thread_ts,omitempty" mapstructure:"thread_ts"`
 }
 
 func (m Message) BuildGoSlackMessageOptions() ([]goslack.MsgOption, error) {
@@ -28,6 +29,11 @@ func (m Message) BuildGoSlackMessageOptions() ([]goslack.MsgOption, error) {
 		goslackAttachments = append(goslackAttachments, *attachment)
 	}
 
+	goslackBlocks, err := m.Blocks.ToGoSlack()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse slack blocks: %w", err)
+	}
+
 	msgOptions := []goslack.MsgOption{}
 
 	if m.Text != "" {
@@ -50,6 +56,14 @@ func (m Message) BuildGoSlackMessageOptions() ([]goslack.MsgOption, error) {
 		msgOptions = append(msgOptions, goslack.MsgOptionAttachments(goslackAttachments...))
 	}
 
+	if len(goslackBlocks.BlockSet) != 0 {
+		msgOptions = append(msgOptions, goslack.MsgOptionBlocks(goslackBlocks.BlockSet...))
+	}
+
+	if m.ThreadTS != "" {
+		msgOptions = append(msgOptions, goslack.MsgOptionTS(m.ThreadTS))
+	}
+
 	return msgOptions, nil
 }
 
@@ -71,3 +85,26 @@ func (ma MessageAttachment) ToGoSlack() (*goslack.Attachment, error) {
 
 	return ga, nil
 }
+
+// MessageBlocks is a list of block kit layout blocks
+// https://api.slack.com/reference/block-kit/blocks
+type MessageBlocks []map[string]interface{}
+
+func (mb MessageBlocks) ToGoSlack() (goslack.Blocks, error) {
+	if len(mb) == 0 {
+		return goslack.Blocks{}, nil
+	}
+
+	// goslack.Blocks decodes each block based on its type
+	gbBlob, err := json.Marshal(mb)
+	if err != nil {
+		return goslack.Blocks{}, err
+	}
+
+	gb := goslack.Blocks{}
+	if err := json.Unmarshal(gbBlob, &gb); err != nil {
+		return goslack.Blocks{}, err
+	}
+
+	return gb, nil
+}
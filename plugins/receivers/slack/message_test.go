@@ -2,6 +2,8 @@ package slack
 
 import (
 	"testing"
+
+	goslack "github.com/slack-go/slack"
 )
 
 func TestMessage_BuildGoSlackMessageOptions(t *testing.T) {
@@ -19,6 +21,15 @@ func TestMessage_BuildGoSlackMessageOptions(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "should return error if failed to parse message blocks",
+			message: Message{
+				Blocks: MessageBlocks{
+					{"type": "section", "text": "test"},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "should build all message options if all fields in message present",
 			message: Message{
@@ -42,6 +53,15 @@ func TestMessage_BuildGoSlackMessageOptions(t *testing.T) {
 						},
 					},
 				},
+				Blocks: MessageBlocks{
+					{
+						"type": "section",
+						"text": map[string]interface{}{
+							"type": "mrkdwn",
+							"text": "this is markdown task",
+						},
+					},
+				},
 			},
 			wantErr: false,
 		},
@@ -56,3 +76,48 @@ func TestMessage_BuildGoSlackMessageOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestMessageBlocks_ToGoSlack(t *testing.T) {
+	blocks := MessageBlocks{
+		{
+			"type": "section",
+			"text": map[string]interface{}{
+				"type": "mrkdwn",
+				"text": "this is markdown task",
+			},
+		},
+		{
+			"type": "actions",
+			"elements": []interface{}{
+				map[string]interface{}{
+					"type": "button",
+					"text": map[string]interface{}{
+						"type": "plain_text",
+						"text": "Runbook",
+					},
+					"url": "http://runbook",
+				},
+			},
+		},
+	}
+
+	got, err := blocks.ToGoSlack()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got.BlockSet) != 2 {
+		t.Fatalf("expected 2 blocks, got %d", len(got.BlockSet))
+	}
+	if got.BlockSet[0].BlockType() != goslack.MBTSection {
+		t.Errorf("expected section block, got %s", got.BlockSet[0].BlockType())
+	}
+	actions, ok := got.BlockSet[1].(*goslack.ActionBlock)
+	if !ok {
+		t.Fatalf("expected action block, got %T", got.BlockSet[1])
+	}
+	button, ok := actions.Elements.ElementSet[0].(*goslack.ButtonBlockElement)
+	if !ok || button.URL != "http://runbook" {
+		t.Errorf("expected runbook button, got %v", actions.Elements.ElementSet[0])
+	}
+}
@@ -198,6 +198,81 @@ func (_c *GoSlackCaller_SendMessageContext_Call) Return(_a0 string, _a1 string,
 	return _c
 }
 
+// UpdateMessageContext provides a mock function with given fields: ctx, channelID, timestamp, options
+func (_m *GoSlackCaller) UpdateMessageContext(ctx context.Context, channelID string, timestamp string, options ...slack.MsgOption) (string, string, string, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, channelID, timestamp)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, ...slack.MsgOption) string); ok {
+		r0 = rf(ctx, channelID, timestamp, options...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, ...slack.MsgOption) string); ok {
+		r1 = rf(ctx, channelID, timestamp, options...)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 string
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, ...slack.MsgOption) string); ok {
+		r2 = rf(ctx, channelID, timestamp, options...)
+	} else {
+		r2 = ret.Get(2).(string)
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(context.Context, string, string, ...slack.MsgOption) error); ok {
+		r3 = rf(ctx, channelID, timestamp, options...)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// GoSlackCaller_UpdateMessageContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMessageContext'
+type GoSlackCaller_UpdateMessageContext_Call struct {
+	*mock.Call
+}
+
+// UpdateMessageContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - channelID string
+//   - timestamp string
+//   - options ...slack.MsgOption
+func (_e *GoSlackCaller_Expecter) UpdateMessageContext(ctx interface{}, channelID interface{}, timestamp interface{}, options ...interface{}) *GoSlackCaller_UpdateMessageContext_Call {
+	return &GoSlackCaller_UpdateMessageContext_Call{Call: _e.mock.On("UpdateMessageContext",
+		append([]interface{}{ctx, channelID, timestamp}, options...)...)}
+}
+
+func (_c *GoSlackCaller_UpdateMessageContext_Call) Run(run func(ctx context.Context, channelID string, timestamp string, options ...slack.MsgOption)) *GoSlackCaller_UpdateMessageContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]slack.MsgOption, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(slack.MsgOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *GoSlackCaller_UpdateMessageContext_Call) Return(_a0 string, _a1 string, _a2 string, _a3 error) *GoSlackCaller_UpdateMessageContext_Call {
+	_c.Call.Return(_a0, _a1, _a2, _a3)
+	return _c
+}
+
 type mockConstructorTestingTNewGoSlackCaller interface {
 	mock.TestingT
 	Cleanup(func())
@@ -119,17 +119,24 @@ func (_c *SlackCaller_GetWorkspaceChannels_Call) Return(_a0 []slack.Channel, _a1
 }
 
 // Notify provides a mock function with given fields: ctx, conf, message
-func (_m *SlackCaller) Notify(ctx context.Context, conf slack.NotificationConfig, message slack.Message) error {
+func (_m *SlackCaller) Notify(ctx context.Context, conf slack.NotificationConfig, message slack.Message) (slack.MessageReference, error) {
 	ret := _m.Called(ctx, conf, message)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, slack.NotificationConfig, slack.Message) error); ok {
+	var r0 slack.MessageReference
+	if rf, ok := ret.Get(0).(func(context.Context, slack.NotificationConfig, slack.Message) slack.MessageReference); ok {
 		r0 = rf(ctx, conf, message)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(slack.MessageReference)
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, slack.NotificationConfig, slack.Message) error); ok {
+		r1 = rf(ctx, conf, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // SlackCaller_Notify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Notify'
@@ -152,7 +159,47 @@ func (_c *SlackCaller_Notify_Call) Run(run func(ctx context.Context, conf slack.
 	return _c
 }
 
-func (_c *SlackCaller_Notify_Call) Return(_a0 error) *SlackCaller_Notify_Call {
+func (_c *SlackCaller_Notify_Call) Return(_a0 slack.MessageReference, _a1 error) *SlackCaller_Notify_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// UpdateMessage provides a mock function with given fields: ctx, conf, ref, message
+func (_m *SlackCaller) UpdateMessage(ctx context.Context, conf slack.NotificationConfig, ref slack.MessageReference, message slack.Message) error {
+	ret := _m.Called(ctx, conf, ref, message)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, slack.NotificationConfig, slack.MessageReference, slack.Message) error); ok {
+		r0 = rf(ctx, conf, ref, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SlackCaller_UpdateMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMessage'
+type SlackCaller_UpdateMessage_Call struct {
+	*mock.Call
+}
+
+// UpdateMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conf slack.NotificationConfig
+//   - ref slack.MessageReference
+//   - message slack.Message
+func (_e *SlackCaller_Expecter) UpdateMessage(ctx interface{}, conf interface{}, ref interface{}, message interface{}) *SlackCaller_UpdateMessage_Call {
+	return &SlackCaller_UpdateMessage_Call{Call: _e.mock.On("UpdateMessage", ctx, conf, ref, message)}
+}
+
+func (_c *SlackCaller_UpdateMessage_Call) Run(run func(ctx context.Context, conf slack.NotificationConfig, ref slack.MessageReference, message slack.Message)) *SlackCaller_UpdateMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(slack.NotificationConfig), args[2].(slack.MessageReference), args[3].(slack.Message))
+	})
+	return _c
+}
+
+func (_c *SlackCaller_UpdateMessage_Call) Return(_a0 error) *SlackCaller_UpdateMessage_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
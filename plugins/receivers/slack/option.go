@@ -1,6 +1,7 @@
 package slack
 
 import (
+	"github.com/odpf/siren/core/notification"
 	"github.com/odpf/siren/pkg/httpclient"
 	"github.com/odpf/siren/pkg/retry"
 )
@@ -27,3 +28,11 @@ func WithSlackClient(client SlackCaller) ServiceOption {
 		s.client = client
 	}
 }
+
+// WithThreadRepository stores the first posted message of a unique key
+// so later messages are posted as its replies and resolved message updates it
+func WithThreadRepository(repository notification.ThreadRepository) ServiceOption {
+	return func(s *PluginService) {
+		s.threadRepository = repository
+	}
+}
@@ -27,6 +27,8 @@ type PluginService struct {
 	cryptoClient Encryptor
 	httpClient   *httpclient.Client
 	retrier      retry.Runner
+
+	threadRepository notification.ThreadRepository
 }
 
 // NewPluginService returns slack plugin service struct. This service implement [receiver.Resolver] and [notification.Notifier] interface.
@@ -171,15 +173,15 @@ func (s *PluginService) PostHookQueueTransformConfigs(ctx context.Context, notif
 	return notificationConfig.AsMap(), nil
 }
 
-func (s *PluginService) Send(ctx context.Context, notificationMessage notification.Message) (bool, error) {
+func (s *PluginService) Send(ctx context.Context, notificationMessage notification.Message) (string, bool, error) {
 	notificationConfig := &NotificationConfig{}
 	if err := mapstructure.Decode(notificationMessage.Configs, notificationConfig); err != nil {
-		return false, err
+		return "", false, err
 	}
 
 	slackMessage := &Message{}
 	if err := mapstructure.Decode(notificationMessage.Details, &slackMessage); err != nil {
-		return false, err
+		return "", false, err
 	}
 
 	if notificationConfig.ChannelType == "" {
@@ -189,17 +191,72 @@ func (s *PluginService) Send(ctx context.Context, notificationMessage notificati
 		slackMessage.Channel = notificationConfig.ChannelName
 	}
 
-	if err := s.client.Notify(ctx, *notificationConfig, *slackMessage); err != nil {
+	var (
+		uniqueKey   = notificationMessage.UniqueKey()
+		threaded    = s.threadRepository != nil && uniqueKey != ""
+		destination = fmt.Sprintf("%s/%s", notificationConfig.Workspace, slackMessage.Channel)
+		thread      notification.Thread
+	)
+
+	if threaded {
+		var err error
+		thread, err = s.threadRepository.Get(ctx, uniqueKey, notificationMessage.ReceiverType, destination)
+		if err != nil && !errors.Is(err, errors.ErrNotFound) {
+			return "", true, fmt.Errorf("failed to get thread of %s: %w", uniqueKey, err)
+		}
+	}
+
+	if thread.ExternalID != "" {
+		if notificationMessage.IsAlertResolved() {
+			return s.resolveThread(ctx, *notificationConfig, thread, *slackMessage)
+		}
+		slackMessage.ThreadTS = thread.ExternalID
+	}
+
+	ref, err := s.client.Notify(ctx, *notificationConfig, *slackMessage)
+	if err != nil {
+		if errors.As(err, new(retry.RetryableError)) {
+			return "", true, err
+		} else {
+			return "", false, err
+		}
+	}
+
+	if threaded && thread.ExternalID == "" && !notificationMessage.IsAlertResolved() && ref.Timestamp != "" {
+		if err := s.threadRepository.Create(ctx, notification.Thread{
+			UniqueKey:    uniqueKey,
+			ReceiverType: notificationMessage.ReceiverType,
+			Destination:  destination,
+			ExternalID:   ref.Timestamp,
+			ChannelID:    ref.ChannelID,
+		}); err != nil {
+			// the message is already posted, retrying would post it twice
+			return ref.Timestamp, false, fmt.Errorf("failed to store thread of %s: %w", uniqueKey, err)
+		}
+	}
+
+	return ref.Timestamp, false, nil
+}
+
+// resolveThread updates the parent message of the thread with the resolved message
+// the thread is closed so the next firing message starts a new thread
+func (s *PluginService) resolveThread(ctx context.Context, notificationConfig NotificationConfig, thread notification.Thread, slackMessage Message) (string, bool, error) {
+	ref := MessageReference{ChannelID: thread.ChannelID, Timestamp: thread.ExternalID}
+	if err := s.client.UpdateMessage(ctx, notificationConfig, ref, slackMessage); err != nil {
 		if errors.As(err, new(retry.RetryableError)) {
-			return true, err
+			return "", true, err
 		} else {
-			return false, err
+			return "", false, err
 		}
 	}
 
-	return false, nil
+	if err := s.threadRepository.Delete(ctx, thread.UniqueKey, thread.ReceiverType, thread.Destination); err != nil {
+		return thread.ExternalID, false, fmt.Errorf("failed to close thread of %s: %w", thread.UniqueKey, err)
+	}
+
+	return thread.ExternalID, false, nil
 }
 
-func (s *PluginService) GetSystemDefaultTemplate() string {
+func (s *PluginService) GetSystemDefaultTemplate(notificationConfigMap map[string]interface{}) string {
 	return defaultAlertTemplateBody
 }
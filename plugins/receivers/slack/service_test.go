@@ -5,13 +5,17 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/mitchellh/mapstructure"
 	"github.com/odpf/siren/core/notification"
+	notificationmocks "github.com/odpf/siren/core/notification/mocks"
+	"github.com/odpf/siren/core/template"
 	"github.com/odpf/siren/pkg/errors"
 	"github.com/odpf/siren/pkg/retry"
 	"github.com/odpf/siren/pkg/secret"
 	"github.com/odpf/siren/plugins/receivers/slack"
 	"github.com/odpf/siren/plugins/receivers/slack/mocks"
 	"github.com/stretchr/testify/mock"
+	"gopkg.in/yaml.v3"
 )
 
 func TestService_BuildData(t *testing.T) {
@@ -84,10 +88,39 @@ func TestService_BuildData(t *testing.T) {
 }
 
 func TestService_Send(t *testing.T) {
+	var (
+		firingMessage = notification.Message{
+			ReceiverType: "slack",
+			Configs: map[string]interface{}{
+				"token":        "123123",
+				"workspace":    "odpf",
+				"channel_name": "test-channel",
+			},
+			Details: map[string]interface{}{
+				"text":                             "hello",
+				notification.DetailsKeyUniqueKey:   "some-unique-key",
+				notification.DetailsKeyAlertStatus: "firing",
+			},
+		}
+		resolvedMessage = notification.Message{
+			ReceiverType: "slack",
+			Configs: map[string]interface{}{
+				"token":        "123123",
+				"workspace":    "odpf",
+				"channel_name": "test-channel",
+			},
+			Details: map[string]interface{}{
+				"text":                             "hello",
+				notification.DetailsKeyUniqueKey:   "some-unique-key",
+				notification.DetailsKeyAlertStatus: "resolved",
+			},
+		}
+	)
 	tests := []struct {
 		name                string
-		setup               func(*mocks.SlackCaller)
+		setup               func(*mocks.SlackCaller, *notificationmocks.ThreadRepository)
 		notificationMessage notification.Message
+		wantExternalID      string
 		wantRetryable       bool
 		wantErr             bool
 	}{
@@ -111,8 +144,8 @@ func TestService_Send(t *testing.T) {
 		},
 		{
 			name: "should return error and not retryable if notify return error",
-			setup: func(sc *mocks.SlackCaller) {
-				sc.EXPECT().Notify(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("slack.NotificationConfig"), mock.AnythingOfType("slack.Message")).Return(errors.New("some error"))
+			setup: func(sc *mocks.SlackCaller, _ *notificationmocks.ThreadRepository) {
+				sc.EXPECT().Notify(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("slack.NotificationConfig"), mock.AnythingOfType("slack.Message")).Return(slack.MessageReference{}, errors.New("some error"))
 			},
 			notificationMessage: notification.Message{
 				Configs: map[string]interface{}{
@@ -127,8 +160,8 @@ func TestService_Send(t *testing.T) {
 		},
 		{
 			name: "should return error and retryable if notify return retryable error",
-			setup: func(sc *mocks.SlackCaller) {
-				sc.EXPECT().Notify(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("slack.NotificationConfig"), mock.AnythingOfType("slack.Message")).Return(retry.RetryableError{Err: errors.New("some error")})
+			setup: func(sc *mocks.SlackCaller, _ *notificationmocks.ThreadRepository) {
+				sc.EXPECT().Notify(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("slack.NotificationConfig"), mock.AnythingOfType("slack.Message")).Return(slack.MessageReference{}, retry.RetryableError{Err: errors.New("some error")})
 			},
 			notificationMessage: notification.Message{
 				Configs: map[string]interface{}{
@@ -141,18 +174,131 @@ func TestService_Send(t *testing.T) {
 			wantRetryable: true,
 			wantErr:       true,
 		},
+		{
+			name: "should start a thread with the posted message of firing alert",
+			setup: func(sc *mocks.SlackCaller, tr *notificationmocks.ThreadRepository) {
+				tr.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), "some-unique-key", "slack", "odpf/test-channel").Return(notification.Thread{}, errors.ErrNotFound)
+				sc.EXPECT().Notify(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("slack.NotificationConfig"), mock.AnythingOfType("slack.Message")).Return(slack.MessageReference{
+					ChannelID: "channel-id",
+					Timestamp: "1503435956.000247",
+				}, nil)
+				tr.EXPECT().Create(mock.AnythingOfType("*context.emptyCtx"), notification.Thread{
+					UniqueKey:    "some-unique-key",
+					ReceiverType: "slack",
+					Destination:  "odpf/test-channel",
+					ExternalID:   "1503435956.000247",
+					ChannelID:    "channel-id",
+				}).Return(nil)
+			},
+			notificationMessage: firingMessage,
+			wantExternalID:      "1503435956.000247",
+		},
+		{
+			name: "should return error and not retryable if failed to store thread",
+			setup: func(sc *mocks.SlackCaller, tr *notificationmocks.ThreadRepository) {
+				tr.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), "some-unique-key", "slack", "odpf/test-channel").Return(notification.Thread{}, errors.ErrNotFound)
+				sc.EXPECT().Notify(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("slack.NotificationConfig"), mock.AnythingOfType("slack.Message")).Return(slack.MessageReference{
+					ChannelID: "channel-id",
+					Timestamp: "1503435956.000247",
+				}, nil)
+				tr.EXPECT().Create(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("notification.Thread")).Return(errors.New("some error"))
+			},
+			notificationMessage: firingMessage,
+			wantExternalID:      "1503435956.000247",
+			wantRetryable:       false,
+			wantErr:             true,
+		},
+		{
+			name: "should reply to the thread if firing alert is notified again",
+			setup: func(sc *mocks.SlackCaller, tr *notificationmocks.ThreadRepository) {
+				tr.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), "some-unique-key", "slack", "odpf/test-channel").Return(notification.Thread{
+					UniqueKey:    "some-unique-key",
+					ReceiverType: "slack",
+					Destination:  "odpf/test-channel",
+					ExternalID:   "1503435956.000247",
+				}, nil)
+				sc.EXPECT().Notify(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("slack.NotificationConfig"), slack.Message{
+					Channel:  "test-channel",
+					Text:     "hello",
+					ThreadTS: "1503435956.000247",
+				}).Return(slack.MessageReference{
+					ChannelID: "channel-id",
+					Timestamp: "1503435999.000100",
+				}, nil)
+			},
+			notificationMessage: firingMessage,
+			wantExternalID:      "1503435999.000100",
+		},
+		{
+			name: "should update the parent message and close the thread of resolved alert",
+			setup: func(sc *mocks.SlackCaller, tr *notificationmocks.ThreadRepository) {
+				tr.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), "some-unique-key", "slack", "odpf/test-channel").Return(notification.Thread{
+					UniqueKey:    "some-unique-key",
+					ReceiverType: "slack",
+					Destination:  "odpf/test-channel",
+					ExternalID:   "1503435956.000247",
+					ChannelID:    "D123",
+				}, nil)
+				sc.EXPECT().UpdateMessage(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("slack.NotificationConfig"), slack.MessageReference{
+					ChannelID: "D123",
+					Timestamp: "1503435956.000247",
+				}, mock.AnythingOfType("slack.Message")).Return(nil)
+				tr.EXPECT().Delete(mock.AnythingOfType("*context.emptyCtx"), "some-unique-key", "slack", "odpf/test-channel").Return(nil)
+			},
+			notificationMessage: resolvedMessage,
+			wantExternalID:      "1503435956.000247",
+		},
+		{
+			name: "should return error and retryable if update message return retryable error",
+			setup: func(sc *mocks.SlackCaller, tr *notificationmocks.ThreadRepository) {
+				tr.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), "some-unique-key", "slack", "odpf/test-channel").Return(notification.Thread{
+					ExternalID: "1503435956.000247",
+				}, nil)
+				sc.EXPECT().UpdateMessage(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("slack.NotificationConfig"), slack.MessageReference{Timestamp: "1503435956.000247"}, mock.AnythingOfType("slack.Message")).Return(retry.RetryableError{Err: errors.New("some error")})
+			},
+			notificationMessage: resolvedMessage,
+			wantRetryable:       true,
+			wantErr:             true,
+		},
+		{
+			name: "should post a new message of resolved alert if there is no thread",
+			setup: func(sc *mocks.SlackCaller, tr *notificationmocks.ThreadRepository) {
+				tr.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), "some-unique-key", "slack", "odpf/test-channel").Return(notification.Thread{}, errors.ErrNotFound)
+				sc.EXPECT().Notify(mock.AnythingOfType("*context.emptyCtx"), mock.AnythingOfType("slack.NotificationConfig"), mock.AnythingOfType("slack.Message")).Return(slack.MessageReference{
+					ChannelID: "channel-id",
+					Timestamp: "1503435956.000247",
+				}, nil)
+			},
+			notificationMessage: resolvedMessage,
+			wantExternalID:      "1503435956.000247",
+		},
+		{
+			name: "should return error and retryable if failed to get thread",
+			setup: func(sc *mocks.SlackCaller, tr *notificationmocks.ThreadRepository) {
+				tr.EXPECT().Get(mock.AnythingOfType("*context.emptyCtx"), "some-unique-key", "slack", "odpf/test-channel").Return(notification.Thread{}, errors.New("some error"))
+			},
+			notificationMessage: resolvedMessage,
+			wantRetryable:       true,
+			wantErr:             true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mockSlackClient := new(mocks.SlackCaller)
+			var (
+				mockSlackClient      = new(mocks.SlackCaller)
+				mockThreadRepository = new(notificationmocks.ThreadRepository)
+			)
 
 			if tt.setup != nil {
-				tt.setup(mockSlackClient)
+				tt.setup(mockSlackClient, mockThreadRepository)
 			}
 
-			s := slack.NewPluginService(slack.AppConfig{}, nil, slack.WithSlackClient(mockSlackClient))
+			s := slack.NewPluginService(slack.AppConfig{}, nil,
+				slack.WithSlackClient(mockSlackClient),
+				slack.WithThreadRepository(mockThreadRepository),
+			)
 
-			got, err := s.Send(context.Background(), tt.notificationMessage)
+			externalID, got, err := s.Send(context.Background(), tt.notificationMessage)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Service.Publish() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -160,6 +306,10 @@ func TestService_Send(t *testing.T) {
 			if got != tt.wantRetryable {
 				t.Errorf("Service.Publish() = %v, want %v", got, tt.wantRetryable)
 			}
+			if externalID != tt.wantExternalID {
+				t.Errorf("Service.Publish() externalID = %v, want %v", externalID, tt.wantExternalID)
+			}
+			mockThreadRepository.AssertExpectations(t)
 		})
 	}
 }
@@ -309,3 +459,100 @@ func TestService_PostHookQueueTransformConfigs(t *testing.T) {
 		})
 	}
 }
+
+func TestService_GetSystemDefaultTemplate(t *testing.T) {
+	tests := []struct {
+		name            string
+		n               notification.Notification
+		wantText        string
+		wantColor       string
+		wantBlockTypes  []string
+		wantAttachments int
+	}{
+		{
+			name: "should render block kit layout with severity colour, runbook button, and dashboard link",
+			n: notification.Notification{
+				Data: map[string]interface{}{
+					"status":            "firing",
+					"num_alerts_firing": 1,
+					"summary":           "cpu usage is high",
+					"dashboard":         "http://dashboard",
+					"playbook":          "http://runbook",
+				},
+				Labels: map[string]string{
+					"severity":  "CRITICAL",
+					"alertname": "cpu-high",
+				},
+			},
+			wantText:        ":fire: (FIRING:1) *(CRITICAL)* cpu-high",
+			wantColor:       "danger",
+			wantBlockTypes:  []string{"section", "context", "actions"},
+			wantAttachments: 1,
+		},
+		{
+			name: "should not render attachment if there is no summary, dashboard, and runbook",
+			n: notification.Notification{
+				Data: map[string]interface{}{
+					"status": "resolved",
+				},
+				Labels: map[string]string{
+					"severity":  "WARNING",
+					"alertname": "cpu-high",
+				},
+			},
+			wantText: ":white_check_mark: (RESOLVED) ~(WARNING)~ cpu-high",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := slack.NewPluginService(slack.AppConfig{}, nil)
+
+			rendered, err := template.RenderBody(s.GetSystemDefaultTemplate(nil), tt.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var details map[string]interface{}
+			if err := yaml.Unmarshal([]byte(rendered), &details); err != nil {
+				t.Fatalf("failed to unmarshal rendered template: %v\n%s", err, rendered)
+			}
+
+			msg := slack.Message{}
+			if err := mapstructure.Decode(details, &msg); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := msg.BuildGoSlackMessageOptions(); err != nil {
+				t.Fatal(err)
+			}
+
+			if msg.Text != tt.wantText {
+				t.Errorf("got text %q, want %q", msg.Text, tt.wantText)
+			}
+			if len(msg.Blocks) != 1 {
+				t.Errorf("got %d blocks, want 1", len(msg.Blocks))
+			}
+			if len(msg.Attachments) != tt.wantAttachments {
+				t.Fatalf("got %d attachments, want %d", len(msg.Attachments), tt.wantAttachments)
+			}
+			if tt.wantAttachments == 0 {
+				return
+			}
+
+			attachment, err := msg.Attachments[0].ToGoSlack()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if attachment.Color != tt.wantColor {
+				t.Errorf("got color %q, want %q", attachment.Color, tt.wantColor)
+			}
+			var gotBlockTypes []string
+			for _, b := range attachment.Blocks.BlockSet {
+				gotBlockTypes = append(gotBlockTypes, string(b.BlockType()))
+			}
+			if !reflect.DeepEqual(gotBlockTypes, tt.wantBlockTypes) {
+				t.Errorf("got block types %v, want %v", gotBlockTypes, tt.wantBlockTypes)
+			}
+		})
+	}
+}
@@ -16,5 +16,6 @@ type Encryptor interface {
 type SlackCaller interface {
 	ExchangeAuth(ctx context.Context, authCode, clientID, clientSecret string) (Credential, error)
 	GetWorkspaceChannels(ctx context.Context, token secret.MaskableString) ([]Channel, error)
-	Notify(ctx context.Context, conf NotificationConfig, message Message) error
+	Notify(ctx context.Context, conf NotificationConfig, message Message) (MessageReference, error)
+	UpdateMessage(ctx context.Context, conf NotificationConfig, ref MessageReference, message Message) error
 }
@@ -0,0 +1,135 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/odpf/siren/pkg/httpclient"
+	"github.com/odpf/siren/pkg/retry"
+)
+
+const (
+	defaultTelegramAPIHost = "https://api.telegram.org"
+	sendMessagePathFormat  = "%s/bot%s/sendMessage"
+)
+
+type ClientOption func(*Client)
+
+// ClientWithHTTPClient assigns custom client when creating a telegram client
+func ClientWithHTTPClient(cli *httpclient.Client) ClientOption {
+	return func(c *Client) {
+		c.httpClient = cli
+	}
+}
+
+// ClientWithRetrier wraps client call with retrier
+func ClientWithRetrier(runner retry.Runner) ClientOption {
+	return func(c *Client) {
+		c.retrier = runner
+	}
+}
+
+type Client struct {
+	cfg        AppConfig
+	httpClient *httpclient.Client
+	retrier    retry.Runner
+}
+
+func NewClient(cfg AppConfig, opts ...ClientOption) *Client {
+	if cfg.APIHost == "" {
+		cfg.APIHost = defaultTelegramAPIHost
+	}
+
+	c := &Client{
+		cfg: cfg,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.httpClient == nil {
+		c.httpClient = httpclient.New(cfg.HTTPClient)
+	}
+
+	return c
+}
+
+// Notify sends the message to the chat with telegram bot api and returns the sent message id
+func (c *Client) Notify(ctx context.Context, conf NotificationConfig, message Message) (int64, error) {
+	var messageID int64
+	if c.retrier != nil {
+		err := c.retrier.Run(ctx, func(ctx context.Context) error {
+			var err error
+			messageID, err = c.notify(ctx, conf, message)
+			return err
+		})
+		return messageID, err
+	}
+	return c.notify(ctx, conf, message)
+}
+
+func (c *Client) notify(ctx context.Context, conf NotificationConfig, message Message) (int64, error) {
+	if err := message.Validate(); err != nil {
+		return 0, err
+	}
+
+	payloadJSON, err := json.Marshal(Payload{
+		ChatID:  conf.ChatID,
+		Message: message,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal telegram payload: %w", err)
+	}
+
+	apiURL := fmt.Sprintf(sendMessagePathFormat, c.cfg.APIHost, conf.BotToken.UnmaskedString())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, apiURL, bytes.NewReader(payloadJSON))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request body: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.HTTP().Do(req)
+	if err != nil {
+		// the bot token is part of the url, do not leak it in the error
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return 0, retry.RetryableError{Err: fmt.Errorf("failure in http call: %w", err)}
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var response Response
+	_ = json.Unmarshal(bodyBytes, &response)
+
+	if resp.StatusCode == 429 || resp.StatusCode >= 500 {
+		rtErr := retry.RetryableError{Err: errors.New(http.StatusText(resp.StatusCode))}
+		if response.Parameters != nil && response.Parameters.RetryAfter > 0 {
+			rtErr.RetryAfter = time.Duration(response.Parameters.RetryAfter) * time.Second
+		}
+		return 0, rtErr
+	}
+
+	if resp.StatusCode >= 300 || !response.OK {
+		return 0, fmt.Errorf("error with status code %s and body %s", http.StatusText(resp.StatusCode), string(bodyBytes))
+	}
+
+	if response.Result == nil {
+		return 0, nil
+	}
+
+	return response.Result.MessageID, nil
+}
@@ -0,0 +1,130 @@
+package telegram_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/odpf/siren/pkg/retry"
+	"github.com/odpf/siren/plugins/receivers/telegram"
+	"github.com/stretchr/testify/assert"
+)
+
+var testNotificationConfig = telegram.NotificationConfig{
+	ReceiverConfig: telegram.ReceiverConfig{
+		BotToken: "123:abc",
+		ChatID:   "-100123",
+	},
+}
+
+func TestClient_Notify(t *testing.T) {
+	t.Run("return error when message is invalid", func(t *testing.T) {
+		c := telegram.NewClient(telegram.AppConfig{})
+		_, err := c.Notify(context.Background(), testNotificationConfig, telegram.Message{})
+
+		assert.EqualError(t, err, "telegram message has no text")
+	})
+
+	t.Run("return retryable error with retry after when api returns 429", func(t *testing.T) {
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 7","parameters":{"retry_after":7}}`))
+		}))
+		defer testServer.Close()
+
+		c := telegram.NewClient(telegram.AppConfig{APIHost: testServer.URL})
+		_, err := c.Notify(context.Background(), testNotificationConfig, telegram.Message{Text: "hello"})
+
+		var rtErr retry.RetryableError
+		assert.True(t, errors.As(err, &rtErr))
+		assert.Equal(t, 7*time.Second, rtErr.RetryAfter)
+	})
+
+	t.Run("return retryable error when api returns 5xx", func(t *testing.T) {
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer testServer.Close()
+
+		c := telegram.NewClient(telegram.AppConfig{APIHost: testServer.URL})
+		_, err := c.Notify(context.Background(), testNotificationConfig, telegram.Message{Text: "hello"})
+
+		var rtErr retry.RetryableError
+		assert.True(t, errors.As(err, &rtErr))
+		assert.Zero(t, rtErr.RetryAfter)
+	})
+
+	t.Run("return non retryable error when api returns 4xx", func(t *testing.T) {
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`))
+		}))
+		defer testServer.Close()
+
+		c := telegram.NewClient(telegram.AppConfig{APIHost: testServer.URL})
+		_, err := c.Notify(context.Background(), testNotificationConfig, telegram.Message{Text: "hello"})
+
+		assert.EqualError(t, err, `error with status code Bad Request and body {"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`)
+		assert.False(t, errors.As(err, new(retry.RetryableError)))
+	})
+
+	t.Run("return error without bot token when http call failed", func(t *testing.T) {
+		c := telegram.NewClient(telegram.AppConfig{APIHost: "http://localhost:0"})
+		_, err := c.Notify(context.Background(), testNotificationConfig, telegram.Message{Text: "hello"})
+
+		assert.True(t, errors.As(err, new(retry.RetryableError)))
+		assert.NotContains(t, err.Error(), "123:abc")
+	})
+
+	t.Run("return message id when notify succeed", func(t *testing.T) {
+		var payload map[string]interface{}
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/bot123:abc/sendMessage", r.URL.Path)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			w.Write([]byte(`{"ok":true,"result":{"message_id":42}}`))
+		}))
+		defer testServer.Close()
+
+		c := telegram.NewClient(telegram.AppConfig{APIHost: testServer.URL})
+		messageID, err := c.Notify(context.Background(), testNotificationConfig, telegram.Message{Text: "<b>hello</b>", ParseMode: telegram.ParseModeHTML})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(42), messageID)
+		assert.Equal(t, map[string]interface{}{
+			"chat_id":    "-100123",
+			"text":       "<b>hello</b>",
+			"parse_mode": "HTML",
+		}, payload)
+	})
+
+	t.Run("retry the call after retry after when api returns 429", func(t *testing.T) {
+		var (
+			counter int
+			calls   []time.Time
+		)
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			counter++
+			calls = append(calls, time.Now())
+			if counter < 2 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				w.Write([]byte(`{"ok":false,"error_code":429,"parameters":{"retry_after":1}}`))
+				return
+			}
+			w.Write([]byte(`{"ok":true,"result":{"message_id":42}}`))
+		}))
+		defer testServer.Close()
+
+		c := telegram.NewClient(telegram.AppConfig{APIHost: testServer.URL}, telegram.ClientWithRetrier(retry.New(retry.Config{Enable: true})))
+		messageID, err := c.Notify(context.Background(), testNotificationConfig, telegram.Message{Text: "hello"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(42), messageID)
+		assert.Equal(t, 2, counter)
+		assert.GreaterOrEqual(t, calls[1].Sub(calls[0]), time.Second)
+	})
+}
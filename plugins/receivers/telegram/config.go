@@ -0,0 +1,48 @@
+package telegram
+
+import (
+	"fmt"
+
+	"github.com/odpf/siren/pkg/httpclient"
+	"github.com/odpf/siren/pkg/retry"
+	"github.com/odpf/siren/pkg/secret"
+)
+
+// AppConfig is a config loaded when siren is started
+type AppConfig struct {
+	APIHost    string            `mapstructure:"api_host" yaml:"api_host"`
+	Retry      retry.Config      `mapstructure:"retry" yaml:"retry"`
+	HTTPClient httpclient.Config `mapstructure:"http_client" yaml:"http_client"`
+}
+
+// ReceiverConfig is a stored config for a telegram receiver
+type ReceiverConfig struct {
+	BotToken secret.MaskableString `mapstructure:"bot_token"`
+	ChatID   string                `mapstructure:"chat_id"`
+}
+
+func (c *ReceiverConfig) Validate() error {
+	if c.BotToken == "" {
+		return fmt.Errorf("invalid telegram receiver config, bot_token: %s", c.BotToken)
+	}
+	if c.ChatID == "" {
+		return fmt.Errorf("invalid telegram receiver config, chat_id: %s", c.ChatID)
+	}
+	return nil
+}
+
+func (c *ReceiverConfig) AsMap() map[string]interface{} {
+	return map[string]interface{}{
+		"bot_token": c.BotToken,
+		"chat_id":   c.ChatID,
+	}
+}
+
+// NotificationConfig has all configs needed to send notification
+type NotificationConfig struct {
+	ReceiverConfig `mapstructure:",squash"`
+}
+
+func (c *NotificationConfig) AsMap() map[string]interface{} {
+	return c.ReceiverConfig.AsMap()
+}
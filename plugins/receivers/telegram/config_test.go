@@ -0,0 +1,62 @@
+package telegram
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/odpf/siren/pkg/secret"
+)
+
+func TestReceiverConfig(t *testing.T) {
+	t.Run("validate", func(t *testing.T) {
+		testCases := []struct {
+			name    string
+			c       ReceiverConfig
+			wantErr bool
+		}{
+			{
+				name:    "return error if bot token is missing",
+				c:       ReceiverConfig{ChatID: "-100123"},
+				wantErr: true,
+			},
+			{
+				name:    "return error if chat id is missing",
+				c:       ReceiverConfig{BotToken: "123:abc"},
+				wantErr: true,
+			},
+			{
+				name: "return nil if all required fields are present",
+				c: ReceiverConfig{
+					BotToken: "123:abc",
+					ChatID:   "-100123",
+				},
+				wantErr: false,
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				if err := tc.c.Validate(); (err != nil) != tc.wantErr {
+					t.Errorf("ReceiverConfig.Validate() error = %v, wantErr %v", err, tc.wantErr)
+				}
+			})
+		}
+	})
+}
+
+func TestNotificationConfig(t *testing.T) {
+	t.Run("AsMap", func(t *testing.T) {
+		nc := NotificationConfig{
+			ReceiverConfig: ReceiverConfig{
+				BotToken: "123:abc",
+				ChatID:   "-100123",
+			},
+		}
+
+		if diff := cmp.Diff(map[string]interface{}{
+			"bot_token": secret.MaskableString("123:abc"),
+			"chat_id":   "-100123",
+		}, nc.AsMap()); diff != "" {
+			t.Errorf("result not match\n%v", diff)
+		}
+	})
+}
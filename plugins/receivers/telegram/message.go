@@ -0,0 +1,63 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+const (
+	ParseModeMarkdown   = "Markdown"
+	ParseModeMarkdownV2 = "MarkdownV2"
+	ParseModeHTML       = "HTML"
+
+	maxTextLength = 4096
+)
+
+// Message is the content of a telegram message
+// parse mode is empty for plain text
+type Message struct {
+	Text                  string `yaml:"text,omitempty" json:"text,omitempty" mapstructure:"text"`
+	ParseMode             string `yaml:"parse_mode,omitempty" json:"parse_mode,omitempty" mapstructure:"parse_mode"`
+	DisableWebPagePreview bool   `yaml:"disable_web_page_preview,omitempty" json:"disable_web_page_preview,omitempty" mapstructure:"disable_web_page_preview"`
+	DisableNotification   bool   `yaml:"disable_notification,omitempty" json:"disable_notification,omitempty" mapstructure:"disable_notification"`
+}
+
+func (m Message) Validate() error {
+	if m.Text == "" {
+		return errors.New("telegram message has no text")
+	}
+	if utf8.RuneCountInString(m.Text) > maxTextLength {
+		return fmt.Errorf("telegram message text exceeds %d characters", maxTextLength)
+	}
+	switch m.ParseMode {
+	case "", ParseModeMarkdown, ParseModeMarkdownV2, ParseModeHTML:
+	default:
+		return fmt.Errorf("invalid telegram parse_mode: %s", m.ParseMode)
+	}
+	return nil
+}
+
+// Payload is the request body of telegram sendMessage method
+type Payload struct {
+	ChatID string `json:"chat_id"`
+	Message
+}
+
+// Response is the response body of telegram bot api
+type Response struct {
+	OK          bool               `json:"ok"`
+	ErrorCode   int                `json:"error_code,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Result      *ResponseResult    `json:"result,omitempty"`
+	Parameters  *ResponseParameter `json:"parameters,omitempty"`
+}
+
+type ResponseResult struct {
+	MessageID int64 `json:"message_id"`
+}
+
+type ResponseParameter struct {
+	// RetryAfter is the number of seconds left to wait before the request can be repeated
+	RetryAfter int `json:"retry_after,omitempty"`
+}
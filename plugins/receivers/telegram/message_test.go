@@ -0,0 +1,56 @@
+package telegram_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/odpf/siren/plugins/receivers/telegram"
+)
+
+func TestMessage_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		m       telegram.Message
+		wantErr bool
+	}{
+		{
+			name:    "should return error if message has no text",
+			wantErr: true,
+		},
+		{
+			name: "should return error if text is too long",
+			m: telegram.Message{
+				Text: strings.Repeat("a", 4097),
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return error if parse mode is unknown",
+			m: telegram.Message{
+				Text:      "hello",
+				ParseMode: "html",
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return nil if plain text",
+			m: telegram.Message{
+				Text: "hello",
+			},
+		},
+		{
+			name: "should return nil if parse mode is supported",
+			m: telegram.Message{
+				Text:      "<b>hello</b>",
+				ParseMode: telegram.ParseModeHTML,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.m.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Message.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	telegram "github.com/odpf/siren/plugins/receivers/telegram"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TelegramCaller is an autogenerated mock type for the TelegramCaller type
+type TelegramCaller struct {
+	mock.Mock
+}
+
+type TelegramCaller_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TelegramCaller) EXPECT() *TelegramCaller_Expecter {
+	return &TelegramCaller_Expecter{mock: &_m.Mock}
+}
+
+// Notify provides a mock function with given fields: ctx, conf, message
+func (_m *TelegramCaller) Notify(ctx context.Context, conf telegram.NotificationConfig, message telegram.Message) (int64, error) {
+	ret := _m.Called(ctx, conf, message)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, telegram.NotificationConfig, telegram.Message) int64); ok {
+		r0 = rf(ctx, conf, message)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, telegram.NotificationConfig, telegram.Message) error); ok {
+		r1 = rf(ctx, conf, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TelegramCaller_Notify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Notify'
+type TelegramCaller_Notify_Call struct {
+	*mock.Call
+}
+
+// Notify is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conf telegram.NotificationConfig
+//   - message telegram.Message
+func (_e *TelegramCaller_Expecter) Notify(ctx interface{}, conf interface{}, message interface{}) *TelegramCaller_Notify_Call {
+	return &TelegramCaller_Notify_Call{Call: _e.mock.On("Notify", ctx, conf, message)}
+}
+
+func (_c *TelegramCaller_Notify_Call) Run(run func(ctx context.Context, conf telegram.NotificationConfig, message telegram.Message)) *TelegramCaller_Notify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(telegram.NotificationConfig), args[2].(telegram.Message))
+	})
+	return _c
+}
+
+func (_c *TelegramCaller_Notify_Call) Return(_a0 int64, _a1 error) *TelegramCaller_Notify_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+type mockConstructorTestingTNewTelegramCaller interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewTelegramCaller creates a new instance of TelegramCaller. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewTelegramCaller(t mockConstructorTestingTNewTelegramCaller) *TelegramCaller {
+	mock := &TelegramCaller{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,156 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/odpf/siren/core/notification"
+	"github.com/odpf/siren/pkg/errors"
+	"github.com/odpf/siren/pkg/httpclient"
+	"github.com/odpf/siren/pkg/retry"
+	"github.com/odpf/siren/plugins/receivers/base"
+)
+
+// PluginService is a plugin service layer for telegram
+type PluginService struct {
+	base.UnimplementedService
+	client       TelegramCaller
+	cryptoClient Encryptor
+	httpClient   *httpclient.Client
+	retrier      retry.Runner
+}
+
+// NewPluginService returns telegram plugin service struct. This service implement [receiver.Resolver] and [notification.Notifier] interface.
+func NewPluginService(cfg AppConfig, cryptoClient Encryptor, opts ...ServiceOption) *PluginService {
+	s := &PluginService{}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.cryptoClient = cryptoClient
+
+	if s.httpClient == nil {
+		s.httpClient = httpclient.New(cfg.HTTPClient)
+	}
+
+	if s.retrier == nil {
+		s.retrier = retry.New(cfg.Retry)
+	}
+
+	if s.client == nil {
+		s.client = NewClient(cfg, ClientWithHTTPClient(s.httpClient), ClientWithRetrier(s.retrier))
+	}
+
+	return s
+}
+
+func (s *PluginService) PreHookDBTransformConfigs(ctx context.Context, configurations map[string]interface{}) (map[string]interface{}, error) {
+	receiverConfig := &ReceiverConfig{}
+	if err := mapstructure.Decode(configurations, receiverConfig); err != nil {
+		return nil, fmt.Errorf("failed to transform configurations to receiver config: %w", err)
+	}
+
+	if err := receiverConfig.Validate(); err != nil {
+		return nil, errors.ErrInvalid.WithMsgf(err.Error())
+	}
+
+	cipherText, err := s.cryptoClient.Encrypt(receiverConfig.BotToken)
+	if err != nil {
+		return nil, fmt.Errorf("telegram bot token encryption failed: %w", err)
+	}
+
+	receiverConfig.BotToken = cipherText
+
+	return receiverConfig.AsMap(), nil
+}
+
+// PostHookDBTransformConfigs do transformation in post-hook service lifecycle
+func (s *PluginService) PostHookDBTransformConfigs(ctx context.Context, configurations map[string]interface{}) (map[string]interface{}, error) {
+	receiverConfig := &ReceiverConfig{}
+	if err := mapstructure.Decode(configurations, receiverConfig); err != nil {
+		return nil, fmt.Errorf("failed to transform configurations to receiver config: %w", err)
+	}
+
+	if err := receiverConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	botToken, err := s.cryptoClient.Decrypt(receiverConfig.BotToken)
+	if err != nil {
+		return nil, fmt.Errorf("telegram bot token decryption failed: %w", err)
+	}
+
+	receiverConfig.BotToken = botToken
+
+	return receiverConfig.AsMap(), nil
+}
+
+func (s *PluginService) PreHookQueueTransformConfigs(ctx context.Context, notificationConfigMap map[string]interface{}) (map[string]interface{}, error) {
+	notificationConfig := &NotificationConfig{}
+	if err := mapstructure.Decode(notificationConfigMap, notificationConfig); err != nil {
+		return nil, fmt.Errorf("failed to transform configurations to telegram notification config: %w", err)
+	}
+
+	if err := notificationConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	cipher, err := s.cryptoClient.Encrypt(notificationConfig.BotToken)
+	if err != nil {
+		return nil, fmt.Errorf("telegram bot token encryption failed: %w", err)
+	}
+
+	notificationConfig.BotToken = cipher
+
+	return notificationConfig.AsMap(), nil
+}
+
+func (s *PluginService) PostHookQueueTransformConfigs(ctx context.Context, notificationConfigMap map[string]interface{}) (map[string]interface{}, error) {
+	notificationConfig := &NotificationConfig{}
+	if err := mapstructure.Decode(notificationConfigMap, notificationConfig); err != nil {
+		return nil, fmt.Errorf("failed to transform configurations to notification config: %w", err)
+	}
+
+	if err := notificationConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	botToken, err := s.cryptoClient.Decrypt(notificationConfig.BotToken)
+	if err != nil {
+		return nil, fmt.Errorf("telegram bot token decryption failed: %w", err)
+	}
+
+	notificationConfig.BotToken = botToken
+
+	return notificationConfig.AsMap(), nil
+}
+
+func (s *PluginService) Send(ctx context.Context, notificationMessage notification.Message) (string, bool, error) {
+	notificationConfig := &NotificationConfig{}
+	if err := mapstructure.Decode(notificationMessage.Configs, notificationConfig); err != nil {
+		return "", false, err
+	}
+
+	telegramMessage := &Message{}
+	if err := mapstructure.Decode(notificationMessage.Details, telegramMessage); err != nil {
+		return "", false, err
+	}
+
+	messageID, err := s.client.Notify(ctx, *notificationConfig, *telegramMessage)
+	if err != nil {
+		if errors.As(err, new(retry.RetryableError)) {
+			return "", true, err
+		} else {
+			return "", false, err
+		}
+	}
+
+	return strconv.FormatInt(messageID, 10), false, nil
+}
+
+func (s *PluginService) GetSystemDefaultTemplate(notificationConfigMap map[string]interface{}) string {
+	return defaultAlertTemplateBody
+}
@@ -0,0 +1,202 @@
+package telegram_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/odpf/siren/core/notification"
+	"github.com/odpf/siren/core/template"
+	"github.com/odpf/siren/pkg/errors"
+	"github.com/odpf/siren/pkg/retry"
+	"github.com/odpf/siren/plugins/receivers/receivertest"
+	"github.com/odpf/siren/plugins/receivers/telegram"
+	"github.com/odpf/siren/plugins/receivers/telegram/mocks"
+	"github.com/stretchr/testify/mock"
+	"gopkg.in/yaml.v3"
+)
+
+func TestService_TransformConfigs(t *testing.T) {
+	receivertest.TestSecretConfigHooks(t, func(e receivertest.Encryptor) receivertest.ConfigTransformer {
+		return telegram.NewPluginService(telegram.AppConfig{}, e)
+	}, "bot_token", map[string]interface{}{
+		"bot_token": "123:abc",
+		"chat_id":   "-100123",
+	})
+}
+
+func TestService_Send(t *testing.T) {
+	tests := []struct {
+		name                string
+		setup               func(*mocks.TelegramCaller)
+		notificationMessage notification.Message
+		wantExternalID      string
+		wantRetryable       bool
+		wantErr             bool
+	}{
+		{
+			name: "should return error if failed to decode notification config",
+			notificationMessage: notification.Message{
+				Configs: map[string]interface{}{
+					"bot_token": true,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return error if failed to decode notification detail",
+			notificationMessage: notification.Message{
+				Details: map[string]interface{}{
+					"text": make(chan bool),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return error and not retryable if notify return error",
+			setup: func(mc *mocks.TelegramCaller) {
+				mc.EXPECT().Notify(mock.AnythingOfType("*context.emptyCtx"), testNotificationConfig, telegram.Message{Text: "hello"}).Return(0, errors.New("some error"))
+			},
+			notificationMessage: notification.Message{
+				Configs: map[string]interface{}{
+					"bot_token": "123:abc",
+					"chat_id":   "-100123",
+				},
+				Details: map[string]interface{}{
+					"text": "hello",
+				},
+			},
+			wantRetryable: false,
+			wantErr:       true,
+		},
+		{
+			name: "should return error and retryable if notify return retryable error",
+			setup: func(mc *mocks.TelegramCaller) {
+				mc.EXPECT().Notify(mock.AnythingOfType("*context.emptyCtx"), testNotificationConfig, telegram.Message{Text: "hello"}).Return(0, retry.RetryableError{Err: errors.New("some error")})
+			},
+			notificationMessage: notification.Message{
+				Configs: map[string]interface{}{
+					"bot_token": "123:abc",
+					"chat_id":   "-100123",
+				},
+				Details: map[string]interface{}{
+					"text": "hello",
+				},
+			},
+			wantRetryable: true,
+			wantErr:       true,
+		},
+		{
+			name: "should return no error if notify succeed",
+			setup: func(mc *mocks.TelegramCaller) {
+				mc.EXPECT().Notify(mock.AnythingOfType("*context.emptyCtx"), testNotificationConfig, telegram.Message{Text: "hello"}).Return(42, nil)
+			},
+			notificationMessage: notification.Message{
+				Configs: map[string]interface{}{
+					"bot_token": "123:abc",
+					"chat_id":   "-100123",
+				},
+				Details: map[string]interface{}{
+					"text": "hello",
+				},
+			},
+			wantExternalID: "42",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mockTelegramClient = new(mocks.TelegramCaller)
+			)
+
+			if tt.setup != nil {
+				tt.setup(mockTelegramClient)
+			}
+
+			s := telegram.NewPluginService(telegram.AppConfig{}, nil, telegram.WithTelegramClient(mockTelegramClient))
+
+			externalID, got, err := s.Send(context.Background(), tt.notificationMessage)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.Send() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if externalID != tt.wantExternalID {
+				t.Errorf("Service.Send() externalID = %v, want %v", externalID, tt.wantExternalID)
+			}
+			if got != tt.wantRetryable {
+				t.Errorf("Service.Send() = %v, want %v", got, tt.wantRetryable)
+			}
+			mockTelegramClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_GetSystemDefaultTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        notification.Notification
+		wantText string
+	}{
+		{
+			name: "should render escaped html with severity icon, summary, dashboard, and runbook",
+			n: notification.Notification{
+				Data: map[string]interface{}{
+					"status":            "firing",
+					"num_alerts_firing": 1,
+					"summary":           "cpu usage > 90%",
+					"dashboard":         "http://dashboard",
+					"playbook":          "http://runbook",
+				},
+				Labels: map[string]string{
+					"severity":  "CRITICAL",
+					"alertname": "cpu-high",
+				},
+			},
+			wantText: "🔥 <b>(FIRING:1) (CRITICAL) cpu-high</b>\n\ncpu usage &gt; 90%\n\n<a href=\"http://dashboard\">Dashboard</a> | <a href=\"http://runbook\">Runbook</a>\n",
+		},
+		{
+			name: "should only render title if there is no summary, dashboard, and runbook",
+			n: notification.Notification{
+				Data: map[string]interface{}{
+					"status": "resolved",
+				},
+				Labels: map[string]string{
+					"severity":  "WARNING",
+					"alertname": "cpu-high",
+				},
+			},
+			wantText: "✅ <b>(RESOLVED) (WARNING) cpu-high</b>\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := telegram.NewPluginService(telegram.AppConfig{}, nil)
+
+			rendered, err := template.RenderBody(s.GetSystemDefaultTemplate(nil), tt.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var details map[string]interface{}
+			if err := yaml.Unmarshal([]byte(rendered), &details); err != nil {
+				t.Fatalf("failed to unmarshal rendered template: %v\n%s", err, rendered)
+			}
+
+			msg := telegram.Message{}
+			if err := mapstructure.Decode(details, &msg); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := msg.Validate(); err != nil {
+				t.Fatal(err)
+			}
+
+			if msg.ParseMode != telegram.ParseModeHTML {
+				t.Errorf("got parse mode %q, want %q", msg.ParseMode, telegram.ParseModeHTML)
+			}
+			if msg.Text != tt.wantText {
+				t.Errorf("got text %q, want %q", msg.Text, tt.wantText)
+			}
+		})
+	}
+}
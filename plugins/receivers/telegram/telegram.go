@@ -0,0 +1,18 @@
+package telegram
+
+import (
+	"context"
+
+	"github.com/odpf/siren/pkg/secret"
+)
+
+//go:generate mockery --name=Encryptor -r --case underscore --with-expecter --structname Encryptor --filename encryptor.go --output=./mocks
+type Encryptor interface {
+	Encrypt(str secret.MaskableString) (secret.MaskableString, error)
+	Decrypt(str secret.MaskableString) (secret.MaskableString, error)
+}
+
+//go:generate mockery --name=TelegramCaller -r --case underscore --with-expecter --structname TelegramCaller --filename telegram_caller.go --output=./mocks
+type TelegramCaller interface {
+	Notify(ctx context.Context, conf NotificationConfig, message Message) (int64, error)
+}
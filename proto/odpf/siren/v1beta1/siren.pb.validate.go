@@ -156,6 +156,35 @@ func (m *Provider) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProviderValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProviderValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProviderValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ProviderMultiError(errors)
 	}
@@ -570,6 +599,35 @@ func (m *CreateProviderRequest) validate(all bool) error {
 
 	// no validation rules for Labels
 
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateProviderRequestValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateProviderRequestValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateProviderRequestValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return CreateProviderRequestMultiError(errors)
 	}
@@ -1078,6 +1136,35 @@ func (m *UpdateProviderRequest) validate(all bool) error {
 
 	// no validation rules for Labels
 
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateProviderRequestValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateProviderRequestValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateProviderRequestValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return UpdateProviderRequestMultiError(errors)
 	}
@@ -8104,31 +8191,33 @@ var _ interface {
 	ErrorName() string
 } = UpdateRuleResponseValidationError{}
 
-// Validate checks the field values on TemplateVariables with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TemplateVariables) Validate() error {
+// Validate checks the field values on PreviewRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreviewRuleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TemplateVariables with the rules
+// ValidateAll checks the field values on PreviewRuleRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TemplateVariablesMultiError, or nil if none found.
-func (m *TemplateVariables) ValidateAll() error {
+// PreviewRuleRequestMultiError, or nil if none found.
+func (m *PreviewRuleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TemplateVariables) validate(all bool) error {
+func (m *PreviewRuleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_TemplateVariables_Name_Pattern.MatchString(m.GetName()) {
-		err := TemplateVariablesValidationError{
-			field:  "Name",
+	// no validation rules for Enabled
+
+	if !_PreviewRuleRequest_GroupName_Pattern.MatchString(m.GetGroupName()) {
+		err := PreviewRuleRequestValidationError{
+			field:  "GroupName",
 			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
 		}
 		if !all {
@@ -8137,9 +8226,9 @@ func (m *TemplateVariables) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_TemplateVariables_Type_Pattern.MatchString(m.GetType()) {
-		err := TemplateVariablesValidationError{
-			field:  "Type",
+	if !_PreviewRuleRequest_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := PreviewRuleRequestValidationError{
+			field:  "Namespace",
 			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
 		}
 		if !all {
@@ -8148,23 +8237,66 @@ func (m *TemplateVariables) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Default
+	if !_PreviewRuleRequest_Template_Pattern.MatchString(m.GetTemplate()) {
+		err := PreviewRuleRequestValidationError{
+			field:  "Template",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Description
+	for idx, item := range m.GetVariables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PreviewRuleRequestValidationError{
+						field:  fmt.Sprintf("Variables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PreviewRuleRequestValidationError{
+						field:  fmt.Sprintf("Variables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PreviewRuleRequestValidationError{
+					field:  fmt.Sprintf("Variables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ProviderNamespace
 
 	if len(errors) > 0 {
-		return TemplateVariablesMultiError(errors)
+		return PreviewRuleRequestMultiError(errors)
 	}
 	return nil
 }
 
-// TemplateVariablesMultiError is an error wrapping multiple validation errors
-// returned by TemplateVariables.ValidateAll() if the designated constraints
+// PreviewRuleRequestMultiError is an error wrapping multiple validation errors
+// returned by PreviewRuleRequest.ValidateAll() if the designated constraints
 // aren't met.
-type TemplateVariablesMultiError []error
+type PreviewRuleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TemplateVariablesMultiError) Error() string {
+func (m PreviewRuleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8173,11 +8305,11 @@ func (m TemplateVariablesMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TemplateVariablesMultiError) AllErrors() []error { return m }
+func (m PreviewRuleRequestMultiError) AllErrors() []error { return m }
 
-// TemplateVariablesValidationError is the validation error returned by
-// TemplateVariables.Validate if the designated constraints aren't met.
-type TemplateVariablesValidationError struct {
+// PreviewRuleRequestValidationError is the validation error returned by
+// PreviewRuleRequest.Validate if the designated constraints aren't met.
+type PreviewRuleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8185,24 +8317,24 @@ type TemplateVariablesValidationError struct {
 }
 
 // Field function returns field value.
-func (e TemplateVariablesValidationError) Field() string { return e.field }
+func (e PreviewRuleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TemplateVariablesValidationError) Reason() string { return e.reason }
+func (e PreviewRuleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TemplateVariablesValidationError) Cause() error { return e.cause }
+func (e PreviewRuleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TemplateVariablesValidationError) Key() bool { return e.key }
+func (e PreviewRuleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TemplateVariablesValidationError) ErrorName() string {
-	return "TemplateVariablesValidationError"
+func (e PreviewRuleRequestValidationError) ErrorName() string {
+	return "PreviewRuleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TemplateVariablesValidationError) Error() string {
+func (e PreviewRuleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8214,14 +8346,14 @@ func (e TemplateVariablesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTemplateVariables.%s: %s%s",
+		"invalid %sPreviewRuleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TemplateVariablesValidationError{}
+var _ error = PreviewRuleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8229,137 +8361,185 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TemplateVariablesValidationError{}
+} = PreviewRuleRequestValidationError{}
 
-var _TemplateVariables_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+var _PreviewRuleRequest_GroupName_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
 
-var _TemplateVariables_Type_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+var _PreviewRuleRequest_Namespace_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
 
-// Validate checks the field values on Template with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Template) Validate() error {
+var _PreviewRuleRequest_Template_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on PreviewRuleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreviewRuleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Template with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TemplateMultiError, or nil
-// if none found.
-func (m *Template) ValidateAll() error {
+// ValidateAll checks the field values on PreviewRuleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreviewRuleResponseMultiError, or nil if none found.
+func (m *PreviewRuleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Template) validate(all bool) error {
+func (m *PreviewRuleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if !_Template_Name_Pattern.MatchString(m.GetName()) {
-		err := TemplateValidationError{
-			field:  "Name",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_Template_Body_Pattern.MatchString(m.GetBody()) {
-		err := TemplateValidationError{
-			field:  "Body",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetTags()) < 1 {
-		err := TemplateValidationError{
-			field:  "Tags",
-			reason: "value must contain at least 1 item(s)",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
+		switch v := interface{}(m.GetRule()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TemplateValidationError{
-					field:  "CreatedAt",
+				errors = append(errors, PreviewRuleResponseValidationError{
+					field:  "Rule",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TemplateValidationError{
-					field:  "CreatedAt",
+				errors = append(errors, PreviewRuleResponseValidationError{
+					field:  "Rule",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRule()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return TemplateValidationError{
-				field:  "CreatedAt",
+			return PreviewRuleResponseValidationError{
+				field:  "Rule",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TemplateValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TemplateValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TemplateValidationError{
-				field:  "UpdatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Current
 
-	if len(m.GetVariables()) < 1 {
-		err := TemplateValidationError{
-			field:  "Variables",
-			reason: "value must contain at least 1 item(s)",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Proposed
+
+	// no validation rules for Diff
+
+	if len(errors) > 0 {
+		return PreviewRuleResponseMultiError(errors)
+	}
+	return nil
+}
+
+// PreviewRuleResponseMultiError is an error wrapping multiple validation
+// errors returned by PreviewRuleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PreviewRuleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreviewRuleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreviewRuleResponseMultiError) AllErrors() []error { return m }
+
+// PreviewRuleResponseValidationError is the validation error returned by
+// PreviewRuleResponse.Validate if the designated constraints aren't met.
+type PreviewRuleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreviewRuleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreviewRuleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreviewRuleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreviewRuleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreviewRuleResponseValidationError) ErrorName() string {
+	return "PreviewRuleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreviewRuleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreviewRuleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreviewRuleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreviewRuleResponseValidationError{}
+
+// Validate checks the field values on RuleRevision with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RuleRevision) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RuleRevision with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RuleRevisionMultiError, or
+// nil if none found.
+func (m *RuleRevision) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RuleRevision) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for RuleId
+
+	// no validation rules for Name
+
+	// no validation rules for Enabled
+
+	// no validation rules for GroupName
+
+	// no validation rules for Namespace
+
+	// no validation rules for Template
 
 	for idx, item := range m.GetVariables() {
 		_, _ = idx, item
@@ -8368,7 +8548,7 @@ func (m *Template) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TemplateValidationError{
+					errors = append(errors, RuleRevisionValidationError{
 						field:  fmt.Sprintf("Variables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -8376,7 +8556,7 @@ func (m *Template) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, TemplateValidationError{
+					errors = append(errors, RuleRevisionValidationError{
 						field:  fmt.Sprintf("Variables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -8385,7 +8565,7 @@ func (m *Template) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return TemplateValidationError{
+				return RuleRevisionValidationError{
 					field:  fmt.Sprintf("Variables[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -8395,18 +8575,55 @@ func (m *Template) validate(all bool) error {
 
 	}
 
+	// no validation rules for ProviderNamespace
+
+	// no validation rules for Body
+
+	// no validation rules for Actor
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RuleRevisionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RuleRevisionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RuleRevisionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Deleted
+
 	if len(errors) > 0 {
-		return TemplateMultiError(errors)
+		return RuleRevisionMultiError(errors)
 	}
 	return nil
 }
 
-// TemplateMultiError is an error wrapping multiple validation errors returned
-// by Template.ValidateAll() if the designated constraints aren't met.
-type TemplateMultiError []error
+// RuleRevisionMultiError is an error wrapping multiple validation errors
+// returned by RuleRevision.ValidateAll() if the designated constraints aren't met.
+type RuleRevisionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TemplateMultiError) Error() string {
+func (m RuleRevisionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8415,11 +8632,11 @@ func (m TemplateMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TemplateMultiError) AllErrors() []error { return m }
+func (m RuleRevisionMultiError) AllErrors() []error { return m }
 
-// TemplateValidationError is the validation error returned by
-// Template.Validate if the designated constraints aren't met.
-type TemplateValidationError struct {
+// RuleRevisionValidationError is the validation error returned by
+// RuleRevision.Validate if the designated constraints aren't met.
+type RuleRevisionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8427,22 +8644,22 @@ type TemplateValidationError struct {
 }
 
 // Field function returns field value.
-func (e TemplateValidationError) Field() string { return e.field }
+func (e RuleRevisionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TemplateValidationError) Reason() string { return e.reason }
+func (e RuleRevisionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TemplateValidationError) Cause() error { return e.cause }
+func (e RuleRevisionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TemplateValidationError) Key() bool { return e.key }
+func (e RuleRevisionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TemplateValidationError) ErrorName() string { return "TemplateValidationError" }
+func (e RuleRevisionValidationError) ErrorName() string { return "RuleRevisionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TemplateValidationError) Error() string {
+func (e RuleRevisionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8454,14 +8671,14 @@ func (e TemplateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTemplate.%s: %s%s",
+		"invalid %sRuleRevision.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TemplateValidationError{}
+var _ error = RuleRevisionValidationError{}
 
 var _ interface {
 	Field() string
@@ -8469,49 +8686,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TemplateValidationError{}
-
-var _Template_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
-
-var _Template_Body_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+} = RuleRevisionValidationError{}
 
-// Validate checks the field values on ListTemplatesRequest with the rules
+// Validate checks the field values on ListRuleRevisionsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListTemplatesRequest) Validate() error {
+func (m *ListRuleRevisionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListTemplatesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListRuleRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListTemplatesRequestMultiError, or nil if none found.
-func (m *ListTemplatesRequest) ValidateAll() error {
+// ListRuleRevisionsRequestMultiError, or nil if none found.
+func (m *ListRuleRevisionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListTemplatesRequest) validate(all bool) error {
+func (m *ListRuleRevisionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Tag
+	// no validation rules for RuleId
 
 	if len(errors) > 0 {
-		return ListTemplatesRequestMultiError(errors)
+		return ListRuleRevisionsRequestMultiError(errors)
 	}
 	return nil
 }
 
-// ListTemplatesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListTemplatesRequest.ValidateAll() if the designated
+// ListRuleRevisionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRuleRevisionsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListTemplatesRequestMultiError []error
+type ListRuleRevisionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListTemplatesRequestMultiError) Error() string {
+func (m ListRuleRevisionsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8520,11 +8733,11 @@ func (m ListTemplatesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListTemplatesRequestMultiError) AllErrors() []error { return m }
+func (m ListRuleRevisionsRequestMultiError) AllErrors() []error { return m }
 
-// ListTemplatesRequestValidationError is the validation error returned by
-// ListTemplatesRequest.Validate if the designated constraints aren't met.
-type ListTemplatesRequestValidationError struct {
+// ListRuleRevisionsRequestValidationError is the validation error returned by
+// ListRuleRevisionsRequest.Validate if the designated constraints aren't met.
+type ListRuleRevisionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8532,24 +8745,24 @@ type ListTemplatesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListTemplatesRequestValidationError) Field() string { return e.field }
+func (e ListRuleRevisionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListTemplatesRequestValidationError) Reason() string { return e.reason }
+func (e ListRuleRevisionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListTemplatesRequestValidationError) Cause() error { return e.cause }
+func (e ListRuleRevisionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListTemplatesRequestValidationError) Key() bool { return e.key }
+func (e ListRuleRevisionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListTemplatesRequestValidationError) ErrorName() string {
-	return "ListTemplatesRequestValidationError"
+func (e ListRuleRevisionsRequestValidationError) ErrorName() string {
+	return "ListRuleRevisionsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListTemplatesRequestValidationError) Error() string {
+func (e ListRuleRevisionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8561,14 +8774,14 @@ func (e ListTemplatesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListTemplatesRequest.%s: %s%s",
+		"invalid %sListRuleRevisionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListTemplatesRequestValidationError{}
+var _ error = ListRuleRevisionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8576,47 +8789,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListTemplatesRequestValidationError{}
+} = ListRuleRevisionsRequestValidationError{}
 
-// Validate checks the field values on ListTemplatesResponse with the rules
+// Validate checks the field values on ListRuleRevisionsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListTemplatesResponse) Validate() error {
+func (m *ListRuleRevisionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListTemplatesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListRuleRevisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListTemplatesResponseMultiError, or nil if none found.
-func (m *ListTemplatesResponse) ValidateAll() error {
+// ListRuleRevisionsResponseMultiError, or nil if none found.
+func (m *ListRuleRevisionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListTemplatesResponse) validate(all bool) error {
+func (m *ListRuleRevisionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetTemplates() {
+	for idx, item := range m.GetRevisions() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListTemplatesResponseValidationError{
-						field:  fmt.Sprintf("Templates[%v]", idx),
+					errors = append(errors, ListRuleRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListTemplatesResponseValidationError{
-						field:  fmt.Sprintf("Templates[%v]", idx),
+					errors = append(errors, ListRuleRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -8624,8 +8837,8 @@ func (m *ListTemplatesResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListTemplatesResponseValidationError{
-					field:  fmt.Sprintf("Templates[%v]", idx),
+				return ListRuleRevisionsResponseValidationError{
+					field:  fmt.Sprintf("Revisions[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -8635,18 +8848,18 @@ func (m *ListTemplatesResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListTemplatesResponseMultiError(errors)
+		return ListRuleRevisionsResponseMultiError(errors)
 	}
 	return nil
 }
 
-// ListTemplatesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListTemplatesResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListTemplatesResponseMultiError []error
+// ListRuleRevisionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListRuleRevisionsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListRuleRevisionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListTemplatesResponseMultiError) Error() string {
+func (m ListRuleRevisionsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8655,11 +8868,11 @@ func (m ListTemplatesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListTemplatesResponseMultiError) AllErrors() []error { return m }
+func (m ListRuleRevisionsResponseMultiError) AllErrors() []error { return m }
 
-// ListTemplatesResponseValidationError is the validation error returned by
-// ListTemplatesResponse.Validate if the designated constraints aren't met.
-type ListTemplatesResponseValidationError struct {
+// ListRuleRevisionsResponseValidationError is the validation error returned by
+// ListRuleRevisionsResponse.Validate if the designated constraints aren't met.
+type ListRuleRevisionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8667,24 +8880,24 @@ type ListTemplatesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListTemplatesResponseValidationError) Field() string { return e.field }
+func (e ListRuleRevisionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListTemplatesResponseValidationError) Reason() string { return e.reason }
+func (e ListRuleRevisionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListTemplatesResponseValidationError) Cause() error { return e.cause }
+func (e ListRuleRevisionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListTemplatesResponseValidationError) Key() bool { return e.key }
+func (e ListRuleRevisionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListTemplatesResponseValidationError) ErrorName() string {
-	return "ListTemplatesResponseValidationError"
+func (e ListRuleRevisionsResponseValidationError) ErrorName() string {
+	return "ListRuleRevisionsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListTemplatesResponseValidationError) Error() string {
+func (e ListRuleRevisionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8696,14 +8909,14 @@ func (e ListTemplatesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListTemplatesResponse.%s: %s%s",
+		"invalid %sListRuleRevisionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListTemplatesResponseValidationError{}
+var _ error = ListRuleRevisionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8711,24 +8924,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListTemplatesResponseValidationError{}
+} = ListRuleRevisionsResponseValidationError{}
 
-// Validate checks the field values on UpsertTemplateRequest with the rules
+// Validate checks the field values on GetRuleRevisionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpsertTemplateRequest) Validate() error {
+func (m *GetRuleRevisionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpsertTemplateRequest with the rules
+// ValidateAll checks the field values on GetRuleRevisionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpsertTemplateRequestMultiError, or nil if none found.
-func (m *UpsertTemplateRequest) ValidateAll() error {
+// GetRuleRevisionRequestMultiError, or nil if none found.
+func (m *GetRuleRevisionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpsertTemplateRequest) validate(all bool) error {
+func (m *GetRuleRevisionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8737,101 +8950,32 @@ func (m *UpsertTemplateRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
-	if !_UpsertTemplateRequest_Name_Pattern.MatchString(m.GetName()) {
-		err := UpsertTemplateRequestValidationError{
-			field:  "Name",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	if len(errors) > 0 {
+		return GetRuleRevisionRequestMultiError(errors)
 	}
+	return nil
+}
 
-	// no validation rules for Body
+// GetRuleRevisionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRuleRevisionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRuleRevisionRequestMultiError []error
 
-	if len(m.GetTags()) < 1 {
-		err := UpsertTemplateRequestValidationError{
-			field:  "Tags",
-			reason: "value must contain at least 1 item(s)",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRuleRevisionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
-
-	if len(m.GetVariables()) < 1 {
-		err := UpsertTemplateRequestValidationError{
-			field:  "Variables",
-			reason: "value must contain at least 1 item(s)",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	for idx, item := range m.GetVariables() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UpsertTemplateRequestValidationError{
-						field:  fmt.Sprintf("Variables[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UpsertTemplateRequestValidationError{
-						field:  fmt.Sprintf("Variables[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UpsertTemplateRequestValidationError{
-					field:  fmt.Sprintf("Variables[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if len(errors) > 0 {
-		return UpsertTemplateRequestMultiError(errors)
-	}
-	return nil
-}
-
-// UpsertTemplateRequestMultiError is an error wrapping multiple validation
-// errors returned by UpsertTemplateRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpsertTemplateRequestMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m UpsertTemplateRequestMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
+	return strings.Join(msgs, "; ")
+}
 
 // AllErrors returns a list of validation violation errors.
-func (m UpsertTemplateRequestMultiError) AllErrors() []error { return m }
+func (m GetRuleRevisionRequestMultiError) AllErrors() []error { return m }
 
-// UpsertTemplateRequestValidationError is the validation error returned by
-// UpsertTemplateRequest.Validate if the designated constraints aren't met.
-type UpsertTemplateRequestValidationError struct {
+// GetRuleRevisionRequestValidationError is the validation error returned by
+// GetRuleRevisionRequest.Validate if the designated constraints aren't met.
+type GetRuleRevisionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8839,24 +8983,24 @@ type UpsertTemplateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpsertTemplateRequestValidationError) Field() string { return e.field }
+func (e GetRuleRevisionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpsertTemplateRequestValidationError) Reason() string { return e.reason }
+func (e GetRuleRevisionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpsertTemplateRequestValidationError) Cause() error { return e.cause }
+func (e GetRuleRevisionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpsertTemplateRequestValidationError) Key() bool { return e.key }
+func (e GetRuleRevisionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpsertTemplateRequestValidationError) ErrorName() string {
-	return "UpsertTemplateRequestValidationError"
+func (e GetRuleRevisionRequestValidationError) ErrorName() string {
+	return "GetRuleRevisionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpsertTemplateRequestValidationError) Error() string {
+func (e GetRuleRevisionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8868,14 +9012,14 @@ func (e UpsertTemplateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpsertTemplateRequest.%s: %s%s",
+		"invalid %sGetRuleRevisionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpsertTemplateRequestValidationError{}
+var _ error = GetRuleRevisionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8883,47 +9027,72 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpsertTemplateRequestValidationError{}
-
-var _UpsertTemplateRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+} = GetRuleRevisionRequestValidationError{}
 
-// Validate checks the field values on UpsertTemplateResponse with the rules
+// Validate checks the field values on GetRuleRevisionResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpsertTemplateResponse) Validate() error {
+func (m *GetRuleRevisionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpsertTemplateResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetRuleRevisionResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpsertTemplateResponseMultiError, or nil if none found.
-func (m *UpsertTemplateResponse) ValidateAll() error {
+// GetRuleRevisionResponseMultiError, or nil if none found.
+func (m *GetRuleRevisionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpsertTemplateResponse) validate(all bool) error {
+func (m *GetRuleRevisionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if all {
+		switch v := interface{}(m.GetRevision()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRuleRevisionResponseValidationError{
+					field:  "Revision",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRuleRevisionResponseValidationError{
+					field:  "Revision",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRevision()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRuleRevisionResponseValidationError{
+				field:  "Revision",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return UpsertTemplateResponseMultiError(errors)
+		return GetRuleRevisionResponseMultiError(errors)
 	}
 	return nil
 }
 
-// UpsertTemplateResponseMultiError is an error wrapping multiple validation
-// errors returned by UpsertTemplateResponse.ValidateAll() if the designated
+// GetRuleRevisionResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRuleRevisionResponse.ValidateAll() if the designated
 // constraints aren't met.
-type UpsertTemplateResponseMultiError []error
+type GetRuleRevisionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpsertTemplateResponseMultiError) Error() string {
+func (m GetRuleRevisionResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8932,11 +9101,11 @@ func (m UpsertTemplateResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpsertTemplateResponseMultiError) AllErrors() []error { return m }
+func (m GetRuleRevisionResponseMultiError) AllErrors() []error { return m }
 
-// UpsertTemplateResponseValidationError is the validation error returned by
-// UpsertTemplateResponse.Validate if the designated constraints aren't met.
-type UpsertTemplateResponseValidationError struct {
+// GetRuleRevisionResponseValidationError is the validation error returned by
+// GetRuleRevisionResponse.Validate if the designated constraints aren't met.
+type GetRuleRevisionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8944,24 +9113,24 @@ type UpsertTemplateResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpsertTemplateResponseValidationError) Field() string { return e.field }
+func (e GetRuleRevisionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpsertTemplateResponseValidationError) Reason() string { return e.reason }
+func (e GetRuleRevisionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpsertTemplateResponseValidationError) Cause() error { return e.cause }
+func (e GetRuleRevisionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpsertTemplateResponseValidationError) Key() bool { return e.key }
+func (e GetRuleRevisionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpsertTemplateResponseValidationError) ErrorName() string {
-	return "UpsertTemplateResponseValidationError"
+func (e GetRuleRevisionResponseValidationError) ErrorName() string {
+	return "GetRuleRevisionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpsertTemplateResponseValidationError) Error() string {
+func (e GetRuleRevisionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8973,14 +9142,14 @@ func (e UpsertTemplateResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpsertTemplateResponse.%s: %s%s",
+		"invalid %sGetRuleRevisionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpsertTemplateResponseValidationError{}
+var _ error = GetRuleRevisionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8988,54 +9157,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpsertTemplateResponseValidationError{}
+} = GetRuleRevisionResponseValidationError{}
 
-// Validate checks the field values on GetTemplateRequest with the rules
+// Validate checks the field values on RollbackRuleRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetTemplateRequest) Validate() error {
+func (m *RollbackRuleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetTemplateRequest with the rules
+// ValidateAll checks the field values on RollbackRuleRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetTemplateRequestMultiError, or nil if none found.
-func (m *GetTemplateRequest) ValidateAll() error {
+// RollbackRuleRequestMultiError, or nil if none found.
+func (m *RollbackRuleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetTemplateRequest) validate(all bool) error {
+func (m *RollbackRuleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_GetTemplateRequest_Name_Pattern.MatchString(m.GetName()) {
-		err := GetTemplateRequestValidationError{
-			field:  "Name",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for RevisionId
 
 	if len(errors) > 0 {
-		return GetTemplateRequestMultiError(errors)
+		return RollbackRuleRequestMultiError(errors)
 	}
 	return nil
 }
 
-// GetTemplateRequestMultiError is an error wrapping multiple validation errors
-// returned by GetTemplateRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetTemplateRequestMultiError []error
+// RollbackRuleRequestMultiError is an error wrapping multiple validation
+// errors returned by RollbackRuleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RollbackRuleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetTemplateRequestMultiError) Error() string {
+func (m RollbackRuleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9044,11 +9204,11 @@ func (m GetTemplateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetTemplateRequestMultiError) AllErrors() []error { return m }
+func (m RollbackRuleRequestMultiError) AllErrors() []error { return m }
 
-// GetTemplateRequestValidationError is the validation error returned by
-// GetTemplateRequest.Validate if the designated constraints aren't met.
-type GetTemplateRequestValidationError struct {
+// RollbackRuleRequestValidationError is the validation error returned by
+// RollbackRuleRequest.Validate if the designated constraints aren't met.
+type RollbackRuleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9056,24 +9216,24 @@ type GetTemplateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetTemplateRequestValidationError) Field() string { return e.field }
+func (e RollbackRuleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetTemplateRequestValidationError) Reason() string { return e.reason }
+func (e RollbackRuleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetTemplateRequestValidationError) Cause() error { return e.cause }
+func (e RollbackRuleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetTemplateRequestValidationError) Key() bool { return e.key }
+func (e RollbackRuleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetTemplateRequestValidationError) ErrorName() string {
-	return "GetTemplateRequestValidationError"
+func (e RollbackRuleRequestValidationError) ErrorName() string {
+	return "RollbackRuleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetTemplateRequestValidationError) Error() string {
+func (e RollbackRuleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9085,14 +9245,14 @@ func (e GetTemplateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetTemplateRequest.%s: %s%s",
+		"invalid %sRollbackRuleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetTemplateRequestValidationError{}
+var _ error = RollbackRuleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9100,26 +9260,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetTemplateRequestValidationError{}
+} = RollbackRuleRequestValidationError{}
 
-var _GetTemplateRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
-
-// Validate checks the field values on GetTemplateResponse with the rules
+// Validate checks the field values on RollbackRuleResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetTemplateResponse) Validate() error {
+func (m *RollbackRuleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetTemplateResponse with the rules
+// ValidateAll checks the field values on RollbackRuleResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetTemplateResponseMultiError, or nil if none found.
-func (m *GetTemplateResponse) ValidateAll() error {
+// RollbackRuleResponseMultiError, or nil if none found.
+func (m *RollbackRuleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetTemplateResponse) validate(all bool) error {
+func (m *RollbackRuleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9127,28 +9285,28 @@ func (m *GetTemplateResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetTemplate()).(type) {
+		switch v := interface{}(m.GetRule()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetTemplateResponseValidationError{
-					field:  "Template",
+				errors = append(errors, RollbackRuleResponseValidationError{
+					field:  "Rule",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetTemplateResponseValidationError{
-					field:  "Template",
+				errors = append(errors, RollbackRuleResponseValidationError{
+					field:  "Rule",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTemplate()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRule()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetTemplateResponseValidationError{
-				field:  "Template",
+			return RollbackRuleResponseValidationError{
+				field:  "Rule",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -9156,18 +9314,18 @@ func (m *GetTemplateResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetTemplateResponseMultiError(errors)
+		return RollbackRuleResponseMultiError(errors)
 	}
 	return nil
 }
 
-// GetTemplateResponseMultiError is an error wrapping multiple validation
-// errors returned by GetTemplateResponse.ValidateAll() if the designated
+// RollbackRuleResponseMultiError is an error wrapping multiple validation
+// errors returned by RollbackRuleResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetTemplateResponseMultiError []error
+type RollbackRuleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetTemplateResponseMultiError) Error() string {
+func (m RollbackRuleResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9176,11 +9334,11 @@ func (m GetTemplateResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetTemplateResponseMultiError) AllErrors() []error { return m }
+func (m RollbackRuleResponseMultiError) AllErrors() []error { return m }
 
-// GetTemplateResponseValidationError is the validation error returned by
-// GetTemplateResponse.Validate if the designated constraints aren't met.
-type GetTemplateResponseValidationError struct {
+// RollbackRuleResponseValidationError is the validation error returned by
+// RollbackRuleResponse.Validate if the designated constraints aren't met.
+type RollbackRuleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9188,24 +9346,24 @@ type GetTemplateResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetTemplateResponseValidationError) Field() string { return e.field }
+func (e RollbackRuleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetTemplateResponseValidationError) Reason() string { return e.reason }
+func (e RollbackRuleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetTemplateResponseValidationError) Cause() error { return e.cause }
+func (e RollbackRuleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetTemplateResponseValidationError) Key() bool { return e.key }
+func (e RollbackRuleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetTemplateResponseValidationError) ErrorName() string {
-	return "GetTemplateResponseValidationError"
+func (e RollbackRuleResponseValidationError) ErrorName() string {
+	return "RollbackRuleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetTemplateResponseValidationError) Error() string {
+func (e RollbackRuleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9217,14 +9375,14 @@ func (e GetTemplateResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetTemplateResponse.%s: %s%s",
+		"invalid %sRollbackRuleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetTemplateResponseValidationError{}
+var _ error = RollbackRuleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9232,45 +9390,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetTemplateResponseValidationError{}
+} = RollbackRuleResponseValidationError{}
 
-// Validate checks the field values on DeleteTemplateRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteTemplateRequest) Validate() error {
+// Validate checks the field values on DeleteRuleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRuleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteTemplateRequest with the rules
+// ValidateAll checks the field values on DeleteRuleRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteTemplateRequestMultiError, or nil if none found.
-func (m *DeleteTemplateRequest) ValidateAll() error {
+// DeleteRuleRequestMultiError, or nil if none found.
+func (m *DeleteRuleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteTemplateRequest) validate(all bool) error {
+func (m *DeleteRuleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return DeleteTemplateRequestMultiError(errors)
+		return DeleteRuleRequestMultiError(errors)
 	}
 	return nil
 }
 
-// DeleteTemplateRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteTemplateRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteTemplateRequestMultiError []error
+// DeleteRuleRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteRuleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteRuleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteTemplateRequestMultiError) Error() string {
+func (m DeleteRuleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9279,11 +9437,11 @@ func (m DeleteTemplateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteTemplateRequestMultiError) AllErrors() []error { return m }
+func (m DeleteRuleRequestMultiError) AllErrors() []error { return m }
 
-// DeleteTemplateRequestValidationError is the validation error returned by
-// DeleteTemplateRequest.Validate if the designated constraints aren't met.
-type DeleteTemplateRequestValidationError struct {
+// DeleteRuleRequestValidationError is the validation error returned by
+// DeleteRuleRequest.Validate if the designated constraints aren't met.
+type DeleteRuleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9291,24 +9449,24 @@ type DeleteTemplateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteTemplateRequestValidationError) Field() string { return e.field }
+func (e DeleteRuleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteTemplateRequestValidationError) Reason() string { return e.reason }
+func (e DeleteRuleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteTemplateRequestValidationError) Cause() error { return e.cause }
+func (e DeleteRuleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteTemplateRequestValidationError) Key() bool { return e.key }
+func (e DeleteRuleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteTemplateRequestValidationError) ErrorName() string {
-	return "DeleteTemplateRequestValidationError"
+func (e DeleteRuleRequestValidationError) ErrorName() string {
+	return "DeleteRuleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteTemplateRequestValidationError) Error() string {
+func (e DeleteRuleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9320,14 +9478,14 @@ func (e DeleteTemplateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteTemplateRequest.%s: %s%s",
+		"invalid %sDeleteRuleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteTemplateRequestValidationError{}
+var _ error = DeleteRuleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9335,24 +9493,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteTemplateRequestValidationError{}
+} = DeleteRuleRequestValidationError{}
 
-// Validate checks the field values on DeleteTemplateResponse with the rules
+// Validate checks the field values on DeleteRuleResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteTemplateResponse) Validate() error {
+func (m *DeleteRuleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteTemplateResponse with the rules
+// ValidateAll checks the field values on DeleteRuleResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteTemplateResponseMultiError, or nil if none found.
-func (m *DeleteTemplateResponse) ValidateAll() error {
+// DeleteRuleResponseMultiError, or nil if none found.
+func (m *DeleteRuleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteTemplateResponse) validate(all bool) error {
+func (m *DeleteRuleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9360,18 +9518,18 @@ func (m *DeleteTemplateResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return DeleteTemplateResponseMultiError(errors)
+		return DeleteRuleResponseMultiError(errors)
 	}
 	return nil
 }
 
-// DeleteTemplateResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteTemplateResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteTemplateResponseMultiError []error
+// DeleteRuleResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteRuleResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteRuleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteTemplateResponseMultiError) Error() string {
+func (m DeleteRuleResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9380,11 +9538,11 @@ func (m DeleteTemplateResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteTemplateResponseMultiError) AllErrors() []error { return m }
+func (m DeleteRuleResponseMultiError) AllErrors() []error { return m }
 
-// DeleteTemplateResponseValidationError is the validation error returned by
-// DeleteTemplateResponse.Validate if the designated constraints aren't met.
-type DeleteTemplateResponseValidationError struct {
+// DeleteRuleResponseValidationError is the validation error returned by
+// DeleteRuleResponse.Validate if the designated constraints aren't met.
+type DeleteRuleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9392,24 +9550,24 @@ type DeleteTemplateResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteTemplateResponseValidationError) Field() string { return e.field }
+func (e DeleteRuleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteTemplateResponseValidationError) Reason() string { return e.reason }
+func (e DeleteRuleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteTemplateResponseValidationError) Cause() error { return e.cause }
+func (e DeleteRuleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteTemplateResponseValidationError) Key() bool { return e.key }
+func (e DeleteRuleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteTemplateResponseValidationError) ErrorName() string {
-	return "DeleteTemplateResponseValidationError"
+func (e DeleteRuleResponseValidationError) ErrorName() string {
+	return "DeleteRuleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteTemplateResponseValidationError) Error() string {
+func (e DeleteRuleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9421,14 +9579,14 @@ func (e DeleteTemplateResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteTemplateResponse.%s: %s%s",
+		"invalid %sDeleteRuleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteTemplateResponseValidationError{}
+var _ error = DeleteRuleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9436,56 +9594,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteTemplateResponseValidationError{}
+} = DeleteRuleResponseValidationError{}
 
-// Validate checks the field values on RenderTemplateRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RenderTemplateRequest) Validate() error {
+// Validate checks the field values on RuleDrift with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RuleDrift) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RenderTemplateRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RenderTemplateRequestMultiError, or nil if none found.
-func (m *RenderTemplateRequest) ValidateAll() error {
+// ValidateAll checks the field values on RuleDrift with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RuleDriftMultiError, or nil
+// if none found.
+func (m *RuleDrift) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RenderTemplateRequest) validate(all bool) error {
+func (m *RuleDrift) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_RenderTemplateRequest_Name_Pattern.MatchString(m.GetName()) {
-		err := RenderTemplateRequestValidationError{
-			field:  "Name",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for RuleId
 
-	// no validation rules for Variables
+	// no validation rules for Namespace
+
+	// no validation rules for GroupName
+
+	// no validation rules for Name
+
+	// no validation rules for Status
+
+	// no validation rules for Expected
+
+	// no validation rules for Actual
+
+	// no validation rules for Reconciled
 
 	if len(errors) > 0 {
-		return RenderTemplateRequestMultiError(errors)
+		return RuleDriftMultiError(errors)
 	}
 	return nil
 }
 
-// RenderTemplateRequestMultiError is an error wrapping multiple validation
-// errors returned by RenderTemplateRequest.ValidateAll() if the designated
-// constraints aren't met.
-type RenderTemplateRequestMultiError []error
+// RuleDriftMultiError is an error wrapping multiple validation errors returned
+// by RuleDrift.ValidateAll() if the designated constraints aren't met.
+type RuleDriftMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RenderTemplateRequestMultiError) Error() string {
+func (m RuleDriftMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9494,11 +9654,11 @@ func (m RenderTemplateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RenderTemplateRequestMultiError) AllErrors() []error { return m }
+func (m RuleDriftMultiError) AllErrors() []error { return m }
 
-// RenderTemplateRequestValidationError is the validation error returned by
-// RenderTemplateRequest.Validate if the designated constraints aren't met.
-type RenderTemplateRequestValidationError struct {
+// RuleDriftValidationError is the validation error returned by
+// RuleDrift.Validate if the designated constraints aren't met.
+type RuleDriftValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9506,24 +9666,22 @@ type RenderTemplateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RenderTemplateRequestValidationError) Field() string { return e.field }
+func (e RuleDriftValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RenderTemplateRequestValidationError) Reason() string { return e.reason }
+func (e RuleDriftValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RenderTemplateRequestValidationError) Cause() error { return e.cause }
+func (e RuleDriftValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RenderTemplateRequestValidationError) Key() bool { return e.key }
+func (e RuleDriftValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RenderTemplateRequestValidationError) ErrorName() string {
-	return "RenderTemplateRequestValidationError"
-}
+func (e RuleDriftValidationError) ErrorName() string { return "RuleDriftValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RenderTemplateRequestValidationError) Error() string {
+func (e RuleDriftValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9535,14 +9693,14 @@ func (e RenderTemplateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRenderTemplateRequest.%s: %s%s",
+		"invalid %sRuleDrift.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RenderTemplateRequestValidationError{}
+var _ error = RuleDriftValidationError{}
 
 var _ interface {
 	Field() string
@@ -9550,47 +9708,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RenderTemplateRequestValidationError{}
-
-var _RenderTemplateRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+} = RuleDriftValidationError{}
 
-// Validate checks the field values on RenderTemplateResponse with the rules
+// Validate checks the field values on GetRuleDriftRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RenderTemplateResponse) Validate() error {
+func (m *GetRuleDriftRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RenderTemplateResponse with the rules
+// ValidateAll checks the field values on GetRuleDriftRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RenderTemplateResponseMultiError, or nil if none found.
-func (m *RenderTemplateResponse) ValidateAll() error {
+// GetRuleDriftRequestMultiError, or nil if none found.
+func (m *GetRuleDriftRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RenderTemplateResponse) validate(all bool) error {
+func (m *GetRuleDriftRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Body
+	// no validation rules for ProviderNamespace
 
 	if len(errors) > 0 {
-		return RenderTemplateResponseMultiError(errors)
+		return GetRuleDriftRequestMultiError(errors)
 	}
 	return nil
 }
 
-// RenderTemplateResponseMultiError is an error wrapping multiple validation
-// errors returned by RenderTemplateResponse.ValidateAll() if the designated
+// GetRuleDriftRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRuleDriftRequest.ValidateAll() if the designated
 // constraints aren't met.
-type RenderTemplateResponseMultiError []error
+type GetRuleDriftRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RenderTemplateResponseMultiError) Error() string {
+func (m GetRuleDriftRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9599,11 +9755,11 @@ func (m RenderTemplateResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RenderTemplateResponseMultiError) AllErrors() []error { return m }
+func (m GetRuleDriftRequestMultiError) AllErrors() []error { return m }
 
-// RenderTemplateResponseValidationError is the validation error returned by
-// RenderTemplateResponse.Validate if the designated constraints aren't met.
-type RenderTemplateResponseValidationError struct {
+// GetRuleDriftRequestValidationError is the validation error returned by
+// GetRuleDriftRequest.Validate if the designated constraints aren't met.
+type GetRuleDriftRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9611,24 +9767,24 @@ type RenderTemplateResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RenderTemplateResponseValidationError) Field() string { return e.field }
+func (e GetRuleDriftRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RenderTemplateResponseValidationError) Reason() string { return e.reason }
+func (e GetRuleDriftRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RenderTemplateResponseValidationError) Cause() error { return e.cause }
+func (e GetRuleDriftRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RenderTemplateResponseValidationError) Key() bool { return e.key }
+func (e GetRuleDriftRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RenderTemplateResponseValidationError) ErrorName() string {
-	return "RenderTemplateResponseValidationError"
+func (e GetRuleDriftRequestValidationError) ErrorName() string {
+	return "GetRuleDriftRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RenderTemplateResponseValidationError) Error() string {
+func (e GetRuleDriftRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9640,14 +9796,14 @@ func (e RenderTemplateResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRenderTemplateResponse.%s: %s%s",
+		"invalid %sGetRuleDriftRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RenderTemplateResponseValidationError{}
+var _ error = GetRuleDriftRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9655,165 +9811,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RenderTemplateResponseValidationError{}
+} = GetRuleDriftRequestValidationError{}
 
-// Validate checks the field values on Silence with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Silence) Validate() error {
+// Validate checks the field values on GetRuleDriftResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRuleDriftResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Silence with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in SilenceMultiError, or nil if none found.
-func (m *Silence) ValidateAll() error {
+// ValidateAll checks the field values on GetRuleDriftResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRuleDriftResponseMultiError, or nil if none found.
+func (m *GetRuleDriftResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Silence) validate(all bool) error {
+func (m *GetRuleDriftResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for NamespaceId
-
-	// no validation rules for Type
-
-	// no validation rules for TargetId
+	// no validation rules for ProviderNamespace
 
-	if all {
-		switch v := interface{}(m.GetTargetExpression()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SilenceValidationError{
-					field:  "TargetExpression",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SilenceValidationError{
-					field:  "TargetExpression",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTargetExpression()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SilenceValidationError{
-				field:  "TargetExpression",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for NamespaceUrn
 
-	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SilenceValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SilenceValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SilenceValidationError{
-				field:  "CreatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	for idx, item := range m.GetDrifts() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SilenceValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRuleDriftResponseValidationError{
+						field:  fmt.Sprintf("Drifts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRuleDriftResponseValidationError{
+						field:  fmt.Sprintf("Drifts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SilenceValidationError{
-					field:  "UpdatedAt",
+				return GetRuleDriftResponseValidationError{
+					field:  fmt.Sprintf("Drifts[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SilenceValidationError{
-				field:  "UpdatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	if all {
-		switch v := interface{}(m.GetDeletedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SilenceValidationError{
-					field:  "DeletedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SilenceValidationError{
-					field:  "DeletedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SilenceValidationError{
-				field:  "DeletedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
 	}
 
 	if len(errors) > 0 {
-		return SilenceMultiError(errors)
+		return GetRuleDriftResponseMultiError(errors)
 	}
 	return nil
 }
 
-// SilenceMultiError is an error wrapping multiple validation errors returned
-// by Silence.ValidateAll() if the designated constraints aren't met.
-type SilenceMultiError []error
+// GetRuleDriftResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRuleDriftResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetRuleDriftResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SilenceMultiError) Error() string {
+func (m GetRuleDriftResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9822,11 +9894,11 @@ func (m SilenceMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SilenceMultiError) AllErrors() []error { return m }
+func (m GetRuleDriftResponseMultiError) AllErrors() []error { return m }
 
-// SilenceValidationError is the validation error returned by Silence.Validate
-// if the designated constraints aren't met.
-type SilenceValidationError struct {
+// GetRuleDriftResponseValidationError is the validation error returned by
+// GetRuleDriftResponse.Validate if the designated constraints aren't met.
+type GetRuleDriftResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9834,22 +9906,24 @@ type SilenceValidationError struct {
 }
 
 // Field function returns field value.
-func (e SilenceValidationError) Field() string { return e.field }
+func (e GetRuleDriftResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SilenceValidationError) Reason() string { return e.reason }
+func (e GetRuleDriftResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SilenceValidationError) Cause() error { return e.cause }
+func (e GetRuleDriftResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SilenceValidationError) Key() bool { return e.key }
+func (e GetRuleDriftResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SilenceValidationError) ErrorName() string { return "SilenceValidationError" }
+func (e GetRuleDriftResponseValidationError) ErrorName() string {
+	return "GetRuleDriftResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SilenceValidationError) Error() string {
+func (e GetRuleDriftResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9861,14 +9935,14 @@ func (e SilenceValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSilence.%s: %s%s",
+		"invalid %sGetRuleDriftResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SilenceValidationError{}
+var _ error = GetRuleDriftResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9876,78 +9950,4940 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SilenceValidationError{}
+} = GetRuleDriftResponseValidationError{}
 
-// Validate checks the field values on CreateSilenceRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateSilenceRequest) Validate() error {
+// Validate checks the field values on TemplateVariables with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TemplateVariables) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateSilenceRequest with the rules
+// ValidateAll checks the field values on TemplateVariables with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TemplateVariablesMultiError, or nil if none found.
+func (m *TemplateVariables) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TemplateVariables) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_TemplateVariables_Name_Pattern.MatchString(m.GetName()) {
+		err := TemplateVariablesValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_TemplateVariables_Type_Pattern.MatchString(m.GetType()) {
+		err := TemplateVariablesValidationError{
+			field:  "Type",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Default
+
+	// no validation rules for Description
+
+	if len(errors) > 0 {
+		return TemplateVariablesMultiError(errors)
+	}
+	return nil
+}
+
+// TemplateVariablesMultiError is an error wrapping multiple validation errors
+// returned by TemplateVariables.ValidateAll() if the designated constraints
+// aren't met.
+type TemplateVariablesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TemplateVariablesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TemplateVariablesMultiError) AllErrors() []error { return m }
+
+// TemplateVariablesValidationError is the validation error returned by
+// TemplateVariables.Validate if the designated constraints aren't met.
+type TemplateVariablesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TemplateVariablesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TemplateVariablesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TemplateVariablesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TemplateVariablesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TemplateVariablesValidationError) ErrorName() string {
+	return "TemplateVariablesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TemplateVariablesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTemplateVariables.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TemplateVariablesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TemplateVariablesValidationError{}
+
+var _TemplateVariables_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+var _TemplateVariables_Type_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on Template with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Template) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Template with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TemplateMultiError, or nil
+// if none found.
+func (m *Template) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Template) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if !_Template_Name_Pattern.MatchString(m.GetName()) {
+		err := TemplateValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Template_Body_Pattern.MatchString(m.GetBody()) {
+		err := TemplateValidationError{
+			field:  "Body",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTags()) < 1 {
+		err := TemplateValidationError{
+			field:  "Tags",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TemplateValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TemplateValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TemplateValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TemplateValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TemplateValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TemplateValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetVariables()) < 1 {
+		err := TemplateValidationError{
+			field:  "Variables",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetVariables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TemplateValidationError{
+						field:  fmt.Sprintf("Variables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TemplateValidationError{
+						field:  fmt.Sprintf("Variables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TemplateValidationError{
+					field:  fmt.Sprintf("Variables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TemplateMultiError(errors)
+	}
+	return nil
+}
+
+// TemplateMultiError is an error wrapping multiple validation errors returned
+// by Template.ValidateAll() if the designated constraints aren't met.
+type TemplateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TemplateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TemplateMultiError) AllErrors() []error { return m }
+
+// TemplateValidationError is the validation error returned by
+// Template.Validate if the designated constraints aren't met.
+type TemplateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TemplateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TemplateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TemplateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TemplateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TemplateValidationError) ErrorName() string { return "TemplateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TemplateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTemplate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TemplateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TemplateValidationError{}
+
+var _Template_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+var _Template_Body_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on ListTemplatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTemplatesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTemplatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTemplatesRequestMultiError, or nil if none found.
+func (m *ListTemplatesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTemplatesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tag
+
+	if len(errors) > 0 {
+		return ListTemplatesRequestMultiError(errors)
+	}
+	return nil
+}
+
+// ListTemplatesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTemplatesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTemplatesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTemplatesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTemplatesRequestMultiError) AllErrors() []error { return m }
+
+// ListTemplatesRequestValidationError is the validation error returned by
+// ListTemplatesRequest.Validate if the designated constraints aren't met.
+type ListTemplatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTemplatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTemplatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTemplatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTemplatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTemplatesRequestValidationError) ErrorName() string {
+	return "ListTemplatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTemplatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTemplatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTemplatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTemplatesRequestValidationError{}
+
+// Validate checks the field values on ListTemplatesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTemplatesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTemplatesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTemplatesResponseMultiError, or nil if none found.
+func (m *ListTemplatesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTemplatesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTemplates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTemplatesResponseValidationError{
+						field:  fmt.Sprintf("Templates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTemplatesResponseValidationError{
+						field:  fmt.Sprintf("Templates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTemplatesResponseValidationError{
+					field:  fmt.Sprintf("Templates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTemplatesResponseMultiError(errors)
+	}
+	return nil
+}
+
+// ListTemplatesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTemplatesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListTemplatesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTemplatesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTemplatesResponseMultiError) AllErrors() []error { return m }
+
+// ListTemplatesResponseValidationError is the validation error returned by
+// ListTemplatesResponse.Validate if the designated constraints aren't met.
+type ListTemplatesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTemplatesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTemplatesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTemplatesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTemplatesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTemplatesResponseValidationError) ErrorName() string {
+	return "ListTemplatesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTemplatesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTemplatesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTemplatesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTemplatesResponseValidationError{}
+
+// Validate checks the field values on UpsertTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpsertTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpsertTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpsertTemplateRequestMultiError, or nil if none found.
+func (m *UpsertTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpsertTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if !_UpsertTemplateRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := UpsertTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Body
+
+	if len(m.GetTags()) < 1 {
+		err := UpsertTemplateRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetVariables()) < 1 {
+		err := UpsertTemplateRequestValidationError{
+			field:  "Variables",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetVariables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpsertTemplateRequestValidationError{
+						field:  fmt.Sprintf("Variables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpsertTemplateRequestValidationError{
+						field:  fmt.Sprintf("Variables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpsertTemplateRequestValidationError{
+					field:  fmt.Sprintf("Variables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for SyncRules
+
+	if len(errors) > 0 {
+		return UpsertTemplateRequestMultiError(errors)
+	}
+	return nil
+}
+
+// UpsertTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by UpsertTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpsertTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpsertTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpsertTemplateRequestMultiError) AllErrors() []error { return m }
+
+// UpsertTemplateRequestValidationError is the validation error returned by
+// UpsertTemplateRequest.Validate if the designated constraints aren't met.
+type UpsertTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpsertTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpsertTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpsertTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpsertTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpsertTemplateRequestValidationError) ErrorName() string {
+	return "UpsertTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpsertTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpsertTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpsertTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpsertTemplateRequestValidationError{}
+
+var _UpsertTemplateRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on UpsertTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpsertTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpsertTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpsertTemplateResponseMultiError, or nil if none found.
+func (m *UpsertTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpsertTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	for idx, item := range m.GetRuleSyncResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpsertTemplateResponseValidationError{
+						field:  fmt.Sprintf("RuleSyncResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpsertTemplateResponseValidationError{
+						field:  fmt.Sprintf("RuleSyncResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpsertTemplateResponseValidationError{
+					field:  fmt.Sprintf("RuleSyncResults[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpsertTemplateResponseMultiError(errors)
+	}
+	return nil
+}
+
+// UpsertTemplateResponseMultiError is an error wrapping multiple validation
+// errors returned by UpsertTemplateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpsertTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpsertTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpsertTemplateResponseMultiError) AllErrors() []error { return m }
+
+// UpsertTemplateResponseValidationError is the validation error returned by
+// UpsertTemplateResponse.Validate if the designated constraints aren't met.
+type UpsertTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpsertTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpsertTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpsertTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpsertTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpsertTemplateResponseValidationError) ErrorName() string {
+	return "UpsertTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpsertTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpsertTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpsertTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpsertTemplateResponseValidationError{}
+
+// Validate checks the field values on RuleSyncResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RuleSyncResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RuleSyncResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RuleSyncResultMultiError,
+// or nil if none found.
+func (m *RuleSyncResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RuleSyncResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RuleId
+
+	// no validation rules for Name
+
+	// no validation rules for Namespace
+
+	// no validation rules for Success
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return RuleSyncResultMultiError(errors)
+	}
+	return nil
+}
+
+// RuleSyncResultMultiError is an error wrapping multiple validation errors
+// returned by RuleSyncResult.ValidateAll() if the designated constraints
+// aren't met.
+type RuleSyncResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RuleSyncResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RuleSyncResultMultiError) AllErrors() []error { return m }
+
+// RuleSyncResultValidationError is the validation error returned by
+// RuleSyncResult.Validate if the designated constraints aren't met.
+type RuleSyncResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RuleSyncResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RuleSyncResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RuleSyncResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RuleSyncResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RuleSyncResultValidationError) ErrorName() string { return "RuleSyncResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RuleSyncResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRuleSyncResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RuleSyncResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RuleSyncResultValidationError{}
+
+// Validate checks the field values on SyncTemplateRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SyncTemplateRulesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SyncTemplateRulesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SyncTemplateRulesRequestMultiError, or nil if none found.
+func (m *SyncTemplateRulesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncTemplateRulesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_SyncTemplateRulesRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := SyncTemplateRulesRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SyncTemplateRulesRequestMultiError(errors)
+	}
+	return nil
+}
+
+// SyncTemplateRulesRequestMultiError is an error wrapping multiple validation
+// errors returned by SyncTemplateRulesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SyncTemplateRulesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SyncTemplateRulesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SyncTemplateRulesRequestMultiError) AllErrors() []error { return m }
+
+// SyncTemplateRulesRequestValidationError is the validation error returned by
+// SyncTemplateRulesRequest.Validate if the designated constraints aren't met.
+type SyncTemplateRulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncTemplateRulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncTemplateRulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncTemplateRulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncTemplateRulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncTemplateRulesRequestValidationError) ErrorName() string {
+	return "SyncTemplateRulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SyncTemplateRulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncTemplateRulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncTemplateRulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncTemplateRulesRequestValidationError{}
+
+var _SyncTemplateRulesRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on SyncTemplateRulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SyncTemplateRulesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SyncTemplateRulesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SyncTemplateRulesResponseMultiError, or nil if none found.
+func (m *SyncTemplateRulesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncTemplateRulesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRuleSyncResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SyncTemplateRulesResponseValidationError{
+						field:  fmt.Sprintf("RuleSyncResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SyncTemplateRulesResponseValidationError{
+						field:  fmt.Sprintf("RuleSyncResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SyncTemplateRulesResponseValidationError{
+					field:  fmt.Sprintf("RuleSyncResults[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SyncTemplateRulesResponseMultiError(errors)
+	}
+	return nil
+}
+
+// SyncTemplateRulesResponseMultiError is an error wrapping multiple validation
+// errors returned by SyncTemplateRulesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type SyncTemplateRulesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SyncTemplateRulesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SyncTemplateRulesResponseMultiError) AllErrors() []error { return m }
+
+// SyncTemplateRulesResponseValidationError is the validation error returned by
+// SyncTemplateRulesResponse.Validate if the designated constraints aren't met.
+type SyncTemplateRulesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncTemplateRulesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncTemplateRulesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncTemplateRulesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncTemplateRulesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncTemplateRulesResponseValidationError) ErrorName() string {
+	return "SyncTemplateRulesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SyncTemplateRulesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncTemplateRulesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncTemplateRulesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncTemplateRulesResponseValidationError{}
+
+// Validate checks the field values on GetTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTemplateRequestMultiError, or nil if none found.
+func (m *GetTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GetTemplateRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := GetTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetTemplateRequestMultiError(errors)
+	}
+	return nil
+}
+
+// GetTemplateRequestMultiError is an error wrapping multiple validation errors
+// returned by GetTemplateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTemplateRequestMultiError) AllErrors() []error { return m }
+
+// GetTemplateRequestValidationError is the validation error returned by
+// GetTemplateRequest.Validate if the designated constraints aren't met.
+type GetTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTemplateRequestValidationError) ErrorName() string {
+	return "GetTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTemplateRequestValidationError{}
+
+var _GetTemplateRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on GetTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTemplateResponseMultiError, or nil if none found.
+func (m *GetTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTemplate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTemplateResponseValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTemplateResponseValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTemplate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTemplateResponseValidationError{
+				field:  "Template",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTemplateResponseMultiError(errors)
+	}
+	return nil
+}
+
+// GetTemplateResponseMultiError is an error wrapping multiple validation
+// errors returned by GetTemplateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTemplateResponseMultiError) AllErrors() []error { return m }
+
+// GetTemplateResponseValidationError is the validation error returned by
+// GetTemplateResponse.Validate if the designated constraints aren't met.
+type GetTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTemplateResponseValidationError) ErrorName() string {
+	return "GetTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTemplateResponseValidationError{}
+
+// Validate checks the field values on DeleteTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTemplateRequestMultiError, or nil if none found.
+func (m *DeleteTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return DeleteTemplateRequestMultiError(errors)
+	}
+	return nil
+}
+
+// DeleteTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTemplateRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTemplateRequestValidationError is the validation error returned by
+// DeleteTemplateRequest.Validate if the designated constraints aren't met.
+type DeleteTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTemplateRequestValidationError) ErrorName() string {
+	return "DeleteTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTemplateRequestValidationError{}
+
+// Validate checks the field values on DeleteTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTemplateResponseMultiError, or nil if none found.
+func (m *DeleteTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteTemplateResponseMultiError(errors)
+	}
+	return nil
+}
+
+// DeleteTemplateResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteTemplateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTemplateResponseMultiError) AllErrors() []error { return m }
+
+// DeleteTemplateResponseValidationError is the validation error returned by
+// DeleteTemplateResponse.Validate if the designated constraints aren't met.
+type DeleteTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTemplateResponseValidationError) ErrorName() string {
+	return "DeleteTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTemplateResponseValidationError{}
+
+// Validate checks the field values on RenderTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenderTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenderTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenderTemplateRequestMultiError, or nil if none found.
+func (m *RenderTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenderTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_RenderTemplateRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := RenderTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Variables
+
+	if len(errors) > 0 {
+		return RenderTemplateRequestMultiError(errors)
+	}
+	return nil
+}
+
+// RenderTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by RenderTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RenderTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenderTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenderTemplateRequestMultiError) AllErrors() []error { return m }
+
+// RenderTemplateRequestValidationError is the validation error returned by
+// RenderTemplateRequest.Validate if the designated constraints aren't met.
+type RenderTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenderTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenderTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenderTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenderTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenderTemplateRequestValidationError) ErrorName() string {
+	return "RenderTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenderTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenderTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenderTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenderTemplateRequestValidationError{}
+
+var _RenderTemplateRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on RenderTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenderTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenderTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenderTemplateResponseMultiError, or nil if none found.
+func (m *RenderTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenderTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Body
+
+	if len(errors) > 0 {
+		return RenderTemplateResponseMultiError(errors)
+	}
+	return nil
+}
+
+// RenderTemplateResponseMultiError is an error wrapping multiple validation
+// errors returned by RenderTemplateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RenderTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenderTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenderTemplateResponseMultiError) AllErrors() []error { return m }
+
+// RenderTemplateResponseValidationError is the validation error returned by
+// RenderTemplateResponse.Validate if the designated constraints aren't met.
+type RenderTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenderTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenderTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenderTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenderTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenderTemplateResponseValidationError) ErrorName() string {
+	return "RenderTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenderTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenderTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenderTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenderTemplateResponseValidationError{}
+
+// Validate checks the field values on TemplateRevision with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TemplateRevision) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TemplateRevision with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TemplateRevisionMultiError, or nil if none found.
+func (m *TemplateRevision) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TemplateRevision) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TemplateId
+
+	// no validation rules for Name
+
+	// no validation rules for Body
+
+	for idx, item := range m.GetVariables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TemplateRevisionValidationError{
+						field:  fmt.Sprintf("Variables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TemplateRevisionValidationError{
+						field:  fmt.Sprintf("Variables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TemplateRevisionValidationError{
+					field:  fmt.Sprintf("Variables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Actor
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TemplateRevisionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TemplateRevisionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TemplateRevisionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TemplateRevisionMultiError(errors)
+	}
+	return nil
+}
+
+// TemplateRevisionMultiError is an error wrapping multiple validation errors
+// returned by TemplateRevision.ValidateAll() if the designated constraints
+// aren't met.
+type TemplateRevisionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TemplateRevisionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TemplateRevisionMultiError) AllErrors() []error { return m }
+
+// TemplateRevisionValidationError is the validation error returned by
+// TemplateRevision.Validate if the designated constraints aren't met.
+type TemplateRevisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TemplateRevisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TemplateRevisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TemplateRevisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TemplateRevisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TemplateRevisionValidationError) ErrorName() string { return "TemplateRevisionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TemplateRevisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTemplateRevision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TemplateRevisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TemplateRevisionValidationError{}
+
+// Validate checks the field values on ListTemplateRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTemplateRevisionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTemplateRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTemplateRevisionsRequestMultiError, or nil if none found.
+func (m *ListTemplateRevisionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTemplateRevisionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ListTemplateRevisionsRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := ListTemplateRevisionsRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTemplateRevisionsRequestMultiError(errors)
+	}
+	return nil
+}
+
+// ListTemplateRevisionsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListTemplateRevisionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListTemplateRevisionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTemplateRevisionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTemplateRevisionsRequestMultiError) AllErrors() []error { return m }
+
+// ListTemplateRevisionsRequestValidationError is the validation error returned
+// by ListTemplateRevisionsRequest.Validate if the designated constraints
+// aren't met.
+type ListTemplateRevisionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTemplateRevisionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTemplateRevisionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTemplateRevisionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTemplateRevisionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTemplateRevisionsRequestValidationError) ErrorName() string {
+	return "ListTemplateRevisionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTemplateRevisionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTemplateRevisionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTemplateRevisionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTemplateRevisionsRequestValidationError{}
+
+var _ListTemplateRevisionsRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on ListTemplateRevisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTemplateRevisionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTemplateRevisionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListTemplateRevisionsResponseMultiError, or nil if none found.
+func (m *ListTemplateRevisionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTemplateRevisionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRevisions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTemplateRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTemplateRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTemplateRevisionsResponseValidationError{
+					field:  fmt.Sprintf("Revisions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTemplateRevisionsResponseMultiError(errors)
+	}
+	return nil
+}
+
+// ListTemplateRevisionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListTemplateRevisionsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListTemplateRevisionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTemplateRevisionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTemplateRevisionsResponseMultiError) AllErrors() []error { return m }
+
+// ListTemplateRevisionsResponseValidationError is the validation error
+// returned by ListTemplateRevisionsResponse.Validate if the designated
+// constraints aren't met.
+type ListTemplateRevisionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTemplateRevisionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTemplateRevisionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTemplateRevisionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTemplateRevisionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTemplateRevisionsResponseValidationError) ErrorName() string {
+	return "ListTemplateRevisionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTemplateRevisionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTemplateRevisionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTemplateRevisionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTemplateRevisionsResponseValidationError{}
+
+// Validate checks the field values on GetTemplateRevisionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTemplateRevisionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTemplateRevisionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTemplateRevisionRequestMultiError, or nil if none found.
+func (m *GetTemplateRevisionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTemplateRevisionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetTemplateRevisionRequestMultiError(errors)
+	}
+	return nil
+}
+
+// GetTemplateRevisionRequestMultiError is an error wrapping multiple
+// validation errors returned by GetTemplateRevisionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetTemplateRevisionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTemplateRevisionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTemplateRevisionRequestMultiError) AllErrors() []error { return m }
+
+// GetTemplateRevisionRequestValidationError is the validation error returned
+// by GetTemplateRevisionRequest.Validate if the designated constraints aren't met.
+type GetTemplateRevisionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTemplateRevisionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTemplateRevisionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTemplateRevisionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTemplateRevisionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTemplateRevisionRequestValidationError) ErrorName() string {
+	return "GetTemplateRevisionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTemplateRevisionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTemplateRevisionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTemplateRevisionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTemplateRevisionRequestValidationError{}
+
+// Validate checks the field values on GetTemplateRevisionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTemplateRevisionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTemplateRevisionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTemplateRevisionResponseMultiError, or nil if none found.
+func (m *GetTemplateRevisionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTemplateRevisionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRevision()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTemplateRevisionResponseValidationError{
+					field:  "Revision",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTemplateRevisionResponseValidationError{
+					field:  "Revision",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRevision()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTemplateRevisionResponseValidationError{
+				field:  "Revision",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTemplateRevisionResponseMultiError(errors)
+	}
+	return nil
+}
+
+// GetTemplateRevisionResponseMultiError is an error wrapping multiple
+// validation errors returned by GetTemplateRevisionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetTemplateRevisionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTemplateRevisionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTemplateRevisionResponseMultiError) AllErrors() []error { return m }
+
+// GetTemplateRevisionResponseValidationError is the validation error returned
+// by GetTemplateRevisionResponse.Validate if the designated constraints
+// aren't met.
+type GetTemplateRevisionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTemplateRevisionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTemplateRevisionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTemplateRevisionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTemplateRevisionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTemplateRevisionResponseValidationError) ErrorName() string {
+	return "GetTemplateRevisionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTemplateRevisionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTemplateRevisionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTemplateRevisionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTemplateRevisionResponseValidationError{}
+
+// Validate checks the field values on RollbackTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RollbackTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RollbackTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackTemplateRequestMultiError, or nil if none found.
+func (m *RollbackTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RollbackTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RevisionId
+
+	if len(errors) > 0 {
+		return RollbackTemplateRequestMultiError(errors)
+	}
+	return nil
+}
+
+// RollbackTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by RollbackTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RollbackTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackTemplateRequestMultiError) AllErrors() []error { return m }
+
+// RollbackTemplateRequestValidationError is the validation error returned by
+// RollbackTemplateRequest.Validate if the designated constraints aren't met.
+type RollbackTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackTemplateRequestValidationError) ErrorName() string {
+	return "RollbackTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackTemplateRequestValidationError{}
+
+// Validate checks the field values on RollbackTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RollbackTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RollbackTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackTemplateResponseMultiError, or nil if none found.
+func (m *RollbackTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RollbackTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTemplate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RollbackTemplateResponseValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RollbackTemplateResponseValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTemplate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RollbackTemplateResponseValidationError{
+				field:  "Template",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetRuleSyncResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RollbackTemplateResponseValidationError{
+						field:  fmt.Sprintf("RuleSyncResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RollbackTemplateResponseValidationError{
+						field:  fmt.Sprintf("RuleSyncResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RollbackTemplateResponseValidationError{
+					field:  fmt.Sprintf("RuleSyncResults[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RollbackTemplateResponseMultiError(errors)
+	}
+	return nil
+}
+
+// RollbackTemplateResponseMultiError is an error wrapping multiple validation
+// errors returned by RollbackTemplateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RollbackTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackTemplateResponseMultiError) AllErrors() []error { return m }
+
+// RollbackTemplateResponseValidationError is the validation error returned by
+// RollbackTemplateResponse.Validate if the designated constraints aren't met.
+type RollbackTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackTemplateResponseValidationError) ErrorName() string {
+	return "RollbackTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackTemplateResponseValidationError{}
+
+// Validate checks the field values on Silence with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Silence) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Silence with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SilenceMultiError, or nil if none found.
+func (m *Silence) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Silence) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for Type
+
+	// no validation rules for TargetId
+
+	if all {
+		switch v := interface{}(m.GetTargetExpression()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SilenceValidationError{
+					field:  "TargetExpression",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SilenceValidationError{
+					field:  "TargetExpression",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTargetExpression()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SilenceValidationError{
+				field:  "TargetExpression",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SilenceValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SilenceValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SilenceValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SilenceValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SilenceValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SilenceValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SilenceValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SilenceValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SilenceValidationError{
+				field:  "DeletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SilenceValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SilenceValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SilenceValidationError{
+				field:  "StartsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SilenceValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SilenceValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SilenceValidationError{
+				field:  "EndsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSchedule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SilenceValidationError{
+					field:  "Schedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SilenceValidationError{
+					field:  "Schedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchedule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SilenceValidationError{
+				field:  "Schedule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SilenceMultiError(errors)
+	}
+	return nil
+}
+
+// SilenceMultiError is an error wrapping multiple validation errors returned
+// by Silence.ValidateAll() if the designated constraints aren't met.
+type SilenceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SilenceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SilenceMultiError) AllErrors() []error { return m }
+
+// SilenceValidationError is the validation error returned by Silence.Validate
+// if the designated constraints aren't met.
+type SilenceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SilenceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SilenceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SilenceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SilenceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SilenceValidationError) ErrorName() string { return "SilenceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SilenceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSilence.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SilenceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SilenceValidationError{}
+
+// Validate checks the field values on SilenceSchedule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SilenceSchedule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SilenceSchedule with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SilenceScheduleMultiError, or nil if none found.
+func (m *SilenceSchedule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SilenceSchedule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Cron
+
+	if all {
+		switch v := interface{}(m.GetDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SilenceScheduleValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SilenceScheduleValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SilenceScheduleValidationError{
+				field:  "Duration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TimeZone
+
+	if len(errors) > 0 {
+		return SilenceScheduleMultiError(errors)
+	}
+	return nil
+}
+
+// SilenceScheduleMultiError is an error wrapping multiple validation errors
+// returned by SilenceSchedule.ValidateAll() if the designated constraints
+// aren't met.
+type SilenceScheduleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SilenceScheduleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SilenceScheduleMultiError) AllErrors() []error { return m }
+
+// SilenceScheduleValidationError is the validation error returned by
+// SilenceSchedule.Validate if the designated constraints aren't met.
+type SilenceScheduleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SilenceScheduleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SilenceScheduleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SilenceScheduleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SilenceScheduleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SilenceScheduleValidationError) ErrorName() string { return "SilenceScheduleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SilenceScheduleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSilenceSchedule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SilenceScheduleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SilenceScheduleValidationError{}
+
+// Validate checks the field values on CreateSilenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSilenceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSilenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSilenceRequestMultiError, or nil if none found.
+func (m *CreateSilenceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSilenceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for Type
+
+	// no validation rules for TargetId
+
+	if all {
+		switch v := interface{}(m.GetTargetExpression()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSilenceRequestValidationError{
+					field:  "TargetExpression",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSilenceRequestValidationError{
+					field:  "TargetExpression",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTargetExpression()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSilenceRequestValidationError{
+				field:  "TargetExpression",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSilenceRequestValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSilenceRequestValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSilenceRequestValidationError{
+				field:  "StartsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSilenceRequestValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSilenceRequestValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSilenceRequestValidationError{
+				field:  "EndsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSchedule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSilenceRequestValidationError{
+					field:  "Schedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSilenceRequestValidationError{
+					field:  "Schedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchedule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSilenceRequestValidationError{
+				field:  "Schedule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateSilenceRequestMultiError(errors)
+	}
+	return nil
+}
+
+// CreateSilenceRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateSilenceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSilenceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSilenceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSilenceRequestMultiError) AllErrors() []error { return m }
+
+// CreateSilenceRequestValidationError is the validation error returned by
+// CreateSilenceRequest.Validate if the designated constraints aren't met.
+type CreateSilenceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSilenceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSilenceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSilenceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSilenceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSilenceRequestValidationError) ErrorName() string {
+	return "CreateSilenceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSilenceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSilenceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSilenceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSilenceRequestValidationError{}
+
+// Validate checks the field values on CreateSilenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSilenceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSilenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSilenceResponseMultiError, or nil if none found.
+func (m *CreateSilenceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSilenceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CreateSilenceResponseMultiError(errors)
+	}
+	return nil
+}
+
+// CreateSilenceResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateSilenceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSilenceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSilenceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSilenceResponseMultiError) AllErrors() []error { return m }
+
+// CreateSilenceResponseValidationError is the validation error returned by
+// CreateSilenceResponse.Validate if the designated constraints aren't met.
+type CreateSilenceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSilenceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSilenceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSilenceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSilenceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSilenceResponseValidationError) ErrorName() string {
+	return "CreateSilenceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSilenceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSilenceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSilenceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSilenceResponseValidationError{}
+
+// Validate checks the field values on ListSilencesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSilencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSilencesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSilencesRequestMultiError, or nil if none found.
+func (m *ListSilencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSilencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SubscriptionId
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for Match
+
+	// no validation rules for SubscriptionMatch
+
+	if len(errors) > 0 {
+		return ListSilencesRequestMultiError(errors)
+	}
+	return nil
+}
+
+// ListSilencesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSilencesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSilencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSilencesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSilencesRequestMultiError) AllErrors() []error { return m }
+
+// ListSilencesRequestValidationError is the validation error returned by
+// ListSilencesRequest.Validate if the designated constraints aren't met.
+type ListSilencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSilencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSilencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSilencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSilencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSilencesRequestValidationError) ErrorName() string {
+	return "ListSilencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSilencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSilencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSilencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSilencesRequestValidationError{}
+
+// Validate checks the field values on ListSilencesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSilencesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSilencesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSilencesResponseMultiError, or nil if none found.
+func (m *ListSilencesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSilencesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSilences() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSilencesResponseValidationError{
+						field:  fmt.Sprintf("Silences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSilencesResponseValidationError{
+						field:  fmt.Sprintf("Silences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSilencesResponseValidationError{
+					field:  fmt.Sprintf("Silences[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSilencesResponseMultiError(errors)
+	}
+	return nil
+}
+
+// ListSilencesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSilencesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSilencesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSilencesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSilencesResponseMultiError) AllErrors() []error { return m }
+
+// ListSilencesResponseValidationError is the validation error returned by
+// ListSilencesResponse.Validate if the designated constraints aren't met.
+type ListSilencesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSilencesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSilencesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSilencesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSilencesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSilencesResponseValidationError) ErrorName() string {
+	return "ListSilencesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSilencesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSilencesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSilencesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSilencesResponseValidationError{}
+
+// Validate checks the field values on GetSilenceRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetSilenceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSilenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSilenceRequestMultiError, or nil if none found.
+func (m *GetSilenceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSilenceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetSilenceRequestMultiError(errors)
+	}
+	return nil
+}
+
+// GetSilenceRequestMultiError is an error wrapping multiple validation errors
+// returned by GetSilenceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetSilenceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSilenceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSilenceRequestMultiError) AllErrors() []error { return m }
+
+// GetSilenceRequestValidationError is the validation error returned by
+// GetSilenceRequest.Validate if the designated constraints aren't met.
+type GetSilenceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSilenceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSilenceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSilenceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSilenceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSilenceRequestValidationError) ErrorName() string {
+	return "GetSilenceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSilenceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSilenceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSilenceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSilenceRequestValidationError{}
+
+// Validate checks the field values on GetSilenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSilenceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSilenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSilenceResponseMultiError, or nil if none found.
+func (m *GetSilenceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSilenceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSilence()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSilenceResponseValidationError{
+					field:  "Silence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSilenceResponseValidationError{
+					field:  "Silence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSilence()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSilenceResponseValidationError{
+				field:  "Silence",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetSilenceResponseMultiError(errors)
+	}
+	return nil
+}
+
+// GetSilenceResponseMultiError is an error wrapping multiple validation errors
+// returned by GetSilenceResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetSilenceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSilenceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSilenceResponseMultiError) AllErrors() []error { return m }
+
+// GetSilenceResponseValidationError is the validation error returned by
+// GetSilenceResponse.Validate if the designated constraints aren't met.
+type GetSilenceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSilenceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSilenceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSilenceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSilenceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSilenceResponseValidationError) ErrorName() string {
+	return "GetSilenceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSilenceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSilenceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSilenceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSilenceResponseValidationError{}
+
+// Validate checks the field values on ExpireSilenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExpireSilenceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExpireSilenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExpireSilenceRequestMultiError, or nil if none found.
+func (m *ExpireSilenceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExpireSilenceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ExpireSilenceRequestMultiError(errors)
+	}
+	return nil
+}
+
+// ExpireSilenceRequestMultiError is an error wrapping multiple validation
+// errors returned by ExpireSilenceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExpireSilenceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExpireSilenceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExpireSilenceRequestMultiError) AllErrors() []error { return m }
+
+// ExpireSilenceRequestValidationError is the validation error returned by
+// ExpireSilenceRequest.Validate if the designated constraints aren't met.
+type ExpireSilenceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExpireSilenceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExpireSilenceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExpireSilenceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExpireSilenceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExpireSilenceRequestValidationError) ErrorName() string {
+	return "ExpireSilenceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExpireSilenceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExpireSilenceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExpireSilenceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExpireSilenceRequestValidationError{}
+
+// Validate checks the field values on ExpireSilenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExpireSilenceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExpireSilenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExpireSilenceResponseMultiError, or nil if none found.
+func (m *ExpireSilenceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExpireSilenceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ExpireSilenceResponseMultiError(errors)
+	}
+	return nil
+}
+
+// ExpireSilenceResponseMultiError is an error wrapping multiple validation
+// errors returned by ExpireSilenceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExpireSilenceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExpireSilenceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExpireSilenceResponseMultiError) AllErrors() []error { return m }
+
+// ExpireSilenceResponseValidationError is the validation error returned by
+// ExpireSilenceResponse.Validate if the designated constraints aren't met.
+type ExpireSilenceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExpireSilenceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExpireSilenceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExpireSilenceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExpireSilenceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExpireSilenceResponseValidationError) ErrorName() string {
+	return "ExpireSilenceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExpireSilenceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExpireSilenceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExpireSilenceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExpireSilenceResponseValidationError{}
+
+// Validate checks the field values on NotificationMessage with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NotificationMessage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationMessage with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NotificationMessageMultiError, or nil if none found.
+func (m *NotificationMessage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationMessage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	// no validation rules for ReceiverType
+
+	if all {
+		switch v := interface{}(m.GetDetails()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationMessageValidationError{
+					field:  "Details",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationMessageValidationError{
+					field:  "Details",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDetails()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationMessageValidationError{
+				field:  "Details",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastError
+
+	// no validation rules for MaxTries
+
+	// no validation rules for TryCount
+
+	// no validation rules for Retryable
+
+	// no validation rules for ExternalId
+
+	for idx, item := range m.GetAttempts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NotificationMessageValidationError{
+						field:  fmt.Sprintf("Attempts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NotificationMessageValidationError{
+						field:  fmt.Sprintf("Attempts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NotificationMessageValidationError{
+					field:  fmt.Sprintf("Attempts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationMessageValidationError{
+					field:  "ExpiredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationMessageValidationError{
+					field:  "ExpiredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationMessageValidationError{
+				field:  "ExpiredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNextAttemptAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationMessageValidationError{
+					field:  "NextAttemptAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationMessageValidationError{
+					field:  "NextAttemptAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextAttemptAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationMessageValidationError{
+				field:  "NextAttemptAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationMessageValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationMessageValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationMessageValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationMessageValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationMessageValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationMessageValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return NotificationMessageMultiError(errors)
+	}
+	return nil
+}
+
+// NotificationMessageMultiError is an error wrapping multiple validation
+// errors returned by NotificationMessage.ValidateAll() if the designated
+// constraints aren't met.
+type NotificationMessageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationMessageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationMessageMultiError) AllErrors() []error { return m }
+
+// NotificationMessageValidationError is the validation error returned by
+// NotificationMessage.Validate if the designated constraints aren't met.
+type NotificationMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationMessageValidationError) ErrorName() string {
+	return "NotificationMessageValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationMessageValidationError{}
+
+// Validate checks the field values on NotificationMessageAttempt with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NotificationMessageAttempt) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationMessageAttempt with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NotificationMessageAttemptMultiError, or nil if none found.
+func (m *NotificationMessageAttempt) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationMessageAttempt) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TryCount
+
+	// no validation rules for Status
+
+	// no validation rules for Error
+
+	if all {
+		switch v := interface{}(m.GetAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationMessageAttemptValidationError{
+					field:  "At",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationMessageAttemptValidationError{
+					field:  "At",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationMessageAttemptValidationError{
+				field:  "At",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return NotificationMessageAttemptMultiError(errors)
+	}
+	return nil
+}
+
+// NotificationMessageAttemptMultiError is an error wrapping multiple
+// validation errors returned by NotificationMessageAttempt.ValidateAll() if
+// the designated constraints aren't met.
+type NotificationMessageAttemptMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationMessageAttemptMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationMessageAttemptMultiError) AllErrors() []error { return m }
+
+// NotificationMessageAttemptValidationError is the validation error returned
+// by NotificationMessageAttempt.Validate if the designated constraints aren't met.
+type NotificationMessageAttemptValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationMessageAttemptValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationMessageAttemptValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationMessageAttemptValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationMessageAttemptValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationMessageAttemptValidationError) ErrorName() string {
+	return "NotificationMessageAttemptValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationMessageAttemptValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationMessageAttempt.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationMessageAttemptValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationMessageAttemptValidationError{}
+
+// Validate checks the field values on ListMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMessagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMessagesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateSilenceRequestMultiError, or nil if none found.
-func (m *CreateSilenceRequest) ValidateAll() error {
+// ListMessagesRequestMultiError, or nil if none found.
+func (m *ListMessagesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateSilenceRequest) validate(all bool) error {
+func (m *ListMessagesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for NamespaceId
+	// no validation rules for Status
 
-	// no validation rules for Type
+	// no validation rules for ReceiverType
 
-	// no validation rules for TargetId
+	// no validation rules for StartTime
 
-	if all {
-		switch v := interface{}(m.GetTargetExpression()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateSilenceRequestValidationError{
-					field:  "TargetExpression",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for EndTime
+
+	// no validation rules for Error
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListMessagesRequestMultiError(errors)
+	}
+	return nil
+}
+
+// ListMessagesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMessagesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListMessagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMessagesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMessagesRequestMultiError) AllErrors() []error { return m }
+
+// ListMessagesRequestValidationError is the validation error returned by
+// ListMessagesRequest.Validate if the designated constraints aren't met.
+type ListMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMessagesRequestValidationError) ErrorName() string {
+	return "ListMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMessagesRequestValidationError{}
+
+// Validate checks the field values on ListMessagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMessagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMessagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMessagesResponseMultiError, or nil if none found.
+func (m *ListMessagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMessagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMessagesResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMessagesResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateSilenceRequestValidationError{
-					field:  "TargetExpression",
+				return ListMessagesResponseValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTargetExpression()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateSilenceRequestValidationError{
-				field:  "TargetExpression",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return CreateSilenceRequestMultiError(errors)
+		return ListMessagesResponseMultiError(errors)
 	}
 	return nil
 }
 
-// CreateSilenceRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateSilenceRequest.ValidateAll() if the designated
+// ListMessagesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListMessagesResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreateSilenceRequestMultiError []error
+type ListMessagesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateSilenceRequestMultiError) Error() string {
+func (m ListMessagesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9956,11 +14892,11 @@ func (m CreateSilenceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateSilenceRequestMultiError) AllErrors() []error { return m }
+func (m ListMessagesResponseMultiError) AllErrors() []error { return m }
 
-// CreateSilenceRequestValidationError is the validation error returned by
-// CreateSilenceRequest.Validate if the designated constraints aren't met.
-type CreateSilenceRequestValidationError struct {
+// ListMessagesResponseValidationError is the validation error returned by
+// ListMessagesResponse.Validate if the designated constraints aren't met.
+type ListMessagesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9968,24 +14904,24 @@ type CreateSilenceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateSilenceRequestValidationError) Field() string { return e.field }
+func (e ListMessagesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateSilenceRequestValidationError) Reason() string { return e.reason }
+func (e ListMessagesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateSilenceRequestValidationError) Cause() error { return e.cause }
+func (e ListMessagesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateSilenceRequestValidationError) Key() bool { return e.key }
+func (e ListMessagesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateSilenceRequestValidationError) ErrorName() string {
-	return "CreateSilenceRequestValidationError"
+func (e ListMessagesResponseValidationError) ErrorName() string {
+	return "ListMessagesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateSilenceRequestValidationError) Error() string {
+func (e ListMessagesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9997,14 +14933,14 @@ func (e CreateSilenceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateSilenceRequest.%s: %s%s",
+		"invalid %sListMessagesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateSilenceRequestValidationError{}
+var _ error = ListMessagesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10012,24 +14948,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateSilenceRequestValidationError{}
+} = ListMessagesResponseValidationError{}
 
-// Validate checks the field values on CreateSilenceResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateSilenceResponse) Validate() error {
+// Validate checks the field values on GetMessageRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetMessageRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateSilenceResponse with the rules
+// ValidateAll checks the field values on GetMessageRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateSilenceResponseMultiError, or nil if none found.
-func (m *CreateSilenceResponse) ValidateAll() error {
+// GetMessageRequestMultiError, or nil if none found.
+func (m *GetMessageRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateSilenceResponse) validate(all bool) error {
+func (m *GetMessageRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -10039,18 +14975,148 @@ func (m *CreateSilenceResponse) validate(all bool) error {
 	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return CreateSilenceResponseMultiError(errors)
+		return GetMessageRequestMultiError(errors)
 	}
 	return nil
 }
 
-// CreateSilenceResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateSilenceResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateSilenceResponseMultiError []error
+// GetMessageRequestMultiError is an error wrapping multiple validation errors
+// returned by GetMessageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMessageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMessageRequestMultiError) AllErrors() []error { return m }
+
+// GetMessageRequestValidationError is the validation error returned by
+// GetMessageRequest.Validate if the designated constraints aren't met.
+type GetMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMessageRequestValidationError) ErrorName() string {
+	return "GetMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMessageRequestValidationError{}
+
+// Validate checks the field values on GetMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMessageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMessageResponseMultiError, or nil if none found.
+func (m *GetMessageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMessageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMessage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetMessageResponseValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetMessageResponseValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetMessageResponseValidationError{
+				field:  "Message",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetMessageResponseMultiError(errors)
+	}
+	return nil
+}
+
+// GetMessageResponseMultiError is an error wrapping multiple validation errors
+// returned by GetMessageResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetMessageResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateSilenceResponseMultiError) Error() string {
+func (m GetMessageResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10059,11 +15125,11 @@ func (m CreateSilenceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateSilenceResponseMultiError) AllErrors() []error { return m }
+func (m GetMessageResponseMultiError) AllErrors() []error { return m }
 
-// CreateSilenceResponseValidationError is the validation error returned by
-// CreateSilenceResponse.Validate if the designated constraints aren't met.
-type CreateSilenceResponseValidationError struct {
+// GetMessageResponseValidationError is the validation error returned by
+// GetMessageResponse.Validate if the designated constraints aren't met.
+type GetMessageResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10071,24 +15137,24 @@ type CreateSilenceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateSilenceResponseValidationError) Field() string { return e.field }
+func (e GetMessageResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateSilenceResponseValidationError) Reason() string { return e.reason }
+func (e GetMessageResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateSilenceResponseValidationError) Cause() error { return e.cause }
+func (e GetMessageResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateSilenceResponseValidationError) Key() bool { return e.key }
+func (e GetMessageResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateSilenceResponseValidationError) ErrorName() string {
-	return "CreateSilenceResponseValidationError"
+func (e GetMessageResponseValidationError) ErrorName() string {
+	return "GetMessageResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateSilenceResponseValidationError) Error() string {
+func (e GetMessageResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10100,14 +15166,14 @@ func (e CreateSilenceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateSilenceResponse.%s: %s%s",
+		"invalid %sGetMessageResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateSilenceResponseValidationError{}
+var _ error = GetMessageResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10115,51 +15181,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateSilenceResponseValidationError{}
+} = GetMessageResponseValidationError{}
 
-// Validate checks the field values on ListSilencesRequest with the rules
+// Validate checks the field values on RequeueMessagesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListSilencesRequest) Validate() error {
+func (m *RequeueMessagesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListSilencesRequest with the rules
+// ValidateAll checks the field values on RequeueMessagesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListSilencesRequestMultiError, or nil if none found.
-func (m *ListSilencesRequest) ValidateAll() error {
+// RequeueMessagesRequestMultiError, or nil if none found.
+func (m *RequeueMessagesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListSilencesRequest) validate(all bool) error {
+func (m *RequeueMessagesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SubscriptionId
-
-	// no validation rules for NamespaceId
-
-	// no validation rules for Match
-
-	// no validation rules for SubscriptionMatch
-
 	if len(errors) > 0 {
-		return ListSilencesRequestMultiError(errors)
+		return RequeueMessagesRequestMultiError(errors)
 	}
 	return nil
 }
 
-// ListSilencesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListSilencesRequest.ValidateAll() if the designated
+// RequeueMessagesRequestMultiError is an error wrapping multiple validation
+// errors returned by RequeueMessagesRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListSilencesRequestMultiError []error
+type RequeueMessagesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListSilencesRequestMultiError) Error() string {
+func (m RequeueMessagesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10168,11 +15226,11 @@ func (m ListSilencesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListSilencesRequestMultiError) AllErrors() []error { return m }
+func (m RequeueMessagesRequestMultiError) AllErrors() []error { return m }
 
-// ListSilencesRequestValidationError is the validation error returned by
-// ListSilencesRequest.Validate if the designated constraints aren't met.
-type ListSilencesRequestValidationError struct {
+// RequeueMessagesRequestValidationError is the validation error returned by
+// RequeueMessagesRequest.Validate if the designated constraints aren't met.
+type RequeueMessagesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10180,24 +15238,24 @@ type ListSilencesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListSilencesRequestValidationError) Field() string { return e.field }
+func (e RequeueMessagesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListSilencesRequestValidationError) Reason() string { return e.reason }
+func (e RequeueMessagesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListSilencesRequestValidationError) Cause() error { return e.cause }
+func (e RequeueMessagesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListSilencesRequestValidationError) Key() bool { return e.key }
+func (e RequeueMessagesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListSilencesRequestValidationError) ErrorName() string {
-	return "ListSilencesRequestValidationError"
+func (e RequeueMessagesRequestValidationError) ErrorName() string {
+	return "RequeueMessagesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListSilencesRequestValidationError) Error() string {
+func (e RequeueMessagesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10209,14 +15267,14 @@ func (e ListSilencesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListSilencesRequest.%s: %s%s",
+		"invalid %sRequeueMessagesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListSilencesRequestValidationError{}
+var _ error = RequeueMessagesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10224,77 +15282,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListSilencesRequestValidationError{}
+} = RequeueMessagesRequestValidationError{}
 
-// Validate checks the field values on ListSilencesResponse with the rules
+// Validate checks the field values on RequeueMessagesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListSilencesResponse) Validate() error {
+func (m *RequeueMessagesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListSilencesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RequeueMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListSilencesResponseMultiError, or nil if none found.
-func (m *ListSilencesResponse) ValidateAll() error {
+// RequeueMessagesResponseMultiError, or nil if none found.
+func (m *RequeueMessagesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListSilencesResponse) validate(all bool) error {
+func (m *RequeueMessagesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetSilences() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListSilencesResponseValidationError{
-						field:  fmt.Sprintf("Silences[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListSilencesResponseValidationError{
-						field:  fmt.Sprintf("Silences[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListSilencesResponseValidationError{
-					field:  fmt.Sprintf("Silences[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Count
 
 	if len(errors) > 0 {
-		return ListSilencesResponseMultiError(errors)
+		return RequeueMessagesResponseMultiError(errors)
 	}
 	return nil
 }
 
-// ListSilencesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListSilencesResponse.ValidateAll() if the designated
+// RequeueMessagesResponseMultiError is an error wrapping multiple validation
+// errors returned by RequeueMessagesResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListSilencesResponseMultiError []error
+type RequeueMessagesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListSilencesResponseMultiError) Error() string {
+func (m RequeueMessagesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10303,11 +15329,11 @@ func (m ListSilencesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListSilencesResponseMultiError) AllErrors() []error { return m }
+func (m RequeueMessagesResponseMultiError) AllErrors() []error { return m }
 
-// ListSilencesResponseValidationError is the validation error returned by
-// ListSilencesResponse.Validate if the designated constraints aren't met.
-type ListSilencesResponseValidationError struct {
+// RequeueMessagesResponseValidationError is the validation error returned by
+// RequeueMessagesResponse.Validate if the designated constraints aren't met.
+type RequeueMessagesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10315,24 +15341,24 @@ type ListSilencesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListSilencesResponseValidationError) Field() string { return e.field }
+func (e RequeueMessagesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListSilencesResponseValidationError) Reason() string { return e.reason }
+func (e RequeueMessagesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListSilencesResponseValidationError) Cause() error { return e.cause }
+func (e RequeueMessagesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListSilencesResponseValidationError) Key() bool { return e.key }
+func (e RequeueMessagesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListSilencesResponseValidationError) ErrorName() string {
-	return "ListSilencesResponseValidationError"
+func (e RequeueMessagesResponseValidationError) ErrorName() string {
+	return "RequeueMessagesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListSilencesResponseValidationError) Error() string {
+func (e RequeueMessagesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10344,14 +15370,14 @@ func (e ListSilencesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListSilencesResponse.%s: %s%s",
+		"invalid %sRequeueMessagesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListSilencesResponseValidationError{}
+var _ error = RequeueMessagesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10359,45 +15385,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListSilencesResponseValidationError{}
+} = RequeueMessagesResponseValidationError{}
 
-// Validate checks the field values on GetSilenceRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetSilenceRequest) Validate() error {
+// Validate checks the field values on ReplayMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplayMessagesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSilenceRequest with the rules
+// ValidateAll checks the field values on ReplayMessagesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSilenceRequestMultiError, or nil if none found.
-func (m *GetSilenceRequest) ValidateAll() error {
+// ReplayMessagesRequestMultiError, or nil if none found.
+func (m *ReplayMessagesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSilenceRequest) validate(all bool) error {
+func (m *ReplayMessagesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
 	if len(errors) > 0 {
-		return GetSilenceRequestMultiError(errors)
+		return ReplayMessagesRequestMultiError(errors)
 	}
 	return nil
 }
 
-// GetSilenceRequestMultiError is an error wrapping multiple validation errors
-// returned by GetSilenceRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetSilenceRequestMultiError []error
+// ReplayMessagesRequestMultiError is an error wrapping multiple validation
+// errors returned by ReplayMessagesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReplayMessagesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSilenceRequestMultiError) Error() string {
+func (m ReplayMessagesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10406,11 +15430,11 @@ func (m GetSilenceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSilenceRequestMultiError) AllErrors() []error { return m }
+func (m ReplayMessagesRequestMultiError) AllErrors() []error { return m }
 
-// GetSilenceRequestValidationError is the validation error returned by
-// GetSilenceRequest.Validate if the designated constraints aren't met.
-type GetSilenceRequestValidationError struct {
+// ReplayMessagesRequestValidationError is the validation error returned by
+// ReplayMessagesRequest.Validate if the designated constraints aren't met.
+type ReplayMessagesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10418,24 +15442,24 @@ type GetSilenceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSilenceRequestValidationError) Field() string { return e.field }
+func (e ReplayMessagesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSilenceRequestValidationError) Reason() string { return e.reason }
+func (e ReplayMessagesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSilenceRequestValidationError) Cause() error { return e.cause }
+func (e ReplayMessagesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSilenceRequestValidationError) Key() bool { return e.key }
+func (e ReplayMessagesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSilenceRequestValidationError) ErrorName() string {
-	return "GetSilenceRequestValidationError"
+func (e ReplayMessagesRequestValidationError) ErrorName() string {
+	return "ReplayMessagesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetSilenceRequestValidationError) Error() string {
+func (e ReplayMessagesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10447,14 +15471,14 @@ func (e GetSilenceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSilenceRequest.%s: %s%s",
+		"invalid %sReplayMessagesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSilenceRequestValidationError{}
+var _ error = ReplayMessagesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10462,72 +15486,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSilenceRequestValidationError{}
+} = ReplayMessagesRequestValidationError{}
 
-// Validate checks the field values on GetSilenceResponse with the rules
+// Validate checks the field values on ReplayMessagesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetSilenceResponse) Validate() error {
+func (m *ReplayMessagesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSilenceResponse with the rules
+// ValidateAll checks the field values on ReplayMessagesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSilenceResponseMultiError, or nil if none found.
-func (m *GetSilenceResponse) ValidateAll() error {
+// ReplayMessagesResponseMultiError, or nil if none found.
+func (m *ReplayMessagesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSilenceResponse) validate(all bool) error {
+func (m *ReplayMessagesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSilence()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetSilenceResponseValidationError{
-					field:  "Silence",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetSilenceResponseValidationError{
-					field:  "Silence",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSilence()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetSilenceResponseValidationError{
-				field:  "Silence",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return GetSilenceResponseMultiError(errors)
+		return ReplayMessagesResponseMultiError(errors)
 	}
 	return nil
 }
 
-// GetSilenceResponseMultiError is an error wrapping multiple validation errors
-// returned by GetSilenceResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetSilenceResponseMultiError []error
+// ReplayMessagesResponseMultiError is an error wrapping multiple validation
+// errors returned by ReplayMessagesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReplayMessagesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSilenceResponseMultiError) Error() string {
+func (m ReplayMessagesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10536,11 +15531,11 @@ func (m GetSilenceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSilenceResponseMultiError) AllErrors() []error { return m }
+func (m ReplayMessagesResponseMultiError) AllErrors() []error { return m }
 
-// GetSilenceResponseValidationError is the validation error returned by
-// GetSilenceResponse.Validate if the designated constraints aren't met.
-type GetSilenceResponseValidationError struct {
+// ReplayMessagesResponseValidationError is the validation error returned by
+// ReplayMessagesResponse.Validate if the designated constraints aren't met.
+type ReplayMessagesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10548,24 +15543,24 @@ type GetSilenceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSilenceResponseValidationError) Field() string { return e.field }
+func (e ReplayMessagesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSilenceResponseValidationError) Reason() string { return e.reason }
+func (e ReplayMessagesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSilenceResponseValidationError) Cause() error { return e.cause }
+func (e ReplayMessagesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSilenceResponseValidationError) Key() bool { return e.key }
+func (e ReplayMessagesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSilenceResponseValidationError) ErrorName() string {
-	return "GetSilenceResponseValidationError"
+func (e ReplayMessagesResponseValidationError) ErrorName() string {
+	return "ReplayMessagesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetSilenceResponseValidationError) Error() string {
+func (e ReplayMessagesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10577,14 +15572,14 @@ func (e GetSilenceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSilenceResponse.%s: %s%s",
+		"invalid %sReplayMessagesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSilenceResponseValidationError{}
+var _ error = ReplayMessagesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10592,45 +15587,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSilenceResponseValidationError{}
+} = ReplayMessagesResponseValidationError{}
 
-// Validate checks the field values on ExpireSilenceRequest with the rules
+// Validate checks the field values on DiscardMessagesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ExpireSilenceRequest) Validate() error {
+func (m *DiscardMessagesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExpireSilenceRequest with the rules
+// ValidateAll checks the field values on DiscardMessagesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ExpireSilenceRequestMultiError, or nil if none found.
-func (m *ExpireSilenceRequest) ValidateAll() error {
+// DiscardMessagesRequestMultiError, or nil if none found.
+func (m *DiscardMessagesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExpireSilenceRequest) validate(all bool) error {
+func (m *DiscardMessagesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
 	if len(errors) > 0 {
-		return ExpireSilenceRequestMultiError(errors)
+		return DiscardMessagesRequestMultiError(errors)
 	}
 	return nil
 }
 
-// ExpireSilenceRequestMultiError is an error wrapping multiple validation
-// errors returned by ExpireSilenceRequest.ValidateAll() if the designated
+// DiscardMessagesRequestMultiError is an error wrapping multiple validation
+// errors returned by DiscardMessagesRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ExpireSilenceRequestMultiError []error
+type DiscardMessagesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExpireSilenceRequestMultiError) Error() string {
+func (m DiscardMessagesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10639,11 +15632,11 @@ func (m ExpireSilenceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExpireSilenceRequestMultiError) AllErrors() []error { return m }
+func (m DiscardMessagesRequestMultiError) AllErrors() []error { return m }
 
-// ExpireSilenceRequestValidationError is the validation error returned by
-// ExpireSilenceRequest.Validate if the designated constraints aren't met.
-type ExpireSilenceRequestValidationError struct {
+// DiscardMessagesRequestValidationError is the validation error returned by
+// DiscardMessagesRequest.Validate if the designated constraints aren't met.
+type DiscardMessagesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10651,24 +15644,24 @@ type ExpireSilenceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExpireSilenceRequestValidationError) Field() string { return e.field }
+func (e DiscardMessagesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExpireSilenceRequestValidationError) Reason() string { return e.reason }
+func (e DiscardMessagesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExpireSilenceRequestValidationError) Cause() error { return e.cause }
+func (e DiscardMessagesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExpireSilenceRequestValidationError) Key() bool { return e.key }
+func (e DiscardMessagesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExpireSilenceRequestValidationError) ErrorName() string {
-	return "ExpireSilenceRequestValidationError"
+func (e DiscardMessagesRequestValidationError) ErrorName() string {
+	return "DiscardMessagesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ExpireSilenceRequestValidationError) Error() string {
+func (e DiscardMessagesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10680,14 +15673,14 @@ func (e ExpireSilenceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExpireSilenceRequest.%s: %s%s",
+		"invalid %sDiscardMessagesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExpireSilenceRequestValidationError{}
+var _ error = DiscardMessagesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10695,43 +15688,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExpireSilenceRequestValidationError{}
+} = DiscardMessagesRequestValidationError{}
 
-// Validate checks the field values on ExpireSilenceResponse with the rules
+// Validate checks the field values on DiscardMessagesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ExpireSilenceResponse) Validate() error {
+func (m *DiscardMessagesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExpireSilenceResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DiscardMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ExpireSilenceResponseMultiError, or nil if none found.
-func (m *ExpireSilenceResponse) ValidateAll() error {
+// DiscardMessagesResponseMultiError, or nil if none found.
+func (m *DiscardMessagesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExpireSilenceResponse) validate(all bool) error {
+func (m *DiscardMessagesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Count
+
 	if len(errors) > 0 {
-		return ExpireSilenceResponseMultiError(errors)
+		return DiscardMessagesResponseMultiError(errors)
 	}
 	return nil
 }
 
-// ExpireSilenceResponseMultiError is an error wrapping multiple validation
-// errors returned by ExpireSilenceResponse.ValidateAll() if the designated
+// DiscardMessagesResponseMultiError is an error wrapping multiple validation
+// errors returned by DiscardMessagesResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ExpireSilenceResponseMultiError []error
+type DiscardMessagesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExpireSilenceResponseMultiError) Error() string {
+func (m DiscardMessagesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10740,11 +15735,11 @@ func (m ExpireSilenceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExpireSilenceResponseMultiError) AllErrors() []error { return m }
+func (m DiscardMessagesResponseMultiError) AllErrors() []error { return m }
 
-// ExpireSilenceResponseValidationError is the validation error returned by
-// ExpireSilenceResponse.Validate if the designated constraints aren't met.
-type ExpireSilenceResponseValidationError struct {
+// DiscardMessagesResponseValidationError is the validation error returned by
+// DiscardMessagesResponse.Validate if the designated constraints aren't met.
+type DiscardMessagesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10752,24 +15747,24 @@ type ExpireSilenceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExpireSilenceResponseValidationError) Field() string { return e.field }
+func (e DiscardMessagesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExpireSilenceResponseValidationError) Reason() string { return e.reason }
+func (e DiscardMessagesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExpireSilenceResponseValidationError) Cause() error { return e.cause }
+func (e DiscardMessagesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExpireSilenceResponseValidationError) Key() bool { return e.key }
+func (e DiscardMessagesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExpireSilenceResponseValidationError) ErrorName() string {
-	return "ExpireSilenceResponseValidationError"
+func (e DiscardMessagesResponseValidationError) ErrorName() string {
+	return "DiscardMessagesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ExpireSilenceResponseValidationError) Error() string {
+func (e DiscardMessagesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10781,14 +15776,14 @@ func (e ExpireSilenceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExpireSilenceResponse.%s: %s%s",
+		"invalid %sDiscardMessagesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExpireSilenceResponseValidationError{}
+var _ error = DiscardMessagesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10796,4 +15791,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExpireSilenceResponseValidationError{}
+} = DiscardMessagesResponseValidationError{}
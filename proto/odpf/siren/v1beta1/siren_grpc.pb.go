@@ -44,15 +44,30 @@ type SirenServiceClient interface {
 	CreateAlertsWithNamespace(ctx context.Context, in *CreateAlertsWithNamespaceRequest, opts ...grpc.CallOption) (*CreateAlertsWithNamespaceResponse, error)
 	ListRules(ctx context.Context, in *ListRulesRequest, opts ...grpc.CallOption) (*ListRulesResponse, error)
 	UpdateRule(ctx context.Context, in *UpdateRuleRequest, opts ...grpc.CallOption) (*UpdateRuleResponse, error)
+	PreviewRule(ctx context.Context, in *PreviewRuleRequest, opts ...grpc.CallOption) (*PreviewRuleResponse, error)
+	DeleteRule(ctx context.Context, in *DeleteRuleRequest, opts ...grpc.CallOption) (*DeleteRuleResponse, error)
+	ListRuleRevisions(ctx context.Context, in *ListRuleRevisionsRequest, opts ...grpc.CallOption) (*ListRuleRevisionsResponse, error)
+	GetRuleRevision(ctx context.Context, in *GetRuleRevisionRequest, opts ...grpc.CallOption) (*GetRuleRevisionResponse, error)
+	RollbackRule(ctx context.Context, in *RollbackRuleRequest, opts ...grpc.CallOption) (*RollbackRuleResponse, error)
+	GetRuleDrift(ctx context.Context, in *GetRuleDriftRequest, opts ...grpc.CallOption) (*GetRuleDriftResponse, error)
 	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error)
 	GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*GetTemplateResponse, error)
 	UpsertTemplate(ctx context.Context, in *UpsertTemplateRequest, opts ...grpc.CallOption) (*UpsertTemplateResponse, error)
 	DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*DeleteTemplateResponse, error)
+	ListTemplateRevisions(ctx context.Context, in *ListTemplateRevisionsRequest, opts ...grpc.CallOption) (*ListTemplateRevisionsResponse, error)
+	GetTemplateRevision(ctx context.Context, in *GetTemplateRevisionRequest, opts ...grpc.CallOption) (*GetTemplateRevisionResponse, error)
+	RollbackTemplate(ctx context.Context, in *RollbackTemplateRequest, opts ...grpc.CallOption) (*RollbackTemplateResponse, error)
+	SyncTemplateRules(ctx context.Context, in *SyncTemplateRulesRequest, opts ...grpc.CallOption) (*SyncTemplateRulesResponse, error)
 	RenderTemplate(ctx context.Context, in *RenderTemplateRequest, opts ...grpc.CallOption) (*RenderTemplateResponse, error)
 	CreateSilence(ctx context.Context, in *CreateSilenceRequest, opts ...grpc.CallOption) (*CreateSilenceResponse, error)
 	ListSilences(ctx context.Context, in *ListSilencesRequest, opts ...grpc.CallOption) (*ListSilencesResponse, error)
 	GetSilence(ctx context.Context, in *GetSilenceRequest, opts ...grpc.CallOption) (*GetSilenceResponse, error)
 	ExpireSilence(ctx context.Context, in *ExpireSilenceRequest, opts ...grpc.CallOption) (*ExpireSilenceResponse, error)
+	ListMessages(ctx context.Context, in *ListMessagesRequest, opts ...grpc.CallOption) (*ListMessagesResponse, error)
+	GetMessage(ctx context.Context, in *GetMessageRequest, opts ...grpc.CallOption) (*GetMessageResponse, error)
+	RequeueMessages(ctx context.Context, in *RequeueMessagesRequest, opts ...grpc.CallOption) (*RequeueMessagesResponse, error)
+	ReplayMessages(ctx context.Context, in *ReplayMessagesRequest, opts ...grpc.CallOption) (*ReplayMessagesResponse, error)
+	DiscardMessages(ctx context.Context, in *DiscardMessagesRequest, opts ...grpc.CallOption) (*DiscardMessagesResponse, error)
 }
 
 type sirenServiceClient struct {
@@ -297,6 +312,60 @@ func (c *sirenServiceClient) UpdateRule(ctx context.Context, in *UpdateRuleReque
 	return out, nil
 }
 
+func (c *sirenServiceClient) PreviewRule(ctx context.Context, in *PreviewRuleRequest, opts ...grpc.CallOption) (*PreviewRuleResponse, error) {
+	out := new(PreviewRuleResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/PreviewRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) DeleteRule(ctx context.Context, in *DeleteRuleRequest, opts ...grpc.CallOption) (*DeleteRuleResponse, error) {
+	out := new(DeleteRuleResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/DeleteRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) ListRuleRevisions(ctx context.Context, in *ListRuleRevisionsRequest, opts ...grpc.CallOption) (*ListRuleRevisionsResponse, error) {
+	out := new(ListRuleRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/ListRuleRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) GetRuleRevision(ctx context.Context, in *GetRuleRevisionRequest, opts ...grpc.CallOption) (*GetRuleRevisionResponse, error) {
+	out := new(GetRuleRevisionResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/GetRuleRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) RollbackRule(ctx context.Context, in *RollbackRuleRequest, opts ...grpc.CallOption) (*RollbackRuleResponse, error) {
+	out := new(RollbackRuleResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/RollbackRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) GetRuleDrift(ctx context.Context, in *GetRuleDriftRequest, opts ...grpc.CallOption) (*GetRuleDriftResponse, error) {
+	out := new(GetRuleDriftResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/GetRuleDrift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sirenServiceClient) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error) {
 	out := new(ListTemplatesResponse)
 	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/ListTemplates", in, out, opts...)
@@ -333,6 +402,42 @@ func (c *sirenServiceClient) DeleteTemplate(ctx context.Context, in *DeleteTempl
 	return out, nil
 }
 
+func (c *sirenServiceClient) ListTemplateRevisions(ctx context.Context, in *ListTemplateRevisionsRequest, opts ...grpc.CallOption) (*ListTemplateRevisionsResponse, error) {
+	out := new(ListTemplateRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/ListTemplateRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) GetTemplateRevision(ctx context.Context, in *GetTemplateRevisionRequest, opts ...grpc.CallOption) (*GetTemplateRevisionResponse, error) {
+	out := new(GetTemplateRevisionResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/GetTemplateRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) RollbackTemplate(ctx context.Context, in *RollbackTemplateRequest, opts ...grpc.CallOption) (*RollbackTemplateResponse, error) {
+	out := new(RollbackTemplateResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/RollbackTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) SyncTemplateRules(ctx context.Context, in *SyncTemplateRulesRequest, opts ...grpc.CallOption) (*SyncTemplateRulesResponse, error) {
+	out := new(SyncTemplateRulesResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/SyncTemplateRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sirenServiceClient) RenderTemplate(ctx context.Context, in *RenderTemplateRequest, opts ...grpc.CallOption) (*RenderTemplateResponse, error) {
 	out := new(RenderTemplateResponse)
 	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/RenderTemplate", in, out, opts...)
@@ -378,6 +483,51 @@ func (c *sirenServiceClient) ExpireSilence(ctx context.Context, in *ExpireSilenc
 	return out, nil
 }
 
+func (c *sirenServiceClient) ListMessages(ctx context.Context, in *ListMessagesRequest, opts ...grpc.CallOption) (*ListMessagesResponse, error) {
+	out := new(ListMessagesResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/ListMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) GetMessage(ctx context.Context, in *GetMessageRequest, opts ...grpc.CallOption) (*GetMessageResponse, error) {
+	out := new(GetMessageResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/GetMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) RequeueMessages(ctx context.Context, in *RequeueMessagesRequest, opts ...grpc.CallOption) (*RequeueMessagesResponse, error) {
+	out := new(RequeueMessagesResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/RequeueMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) ReplayMessages(ctx context.Context, in *ReplayMessagesRequest, opts ...grpc.CallOption) (*ReplayMessagesResponse, error) {
+	out := new(ReplayMessagesResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/ReplayMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) DiscardMessages(ctx context.Context, in *DiscardMessagesRequest, opts ...grpc.CallOption) (*DiscardMessagesResponse, error) {
+	out := new(DiscardMessagesResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/DiscardMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SirenServiceServer is the server API for SirenService service.
 // All implementations must embed UnimplementedSirenServiceServer
 // for forward compatibility
@@ -408,15 +558,30 @@ type SirenServiceServer interface {
 	CreateAlertsWithNamespace(context.Context, *CreateAlertsWithNamespaceRequest) (*CreateAlertsWithNamespaceResponse, error)
 	ListRules(context.Context, *ListRulesRequest) (*ListRulesResponse, error)
 	UpdateRule(context.Context, *UpdateRuleRequest) (*UpdateRuleResponse, error)
+	PreviewRule(context.Context, *PreviewRuleRequest) (*PreviewRuleResponse, error)
+	DeleteRule(context.Context, *DeleteRuleRequest) (*DeleteRuleResponse, error)
+	ListRuleRevisions(context.Context, *ListRuleRevisionsRequest) (*ListRuleRevisionsResponse, error)
+	GetRuleRevision(context.Context, *GetRuleRevisionRequest) (*GetRuleRevisionResponse, error)
+	RollbackRule(context.Context, *RollbackRuleRequest) (*RollbackRuleResponse, error)
+	GetRuleDrift(context.Context, *GetRuleDriftRequest) (*GetRuleDriftResponse, error)
 	ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error)
 	GetTemplate(context.Context, *GetTemplateRequest) (*GetTemplateResponse, error)
 	UpsertTemplate(context.Context, *UpsertTemplateRequest) (*UpsertTemplateResponse, error)
 	DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteTemplateResponse, error)
+	ListTemplateRevisions(context.Context, *ListTemplateRevisionsRequest) (*ListTemplateRevisionsResponse, error)
+	GetTemplateRevision(context.Context, *GetTemplateRevisionRequest) (*GetTemplateRevisionResponse, error)
+	RollbackTemplate(context.Context, *RollbackTemplateRequest) (*RollbackTemplateResponse, error)
+	SyncTemplateRules(context.Context, *SyncTemplateRulesRequest) (*SyncTemplateRulesResponse, error)
 	RenderTemplate(context.Context, *RenderTemplateRequest) (*RenderTemplateResponse, error)
 	CreateSilence(context.Context, *CreateSilenceRequest) (*CreateSilenceResponse, error)
 	ListSilences(context.Context, *ListSilencesRequest) (*ListSilencesResponse, error)
 	GetSilence(context.Context, *GetSilenceRequest) (*GetSilenceResponse, error)
 	ExpireSilence(context.Context, *ExpireSilenceRequest) (*ExpireSilenceResponse, error)
+	ListMessages(context.Context, *ListMessagesRequest) (*ListMessagesResponse, error)
+	GetMessage(context.Context, *GetMessageRequest) (*GetMessageResponse, error)
+	RequeueMessages(context.Context, *RequeueMessagesRequest) (*RequeueMessagesResponse, error)
+	ReplayMessages(context.Context, *ReplayMessagesRequest) (*ReplayMessagesResponse, error)
+	DiscardMessages(context.Context, *DiscardMessagesRequest) (*DiscardMessagesResponse, error)
 	mustEmbedUnimplementedSirenServiceServer()
 }
 
@@ -502,6 +667,24 @@ func (UnimplementedSirenServiceServer) ListRules(context.Context, *ListRulesRequ
 func (UnimplementedSirenServiceServer) UpdateRule(context.Context, *UpdateRuleRequest) (*UpdateRuleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateRule not implemented")
 }
+func (UnimplementedSirenServiceServer) PreviewRule(context.Context, *PreviewRuleRequest) (*PreviewRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewRule not implemented")
+}
+func (UnimplementedSirenServiceServer) DeleteRule(context.Context, *DeleteRuleRequest) (*DeleteRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRule not implemented")
+}
+func (UnimplementedSirenServiceServer) ListRuleRevisions(context.Context, *ListRuleRevisionsRequest) (*ListRuleRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRuleRevisions not implemented")
+}
+func (UnimplementedSirenServiceServer) GetRuleRevision(context.Context, *GetRuleRevisionRequest) (*GetRuleRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRuleRevision not implemented")
+}
+func (UnimplementedSirenServiceServer) RollbackRule(context.Context, *RollbackRuleRequest) (*RollbackRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackRule not implemented")
+}
+func (UnimplementedSirenServiceServer) GetRuleDrift(context.Context, *GetRuleDriftRequest) (*GetRuleDriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRuleDrift not implemented")
+}
 func (UnimplementedSirenServiceServer) ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
 }
@@ -514,6 +697,18 @@ func (UnimplementedSirenServiceServer) UpsertTemplate(context.Context, *UpsertTe
 func (UnimplementedSirenServiceServer) DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteTemplateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplate not implemented")
 }
+func (UnimplementedSirenServiceServer) ListTemplateRevisions(context.Context, *ListTemplateRevisionsRequest) (*ListTemplateRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateRevisions not implemented")
+}
+func (UnimplementedSirenServiceServer) GetTemplateRevision(context.Context, *GetTemplateRevisionRequest) (*GetTemplateRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplateRevision not implemented")
+}
+func (UnimplementedSirenServiceServer) RollbackTemplate(context.Context, *RollbackTemplateRequest) (*RollbackTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackTemplate not implemented")
+}
+func (UnimplementedSirenServiceServer) SyncTemplateRules(context.Context, *SyncTemplateRulesRequest) (*SyncTemplateRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncTemplateRules not implemented")
+}
 func (UnimplementedSirenServiceServer) RenderTemplate(context.Context, *RenderTemplateRequest) (*RenderTemplateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RenderTemplate not implemented")
 }
@@ -529,6 +724,21 @@ func (UnimplementedSirenServiceServer) GetSilence(context.Context, *GetSilenceRe
 func (UnimplementedSirenServiceServer) ExpireSilence(context.Context, *ExpireSilenceRequest) (*ExpireSilenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExpireSilence not implemented")
 }
+func (UnimplementedSirenServiceServer) ListMessages(context.Context, *ListMessagesRequest) (*ListMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMessages not implemented")
+}
+func (UnimplementedSirenServiceServer) GetMessage(context.Context, *GetMessageRequest) (*GetMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessage not implemented")
+}
+func (UnimplementedSirenServiceServer) RequeueMessages(context.Context, *RequeueMessagesRequest) (*RequeueMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequeueMessages not implemented")
+}
+func (UnimplementedSirenServiceServer) ReplayMessages(context.Context, *ReplayMessagesRequest) (*ReplayMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayMessages not implemented")
+}
+func (UnimplementedSirenServiceServer) DiscardMessages(context.Context, *DiscardMessagesRequest) (*DiscardMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscardMessages not implemented")
+}
 func (UnimplementedSirenServiceServer) mustEmbedUnimplementedSirenServiceServer() {}
 
 // UnsafeSirenServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1010,6 +1220,114 @@ func _SirenService_UpdateRule_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SirenService_PreviewRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).PreviewRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/PreviewRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).PreviewRule(ctx, req.(*PreviewRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_DeleteRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).DeleteRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/DeleteRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).DeleteRule(ctx, req.(*DeleteRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_ListRuleRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRuleRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).ListRuleRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/ListRuleRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).ListRuleRevisions(ctx, req.(*ListRuleRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_GetRuleRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuleRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).GetRuleRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/GetRuleRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).GetRuleRevision(ctx, req.(*GetRuleRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_RollbackRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).RollbackRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/RollbackRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).RollbackRule(ctx, req.(*RollbackRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_GetRuleDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuleDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).GetRuleDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/GetRuleDrift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).GetRuleDrift(ctx, req.(*GetRuleDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SirenService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListTemplatesRequest)
 	if err := dec(in); err != nil {
@@ -1082,6 +1400,78 @@ func _SirenService_DeleteTemplate_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SirenService_ListTemplateRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplateRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).ListTemplateRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/ListTemplateRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).ListTemplateRevisions(ctx, req.(*ListTemplateRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_GetTemplateRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplateRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).GetTemplateRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/GetTemplateRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).GetTemplateRevision(ctx, req.(*GetTemplateRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_RollbackTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).RollbackTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/RollbackTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).RollbackTemplate(ctx, req.(*RollbackTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_SyncTemplateRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncTemplateRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).SyncTemplateRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/SyncTemplateRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).SyncTemplateRules(ctx, req.(*SyncTemplateRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SirenService_RenderTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RenderTemplateRequest)
 	if err := dec(in); err != nil {
@@ -1172,6 +1562,96 @@ func _SirenService_ExpireSilence_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SirenService_ListMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).ListMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/ListMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).ListMessages(ctx, req.(*ListMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_GetMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).GetMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/GetMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).GetMessage(ctx, req.(*GetMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_RequeueMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequeueMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).RequeueMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/RequeueMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).RequeueMessages(ctx, req.(*RequeueMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_ReplayMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).ReplayMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/ReplayMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).ReplayMessages(ctx, req.(*ReplayMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_DiscardMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).DiscardMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/DiscardMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).DiscardMessages(ctx, req.(*DiscardMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SirenService_ServiceDesc is the grpc.ServiceDesc for SirenService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1283,6 +1763,30 @@ var SirenService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateRule",
 			Handler:    _SirenService_UpdateRule_Handler,
 		},
+		{
+			MethodName: "PreviewRule",
+			Handler:    _SirenService_PreviewRule_Handler,
+		},
+		{
+			MethodName: "DeleteRule",
+			Handler:    _SirenService_DeleteRule_Handler,
+		},
+		{
+			MethodName: "ListRuleRevisions",
+			Handler:    _SirenService_ListRuleRevisions_Handler,
+		},
+		{
+			MethodName: "GetRuleRevision",
+			Handler:    _SirenService_GetRuleRevision_Handler,
+		},
+		{
+			MethodName: "RollbackRule",
+			Handler:    _SirenService_RollbackRule_Handler,
+		},
+		{
+			MethodName: "GetRuleDrift",
+			Handler:    _SirenService_GetRuleDrift_Handler,
+		},
 		{
 			MethodName: "ListTemplates",
 			Handler:    _SirenService_ListTemplates_Handler,
@@ -1299,6 +1803,22 @@ var SirenService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTemplate",
 			Handler:    _SirenService_DeleteTemplate_Handler,
 		},
+		{
+			MethodName: "ListTemplateRevisions",
+			Handler:    _SirenService_ListTemplateRevisions_Handler,
+		},
+		{
+			MethodName: "GetTemplateRevision",
+			Handler:    _SirenService_GetTemplateRevision_Handler,
+		},
+		{
+			MethodName: "RollbackTemplate",
+			Handler:    _SirenService_RollbackTemplate_Handler,
+		},
+		{
+			MethodName: "SyncTemplateRules",
+			Handler:    _SirenService_SyncTemplateRules_Handler,
+		},
 		{
 			MethodName: "RenderTemplate",
 			Handler:    _SirenService_RenderTemplate_Handler,
@@ -1319,6 +1839,26 @@ var SirenService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExpireSilence",
 			Handler:    _SirenService_ExpireSilence_Handler,
 		},
+		{
+			MethodName: "ListMessages",
+			Handler:    _SirenService_ListMessages_Handler,
+		},
+		{
+			MethodName: "GetMessage",
+			Handler:    _SirenService_GetMessage_Handler,
+		},
+		{
+			MethodName: "RequeueMessages",
+			Handler:    _SirenService_RequeueMessages_Handler,
+		},
+		{
+			MethodName: "ReplayMessages",
+			Handler:    _SirenService_ReplayMessages_Handler,
+		},
+		{
+			MethodName: "DiscardMessages",
+			Handler:    _SirenService_DiscardMessages_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "odpf/siren/v1beta1/siren.proto",